@@ -0,0 +1,96 @@
+package contentfilter
+
+import (
+	"log"
+	"os"
+	"strings"
+)
+
+// What happens to content, from least to most strict
+type Action int
+
+const (
+	// The content is published
+	Allow Action = iota
+	// The content is saved but only shown once a moderator approved it
+	Hold
+	// The content is not saved at all
+	Reject
+)
+
+// What a filter made of some content, and why
+type Verdict struct {
+	Action Action
+	// Why the content was held or rejected, shown to the author and to
+	// moderators
+	Reason string
+}
+
+// Checks content users write, such as posts and comments, before it is
+// saved
+type Filter interface {
+	Check(text string) Verdict
+}
+
+// Runs each filter and keeps the strictest verdict, the first one found of
+// equal ones
+type chain []Filter
+
+func Chain(filters ...Filter) Filter {
+	return chain(filters)
+}
+
+func (c chain) Check(text string) Verdict {
+	verdict := Verdict{Action: Allow}
+
+	for _, filter := range c {
+		if result := filter.Check(text); result.Action > verdict.Action {
+			verdict = result
+		}
+	}
+
+	return verdict
+}
+
+// Builds the filter from the environment. Content with any of the words or
+// phrases in CONTENT_BLOCKED_WORDS is rejected and content with any in
+// CONTENT_REVIEW_WORDS is held for review, both comma separated. Links to
+// the domains in CONTENT_BLOCKED_DOMAINS, or their subdomains, are
+// rejected. CONTENT_REVIEW_LINKS=true holds content with any other link.
+// Without any of them everything is allowed.
+func FromEnvironment() Filter {
+	filters := []Filter{}
+
+	if words := splitList(os.Getenv("CONTENT_BLOCKED_WORDS")); len(words) > 0 {
+		filters = append(filters, NewWordFilter(words, Reject))
+	}
+
+	if words := splitList(os.Getenv("CONTENT_REVIEW_WORDS")); len(words) > 0 {
+		filters = append(filters, NewWordFilter(words, Hold))
+	}
+
+	if domains := splitList(os.Getenv("CONTENT_BLOCKED_DOMAINS")); len(domains) > 0 {
+		filters = append(filters, NewLinkFilter(domains, Reject))
+	}
+
+	if os.Getenv("CONTENT_REVIEW_LINKS") == "true" {
+		filters = append(filters, NewLinkFilter(nil, Hold))
+	}
+
+	if len(filters) == 0 {
+		log.Println("Content filter disabled, allowing everything")
+	}
+
+	return Chain(filters...)
+}
+
+func splitList(list string) []string {
+	items := []string{}
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,62 @@
+package contentfilter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWordFilter(t *testing.T) {
+	filter := NewWordFilter([]string{"Scam", "free money"}, Hold)
+
+	assert.Equal(t, Verdict{Action: Hold, Reason: `contains "scam"`}, filter.Check("This is a SCAM!"))
+	assert.Equal(t, Hold, filter.Check("Get free\nmoney now").Action)
+
+	// Only whole words
+	assert.Equal(t, Allow, filter.Check("Scampi for everyone").Action)
+	assert.Equal(t, Allow, filter.Check("Money isn't free").Action)
+}
+
+func TestLinkFilter_AnyLink(t *testing.T) {
+	filter := NewLinkFilter(nil, Hold)
+
+	assert.Equal(t, Verdict{Action: Hold, Reason: "contains a link"}, filter.Check("Sign up at https://example.org/form"))
+	assert.Equal(t, Hold, filter.Check("See www.example.org").Action)
+	assert.Equal(t, Allow, filter.Check("Meet at 9.30, bring node.js notes").Action)
+}
+
+func TestLinkFilter_Domains(t *testing.T) {
+	filter := NewLinkFilter([]string{"www.Spam.example"}, Reject)
+
+	assert.Equal(t, Verdict{Action: Reject, Reason: "links to spam.example"}, filter.Check("Go to http://user@deals.spam.example:8080/now"))
+	assert.Equal(t, Reject, filter.Check("visit spam.example today").Action)
+
+	// Other domains, and ones only ending the same
+	assert.Equal(t, Allow, filter.Check("https://example.org and notspam.example").Action)
+}
+
+func TestChain(t *testing.T) {
+	filter := Chain(
+		NewWordFilter([]string{"deal"}, Hold),
+		NewLinkFilter([]string{"spam.example"}, Reject),
+		NewWordFilter([]string{"spam"}, Hold),
+	)
+
+	// The strictest verdict wins, the first of equal ones
+	assert.Equal(t, Reject, filter.Check("Great deal at spam.example").Action)
+	assert.Equal(t, `contains "deal"`, filter.Check("spam deal").Reason)
+	assert.Equal(t, Allow, Chain().Check("anything").Action)
+}
+
+func TestFromEnvironment(t *testing.T) {
+	t.Setenv("CONTENT_BLOCKED_WORDS", "slur, ")
+	t.Setenv("CONTENT_REVIEW_WORDS", "")
+	t.Setenv("CONTENT_BLOCKED_DOMAINS", "")
+	t.Setenv("CONTENT_REVIEW_LINKS", "true")
+
+	filter := FromEnvironment()
+
+	assert.Equal(t, Reject, filter.Check("a slur").Action)
+	assert.Equal(t, Hold, filter.Check("https://example.org").Action)
+	assert.Equal(t, Allow, filter.Check("See you Saturday").Action)
+}
@@ -0,0 +1,78 @@
+package contentfilter
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Links starting with a scheme or www.
+var linkPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)[^\s<>"']+`)
+
+// Bare domains, such as example.com/page, checked against blocked domains
+var domainPattern = regexp.MustCompile(`(?i)\b(?:[a-z0-9](?:[a-z0-9-]*[a-z0-9])?\.)+[a-z]{2,}\b`)
+
+// Matches links. With domains, only links to them or their subdomains,
+// bare ones like example.com included. Without, any link starting with a
+// scheme or www.
+type linkFilter struct {
+	domains []string
+	action  Action
+}
+
+func NewLinkFilter(domains []string, action Action) Filter {
+	normalized := []string{}
+	for _, domain := range domains {
+		domain = strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+		if domain != "" {
+			normalized = append(normalized, domain)
+		}
+	}
+
+	return linkFilter{
+		domains: normalized,
+		action:  action,
+	}
+}
+
+func (f linkFilter) Check(text string) Verdict {
+	if len(f.domains) == 0 {
+		if link := linkPattern.FindString(text); link != "" {
+			return Verdict{Action: f.action, Reason: "contains a link"}
+		}
+
+		return Verdict{Action: Allow}
+	}
+
+	hosts := domainPattern.FindAllString(text, -1)
+	for _, link := range linkPattern.FindAllString(text, -1) {
+		if host := linkHost(link); host != "" {
+			hosts = append(hosts, host)
+		}
+	}
+
+	for _, host := range hosts {
+		host = strings.ToLower(host)
+		for _, domain := range f.domains {
+			if host == domain || strings.HasSuffix(host, "."+domain) {
+				return Verdict{Action: f.action, Reason: "links to " + domain}
+			}
+		}
+	}
+
+	return Verdict{Action: Allow}
+}
+
+// The host the link points at, without credentials or port
+func linkHost(link string) string {
+	if !strings.Contains(link, "://") {
+		link = "http://" + link
+	}
+
+	parsed, err := url.Parse(link)
+	if err != nil {
+		return ""
+	}
+
+	return parsed.Hostname()
+}
@@ -0,0 +1,47 @@
+package contentfilter
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Matches whole words and phrases, ignoring case and punctuation, so
+// "class" doesn't match "ass" but "Ass!" does
+type wordFilter struct {
+	words  []string
+	action Action
+}
+
+func NewWordFilter(words []string, action Action) Filter {
+	normalized := []string{}
+	for _, word := range words {
+		if word = normalizeWords(word); word != "" {
+			normalized = append(normalized, word)
+		}
+	}
+
+	return wordFilter{
+		words:  normalized,
+		action: action,
+	}
+}
+
+func (f wordFilter) Check(text string) Verdict {
+	padded := " " + normalizeWords(text) + " "
+
+	for _, word := range f.words {
+		if strings.Contains(padded, " "+word+" ") {
+			return Verdict{Action: f.action, Reason: `contains "` + word + `"`}
+		}
+	}
+
+	return Verdict{Action: Allow}
+}
+
+// Lowercases the text and turns everything but letters and digits into
+// single spaces
+func normalizeWords(text string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}), " ")
+}
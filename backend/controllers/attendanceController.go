@@ -0,0 +1,250 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type AttendanceController interface {
+	CheckInCode(c *gin.Context)
+	CheckIn(c *gin.Context)
+	Roster(c *gin.Context)
+	Hours(c *gin.Context)
+	VerifyHours(c *gin.Context)
+}
+
+type attendanceController struct {
+	attendanceService service.AttendanceService
+}
+
+// Returns the attendance controller instantiated in the Router
+func NewAttendanceController(s service.AttendanceService) AttendanceController {
+	return attendanceController{
+		attendanceService: s,
+	}
+}
+
+// Returns the QR code the signed in volunteer shows to check in to their
+// sign-up to the event in :id, at ?occurrence= and ?shiftId=
+func (controller attendanceController) CheckInCode(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	occurrence, err := parseTimeQuery(c, "occurrence")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "occurrence must be an RFC 3339 time",
+		})
+
+		return
+	}
+
+	var shiftId uint64
+	if c.Query("shiftId") != "" {
+		if shiftId, err = strconv.ParseUint(c.Query("shiftId"), 10, 64); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "shiftId must be an unsigned integer",
+			})
+
+			return
+		}
+	}
+
+	code, err := controller.attendanceService.CheckInCode(eventId, userId, occurrence, uint(shiftId))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, code)
+}
+
+// Checks in the volunteer whose QR code a manager of the event's
+// organization scanned
+func (controller attendanceController) CheckIn(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		Token      string
+		StartHours bool
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	result, err := controller.attendanceService.CheckIn(eventId, managerId, body.Token, body.StartHours)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Lists who signed up to the event in :id, at ?occurrence=, and who has
+// checked in so far
+func (controller attendanceController) Roster(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	occurrence, err := parseTimeQuery(c, "occurrence")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "occurrence must be an RFC 3339 time",
+		})
+
+		return
+	}
+
+	roster, err := controller.attendanceService.Roster(eventId, managerId, occurrence)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, roster)
+}
+
+// Lists the hours volunteered at the event in :id
+func (controller attendanceController) Hours(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	hours, err := controller.attendanceService.Hours(eventId, managerId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, hours)
+}
+
+// Verifies or rejects the hours in :hoursId of the completed event in :id
+func (controller attendanceController) VerifyHours(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	hoursId, err := parseUintParam(c, "hoursId")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "hoursId field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		Approved bool
+		Start    *time.Time
+		End      *time.Time
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	result, err := controller.attendanceService.VerifyHours(eventId, managerId, hoursId, body.Approved, body.Start, body.End)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
@@ -0,0 +1,70 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttendanceControllerUnitTestSuite struct {
+	suite.Suite
+	c           *gin.Context
+	w           *httptest.ResponseRecorder
+	mockService *mocks.AttendanceService
+	controller  AttendanceController
+}
+
+func (suite *AttendanceControllerUnitTestSuite) SetupTest() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(suite.w)
+
+	suite.mockService = new(mocks.AttendanceService)
+	suite.controller = NewAttendanceController(suite.mockService)
+
+	suite.c.Params = gin.Params{{Key: "id", Value: "1"}}
+	suite.c.Set("userId", uint(8))
+}
+
+func (suite *AttendanceControllerUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func TestAttendanceControllerUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceControllerUnitTestSuite))
+}
+
+func (suite *AttendanceControllerUnitTestSuite) TestAttendanceController_Hours() {
+	suite.c.Request = httptest.NewRequest("GET", "/event/1/hours", nil)
+
+	volunteer := models.Users{
+		Handle:    "ada",
+		Email:     "ada@example.org",
+		Password:  "$2a$10$hash",
+		Birthdate: "2010-05-01",
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		ResetCode: uuid.New(),
+	}
+	volunteer.ID = 4
+
+	suite.mockService.On("Hours", uint(1), uint(8)).
+		Return([]models.VolunteerHours{{UsersID: 4, EventID: 1, Status: models.HoursPending, Users: volunteer}}, nil)
+
+	suite.controller.Hours(suite.c)
+
+	body := suite.w.Body.String()
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+	assert.Contains(suite.T(), body, `"Users":{"ID":4,"Handle":"ada","first":"Ada","last":"Lovelace"}`)
+
+	// Volunteers are listed without their credentials, email or birthdate
+	for _, field := range []string{"password", "ResetCode", "bday", "email"} {
+		assert.NotContains(suite.T(), body, `"`+field+`"`)
+	}
+}
@@ -0,0 +1,112 @@
+package controllers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+const calendarContentType = "text/calendar; charset=utf-8"
+
+type CalendarController interface {
+	EventCalendar(c *gin.Context)
+	UserCalendar(c *gin.Context)
+	CalendarURL(c *gin.Context)
+	ResetCalendarURL(c *gin.Context)
+}
+
+type calendarController struct {
+	calendarService service.CalendarService
+}
+
+// Returns the calendar controller instantiated in the Router
+func NewCalendarController(s service.CalendarService) CalendarController {
+	return calendarController{
+		calendarService: s,
+	}
+}
+
+// Download the event in :id as an .ics file
+func (controller calendarController) EventCalendar(c *gin.Context) {
+	id := c.Param("id")
+
+	cal, err := controller.calendarService.EventCalendar(id)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.Header("Content-Disposition", `attachment; filename="event-`+id+`.ics"`)
+	c.Data(http.StatusOK, calendarContentType, []byte(cal))
+}
+
+// The calendar feed of the user with the secret :token, for calendar apps
+// to subscribe to. The token may end in .ics.
+func (controller calendarController) UserCalendar(c *gin.Context) {
+	token := strings.TrimSuffix(c.Param("token"), ".ics")
+
+	cal, err := controller.calendarService.UserCalendar(token)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.Data(http.StatusOK, calendarContentType, []byte(cal))
+}
+
+// Returns the URL of the calendar feed of the user in :id
+func (controller calendarController) CalendarURL(c *gin.Context) {
+	controller.calendarURL(c, false)
+}
+
+// Replaces the URL of the calendar feed of the user in :id, the old one
+// stops working
+func (controller calendarController) ResetCalendarURL(c *gin.Context) {
+	controller.calendarURL(c, true)
+}
+
+func (controller calendarController) calendarURL(c *gin.Context, reset bool) {
+	userId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	// The URL alone gives access to the feed, only its owner may see it
+	if current, ok := middleware.CurrentUserId(c); !ok || current != userId {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Only the user can see their calendar URL",
+		})
+
+		return
+	}
+
+	token, err := controller.calendarService.CalendarToken(userId, reset)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"url": "/calendar/" + token + ".ics",
+	})
+}
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type DeviceController interface {
+	Register(c *gin.Context)
+	All(c *gin.Context)
+	Unregister(c *gin.Context)
+}
+
+type deviceController struct {
+	pushService service.PushService
+}
+
+// Returns the device controller instantiated in the Router
+func NewDeviceController(s service.PushService) DeviceController {
+	return deviceController{
+		pushService: s,
+	}
+}
+
+// Registers the push token of the current user's device. The app calls it
+// on every launch, as providers change tokens.
+func (controller deviceController) Register(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Platform string
+		Token    string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	device, err := controller.pushService.RegisterDevice(userId, body.Platform, body.Token)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, device)
+}
+
+// Lists the current user's signed in devices
+func (controller deviceController) All(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	devices, err := controller.pushService.Devices(userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, devices)
+}
+
+// Stops pushes to the current user's device in :id, e.g. on signing out
+func (controller deviceController) Unregister(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid device id",
+		})
+
+		return
+	}
+
+	if err := controller.pushService.UnregisterDevice(userId, id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Device unregistered",
+	})
+}
@@ -0,0 +1,166 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type DiscussionController interface {
+	Messages(c *gin.Context)
+	PostMessage(c *gin.Context)
+	DeleteMessage(c *gin.Context)
+	PinMessage(c *gin.Context)
+	UnpinMessage(c *gin.Context)
+}
+
+type discussionController struct {
+	discussionService service.DiscussionService
+}
+
+// Returns the discussion controller instantiated in the Router
+func NewDiscussionController(s service.DiscussionService) DiscussionController {
+	return discussionController{
+		discussionService: s,
+	}
+}
+
+// Lists a page of the discussion of the event in :id, latest first
+func (controller discussionController) Messages(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid event id",
+		})
+
+		return
+	}
+
+	limit := parseLimitQuery(c, 50, 100)
+
+	page, err := controller.discussionService.Messages(userId, eventId, c.Query("cursor"), limit)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Posts a message in the discussion of the event in :id
+func (controller discussionController) PostMessage(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid event id",
+		})
+
+		return
+	}
+
+	var body struct {
+		Body string `json:"body"`
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	message, err := controller.discussionService.PostMessage(userId, eventId, body.Body)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, message)
+}
+
+// Deletes the message in :messageId
+func (controller discussionController) DeleteMessage(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "messageId")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid message id",
+		})
+
+		return
+	}
+
+	if err := controller.discussionService.DeleteMessage(userId, id); err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Message deleted",
+	})
+}
+
+// Pins the message in :messageId to the top of the discussion
+func (controller discussionController) PinMessage(c *gin.Context) {
+	controller.pin(c, true)
+}
+
+// Unpins the message in :messageId
+func (controller discussionController) UnpinMessage(c *gin.Context) {
+	controller.pin(c, false)
+}
+
+func (controller discussionController) pin(c *gin.Context, pinned bool) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "messageId")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid message id",
+		})
+
+		return
+	}
+
+	message, err := controller.discussionService.PinMessage(userId, id, pinned)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, message)
+}
@@ -1,9 +1,13 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/service"
 	"github.com/gin-gonic/gin"
@@ -15,42 +19,93 @@ type EventController interface {
 	One(*gin.Context)
 	Update(*gin.Context)
 	Delete(*gin.Context)
+	Search(*gin.Context)
+	Nearby(*gin.Context)
+	Occurrences(*gin.Context)
+	EventOccurrences(*gin.Context)
+	UpdateOccurrence(*gin.Context)
+	CancelOccurrence(*gin.Context)
+	RestoreOccurrence(*gin.Context)
 }
 
 type eventController struct {
-	eventService service.EventService
+	eventService       service.EventService
+	eventStatusService service.EventStatusService
 }
 
 // All implements EventController
 func (controller eventController) All(c *gin.Context) {
-	events, err := controller.eventService.GetEvents();
-
+	loc, err := viewerLocation(c)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	var orgId uint64
+	if c.Query("organizationId") != "" {
+		if orgId, err = strconv.ParseUint(c.Query("organizationId"), 10, 64); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "organizationId must be an unsigned integer",
+			})
+
+			return
+		}
+	}
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	events, err := controller.eventService.GetEvents(parseListQuery(c, "status"), uint(orgId), viewerId);
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
 			"error" : err.Error(),
 		})
 
 		return
 	}
 
+	for i := range events {
+		events[i] = events[i].In(loc)
+	}
+
 	c.JSON(http.StatusOK, events)
 }
 
 // Create implements EventController
 func (controller eventController) Create(c *gin.Context) {
-	var err error
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
 
 	var body struct {
 		OrganizationID  uint
 		Name        	string
 		Address			string
-		Date 			time.Time
+		Start 			time.Time
+		End				time.Time
+		TimeZone		string
+		Recurrence		string
 		Description 	string
 		Interests		string
 		Skills			string
 		GoodFor			string
 		CauseAreas		string
 		Requirements 	string	
+		MinimumAge		uint
+		BackgroundCheck	bool
 	}
 
 	err = c.Bind(&body)
@@ -66,46 +121,55 @@ func (controller eventController) Create(c *gin.Context) {
 		OrganizationID: body.OrganizationID,
 		Name: body.Name,
 		Address: body.Address,
-		Date: body.Date,
+		Start: body.Start,
+		End: body.End,
+		TimeZone: body.TimeZone,
+		Recurrence: body.Recurrence,
 		Description: body.Description,
 		Interests: body.Interests,
 		Skills: body.Skills,
 		GoodFor: body.GoodFor,
 		CauseAreas: body.CauseAreas,
 		Requirements: body.Requirements,
+		MinimumAge: body.MinimumAge,
+		BackgroundCheck: body.BackgroundCheck,
 	}
 
-	res, err := controller.eventService.CreateEvent(event);
+	res, err := controller.eventService.CreateEvent(event, userId);
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(statusOf(err), gin.H{
 			"error" : err.Error(),
 		})
 
 		return
 	}
 	
-	c.JSON(http.StatusOK, res)
+	c.JSON(http.StatusOK, res.In(loc))
 }
 
 // Delete implements EventController
+//
+// Events are cancelled rather than deleted, so their volunteers are told
+// and their history is kept. ?reason= is passed on to the volunteers.
 func (controller eventController) Delete(c *gin.Context) {
-	// Get the existing object
-	id := c.Param("id")
-	org, err := controller.eventService.GetEventById(id)
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
 
+	eventId, err := parseUintParam(c, "id")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": err.Error(),
+			"error": "Invalid event id",
 		})
 
 		return
 	}
 
-	// Delete the object
-	err = controller.eventService.DeleteEvent(org)
+	_, err = controller.eventStatusService.ChangeStatus(eventId, userId, models.EventCancelled, c.Query("reason"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(statusOf(err), gin.H{
 			"error": err.Error(),
 		})
 
@@ -114,11 +178,20 @@ func (controller eventController) Delete(c *gin.Context) {
 
 	// Respond
 	c.JSON(http.StatusOK, gin.H{
-		"message": "Object deleted successfully",
+		"message": "Event cancelled successfully",
 	})}
 
 // One implements EventController
 func (controller eventController) One(c *gin.Context) {
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
 	// Get the id
 	id := c.Param("id")
 
@@ -133,11 +206,35 @@ func (controller eventController) One(c *gin.Context) {
 		return
 	}
 
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	if err := controller.eventService.CheckVisible(event, viewerId); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
 	// Return the object
-	c.JSON(http.StatusAccepted, event)}
+	c.JSON(http.StatusAccepted, event.In(loc))}
 
 // Update implements EventController
 func (controller eventController) Update(c *gin.Context) {
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	id := c.Param("id")
 
 	event, err := controller.eventService.GetEventById(id)
@@ -150,18 +247,22 @@ func (controller eventController) Update(c *gin.Context) {
 		return
 	}
 
-	// Get updates from the body
+	// Get updates from the body, events stay in their organization
 	var body struct {
-		OrganizationID  uint
 		Name        	string
 		Address			string
-		Date 			time.Time
+		Start 			time.Time
+		End				time.Time
+		TimeZone		string
+		Recurrence		string
 		Description 	string
 		Interests		string
 		Skills			string
 		GoodFor			string
 		CauseAreas		string
 		Requirements 	string
+		MinimumAge		uint
+		BackgroundCheck	bool
 	}
 
 	if err := c.Bind(&body); err != nil {
@@ -172,21 +273,25 @@ func (controller eventController) Update(c *gin.Context) {
 		return
 	}
 
-	event.OrganizationID = body.OrganizationID
 	event.Name = body.Name
 	event.Address = body.Address
-	event.Date = body.Date
+	event.Start = body.Start
+	event.End = body.End
+	event.TimeZone = body.TimeZone
+	event.Recurrence = body.Recurrence
 	event.Description = body.Description
 	event.Interests = body.Interests
 	event.Skills = body.Skills			
 	event.GoodFor = body.GoodFor			
 	event.CauseAreas = body.CauseAreas		
 	event.Requirements = body.Requirements 	
+	event.MinimumAge = body.MinimumAge
+	event.BackgroundCheck = body.BackgroundCheck
 
 	// Update the object
-	result, err := controller.eventService.UpdateEvent(event)
+	result, err := controller.eventService.UpdateEvent(event, userId)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(statusOf(err), gin.H{
 			"error": err.Error(),
 		})
 
@@ -194,11 +299,449 @@ func (controller eventController) Update(c *gin.Context) {
 	}
 
 	// Respond
+	c.JSON(http.StatusOK, result.In(loc))
+}
+
+// Search implements EventController
+func (controller eventController) Search(c *gin.Context) {
+	var query models.EventSearchQuery
+
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	query.Text = strings.TrimSpace(c.Query("q"))
+	query.Statuses = parseListQuery(c, "status")
+	query.Sort = c.Query("sort")
+	query.VerifiedOnly = c.Query("verifiedOnly") == "true"
+	query.Limit = parseLimitQuery(c, 20, 100)
+
+	if query.From, err = parseTimeQuery(c, "from"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "from must be a date (YYYY-MM-DD) or RFC 3339 time",
+		})
+
+		return
+	}
+
+	if query.To, err = parseTimeQuery(c, "to"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "to must be a date (YYYY-MM-DD) or RFC 3339 time",
+		})
+
+		return
+	}
+
+	if c.Query("organizationId") != "" {
+		orgId, err := strconv.ParseUint(c.Query("organizationId"), 10, 64)
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "organizationId must be an unsigned integer",
+			})
+
+			return
+		}
+
+		query.OrganizationID = uint(orgId)
+	}
+
+	tagFilters := map[string]*[]uint{
+		"causeAreas": &query.CauseAreas,
+		"skills":     &query.Skills,
+		"goodFor":    &query.GoodFor,
+	}
+
+	for name, ids := range tagFilters {
+		if *ids, err = parseUintListQuery(c, name); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": name + " must be a comma separated list of tag ids",
+			})
+
+			return
+		}
+	}
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	result, err := controller.eventService.SearchEvents(query, c.Query("cursor"), viewerId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	for i := range result.Events {
+		result.Events[i] = result.Events[i].In(loc)
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Nearby implements EventController
+func (controller eventController) Nearby(c *gin.Context) {
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	coordinates := map[string]float64{}
+
+	for _, name := range []string{"lat", "lng", "radius_km"} {
+		value, err := strconv.ParseFloat(c.Query(name), 64)
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": name + " is required and must be a number",
+			})
+
+			return
+		}
+
+		coordinates[name] = value
+	}
+
+	limit := parseLimitQuery(c, 20, 100)
+
+	var organizationId uint
+	if c.Query("organizationId") != "" {
+		orgId, err := strconv.ParseUint(c.Query("organizationId"), 10, 64)
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "organizationId must be an unsigned integer",
+			})
+
+			return
+		}
+
+		organizationId = uint(orgId)
+	}
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	events, err := controller.eventService.NearbyEvents(coordinates["lat"], coordinates["lng"], coordinates["radius_km"], limit, parseListQuery(c, "status"), organizationId, viewerId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	for i := range events {
+		events[i] = events[i].In(loc)
+	}
+
+	c.JSON(http.StatusOK, events)
+}
+
+// Occurrences implements EventController
+func (controller eventController) Occurrences(c *gin.Context) {
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	from, to, err := occurrenceRange(c)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	var orgId uint64
+	if c.Query("organizationId") != "" {
+		if orgId, err = strconv.ParseUint(c.Query("organizationId"), 10, 64); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "organizationId must be an unsigned integer",
+			})
+
+			return
+		}
+	}
+
+	limit := parseLimitQuery(c, 100, 500)
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	occurrences, err := controller.eventService.GetOccurrences(from, to, uint(orgId), limit, parseListQuery(c, "status"), viewerId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	for i := range occurrences {
+		occurrences[i] = occurrences[i].In(loc)
+	}
+
+	c.JSON(http.StatusOK, occurrences)
+}
+
+// EventOccurrences implements EventController
+func (controller eventController) EventOccurrences(c *gin.Context) {
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	event, err := controller.eventService.GetEventById(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	if err := controller.eventService.CheckVisible(event, viewerId); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	from, to, err := occurrenceRange(c)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	occurrences, err := controller.eventService.GetEventOccurrences(event, from, to)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	for i := range occurrences {
+		occurrences[i] = occurrences[i].In(loc)
+	}
+
+	c.JSON(http.StatusOK, occurrences)
+}
+
+// UpdateOccurrence implements EventController
+func (controller eventController) UpdateOccurrence(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	series, err := controller.eventService.GetEventById(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	var body struct {
+		OccurrenceDate	time.Time
+		Scope			string
+		Name        	string
+		Address			string
+		Start 			time.Time
+		End				time.Time
+		TimeZone		string
+		Recurrence		string
+		Description 	string
+		Interests		string
+		Skills			string
+		GoodFor			string
+		CauseAreas		string
+		Requirements 	string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	changes := models.Event {
+		Name: body.Name,
+		Address: body.Address,
+		Start: body.Start,
+		End: body.End,
+		TimeZone: body.TimeZone,
+		Recurrence: body.Recurrence,
+		Description: body.Description,
+		Interests: body.Interests,
+		Skills: body.Skills,
+		GoodFor: body.GoodFor,
+		CauseAreas: body.CauseAreas,
+		Requirements: body.Requirements,
+	}
+
+	result, err := controller.eventService.UpdateOccurrence(series, body.OccurrenceDate, body.Scope, changes, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result.In(loc))
+}
+
+// CancelOccurrence implements EventController
+func (controller eventController) CancelOccurrence(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	series, occurrence, ok := controller.seriesOccurrence(c)
+	if !ok {
+		return
+	}
+
+	result, err := controller.eventService.CancelOccurrence(series, occurrence, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
 	c.JSON(http.StatusOK, result)
 }
 
-func NewEventController(s service.EventService) EventController {
+// RestoreOccurrence implements EventController
+func (controller eventController) RestoreOccurrence(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	series, occurrence, ok := controller.seriesOccurrence(c)
+	if !ok {
+		return
+	}
+
+	if err := controller.eventService.RestoreOccurrence(series, occurrence, userId); err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Occurrence restored successfully",
+	})
+}
+
+// Reads the series in :id and the OccurrenceDate in the body. Responds
+// with an error and returns false when either is invalid.
+func (controller eventController) seriesOccurrence(c *gin.Context) (models.Event, time.Time, bool) {
+	series, err := controller.eventService.GetEventById(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return models.Event{}, time.Time{}, false
+	}
+
+	var body struct {
+		OccurrenceDate time.Time
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return models.Event{}, time.Time{}, false
+	}
+
+	return series, body.OccurrenceDate, true
+}
+
+// Reads ?from= and ?to=, defaulting to now and 30 days after from
+func occurrenceRange(c *gin.Context) (time.Time, time.Time, error) {
+	from, err := parseTimeQuery(c, "from")
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("from must be a date (YYYY-MM-DD) or RFC 3339 time")
+	}
+
+	to, err := parseTimeQuery(c, "to")
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("to must be a date (YYYY-MM-DD) or RFC 3339 time")
+	}
+
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = from.AddDate(0, 0, 30)
+	}
+
+	return from, to, nil
+}
+
+func NewEventController(s service.EventService, st service.EventStatusService) EventController {
 	return eventController{
-		eventService: s,
+		eventService:       s,
+		eventStatusService: st,
 	}
 }
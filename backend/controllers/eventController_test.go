@@ -0,0 +1,201 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventControllerUnitTestSuite struct {
+	suite.Suite
+	event       models.Event
+	c           *gin.Context
+	w           *httptest.ResponseRecorder
+	mockService *mocks.EventService
+	mockStatus  *mocks.EventStatusService
+	controller  EventController
+	err         error
+}
+
+// Ran before every test
+func (suite *EventControllerUnitTestSuite) SetupTest() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(suite.w)
+
+	suite.mockService = new(mocks.EventService)
+	suite.mockStatus = new(mocks.EventStatusService)
+	suite.controller = NewEventController(suite.mockService, suite.mockStatus)
+
+	suite.event = models.Event{}
+	suite.event.ID = 1
+	suite.event.Start = time.Date(2034, 7, 1, 14, 0, 0, 0, time.UTC)
+	suite.event.End = suite.event.Start.Add(2 * time.Hour)
+	suite.event.TimeZone = "America/Chicago"
+
+	suite.err = fmt.Errorf("error")
+
+	suite.c.AddParam("id", "1")
+	suite.c.Request = httptest.NewRequest("GET", "/", nil)
+}
+
+// Ran after every test finishes
+func (suite *EventControllerUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockService.AssertExpectations(suite.T())
+	suite.mockStatus.AssertExpectations(suite.T())
+}
+
+func TestEventControllerUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(EventControllerUnitTestSuite))
+}
+
+func (suite *EventControllerUnitTestSuite) response() models.Event {
+	var event models.Event
+	assert.Nil(suite.T(), json.Unmarshal(suite.w.Body.Bytes(), &event))
+	return event
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_One_UTCByDefault() {
+	suite.mockService.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockService.On("CheckVisible", suite.event, uint(0)).Return(nil)
+
+	suite.controller.One(suite.c)
+
+	assert.Equal(suite.T(), http.StatusAccepted, suite.w.Code)
+	assert.Contains(suite.T(), suite.w.Body.String(), `"Start":"2034-07-01T14:00:00Z"`)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_One_ViewerTimeZone() {
+	suite.c.Request = httptest.NewRequest("GET", "/?tz=Europe/Berlin", nil)
+	suite.mockService.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockService.On("CheckVisible", suite.event, uint(0)).Return(nil)
+
+	suite.controller.One(suite.c)
+
+	assert.Equal(suite.T(), http.StatusAccepted, suite.w.Code)
+	assert.Contains(suite.T(), suite.w.Body.String(), `"Start":"2034-07-01T16:00:00+02:00"`)
+	assert.True(suite.T(), suite.response().Start.Equal(suite.event.Start))
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_One_TimeZoneHeader() {
+	suite.c.Request.Header.Set("X-Timezone", "America/New_York")
+	suite.mockService.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockService.On("CheckVisible", suite.event, uint(0)).Return(nil)
+
+	suite.controller.One(suite.c)
+
+	assert.Contains(suite.T(), suite.w.Body.String(), `"End":"2034-07-01T12:00:00-04:00"`)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_One_UnknownTimeZone() {
+	suite.c.Request = httptest.NewRequest("GET", "/?tz=Mars/Olympus_Mons", nil)
+
+	suite.controller.One(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_One_Fail() {
+	suite.mockService.On("GetEventById", "1").Return(models.Event{}, suite.err)
+
+	suite.controller.One(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_One_HiddenDraft() {
+	suite.event.Status = models.EventDraft
+	suite.mockService.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockService.On("CheckVisible", suite.event, uint(0)).Return(suite.err)
+
+	suite.controller.One(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+	assert.NotContains(suite.T(), suite.w.Body.String(), "Start")
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_All_StatusFilter() {
+	suite.c.Request = httptest.NewRequest("GET", "/?status=draft,+published&organizationId=2", nil)
+	suite.c.Set("userId", uint(4))
+	suite.mockService.On("GetEvents", []string{models.EventDraft, models.EventPublished}, uint(2), uint(4)).
+		Return([]models.Event{suite.event}, nil)
+
+	suite.controller.All(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_All_NotStaff() {
+	suite.c.Request = httptest.NewRequest("GET", "/?status=draft&organizationId=2", nil)
+	suite.mockService.On("GetEvents", []string{models.EventDraft}, uint(2), uint(0)).
+		Return([]models.Event{}, service.ErrNotStaff)
+
+	suite.controller.All(suite.c)
+
+	assert.Equal(suite.T(), http.StatusForbidden, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_Create_NotManager() {
+	suite.c.Set("userId", uint(4))
+	suite.c.Request = httptest.NewRequest("POST", "/event/", strings.NewReader(`{"organizationId": 2, "name": "Park cleanup"}`))
+	suite.c.Request.Header.Set("Content-Type", "application/json")
+	suite.mockService.On("CreateEvent", mock.Anything, uint(4)).Return(models.Event{}, service.ErrNotManager)
+
+	suite.controller.Create(suite.c)
+
+	assert.Equal(suite.T(), http.StatusForbidden, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_Update_SignedOut() {
+	suite.c.Request = httptest.NewRequest("PUT", "/event/1", strings.NewReader(`{"name": "Park cleanup"}`))
+
+	suite.controller.Update(suite.c)
+
+	assert.Equal(suite.T(), http.StatusUnauthorized, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_Update_IgnoresOrganization() {
+	suite.event.OrganizationID = 2
+	suite.c.Set("userId", uint(4))
+	suite.c.Request = httptest.NewRequest("PUT", "/event/1", strings.NewReader(`{"organizationId": 9, "name": "Park cleanup"}`))
+	suite.c.Request.Header.Set("Content-Type", "application/json")
+	suite.mockService.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockService.On("UpdateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.OrganizationID == 2 && event.Name == "Park cleanup"
+	}), uint(4)).Return(suite.event, nil)
+
+	suite.controller.Update(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_Delete_Cancels() {
+	// Deleting cancels, so the volunteers are told and the history is kept
+	suite.c.Set("userId", uint(4))
+	suite.c.Request = httptest.NewRequest("DELETE", "/event/1?reason=Storm", nil)
+	suite.mockStatus.On("ChangeStatus", uint(1), uint(4), models.EventCancelled, "Storm").Return(suite.event, nil)
+
+	suite.controller.Delete(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *EventControllerUnitTestSuite) TestEventController_Delete_NotManager() {
+	suite.c.Set("userId", uint(4))
+	suite.mockStatus.On("ChangeStatus", uint(1), uint(4), models.EventCancelled, "").Return(models.Event{}, service.ErrNotManager)
+
+	suite.controller.Delete(suite.c)
+
+	assert.Equal(suite.T(), http.StatusForbidden, suite.w.Code)
+}
@@ -0,0 +1,106 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type EventStatusController interface {
+	ChangeStatus(c *gin.Context)
+	StatusHistory(c *gin.Context)
+}
+
+type eventStatusController struct {
+	eventStatusService service.EventStatusService
+}
+
+// Returns the event status controller instantiated in the Router
+func NewEventStatusController(s service.EventStatusService) EventStatusController {
+	return eventStatusController{
+		eventStatusService: s,
+	}
+}
+
+// Publishes, cancels or completes the event in :id
+func (controller eventStatusController) ChangeStatus(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		Status string
+		Reason string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	loc, err := viewerLocation(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	event, err := controller.eventStatusService.ChangeStatus(eventId, userId, body.Status, body.Reason)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, event.In(loc))
+}
+
+// Lists the status changes of the event in :id
+func (controller eventStatusController) StatusHistory(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	changes, err := controller.eventStatusService.StatusHistory(eventId, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, changes)
+}
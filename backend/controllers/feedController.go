@@ -0,0 +1,73 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type FeedController interface {
+	UserFeed(c *gin.Context)
+}
+
+type feedController struct {
+	feedService service.FeedService
+}
+
+// Returns the feed controller instantiated in the Router
+func NewFeedController(s service.FeedService) FeedController {
+	return feedController{
+		feedService: s,
+	}
+}
+
+// Returns a page of the feed of the signed in user in :id, best first. Pass ?order=latest for the
+// newest first instead, and the previous page's nextCursor as ?cursor= to
+// get the following page.
+func (controller feedController) UserFeed(c *gin.Context) {
+	userId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	// Feeds hold posts only their owner may see
+	if current, ok := middleware.CurrentUserId(c); !ok || current != userId {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Only the user can see their feed",
+		})
+
+		return
+	}
+
+	limit := parseLimitQuery(c, 20, 100)
+
+	var page models.FeedPage
+
+	switch c.DefaultQuery("order", "ranked") {
+	case "ranked":
+		page, err = controller.feedService.RankedFeed(userId, c.Query("cursor"), limit)
+	case "latest":
+		page, err = controller.feedService.GetFeed(userId, c.Query("cursor"), limit)
+	default:
+		err = errors.New("order must be ranked or latest")
+	}
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
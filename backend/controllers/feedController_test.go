@@ -0,0 +1,58 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeedControllerUnitTestSuite struct {
+	suite.Suite
+	c           *gin.Context
+	w           *httptest.ResponseRecorder
+	mockService *mocks.FeedService
+	controller  FeedController
+}
+
+func (suite *FeedControllerUnitTestSuite) SetupTest() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(suite.w)
+
+	suite.mockService = new(mocks.FeedService)
+	suite.controller = NewFeedController(suite.mockService)
+
+	suite.c.Request = httptest.NewRequest("GET", "/user/4/feed?order=latest", nil)
+	suite.c.Params = gin.Params{{Key: "id", Value: "4"}}
+}
+
+func (suite *FeedControllerUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func TestFeedControllerUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedControllerUnitTestSuite))
+}
+
+func (suite *FeedControllerUnitTestSuite) TestFeedController_UserFeed() {
+	suite.c.Set("userId", uint(4))
+	suite.mockService.On("GetFeed", uint(4), "", 20).Return(models.FeedPage{}, nil)
+
+	suite.controller.UserFeed(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *FeedControllerUnitTestSuite) TestFeedController_UserFeed_OtherUser() {
+	// Someone else's feed would show posts only they may see
+	suite.c.Set("userId", uint(6))
+
+	suite.controller.UserFeed(suite.c)
+
+	assert.Equal(suite.T(), http.StatusForbidden, suite.w.Code)
+}
@@ -0,0 +1,151 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type FollowController interface {
+	Follow(c *gin.Context)
+	Unfollow(c *gin.Context)
+	Followers(c *gin.Context)
+	Following(c *gin.Context)
+}
+
+type followController struct {
+	followService service.FollowService
+}
+
+// Returns the follow controller instantiated in the Router
+func NewFollowController(s service.FollowService) FollowController {
+	return followController{
+		followService: s,
+	}
+}
+
+// The signed in user follows an organization
+func (controller followController) Follow(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	orgId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	result, err := controller.followService.FollowOrganization(userId, orgId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// The signed in user unfollows an organization
+func (controller followController) Unfollow(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	orgId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	err = controller.followService.UnfollowOrganization(userId, orgId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Organization unfollowed",
+	})
+}
+
+// Lists an organization's followers along with the follower count
+func (controller followController) Followers(c *gin.Context) {
+	orgId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	count, err := controller.followService.CountFollowers(orgId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	followers, err := controller.followService.GetFollowers(orgId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"count":     count,
+		"followers": followers,
+	})
+}
+
+// Lists the organizations a user follows
+func (controller followController) Following(c *gin.Context) {
+	userId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	following, err := controller.followService.GetFollowing(userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, following)
+}
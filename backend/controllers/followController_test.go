@@ -0,0 +1,128 @@
+package controllers
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type FollowControllerUnitTestSuite struct {
+	suite.Suite
+	followObject models.OrgFollowers
+	c            *gin.Context
+	w            *httptest.ResponseRecorder
+	mockService  *mocks.FollowService
+	controller   FollowController
+	err          error
+}
+
+// Ran before every test
+func (suite *FollowControllerUnitTestSuite) SetupTest() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(suite.w)
+
+	suite.mockService = new(mocks.FollowService)
+	suite.controller = NewFollowController(suite.mockService)
+
+	suite.followObject.UsersID = 5
+	suite.followObject.OrganizationID = 3
+
+	suite.err = fmt.Errorf("error")
+
+	suite.c.AddParam("id", "3")
+	suite.c.Request = httptest.NewRequest("POST", "/", bytes.NewBuffer([]byte(`{"usersID": 9}`)))
+	suite.c.Request.Header.Set("Content-Type", "application/json")
+	suite.c.Set("userId", uint(5))
+}
+
+// Ran after every test finishes
+func (suite *FollowControllerUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func TestFollowControllerUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowControllerUnitTestSuite))
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Follow_BadId() {
+	suite.c.Params = gin.Params{{Key: "id", Value: "abc"}}
+	suite.controller.Follow(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Follow_Fail() {
+	suite.mockService.On("FollowOrganization", uint(5), uint(3)).Return(models.OrgFollowers{}, suite.err)
+	suite.controller.Follow(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Follow_SignedOut() {
+	suite.c, _ = gin.CreateTestContext(suite.w)
+	suite.c.AddParam("id", "3")
+	suite.c.Request = httptest.NewRequest("POST", "/", bytes.NewBuffer([]byte(`{"usersID": 5}`)))
+	suite.controller.Follow(suite.c)
+
+	assert.Equal(suite.T(), http.StatusUnauthorized, suite.w.Code)
+}
+
+// The signed in user follows, not the one named in the body
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Follow_Success() {
+	suite.mockService.On("FollowOrganization", uint(5), uint(3)).Return(suite.followObject, nil)
+	suite.controller.Follow(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Unfollow_Fail() {
+	suite.mockService.On("UnfollowOrganization", uint(5), uint(3)).Return(suite.err)
+	suite.controller.Unfollow(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Unfollow_Success() {
+	suite.mockService.On("UnfollowOrganization", uint(5), uint(3)).Return(nil)
+	suite.controller.Unfollow(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Followers_CountFail() {
+	suite.mockService.On("CountFollowers", uint(3)).Return(int64(0), suite.err)
+	suite.controller.Followers(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Followers_Success() {
+	suite.mockService.On("CountFollowers", uint(3)).Return(int64(1), nil)
+	suite.mockService.On("GetFollowers", uint(3)).Return([]models.PublicUser{{ID: 5, Handle: "ada", FirstName: "Ada", LastName: "Lovelace"}}, nil)
+	suite.controller.Followers(suite.c)
+
+	body := suite.w.Body.String()
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+	assert.Contains(suite.T(), body, `"count":1`)
+	assert.Contains(suite.T(), body, `"Handle":"ada"`)
+
+	// Followers are listed without their credentials, email or birthdate
+	for _, field := range []string{"password", "ResetCode", "bday", "email"} {
+		assert.NotContains(suite.T(), body, `"`+field+`"`)
+	}
+}
+
+func (suite *FollowControllerUnitTestSuite) TestFollowController_Following_Success() {
+	suite.mockService.On("GetFollowing", uint(3)).Return([]models.OrgFollowers{suite.followObject}, nil)
+	suite.controller.Following(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type GuardianConsentController interface {
+	RequestConsent(c *gin.Context)
+	Consents(c *gin.Context)
+	ConsentRequest(c *gin.Context)
+	RespondToConsent(c *gin.Context)
+}
+
+type guardianConsentController struct {
+	guardianConsentService service.GuardianConsentService
+}
+
+// Returns the guardian consent controller instantiated in the Router
+func NewGuardianConsentController(s service.GuardianConsentService) GuardianConsentController {
+	return guardianConsentController{
+		guardianConsentService: s,
+	}
+}
+
+// Asks the guardian of the minor in :id for consent to volunteer at the
+// event in EventID, or at any event without one
+func (controller guardianConsentController) RequestConsent(c *gin.Context) {
+	userId, ok := controller.ownUser(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		GuardianName  string
+		GuardianEmail string
+		EventID       *uint
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	consent, err := controller.guardianConsentService.RequestConsent(userId, body.GuardianName, body.GuardianEmail, body.EventID)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, consent)
+}
+
+// Lists the consents the user in :id asked for
+func (controller guardianConsentController) Consents(c *gin.Context) {
+	userId, ok := controller.ownUser(c)
+	if !ok {
+		return
+	}
+
+	consents, err := controller.guardianConsentService.Consents(userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, consents)
+}
+
+// What the guardian with the secret :token is asked to consent to
+func (controller guardianConsentController) ConsentRequest(c *gin.Context) {
+	req, err := controller.guardianConsentService.ConsentRequest(c.Param("token"))
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, req)
+}
+
+// Approves, declines or revokes the consent with the secret :token
+func (controller guardianConsentController) RespondToConsent(c *gin.Context) {
+	var body struct {
+		Approved bool
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	req, err := controller.guardianConsentService.RespondToConsent(c.Param("token"), body.Approved,
+		c.ClientIP(), c.Request.UserAgent())
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, req)
+}
+
+// The user in :id, who must be the one signed in
+func (controller guardianConsentController) ownUser(c *gin.Context) (uint, bool) {
+	userId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return 0, false
+	}
+
+	current, ok := currentUserId(c)
+	if !ok {
+		return 0, false
+	}
+
+	if current != userId {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Only the user can manage their guardian consents",
+		})
+
+		return 0, false
+	}
+
+	return userId, true
+}
@@ -67,6 +67,14 @@ func (l loginController) Login(c *gin.Context) {
 		return
 	}
 
+	if user.Suspended(time.Now()) {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error":   "Account is suspended until " + user.SuspendedUntil.Format("January 2, 2006"),
+			"success": false,
+		})
+		return
+	}
+
 	// 15 minute expire for accessToken
 	accessExpire := jwt.NewNumericDate(time.Now().Add(time.Minute * 15))
 	// 30 day expire for refreshToken
@@ -135,6 +135,36 @@ func TestLoginController_Login_PasswordsDontMatch(t *testing.T) {
 	assert.Equal(t, 400, c.Writer.Status())
 }
 
+func TestLoginController_Login_Suspended(t *testing.T) {
+	email := "test@user.com"
+	password := "password"
+	until := time.Now().Add(24 * time.Hour)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	c.AddParam("email", email)
+	c.AddParam("password", password)
+
+	var emptyUser models.Users
+
+	var user models.Users
+	user.Email = email
+	user.Password = password
+	user.SuspendedUntil = &until
+
+	mockService := new(mocks.LoginService)
+	mockService.On("FindUserFromEmail", email, emptyUser).Return(user, nil)
+	mockService.On("CompareHashedAndUserPass", []byte(password), password).Return(nil)
+
+	res := NewLoginController(mockService)
+	res.Login(c)
+
+	mockService.AssertExpectations(t)
+
+	assert.Equal(t, 403, c.Writer.Status())
+}
+
 func TestLoginController_Login_JWTErrorAccess(t *testing.T) {
 	// Verifies that a error is caught if JWT token is not successfully generated
 	email := "test@user.com"
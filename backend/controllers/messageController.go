@@ -0,0 +1,280 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type MessageController interface {
+	Conversations(c *gin.Context)
+	StartConversation(c *gin.Context)
+	Unread(c *gin.Context)
+	Messages(c *gin.Context)
+	SendMessage(c *gin.Context)
+	MarkRead(c *gin.Context)
+	Block(c *gin.Context)
+	Unblock(c *gin.Context)
+}
+
+type messageController struct {
+	messageService service.MessageService
+}
+
+// Returns the message controller instantiated in the Router
+func NewMessageController(s service.MessageService) MessageController {
+	return messageController{
+		messageService: s,
+	}
+}
+
+// Lists a page of the user's conversations, or of the inbox of the
+// organization in ?organizationId= they manage, latest message first
+func (controller messageController) Conversations(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	var orgId uint64
+	if c.Query("organizationId") != "" {
+		var err error
+		if orgId, err = strconv.ParseUint(c.Query("organizationId"), 10, 64); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "organizationId must be an unsigned integer",
+			})
+
+			return
+		}
+	}
+
+	limit := parseLimitQuery(c, 20, 100)
+
+	page, err := controller.messageService.Conversations(userId, uint(orgId), c.Query("cursor"), limit)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Starts a conversation with the user in userId or the organization in
+// organizationId, or finds the one already started
+func (controller messageController) StartConversation(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		UserID         uint `json:"userId"`
+		OrganizationID uint `json:"organizationId"`
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	conversation, err := controller.messageService.StartConversation(userId, body.UserID, body.OrganizationID)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, conversation)
+}
+
+// Counts the messages the user, and each organization they manage, did not
+// read yet
+func (controller messageController) Unread(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	unread, err := controller.messageService.Unread(userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, unread)
+}
+
+// Lists a page of the messages in the conversation in :id, latest first
+func (controller messageController) Messages(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid conversation id",
+		})
+
+		return
+	}
+
+	limit := parseLimitQuery(c, 50, 100)
+
+	page, err := controller.messageService.Messages(userId, id, c.Query("cursor"), limit)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Sends a message in the conversation in :id
+func (controller messageController) SendMessage(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid conversation id",
+		})
+
+		return
+	}
+
+	var body struct {
+		Body string `json:"body"`
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	message, err := controller.messageService.SendMessage(userId, id, body.Body)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, message)
+}
+
+// Marks the messages the user got in the conversation in :id read
+func (controller messageController) MarkRead(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid conversation id",
+		})
+
+		return
+	}
+
+	if err := controller.messageService.MarkRead(userId, id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Messages marked read",
+	})
+}
+
+// Stops the user in :id and the user from messaging each other
+func (controller messageController) Block(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	otherId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user id",
+		})
+
+		return
+	}
+
+	if err := controller.messageService.Block(userId, otherId); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "User blocked",
+	})
+}
+
+// Lifts the user's block of the user in :id
+func (controller messageController) Unblock(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	otherId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user id",
+		})
+
+		return
+	}
+
+	if err := controller.messageService.Unblock(userId, otherId); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "User unblocked",
+	})
+}
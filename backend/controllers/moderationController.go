@@ -0,0 +1,183 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ModerationController interface {
+	ReportPost(c *gin.Context)
+	ReportComment(c *gin.Context)
+	ReportUser(c *gin.Context)
+	ReportOrganization(c *gin.Context)
+	ReportEvent(c *gin.Context)
+	Cases(c *gin.Context)
+	Case(c *gin.Context)
+	Act(c *gin.Context)
+}
+
+type moderationController struct {
+	moderationService service.ModerationService
+}
+
+// Returns the moderation controller instantiated in the Router
+func NewModerationController(s service.ModerationService) ModerationController {
+	return moderationController{
+		moderationService: s,
+	}
+}
+
+// Reports the post in :id to the moderators
+func (controller moderationController) ReportPost(c *gin.Context) {
+	controller.report(c, models.SubjectPost)
+}
+
+// Reports the comment in :id to the moderators
+func (controller moderationController) ReportComment(c *gin.Context) {
+	controller.report(c, models.SubjectComment)
+}
+
+// Reports the user in :id to the moderators
+func (controller moderationController) ReportUser(c *gin.Context) {
+	controller.report(c, models.SubjectUser)
+}
+
+// Reports the organization in :id to the moderators
+func (controller moderationController) ReportOrganization(c *gin.Context) {
+	controller.report(c, models.SubjectOrganization)
+}
+
+// Reports the event in :id to the moderators
+func (controller moderationController) ReportEvent(c *gin.Context) {
+	controller.report(c, models.SubjectEvent)
+}
+
+func (controller moderationController) report(c *gin.Context, subjectType string) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	subjectId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid id",
+		})
+
+		return
+	}
+
+	var body struct {
+		Reason  string
+		Details string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	report, err := controller.moderationService.Report(userId, subjectType, subjectId, body.Reason, body.Details)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, report)
+}
+
+// Lists a page of the moderation queue, oldest first. ?status= is open, the
+// default, or closed. ?cursor= continues from the previous page.
+func (controller moderationController) Cases(c *gin.Context) {
+	limit := parseLimitQuery(c, 20, 100)
+
+	page, err := controller.moderationService.Cases(c.DefaultQuery("status", models.CaseOpen), c.Query("cursor"), limit)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Returns the case in :id with its reports, actions and what it is about
+func (controller moderationController) Case(c *gin.Context) {
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid case id",
+		})
+
+		return
+	}
+
+	moderationCase, err := controller.moderationService.Case(id)
+
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, moderationCase)
+}
+
+// Acts on the case in :id and closes it
+func (controller moderationController) Act(c *gin.Context) {
+	moderatorId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid case id",
+		})
+
+		return
+	}
+
+	var body struct {
+		Action string
+		Note   string
+		// How long to suspend for
+		Days int
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	moderationCase, err := controller.moderationService.Act(moderatorId, id, body.Action, body.Note, body.Days)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, moderationCase)
+}
@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController interface {
+	Inbox(c *gin.Context)
+	UnreadCount(c *gin.Context)
+	MarkRead(c *gin.Context)
+	Delete(c *gin.Context)
+	Preferences(c *gin.Context)
+	SetPreference(c *gin.Context)
+}
+
+type notificationController struct {
+	notificationService service.NotificationService
+}
+
+// Returns the notification controller instantiated in the Router
+func NewNotificationController(s service.NotificationService) NotificationController {
+	return notificationController{
+		notificationService: s,
+	}
+}
+
+// Lists the current user's notifications, latest first. ?unread=true
+// leaves out read ones, ?cursor= continues from the previous page.
+func (controller notificationController) Inbox(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	limit := parseLimitQuery(c, 20, 100)
+
+	page, err := controller.notificationService.Inbox(userId, c.Query("cursor"), limit, c.Query("unread") == "true")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// How many of the current user's notifications are unread
+func (controller notificationController) UnreadCount(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	count, err := controller.notificationService.UnreadCount(userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"unread": count,
+	})
+}
+
+// Marks the current user's notifications in Ids as read, or all of them
+// when Ids is empty
+func (controller notificationController) MarkRead(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Ids []uint
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	marked, err := controller.notificationService.MarkRead(userId, body.Ids)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"marked": marked,
+	})
+}
+
+// Deletes the current user's notification in :id
+func (controller notificationController) Delete(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid notification id",
+		})
+
+		return
+	}
+
+	if err := controller.notificationService.DeleteNotification(userId, id); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Notification deleted",
+	})
+}
+
+// Lists the channels the current user gets each type of notification on
+func (controller notificationController) Preferences(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	preferences, err := controller.notificationService.Preferences(userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, preferences)
+}
+
+// Chooses the channels the current user gets notifications of Type on
+func (controller notificationController) SetPreference(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	var body struct {
+		Type  string
+		InApp bool
+		Email bool
+		Push  bool
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	preference, err := controller.notificationService.SetPreference(models.NotificationPreferences{
+		UsersID: userId,
+		Type:    body.Type,
+		InApp:   body.InApp,
+		Email:   body.Email,
+		Push:    body.Push,
+	})
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, preference)
+}
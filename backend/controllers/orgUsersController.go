@@ -28,10 +28,15 @@ func NewOrgUsersController(serv service.OrgUsersService) OrgUsersController {
 	}
 }
 
-// Create new role tied to a user and organization
+// Create new role tied to a user and organization, as one of its managers
 func (o orgUsersController) CreateOrgUser(c *gin.Context) {
 	var err error
 
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	// Declare a struct for the desired request body
 	var body struct {
 		UserId         uint
@@ -56,10 +61,10 @@ func (o orgUsersController) CreateOrgUser(c *gin.Context) {
 		Role:           body.Role,
 	}
 
-	result, err := o.orgUsersService.CreateOrgUser(orgUser)
+	result, err := o.orgUsersService.CreateOrgUser(orgUser, managerId)
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(statusOf(err), gin.H{
 			"error": "Could not create new OrgUser.",
 		})
 
@@ -129,7 +134,13 @@ func (o orgUsersController) FindOrgUser(c *gin.Context) {
 	c.JSON(http.StatusAccepted, result)
 }
 
+// Change a user's role in an organization, as one of its managers
 func (o orgUsersController) UpdateOrgUser(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	// Get the userId
 	userId64, err := strconv.ParseUint(c.Param("userId"), 10, 64)
 
@@ -156,10 +167,10 @@ func (o orgUsersController) UpdateOrgUser(c *gin.Context) {
 	}
 
 	userId := uint(userId64)
-	result, err := o.orgUsersService.UpdateOrgUser(userId, body.OrganizationId, body.Role)
+	result, err := o.orgUsersService.UpdateOrgUser(userId, body.OrganizationId, body.Role, managerId)
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(statusOf(err), gin.H{
 			"error": "Could not update OrgUser with that userId/orgId.",
 		})
 
@@ -170,7 +181,13 @@ func (o orgUsersController) UpdateOrgUser(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// Remove a user from an organization, as one of its managers or the user
 func (o orgUsersController) DeleteOrgUser(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	// Get the userId
 	userId64, err := strconv.ParseUint(c.Param("userId"), 10, 64)
 
@@ -197,10 +214,10 @@ func (o orgUsersController) DeleteOrgUser(c *gin.Context) {
 	}
 
 	userId := uint(userId64)
-	err = o.orgUsersService.DeleteOrgUser(userId, body.OrganizationId)
+	err = o.orgUsersService.DeleteOrgUser(userId, body.OrganizationId, managerId)
 
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
+		c.JSON(statusOf(err), gin.H{
 			"error": "Could not delete OrgUser.",
 		})
 
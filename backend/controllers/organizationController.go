@@ -32,12 +32,18 @@ func NewOrganizationController(s service.OrganizationService) OrganizationContro
 func (controller organizationController) Create(c *gin.Context) {
 	var err error
 
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	// Declare a struct for the desired request body
 	var body struct {
 		Name string
 		Description string 
 		Verified bool 
 		Interests string 
+		Address string 
 	}
 
 	// Bind struct to context and check for error
@@ -56,9 +62,10 @@ func (controller organizationController) Create(c *gin.Context) {
 		Description: body.Description,
 		Verified: body.Verified,
 		Interests: body.Interests,
+		Address: body.Address,
 	}
 
-	res, err := controller.organizationService.CreateOrganization(object)
+	res, err := controller.organizationService.CreateOrganization(object, userId)
 
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -129,6 +136,7 @@ func (controller organizationController) Update(c *gin.Context) {
 		Description string 
 		Verified bool 
 		Interests string 
+		Address string 
 	}
 
 	if err := c.Bind(&body); err != nil {
@@ -143,6 +151,7 @@ func (controller organizationController) Update(c *gin.Context) {
 	org.Description = body.Description
 	org.Verified = body.Verified
 	org.Interests = body.Interests
+	org.Address = body.Address
 
 	// Update the object
 	result, err := controller.organizationService.UpdateOrganization(org)
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+// Parses a path parameter such as :id into a uint
+func parseUintParam(c *gin.Context, name string) (uint, error) {
+	value, err := strconv.ParseUint(c.Param(name), 10, 64)
+	return uint(value), err
+}
+
+// Reads the page size from the limit query parameter, falling back to the
+// default and capping it at max
+func parseLimitQuery(c *gin.Context, def int, max int) int {
+	limit, err := strconv.Atoi(c.Query("limit"))
+	if err != nil || limit <= 0 {
+		return def
+	}
+	if limit > max {
+		return max
+	}
+	return limit
+}
+
+// Parses a comma separated query parameter such as ?skills=1,2 into ids
+func parseUintListQuery(c *gin.Context, name string) ([]uint, error) {
+	ids := []uint{}
+
+	for _, part := range strings.Split(c.Query(name), ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		id, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return []uint{}, err
+		}
+		ids = append(ids, uint(id))
+	}
+
+	return ids, nil
+}
+
+// Parses a time query parameter given as RFC 3339 or as a plain date
+// (YYYY-MM-DD, midnight UTC). A missing parameter is the zero time.
+func parseTimeQuery(c *gin.Context, name string) (time.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	return time.Parse("2006-01-02", value)
+}
+
+// Reads the time zone to show times in from ?tz= or the X-Timezone header
+// as an IANA name such as America/Chicago. Times are shown in UTC when
+// neither is given.
+func viewerLocation(c *gin.Context) (*time.Location, error) {
+	name := c.Query("tz")
+	if name == "" {
+		name = c.GetHeader("X-Timezone")
+	}
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil || name == "Local" {
+		return nil, errors.New("unknown time zone " + name)
+	}
+
+	return loc, nil
+}
+
+// The id of the user authenticated by middleware.BasicAuth. Responds with
+// an error and returns false when there is none.
+func currentUserId(c *gin.Context) (uint, bool) {
+	userId, ok := middleware.CurrentUserId(c)
+
+	if !ok {
+		c.JSON(http.StatusUnauthorized, gin.H{
+			"error": "Authentication required",
+		})
+	}
+
+	return userId, ok
+}
+
+// 403 for permission errors, 400 otherwise
+func statusOf(err error) int {
+	if errors.Is(err, service.ErrNotManager) || errors.Is(err, service.ErrNotStaff) || errors.Is(err, service.ErrNotAuthor) ||
+		errors.Is(err, service.ErrSuspended) || errors.Is(err, service.ErrCannotMessage) ||
+		errors.Is(err, service.ErrNotParticipant) {
+		return http.StatusForbidden
+	}
+	return http.StatusBadRequest
+}
+
+// Parses a comma separated query parameter such as ?status=draft,published
+func parseListQuery(c *gin.Context, name string) []string {
+	values := []string{}
+
+	for _, part := range strings.Split(c.Query(name), ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			values = append(values, part)
+		}
+	}
+
+	return values
+}
@@ -3,6 +3,7 @@ package controllers
 import (
 	"net/http"
 
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/service"
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,9 @@ type PostsController interface {
 	EditPost(c *gin.Context)
 	FindPost(c *gin.Context)
 	AllPosts(c *gin.Context)
+	OrganizationPosts(c *gin.Context)
+	PinPost(c *gin.Context)
+	UnpinPost(c *gin.Context)
 }
 
 type postsController struct {
@@ -28,11 +32,22 @@ func NewPostsController(s service.PostsService) PostsController {
 
 var postsModel = new(models.Posts)
 
+// Posts as the current user, or as the organization in OrganizationID for
+// its managers
 func (controller postsController) CreatePost(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	var err error
 	var body struct {
-		Handle          string
-		PostDescription string
+		PostDescription        string
+		OrganizationID         *uint
+		Type                   string
+		EventID                *uint
+		Audience               string
+		AudienceOrganizationID *uint
 	}
 	err = c.Bind(&body)
 	if err != nil {
@@ -43,46 +58,47 @@ func (controller postsController) CreatePost(c *gin.Context) {
 	}
 
 	object := models.Posts{
-		Handle:          body.Handle,
-		PostDescription: body.PostDescription,
+		PostDescription:        body.PostDescription,
+		OrganizationID:         body.OrganizationID,
+		Type:                   body.Type,
+		EventID:                body.EventID,
+		Audience:               body.Audience,
+		AudienceOrganizationID: body.AudienceOrganizationID,
 	}
 
-	result, err := controller.postsService.CreatePost(object)
-
-	_ = result
+	result, err := controller.postsService.CreatePost(userId, object)
 
 	if err != nil {
-
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Creation failed",
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
 		})
 
 		return
 	}
 
 	// Respond
-	c.JSON(http.StatusOK, object)
+	c.JSON(http.StatusOK, result)
 }
 
+// Deletes the post in :id, for its author or a manager of its organization
 func (controller postsController) DeletePost(c *gin.Context) {
-	id := c.Param("id")
-
-	result, err := controller.postsService.FindPost(id)
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
 
+	id, err := parseUintParam(c, "id")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve object",
+			"error": "Invalid post id",
 		})
 
 		return
 	}
 
-	// Delete the object
-	err1 := controller.postsService.DeletePost(result)
-
-	if err1 != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not delete object",
+	if err := controller.postsService.DeletePost(userId, id); err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
 		})
 
 		return
@@ -94,50 +110,53 @@ func (controller postsController) DeletePost(c *gin.Context) {
 	})
 }
 
+// Edits the post in :id, for its author or a manager of its organization
 func (controller postsController) EditPost(c *gin.Context) {
-	id := c.Param("id")
-	result, err1 := controller.postsService.FindPost(id)
-
-	var err error
-	var body struct {
-		PostDescription string
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
 	}
-	err = c.Bind(&body)
+
+	id, err := parseUintParam(c, "id")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Request body is invalid",
+			"error": "Invalid post id",
 		})
+
 		return
 	}
-	if err1 != nil {
+
+	var body struct {
+		PostDescription string
+	}
+	if err := c.Bind(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve object",
+			"error": "Request body is invalid",
 		})
-
 		return
 	}
 
-	result.PostDescription = body.PostDescription
-
-	results1, err := controller.postsService.EditPost(result)
+	result, err := controller.postsService.EditPost(userId, id, body.PostDescription)
 
 	if err != nil {
-
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Creation failed",
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
 		})
 
 		return
 	}
 
 	// Respond
-	c.JSON(http.StatusOK, results1)
+	c.JSON(http.StatusOK, result)
 }
 
+// Returns the post in :id, if the signed in user, or anyone signed out, can
+// see it
 func (controller postsController) FindPost(c *gin.Context) {
 	id := c.Param("id")
+	viewerId, _ := middleware.CurrentUserId(c)
 
-	result, err1 := controller.postsService.FindPost(id)
+	result, err1 := controller.postsService.FindPost(id, viewerId)
 
 	if err1 != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -151,9 +170,12 @@ func (controller postsController) FindPost(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// Lists the posts the signed in user, or anyone signed out, can see
 func (controller postsController) AllPosts(c *gin.Context) {
+	viewerId, _ := middleware.CurrentUserId(c)
+
 	// Get object from the database
-	posts, err := controller.postsService.AllPosts()
+	posts, err := controller.postsService.AllPosts(viewerId)
 
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -167,12 +189,80 @@ func (controller postsController) AllPosts(c *gin.Context) {
 	c.JSON(http.StatusOK, posts)
 }
 
+// Lists the posts of the organization in :id the signed in user can see,
+// latest first, with its pinned posts on the first page. ?cursor= continues
+// from the previous page.
+func (controller postsController) OrganizationPosts(c *gin.Context) {
+	orgId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid organization id",
+		})
+
+		return
+	}
+
+	limit := parseLimitQuery(c, 20, 100)
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	page, err := controller.postsService.OrgPosts(orgId, c.Query("cursor"), limit, viewerId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Pins the post in :id to the top of its organization's page
+func (controller postsController) PinPost(c *gin.Context) {
+	controller.pin(c, true)
+}
+
+func (controller postsController) UnpinPost(c *gin.Context) {
+	controller.pin(c, false)
+}
+
+func (controller postsController) pin(c *gin.Context, pinned bool) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid post id",
+		})
+
+		return
+	}
+
+	result, err := controller.postsService.PinPost(userId, id, pinned)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
 type CommentsController interface {
 	CreateComment(c *gin.Context)
 	DeleteComment(c *gin.Context)
 	EditComment(c *gin.Context)
 	FindComment(c *gin.Context)
-	AllComments(c *gin.Context)
+	PostComments(c *gin.Context)
+	CommentHistory(c *gin.Context)
 }
 
 type commentsController struct {
@@ -187,11 +277,17 @@ func NewCommentsController(s service.CommentsService) CommentsController {
 
 var CommentsModel = new(models.Comments)
 
+// Comments as the current user, on PostsID or replying to ParentID
 func (controller commentsController) CreateComment(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
 	var err error
 	var body struct {
 		PostsID            uint
-		Handle             string
+		ParentID           *uint
 		CommentDescription string
 	}
 	err = c.Bind(&body)
@@ -204,46 +300,44 @@ func (controller commentsController) CreateComment(c *gin.Context) {
 
 	object := models.Comments{
 		PostsID:            body.PostsID,
-		Handle:             body.Handle,
+		ParentID:           body.ParentID,
 		CommentDescription: body.CommentDescription,
 	}
 
-	result, err := controller.commentsService.CreateComment(object)
-
-	_ = result
+	result, err := controller.commentsService.CreateComment(userId, object)
 
 	if err != nil {
-
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Creation failed",
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
 		})
 
 		return
 	}
 
 	// Respond
-	c.JSON(http.StatusOK, object)
+	c.JSON(http.StatusOK, result)
 }
 
+// Deletes the comment in :id, for its author or a manager of the post's
+// organization
 func (controller commentsController) DeleteComment(c *gin.Context) {
-	id := c.Param("id")
-
-	result, err := controller.commentsService.FindComment(id)
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
 
+	id, err := parseUintParam(c, "id")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve object",
+			"error": "Invalid comment id",
 		})
 
 		return
 	}
 
-	// Delete the object
-	err1 := controller.commentsService.DeleteComment(result)
-
-	if err1 != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not delete object",
+	if err := controller.commentsService.DeleteComment(userId, id); err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
 		})
 
 		return
@@ -255,141 +349,55 @@ func (controller commentsController) DeleteComment(c *gin.Context) {
 	})
 }
 
+// Edits the comment in :id, for its author or a manager of the post's
+// organization
 func (controller commentsController) EditComment(c *gin.Context) {
-	id := c.Param("id")
-	result, err1 := controller.commentsService.FindComment(id)
-
-	var err error
-	var body struct {
-		CommentDescription string
-	}
-	err = c.Bind(&body)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Request body is invalid",
-		})
+	userId, ok := currentUserId(c)
+	if !ok {
 		return
 	}
-	if err1 != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve object",
-		})
-
-		return
-	}
-
-	result.CommentDescription = body.CommentDescription
-
-	results1, err := controller.commentsService.EditComment(result)
 
+	id, err := parseUintParam(c, "id")
 	if err != nil {
-
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Creation failed",
+			"error": "Invalid comment id",
 		})
 
 		return
 	}
 
-	// Respond
-	c.JSON(http.StatusOK, results1)
-}
-
-func (controller commentsController) FindComment(c *gin.Context) {
-	id := c.Param("id")
-
-	result, err1 := controller.commentsService.FindComment(id)
-
-	if err1 != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve object",
-		})
-
-		return
-	}
-
-	// Return the object
-	c.JSON(http.StatusOK, result)
-}
-
-func (controller commentsController) AllComments(c *gin.Context) {
-	// Get object from the database
-	comments, err := controller.commentsService.AllComments()
-
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve objects",
-		})
-
-		return
-	}
-
-	// Return the array of objects
-	c.JSON(http.StatusOK, comments)
-}
-
-type LikesController interface {
-	CreateLike(c *gin.Context)
-	DeleteLike(c *gin.Context)
-	FindLike(c *gin.Context)
-	AllLikes(c *gin.Context)
-	GetLikes(c *gin.Context)
-}
-
-type likesController struct {
-	likesService service.LikesService
-}
-
-func NewLikesController(s service.LikesService) LikesController {
-	return likesController{
-		likesService: s,
-	}
-}
-
-var LikesModel = new(models.Likes)
-
-func (controller likesController) CreateLike(c *gin.Context) {
-	var err error
 	var body struct {
-		Handle  string
-		PostsID uint
+		CommentDescription string
 	}
-	err = c.Bind(&body)
-	if err != nil {
+	if err := c.Bind(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Request body is invalid",
 		})
 		return
 	}
 
-	object := models.Likes{
-		Handle:  body.Handle,
-		PostsID: body.PostsID,
-	}
-
-	result, err := controller.likesService.CreateLike(object)
-
-	_ = result
+	result, err := controller.commentsService.EditComment(userId, id, body.CommentDescription)
 
 	if err != nil {
-
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Creation failed",
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
 		})
 
 		return
 	}
 
 	// Respond
-	c.JSON(http.StatusOK, object)
+	c.JSON(http.StatusOK, result)
 }
 
-func (controller likesController) DeleteLike(c *gin.Context) {
+func (controller commentsController) FindComment(c *gin.Context) {
 	id := c.Param("id")
 
-	result, err := controller.likesService.FindLike(id)
+	viewerId, _ := middleware.CurrentUserId(c)
 
-	if err != nil {
+	result, err1 := controller.commentsService.FindComment(id, viewerId)
+
+	if err1 != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
 			"error": "Could not retrieve object",
 		})
@@ -397,69 +405,61 @@ func (controller likesController) DeleteLike(c *gin.Context) {
 		return
 	}
 
-	// Delete the object
-	err1 := controller.likesService.DeleteLike(result)
+	// Return the object
+	c.JSON(http.StatusOK, result)
+}
 
-	if err1 != nil {
+// Lists the comment threads of the post in :id, oldest first. ?cursor=
+// continues from the previous page.
+func (controller commentsController) PostComments(c *gin.Context) {
+	id, err := parseUintParam(c, "id")
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not delete object",
+			"error": "Invalid post id",
 		})
 
 		return
 	}
 
-	// Respond
-	c.JSON(http.StatusOK, gin.H{
-		"message": "Object deleted successfully",
-	})
-}
+	limit := parseLimitQuery(c, 20, 100)
 
-func (controller likesController) FindLike(c *gin.Context) {
-	id := c.Param("id")
+	viewerId, _ := middleware.CurrentUserId(c)
 
-	result, err1 := controller.likesService.FindLike(id)
+	page, err := controller.commentsService.PostComments(id, c.Query("cursor"), limit, viewerId)
 
-	if err1 != nil {
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve object",
+			"error": err.Error(),
 		})
 
 		return
 	}
 
-	// Return the object
-	c.JSON(http.StatusOK, result)
+	c.JSON(http.StatusOK, page)
 }
 
-func (controller likesController) AllLikes(c *gin.Context) {
-	// Get object from the database
-	likes, err := controller.likesService.AllLikes()
-
+// Lists what the comment in :id said before each edit
+func (controller commentsController) CommentHistory(c *gin.Context) {
+	id, err := parseUintParam(c, "id")
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve objects",
+			"error": "Invalid comment id",
 		})
 
 		return
 	}
 
-	// Return the array of objects
-	c.JSON(http.StatusOK, likes)
-}
+	viewerId, _ := middleware.CurrentUserId(c)
 
-func (controller likesController) GetLikes(c *gin.Context) {
-	id := c.Param("id")
-	// Get object from the database
-	likes, err := controller.likesService.GetLikes(id)
+	edits, err := controller.commentsService.CommentHistory(id, viewerId)
 
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not retrieve objects",
+			"error": err.Error(),
 		})
 
 		return
 	}
 
-	// Return the array of objects
-	c.JSON(http.StatusOK, likes)
+	c.JSON(http.StatusOK, edits)
 }
@@ -0,0 +1,152 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ReactionController interface {
+	React(c *gin.Context)
+	Unreact(c *gin.Context)
+	Reactions(c *gin.Context)
+	Reactors(c *gin.Context)
+}
+
+type reactionController struct {
+	reactionService service.ReactionService
+}
+
+// Returns the reaction controller instantiated in the Router
+func NewReactionController(s service.ReactionService) ReactionController {
+	return reactionController{
+		reactionService: s,
+	}
+}
+
+// Sets the current user's reaction to the post in :id
+func (controller reactionController) React(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	postId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid post id",
+		})
+
+		return
+	}
+
+	var body struct {
+		Type string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	summary, err := controller.reactionService.React(userId, postId, body.Type)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// Removes the current user's reaction to the post in :id
+func (controller reactionController) Unreact(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	postId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid post id",
+		})
+
+		return
+	}
+
+	summary, err := controller.reactionService.Unreact(userId, postId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// Counts the reactions to the post in :id by type
+func (controller reactionController) Reactions(c *gin.Context) {
+	postId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid post id",
+		})
+
+		return
+	}
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	summary, err := controller.reactionService.Reactions(postId, viewerId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, summary)
+}
+
+// Lists who reacted to the post in :id, latest first. ?type= keeps one kind
+// of reaction and ?cursor= continues from the previous page.
+func (controller reactionController) Reactors(c *gin.Context) {
+	postId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid post id",
+		})
+
+		return
+	}
+
+	limit := parseLimitQuery(c, 20, 100)
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	page, err := controller.reactionService.Reactors(postId, c.Query("type"), c.Query("cursor"), limit, viewerId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
@@ -0,0 +1,96 @@
+package controllers
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type SearchController interface {
+	TrendingHashtags(c *gin.Context)
+	HashtagPosts(c *gin.Context)
+	SearchPosts(c *gin.Context)
+}
+
+type searchController struct {
+	searchService service.SearchService
+}
+
+// Returns the search controller instantiated in the Router
+func NewSearchController(s service.SearchService) SearchController {
+	return searchController{
+		searchService: s,
+	}
+}
+
+// Lists the hashtags used most over the last ?hours= (default 24, at most
+// 720), at most ?limit= of them (default 10, at most 50)
+func (controller searchController) TrendingHashtags(c *gin.Context) {
+	hours := 24
+	if value := c.Query("hours"); value != "" {
+		var err error
+		if hours, err = strconv.Atoi(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Invalid hours",
+			})
+
+			return
+		}
+	}
+
+	limit := parseLimitQuery(c, 10, 50)
+
+	trending, err := controller.searchService.TrendingHashtags(time.Duration(hours)*time.Hour, limit)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, trending)
+}
+
+// Lists a page of the posts using the hashtag in :tag, latest first
+func (controller searchController) HashtagPosts(c *gin.Context) {
+	limit := parseLimitQuery(c, 20, 100)
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	page, err := controller.searchService.TaggedPosts(c.Param("tag"), c.Query("cursor"), limit, viewerId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
+
+// Lists a page of the posts matching ?q=, latest first
+func (controller searchController) SearchPosts(c *gin.Context) {
+	limit := parseLimitQuery(c, 20, 100)
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	page, err := controller.searchService.SearchPosts(c.Query("q"), c.Query("cursor"), limit, viewerId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, page)
+}
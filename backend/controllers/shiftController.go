@@ -0,0 +1,233 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type ShiftController interface {
+	Create(c *gin.Context)
+	All(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+}
+
+type shiftController struct {
+	shiftService service.ShiftService
+}
+
+// Returns the shift controller instantiated in the Router
+func NewShiftController(s service.ShiftService) ShiftController {
+	return shiftController{
+		shiftService: s,
+	}
+}
+
+type shiftBody struct {
+	Role         string
+	Description  string
+	Requirements string
+	Start        time.Time
+	End          time.Time
+	Capacity     int
+	SkillIds     []uint
+}
+
+func (body shiftBody) shift() models.EventShifts {
+	return models.EventShifts{
+		Role:         body.Role,
+		Description:  body.Description,
+		Requirements: body.Requirements,
+		Start:        body.Start,
+		End:          body.End,
+		Capacity:     body.Capacity,
+	}
+}
+
+// Add a shift to the event in :id, as a manager of its organization
+func (controller shiftController) Create(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body shiftBody
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	result, err := controller.shiftService.CreateShift(eventId, body.shift(), body.SkillIds, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// List the shifts of the event in :id at one ?occurrence=, the first by
+// default, with their times and how many volunteers signed up
+func (controller shiftController) All(c *gin.Context) {
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	occurrence, err := parseTimeQuery(c, "occurrence")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "occurrence must be an RFC 3339 time",
+		})
+
+		return
+	}
+
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	shifts, err := controller.shiftService.GetShifts(eventId, occurrence, viewerId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	loc, err := viewerLocation(c)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	for i := range shifts {
+		shifts[i] = shifts[i].In(loc)
+	}
+
+	c.JSON(http.StatusOK, shifts)
+}
+
+func (controller shiftController) Update(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	shift, ok := controller.eventShift(c)
+	if !ok {
+		return
+	}
+
+	var body shiftBody
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	result, err := controller.shiftService.UpdateShift(shift, body.shift(), body.SkillIds, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+func (controller shiftController) Delete(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	shift, ok := controller.eventShift(c)
+	if !ok {
+		return
+	}
+
+	if err := controller.shiftService.DeleteShift(shift, userId); err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Object deleted successfully",
+	})
+}
+
+// Loads the shift in :shiftId, which must belong to the event in :id.
+// Responds with an error and returns false otherwise.
+func (controller shiftController) eventShift(c *gin.Context) (models.EventShifts, bool) {
+	eventId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return models.EventShifts{}, false
+	}
+
+	shiftId, err := parseUintParam(c, "shiftId")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "shiftId field must be an unsigned integer.",
+		})
+
+		return models.EventShifts{}, false
+	}
+
+	shift, err := controller.shiftService.GetShiftById(shiftId)
+	if err != nil || shift.EventID != eventId {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Could not retrieve object",
+		})
+
+		return models.EventShifts{}, false
+	}
+
+	return shift, true
+}
@@ -0,0 +1,154 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type SignupController interface {
+	SignUp(c *gin.Context)
+	Withdraw(c *gin.Context)
+	Signups(c *gin.Context)
+}
+
+type signupController struct {
+	signupService service.SignupService
+}
+
+// Returns the signup controller instantiated in the Router
+func NewSignupController(s service.SignupService) SignupController {
+	return signupController{
+		signupService: s,
+	}
+}
+
+// Signs the signed in user up to an event, or to one occurrence or shift
+// of it
+func (controller signupController) SignUp(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		OccurrenceDate time.Time
+		ShiftID        uint
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	result, err := controller.signupService.SignUp(eventId, userId, body.OccurrenceDate, body.ShiftID)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Withdraws a sign-up of the signed in user
+func (controller signupController) Withdraw(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		OccurrenceDate time.Time
+		ShiftID        uint
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	if err := controller.signupService.Withdraw(eventId, userId, body.OccurrenceDate, body.ShiftID); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Sign-up withdrawn successfully",
+	})
+}
+
+// List the sign-ups to an event, optionally of one ?occurrence=. Only the
+// organization's staff see everyone's.
+func (controller signupController) Signups(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	occurrence, err := parseTimeQuery(c, "occurrence")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "occurrence must be an RFC 3339 time",
+		})
+
+		return
+	}
+
+	signups, err := controller.signupService.GetSignups(eventId, occurrence, userId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, signups)
+}
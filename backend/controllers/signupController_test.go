@@ -0,0 +1,103 @@
+package controllers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignupControllerUnitTestSuite struct {
+	suite.Suite
+	c           *gin.Context
+	w           *httptest.ResponseRecorder
+	mockService *mocks.SignupService
+	controller  SignupController
+}
+
+func (suite *SignupControllerUnitTestSuite) SetupTest() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(suite.w)
+
+	suite.mockService = new(mocks.SignupService)
+	suite.controller = NewSignupController(suite.mockService)
+
+	suite.c.Params = gin.Params{{Key: "id", Value: "1"}}
+	suite.c.Set("userId", uint(8))
+}
+
+func (suite *SignupControllerUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func TestSignupControllerUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(SignupControllerUnitTestSuite))
+}
+
+func (suite *SignupControllerUnitTestSuite) TestSignupController_Signups() {
+	suite.c.Request = httptest.NewRequest("GET", "/event/1/signups", nil)
+
+	volunteer := models.Users{
+		Handle:    "ada",
+		Email:     "ada@example.org",
+		Password:  "$2a$10$hash",
+		Birthdate: "2010-05-01",
+		FirstName: "Ada",
+		LastName:  "Lovelace",
+		ResetCode: uuid.New(),
+	}
+	volunteer.ID = 4
+
+	suite.mockService.On("GetSignups", uint(1), time.Time{}, uint(8)).
+		Return([]models.EventSignups{{EventID: 1, UsersID: 4, Users: volunteer}}, nil)
+
+	suite.controller.Signups(suite.c)
+
+	body := suite.w.Body.String()
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+	assert.Contains(suite.T(), body, `"Users":{"ID":4,"Handle":"ada","first":"Ada","last":"Lovelace"}`)
+
+	// Volunteers are listed without their credentials, email or birthdate
+	for _, field := range []string{"password", "ResetCode", "bday", "email"} {
+		assert.NotContains(suite.T(), body, `"`+field+`"`)
+	}
+}
+
+func (suite *SignupControllerUnitTestSuite) TestSignupController_Signups_SignedOut() {
+	suite.c, _ = gin.CreateTestContext(suite.w)
+	suite.c.Request = httptest.NewRequest("GET", "/event/1/signups", nil)
+
+	suite.controller.Signups(suite.c)
+
+	assert.Equal(suite.T(), http.StatusUnauthorized, suite.w.Code)
+}
+
+func (suite *SignupControllerUnitTestSuite) TestSignupController_SignUp() {
+	// Whoever the body names, the signed in user is signed up
+	suite.c.Request = httptest.NewRequest("POST", "/event/1/signups", strings.NewReader(`{"usersID": 5, "shiftId": 3}`))
+	suite.c.Request.Header.Set("Content-Type", "application/json")
+	suite.mockService.On("SignUp", uint(1), uint(8), time.Time{}, uint(3)).Return(models.EventSignups{EventID: 1, UsersID: 8, ShiftID: 3}, nil)
+
+	suite.controller.SignUp(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *SignupControllerUnitTestSuite) TestSignupController_Withdraw_SignedOut() {
+	suite.c, _ = gin.CreateTestContext(suite.w)
+	suite.c.Params = gin.Params{{Key: "id", Value: "1"}}
+	suite.c.Request = httptest.NewRequest("DELETE", "/event/1/signups", strings.NewReader(`{"usersID": 5}`))
+	suite.c.Request.Header.Set("Content-Type", "application/json")
+
+	suite.controller.Withdraw(suite.c)
+
+	assert.Equal(suite.T(), http.StatusUnauthorized, suite.w.Code)
+}
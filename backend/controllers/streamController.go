@@ -0,0 +1,125 @@
+package controllers
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+// How often an idle stream is pinged so proxies keep it open
+const streamHeartbeat = 25 * time.Second
+
+type StreamController interface {
+	Stream(c *gin.Context)
+	Ticket(c *gin.Context)
+}
+
+type streamController struct {
+	streamService service.StreamService
+}
+
+// Returns the stream controller instantiated in the Router
+func NewStreamController(s service.StreamService) StreamController {
+	return streamController{
+		streamService: s,
+	}
+}
+
+// Streams the current user's notifications as Server-Sent Events, along
+// with what happens on the posts in ?posts= and, for managers, to the
+// rosters of the events in ?events=, until the client disconnects. Clients
+// that cannot send the token header pass a ?ticket= instead.
+func (controller streamController) Stream(c *gin.Context) {
+	userId, ok := middleware.CurrentUserId(c)
+	if !ok {
+		if userId, ok = controller.streamService.RedeemTicket(c.Query("ticket")); !ok {
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"error": "Authentication required",
+			})
+
+			return
+		}
+	}
+
+	postIds, err := parseUintListQuery(c, "posts")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid post ids",
+		})
+
+		return
+	}
+
+	eventIds, err := parseUintListQuery(c, "events")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid event ids",
+		})
+
+		return
+	}
+
+	subscription, err := controller.streamService.Subscribe(userId, postIds, eventIds)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	defer controller.streamService.Unsubscribe(subscription)
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent("ready", gin.H{
+		"topics": subscription.Topics,
+	})
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case event, open := <-subscription.Events:
+			if !open {
+				return false
+			}
+			c.SSEvent(event.Type, event)
+			return true
+		case <-heartbeat.C:
+			c.SSEvent("ping", time.Now().Unix())
+			return true
+		case <-c.Request.Context().Done():
+			return false
+		}
+	})
+}
+
+// Issues the current user a single-use ticket that opens a stream with
+// ?ticket= for a short while, for clients that cannot send the token header
+func (controller streamController) Ticket(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	ticket, err := controller.streamService.IssueTicket(userId)
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": "Could not issue a ticket",
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"ticket": ticket,
+	})
+}
@@ -0,0 +1,132 @@
+package controllers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+// A recorder that tells when the client goes away, as streams need
+type streamRecorder struct {
+	*httptest.ResponseRecorder
+	closed chan bool
+}
+
+func (r streamRecorder) CloseNotify() <-chan bool {
+	return r.closed
+}
+
+type StreamControllerUnitTestSuite struct {
+	suite.Suite
+	c           *gin.Context
+	w           *httptest.ResponseRecorder
+	mockService *mocks.StreamService
+	controller  StreamController
+	err         error
+}
+
+func (suite *StreamControllerUnitTestSuite) SetupTest() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(streamRecorder{ResponseRecorder: suite.w, closed: make(chan bool)})
+
+	suite.mockService = new(mocks.StreamService)
+	suite.controller = NewStreamController(suite.mockService)
+
+	suite.err = fmt.Errorf("error")
+
+	suite.c.Request = httptest.NewRequest("GET", "/stream?posts=9&events=1", nil)
+	suite.c.Set("userId", uint(4))
+}
+
+func (suite *StreamControllerUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockService.AssertExpectations(suite.T())
+}
+
+func TestStreamControllerUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamControllerUnitTestSuite))
+}
+
+func (suite *StreamControllerUnitTestSuite) TestStreamController_Stream() {
+	subscription := &realtime.Subscription{
+		Topics: []string{"user:4", "post:9", "event:1"},
+		Events: make(chan realtime.Event, 1),
+	}
+	subscription.Events <- realtime.Event{Topic: "post:9", Type: "comment", Data: json.RawMessage(`{"PostsID":9}`)}
+	close(subscription.Events)
+
+	suite.mockService.On("Subscribe", uint(4), []uint{9}, []uint{1}).Return(subscription, nil)
+	suite.mockService.On("Unsubscribe", subscription).Return()
+
+	suite.controller.Stream(suite.c)
+
+	body := suite.w.Body.String()
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+	assert.Equal(suite.T(), "text/event-stream", suite.w.Header().Get("Content-Type"))
+	assert.True(suite.T(), strings.HasPrefix(body, "event:ready\ndata:"))
+	assert.Contains(suite.T(), body, `event:comment`+"\n"+`data:{"topic":"post:9","type":"comment","data":{"PostsID":9}}`)
+}
+
+func (suite *StreamControllerUnitTestSuite) TestStreamController_Stream_NotManager() {
+	suite.mockService.On("Subscribe", uint(4), []uint{9}, []uint{1}).Return(nil, service.ErrNotManager)
+
+	suite.controller.Stream(suite.c)
+
+	assert.Equal(suite.T(), http.StatusForbidden, suite.w.Code)
+}
+
+func (suite *StreamControllerUnitTestSuite) TestStreamController_Stream_BadIds() {
+	suite.c.Request = httptest.NewRequest("GET", "/stream?posts=abc", nil)
+
+	suite.controller.Stream(suite.c)
+
+	assert.Equal(suite.T(), http.StatusBadRequest, suite.w.Code)
+}
+
+func (suite *StreamControllerUnitTestSuite) TestStreamController_Stream_Ticket() {
+	subscription := &realtime.Subscription{
+		Topics: []string{"user:4"},
+		Events: make(chan realtime.Event),
+	}
+	close(subscription.Events)
+
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(streamRecorder{ResponseRecorder: suite.w, closed: make(chan bool)})
+	suite.c.Request = httptest.NewRequest("GET", "/stream?ticket=abc", nil)
+	suite.mockService.On("RedeemTicket", "abc").Return(uint(4), true)
+	suite.mockService.On("Subscribe", uint(4), []uint{}, []uint{}).Return(subscription, nil)
+	suite.mockService.On("Unsubscribe", subscription).Return()
+
+	suite.controller.Stream(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+}
+
+func (suite *StreamControllerUnitTestSuite) TestStreamController_Stream_BadTicket() {
+	suite.w = httptest.NewRecorder()
+	suite.c, _ = gin.CreateTestContext(streamRecorder{ResponseRecorder: suite.w, closed: make(chan bool)})
+	suite.c.Request = httptest.NewRequest("GET", "/stream?ticket=abc", nil)
+	suite.mockService.On("RedeemTicket", "abc").Return(uint(0), false)
+
+	suite.controller.Stream(suite.c)
+
+	assert.Equal(suite.T(), http.StatusUnauthorized, suite.w.Code)
+}
+
+func (suite *StreamControllerUnitTestSuite) TestStreamController_Ticket() {
+	suite.mockService.On("IssueTicket", uint(4)).Return("abc", nil)
+
+	suite.controller.Ticket(suite.c)
+
+	assert.Equal(suite.T(), http.StatusOK, suite.w.Code)
+	assert.JSONEq(suite.T(), `{"ticket": "abc"}`, suite.w.Body.String())
+}
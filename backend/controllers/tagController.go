@@ -0,0 +1,282 @@
+package controllers
+
+import (
+	"net/http"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type TagController interface {
+	Create(c *gin.Context)
+	All(c *gin.Context)
+	One(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	Autocomplete(c *gin.Context)
+	UserTags(c *gin.Context)
+	SetUserTags(c *gin.Context)
+	OrganizationTags(c *gin.Context)
+	SetOrganizationTags(c *gin.Context)
+	EventTags(c *gin.Context)
+	SetEventTags(c *gin.Context)
+}
+
+type tagController struct {
+	tagService service.TagService
+}
+
+// Returns the tag controller instantiated in the Router
+func NewTagController(s service.TagService) TagController {
+	return tagController{
+		tagService: s,
+	}
+}
+
+// Create a curated tag (admin only)
+func (controller tagController) Create(c *gin.Context) {
+	var body struct {
+		Name     string
+		Category string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	tag, err := controller.tagService.CreateTag(body.Name, body.Category)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// List every tag, optionally filtered with ?category=
+func (controller tagController) All(c *gin.Context) {
+	tags, err := controller.tagService.GetTags(c.Query("category"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+func (controller tagController) One(c *gin.Context) {
+	tag, err := controller.tagService.GetTagById(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Could not retrieve object",
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tag)
+}
+
+// Rename or recategorize a tag (admin only)
+func (controller tagController) Update(c *gin.Context) {
+	tag, err := controller.tagService.GetTagById(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Could not retrieve object",
+		})
+
+		return
+	}
+
+	var body struct {
+		Name     string
+		Category string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	result, err := controller.tagService.UpdateTag(tag, body.Name, body.Category)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, result)
+}
+
+// Delete a tag (admin only)
+func (controller tagController) Delete(c *gin.Context) {
+	tag, err := controller.tagService.GetTagById(c.Param("id"))
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Could not retrieve object",
+		})
+
+		return
+	}
+
+	if err := controller.tagService.DeleteTag(tag); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Could not delete object",
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Object deleted successfully",
+	})
+}
+
+// Suggest tags as the user types: ?q=&category=&limit=
+func (controller tagController) Autocomplete(c *gin.Context) {
+	limit := parseLimitQuery(c, 10, 50)
+
+	tags, err := controller.tagService.Autocomplete(c.Query("q"), c.Query("category"), limit)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+func (controller tagController) UserTags(c *gin.Context) {
+	controller.ownerTags(c, controller.tagService.GetUserTags)
+}
+
+// Only the user can set their tags
+func (controller tagController) SetUserTags(c *gin.Context) {
+	if id, err := parseUintParam(c, "id"); err == nil {
+		if current, ok := middleware.CurrentUserId(c); ok && current != id {
+			c.JSON(http.StatusForbidden, gin.H{
+				"error": "Only the user can set their tags",
+			})
+
+			return
+		}
+	}
+
+	controller.setOwnerTags(c, func(userId uint, tagIds []uint, _ uint) ([]models.Tags, error) {
+		return controller.tagService.SetUserTags(userId, tagIds)
+	})
+}
+
+func (controller tagController) OrganizationTags(c *gin.Context) {
+	controller.ownerTags(c, controller.tagService.GetOrganizationTags)
+}
+
+func (controller tagController) SetOrganizationTags(c *gin.Context) {
+	controller.setOwnerTags(c, controller.tagService.SetOrganizationTags)
+}
+
+func (controller tagController) EventTags(c *gin.Context) {
+	viewerId, _ := middleware.CurrentUserId(c)
+
+	controller.ownerTags(c, func(eventId uint) ([]models.Tags, error) {
+		return controller.tagService.GetEventTags(eventId, viewerId)
+	})
+}
+
+func (controller tagController) SetEventTags(c *gin.Context) {
+	controller.setOwnerTags(c, controller.tagService.SetEventTags)
+}
+
+// Responds with the tags of the user, organization or event in :id
+func (controller tagController) ownerTags(c *gin.Context, get func(uint) ([]models.Tags, error)) {
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	tags, err := get(id)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
+
+// Replaces the tags of the user, organization or event in :id with the
+// TagIds in the body, as the signed in user
+func (controller tagController) setOwnerTags(c *gin.Context, set func(uint, []uint, uint) ([]models.Tags, error)) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		TagIds []uint
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	tags, err := set(id, body.TagIds, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, tags)
+}
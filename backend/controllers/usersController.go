@@ -1,8 +1,10 @@
 package controllers
 
 import (
+	"errors"
 	"net/http"
 
+	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/service"
 	"github.com/gin-gonic/gin"
@@ -12,6 +14,7 @@ type UsersController interface {
 	Create(c *gin.Context)
 	One(c *gin.Context)
 	Update(c *gin.Context)
+	SetBirthdate(c *gin.Context)
 	Delete(c *gin.Context)
 }
 
@@ -88,7 +91,7 @@ func (controller usersController) Create(c *gin.Context) {
 	if err != nil {
 
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Creation failed",
+			"error": userErrorMessage(err, "Creation failed"),
 		})
 
 		return
@@ -121,13 +124,29 @@ func (controller usersController) One(c *gin.Context) {
 
 func (controller usersController) Update(c *gin.Context) {
 
+	// Only the user can edit their profile
+	userId, err := parseUintParam(c, "id")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid user id",
+		})
+
+		return
+	}
+
+	if current, ok := middleware.CurrentUserId(c); !ok || current != userId {
+		c.JSON(http.StatusForbidden, gin.H{
+			"error": "Only the user can edit their profile",
+		})
+
+		return
+	}
+
 	// Get the existing object
 	id := c.Param("id")
 	var object models.Users
 
-	result, err := controller.usersService.OneUser(id, object)
-
-	_ = result
+	object, err = controller.usersService.OneUser(id, object)
 
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -137,19 +156,17 @@ func (controller usersController) Update(c *gin.Context) {
 		return
 	}
 
-	// Get updates from the body
+	// Get updates from the body, fields left out stay as they are
 	var body struct {
-		Id       uint
-		Handle   string
-		Email    string
-		Password string
+		Handle   *string
+		Email    *string
+		Password *string
 		// birthdate datatypes.Date `gorm: "NOT NULL"`
-		Birthdate string
-		FirstName string
-		LastName  string
+		Birthdate *string
+		FirstName *string
+		LastName  *string
 		// profilePic mediumblob,
-		Interests string
-		Verified  uint
+		Interests *string
 	}
 	if err := c.Bind(&body); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
@@ -159,26 +176,47 @@ func (controller usersController) Update(c *gin.Context) {
 		return
 	}
 
-	object.Handle = body.Handle
-	object.Email = body.Email
-	object.Password = body.Password
-	object.Birthdate = body.Birthdate
-	object.FirstName = body.FirstName
-	object.LastName = body.LastName
+	if body.Handle != nil {
+		object.Handle = *body.Handle
+	}
+	if body.Email != nil {
+		object.Email = *body.Email
+	}
+	if body.Password != nil {
+		hash, err := controller.usersService.HashPassword([]byte(*body.Password))
+
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error": "Failed to hash password",
+			})
+
+			return
+		}
+
+		object.Password = string(hash)
+	}
+	if body.Birthdate != nil {
+		object.Birthdate = *body.Birthdate
+	}
+	if body.FirstName != nil {
+		object.FirstName = *body.FirstName
+	}
+	if body.LastName != nil {
+		object.LastName = *body.LastName
+	}
 	// object.ProfilePic = body.profilePic
-	object.Interests = body.Interests
-	object.Verified = body.Verified
+	if body.Interests != nil {
+		object.Interests = *body.Interests
+	}
 
 	// Update the object
 
-	result1, err := controller.usersService.UpdateUser(object)
-
-	_ = result1
+	object, err = controller.usersService.UpdateUser(object)
 
 	if err != nil {
 		// result = db.Save(&object)
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error": "Could not update object",
+			"error": userErrorMessage(err, "Could not update object"),
 		})
 
 		return
@@ -189,6 +227,34 @@ func (controller usersController) Update(c *gin.Context) {
 
 }
 
+// Correct a user's birthdate, which they can't change themselves once set
+// (admin only)
+func (controller usersController) SetBirthdate(c *gin.Context) {
+	var body struct {
+		Birthdate string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	object, err := controller.usersService.SetBirthdate(c.Param("id"), body.Birthdate)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": userErrorMessage(err, "Could not update object"),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, object)
+}
+
 func (controller usersController) Delete(c *gin.Context) {
 
 	// Get the existing object
@@ -225,3 +291,13 @@ func (controller usersController) Delete(c *gin.Context) {
 	})
 
 }
+
+// The message of a validation error, which tells the user what to fix, or
+// the fallback for anything else, such as the database failing
+func userErrorMessage(err error, fallback string) string {
+	if errors.Is(err, models.ErrBirthdateFormat) || errors.Is(err, models.ErrBirthdateImplausible) || errors.Is(err, service.ErrBirthdateLocked) {
+		return err.Error()
+	}
+
+	return fallback
+}
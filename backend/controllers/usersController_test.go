@@ -2,6 +2,7 @@ package controllers
 
 import (
 	"bytes"
+	"errors"
 
 	"net/http"
 
@@ -10,9 +11,11 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
 )
 
 func TestUserController_CreateSuccess(t *testing.T) {
@@ -133,3 +136,87 @@ func TestUserController_DeleteSuccess(t *testing.T) {
 	mockService.AssertExpectations(t)
 	assert.Equal(t, c.Writer.Status(), http.StatusOK)
 }
+
+func TestUserController_UpdateKeepsOmittedFields(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	fake := []byte(`{"FirstName": "Augusta"}`)
+	c.Request = httptest.NewRequest("PUT", "/user/4", bytes.NewBuffer(fake))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "id", Value: "4"}}
+	c.Set("userId", uint(4))
+
+	var user models.Users
+	user.ID = 4
+	user.Handle = "ada"
+	user.Email = "ada@example.org"
+	user.Birthdate = "2010-05-01"
+	user.FirstName = "Ada"
+
+	updated := user
+	updated.FirstName = "Augusta"
+
+	mockService := new(mocks.UsersService)
+	mockService.On("OneUser", "4", models.Users{}).Return(user, nil)
+	mockService.On("UpdateUser", updated).Return(updated, nil)
+
+	NewUsersController(mockService).Update(c)
+
+	mockService.AssertExpectations(t)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestUserController_UpdateOtherUser(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	fake := []byte(`{"Birthdate": "2000-05-01"}`)
+	c.Request = httptest.NewRequest("PUT", "/user/4", bytes.NewBuffer(fake))
+	c.Params = gin.Params{{Key: "id", Value: "4"}}
+	c.Set("userId", uint(6))
+
+	mockService := new(mocks.UsersService)
+
+	NewUsersController(mockService).Update(c)
+
+	mockService.AssertExpectations(t)
+	assert.Equal(t, http.StatusForbidden, w.Code)
+}
+
+func TestUserController_UpdateFails(t *testing.T) {
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+
+	fake := []byte(`{"Email": "taken@example.org"}`)
+	c.Request = httptest.NewRequest("PUT", "/user/4", bytes.NewBuffer(fake))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "id", Value: "4"}}
+	c.Set("userId", uint(4))
+
+	var user models.Users
+	user.ID = 4
+
+	// Database errors are not shown, validation errors are
+	mockService := new(mocks.UsersService)
+	mockService.On("OneUser", "4", models.Users{}).Return(user, nil)
+	mockService.On("UpdateUser", mock.Anything).Return(models.Users{}, errors.New("Error 1062: Duplicate entry 'taken@example.org' for key 'users.email'")).Once()
+
+	NewUsersController(mockService).Update(c)
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	assert.JSONEq(t, `{"error": "Could not update object"}`, w.Body.String())
+
+	w = httptest.NewRecorder()
+	c, _ = gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest("PUT", "/user/4", bytes.NewBuffer([]byte(`{"Birthdate": "2000-05-01"}`)))
+	c.Request.Header.Set("Content-Type", "application/json")
+	c.Params = gin.Params{{Key: "id", Value: "4"}}
+	c.Set("userId", uint(4))
+	mockService.On("UpdateUser", mock.Anything).Return(models.Users{}, service.ErrBirthdateLocked).Once()
+
+	NewUsersController(mockService).Update(c)
+
+	mockService.AssertExpectations(t)
+	assert.JSONEq(t, `{"error": "birthdate can only be changed by an administrator once set"}`, w.Body.String())
+}
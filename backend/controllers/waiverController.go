@@ -0,0 +1,372 @@
+package controllers
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
+	"github.com/gin-gonic/gin"
+)
+
+type WaiverController interface {
+	Create(c *gin.Context)
+	One(c *gin.Context)
+	OrganizationWaivers(c *gin.Context)
+	Update(c *gin.Context)
+	Delete(c *gin.Context)
+	Versions(c *gin.Context)
+	Accept(c *gin.Context)
+	Requirements(c *gin.Context)
+	RecordBackgroundCheck(c *gin.Context)
+}
+
+type waiverController struct {
+	waiverService service.WaiverService
+}
+
+// Returns the waiver controller instantiated in the Router
+func NewWaiverController(s service.WaiverService) WaiverController {
+	return waiverController{
+		waiverService: s,
+	}
+}
+
+// Creates a waiver of the organization in :id, for all its events or the
+// one in EventID
+func (controller waiverController) Create(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	orgId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		EventID *uint
+		Title   string
+		Body    string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	waiver, err := controller.waiverService.CreateWaiver(orgId, userId, models.Waivers{
+		EventID: body.EventID,
+		Title:   body.Title,
+		Body:    body.Body,
+	})
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, waiver)
+}
+
+// Returns the current version of the waiver in :id
+func (controller waiverController) One(c *gin.Context) {
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	waiver, err := controller.waiverService.GetWaiver(id)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, waiver)
+}
+
+// Lists the waivers of the organization in :id
+func (controller waiverController) OrganizationWaivers(c *gin.Context) {
+	orgId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	waivers, err := controller.waiverService.OrganizationWaivers(orgId)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, waivers)
+}
+
+// Publishes a new version of the waiver in :id
+func (controller waiverController) Update(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		Title string
+		Body  string
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	waiver, err := controller.waiverService.UpdateWaiver(id, userId, body.Title, body.Body)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, waiver)
+}
+
+// Deletes the waiver in :id
+func (controller waiverController) Delete(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	if err := controller.waiverService.DeleteWaiver(id, userId); err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"message": "Waiver deleted",
+	})
+}
+
+// Lists every version of the waiver in :id
+func (controller waiverController) Versions(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	versions, err := controller.waiverService.WaiverVersions(id, userId)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, versions)
+}
+
+// Accepts the Version of the waiver in :id the signed in user was shown
+func (controller waiverController) Accept(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	id, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		Version uint
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	acceptance, err := controller.waiverService.Accept(id, userId, body.Version)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, acceptance)
+}
+
+// What the signed in user needs to sign up to the event in :id, at
+// ?occurrence= for a recurring event
+func (controller waiverController) Requirements(c *gin.Context) {
+	userId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	eventId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	occurrence, err := parseTimeQuery(c, "occurrence")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "occurrence must be an RFC 3339 time",
+		})
+
+		return
+	}
+
+	req, err := controller.waiverService.Requirements(eventId, userId, occurrence)
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, req)
+}
+
+// Records that the organization in :id cleared the background check of
+// the user in :userId
+func (controller waiverController) RecordBackgroundCheck(c *gin.Context) {
+	managerId, ok := currentUserId(c)
+	if !ok {
+		return
+	}
+
+	orgId, err := parseUintParam(c, "id")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "id field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	userId, err := parseUintParam(c, "userId")
+
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "userId field must be an unsigned integer.",
+		})
+
+		return
+	}
+
+	var body struct {
+		ClearedAt time.Time
+		ExpiresAt *time.Time
+	}
+
+	if err := c.Bind(&body); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Request body is invalid",
+		})
+
+		return
+	}
+
+	check, err := controller.waiverService.RecordBackgroundCheck(orgId, managerId, userId, body.ClearedAt, body.ExpiresAt)
+
+	if err != nil {
+		c.JSON(statusOf(err), gin.H{
+			"error": err.Error(),
+		})
+
+		return
+	}
+
+	c.JSON(http.StatusOK, check)
+}
@@ -20,7 +20,7 @@ func Init() {
 	DBNAME := os.Getenv("DB_NAME")
 
 	// Create Connection string
-	URL := fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8&parseTime=True&loc=Local", USER, PASS, HOST, DBNAME)
+	URL := fmt.Sprintf("%s:%s@tcp(%s)/%s?charset=utf8&parseTime=True&loc=UTC", USER, PASS, HOST, DBNAME)
 
 	//Connect to the database
 	database, err := gorm.Open(mysql.Open(URL))
@@ -0,0 +1,147 @@
+package feedcache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+)
+
+// What a feed was built from: whose posts, which organizations' posts and
+// events, and which interests. New content from any of them belongs in it.
+type Sources struct {
+	Handles         []string
+	OrganizationIDs []uint
+	TagIDs          []uint
+}
+
+// A user's ranked feed, kept so paging through it neither ranks it again
+// nor sees it shift between pages
+type Snapshot struct {
+	// Tells rankings of the same user apart, for cursors
+	Version string
+	Items   []models.FeedItem
+	Sources Sources
+	Built   time.Time
+	// Set once new content arrived from its sources. Pages of it are still
+	// served to clients paging through it, but new first pages rank again.
+	Stale bool
+}
+
+// Keeps users' ranked feeds. The memory cache only serves this instance;
+// one backed by Redis or similar lets several instances share feeds.
+type Cache interface {
+	// The user's feed, unless it expired or was forgotten
+	Get(userId uint) (Snapshot, bool)
+	Put(userId uint, snapshot Snapshot)
+	// Marks stale every feed built from any of the sources
+	Invalidate(sources Sources)
+	// Drops the users' feeds, after who they follow changes
+	Forget(userIds ...uint)
+}
+
+type memoryCache struct {
+	mutex sync.Mutex
+	ttl   time.Duration
+	size  int
+	feeds map[uint]Snapshot
+	now   func() time.Time
+}
+
+// Keeps up to size feeds for ttl each, evicting the oldest when full
+func NewMemoryCache(ttl time.Duration, size int) Cache {
+	return &memoryCache{
+		ttl:   ttl,
+		size:  size,
+		feeds: map[uint]Snapshot{},
+		now:   time.Now,
+	}
+}
+
+func (c *memoryCache) Get(userId uint) (Snapshot, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	snapshot, ok := c.feeds[userId]
+	if !ok {
+		return Snapshot{}, false
+	}
+
+	if c.now().Sub(snapshot.Built) >= c.ttl {
+		delete(c.feeds, userId)
+		return Snapshot{}, false
+	}
+
+	return snapshot, true
+}
+
+func (c *memoryCache) Put(userId uint, snapshot Snapshot) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if _, ok := c.feeds[userId]; !ok && len(c.feeds) >= c.size {
+		c.evictOldest()
+	}
+
+	c.feeds[userId] = snapshot
+}
+
+func (c *memoryCache) Invalidate(sources Sources) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for userId, snapshot := range c.feeds {
+		if !snapshot.Stale && overlaps(snapshot.Sources, sources) {
+			snapshot.Stale = true
+			c.feeds[userId] = snapshot
+		}
+	}
+}
+
+func (c *memoryCache) Forget(userIds ...uint) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for _, userId := range userIds {
+		delete(c.feeds, userId)
+	}
+}
+
+// Makes room by dropping the feed built longest ago. Called with the mutex
+// held.
+func (c *memoryCache) evictOldest() {
+	var oldest uint
+	var built time.Time
+
+	for userId, snapshot := range c.feeds {
+		if built.IsZero() || snapshot.Built.Before(built) {
+			oldest, built = userId, snapshot.Built
+		}
+	}
+
+	delete(c.feeds, oldest)
+}
+
+func overlaps(a Sources, b Sources) bool {
+	for _, handle := range b.Handles {
+		for _, h := range a.Handles {
+			if h == handle {
+				return true
+			}
+		}
+	}
+
+	return overlapIds(a.OrganizationIDs, b.OrganizationIDs) || overlapIds(a.TagIDs, b.TagIDs)
+}
+
+func overlapIds(a []uint, b []uint) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x == y {
+				return true
+			}
+		}
+	}
+
+	return false
+}
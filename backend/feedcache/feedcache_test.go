@@ -0,0 +1,60 @@
+package feedcache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryCache(t *testing.T) {
+	now := time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	cache := NewMemoryCache(10*time.Minute, 2).(*memoryCache)
+	cache.now = func() time.Time { return now }
+
+	cache.Put(1, Snapshot{Version: "a", Built: now, Sources: Sources{Handles: []string{"ada"}, OrganizationIDs: []uint{3}}})
+	cache.Put(2, Snapshot{Version: "b", Built: now.Add(time.Minute), Sources: Sources{TagIDs: []uint{7}}})
+
+	// Only feeds built from the new content's sources go stale
+	cache.Invalidate(Sources{Handles: []string{"grace"}, OrganizationIDs: []uint{3}})
+
+	snapshot, ok := cache.Get(1)
+	assert.True(t, ok)
+	assert.True(t, snapshot.Stale)
+
+	snapshot, _ = cache.Get(2)
+	assert.False(t, snapshot.Stale)
+
+	cache.Invalidate(Sources{TagIDs: []uint{7, 8}})
+	snapshot, _ = cache.Get(2)
+	assert.True(t, snapshot.Stale)
+
+	// Feeds expire
+	now = now.Add(10 * time.Minute)
+	_, ok = cache.Get(1)
+	assert.False(t, ok)
+	_, ok = cache.Get(2)
+	assert.True(t, ok)
+
+	cache.Forget(2)
+	_, ok = cache.Get(2)
+	assert.False(t, ok)
+}
+
+func TestMemoryCache_Evicts(t *testing.T) {
+	now := time.Now()
+	cache := NewMemoryCache(time.Hour, 2)
+
+	cache.Put(1, Snapshot{Built: now.Add(time.Minute)})
+	cache.Put(2, Snapshot{Built: now})
+	cache.Put(1, Snapshot{Built: now.Add(2 * time.Minute)})
+	cache.Put(3, Snapshot{Built: now.Add(3 * time.Minute)})
+
+	_, ok := cache.Get(2)
+	assert.False(t, ok)
+
+	_, ok = cache.Get(1)
+	assert.True(t, ok)
+	_, ok = cache.Get(3)
+	assert.True(t, ok)
+}
@@ -0,0 +1,40 @@
+package geocoder
+
+import (
+	"errors"
+	"log"
+	"os"
+	"strings"
+)
+
+var ErrNotFound = errors.New("address could not be geocoded")
+
+type Location struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Turns a free-text address into coordinates
+type Geocoder interface {
+	Geocode(address string) (Location, error)
+}
+
+// Picks the geocoder from the GEOCODER environment variable. "nominatim"
+// uses OpenStreetMap (GEOCODER_URL overrides the server); anything else
+// uses an empty static geocoder, so addresses are simply left without
+// coordinates when running locally.
+func FromEnvironment() Geocoder {
+	switch os.Getenv("GEOCODER") {
+	case "nominatim":
+		log.Println("Geocoding addresses with Nominatim")
+		return NewNominatimGeocoder(os.Getenv("GEOCODER_URL"))
+	default:
+		log.Println("Geocoding disabled, using static geocoder")
+		return NewStaticGeocoder(map[string]Location{})
+	}
+}
+
+// Lowercases and collapses whitespace so lookups ignore formatting
+func normalizeAddress(address string) string {
+	return strings.ToLower(strings.Join(strings.Fields(address), " "))
+}
@@ -0,0 +1,45 @@
+package geocoder
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaticGeocoder(t *testing.T) {
+	g := NewStaticGeocoder(map[string]Location{
+		"1 Main St, Springfield": {Latitude: 39.8, Longitude: -89.6},
+	})
+
+	location, err := g.Geocode("1  MAIN st, springfield ")
+	assert.Nil(t, err)
+	assert.Equal(t, Location{Latitude: 39.8, Longitude: -89.6}, location)
+
+	_, err = g.Geocode("Nowhere")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestNominatimGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/search", r.URL.Path)
+		assert.Equal(t, "VolunteerOne", r.Header.Get("User-Agent"))
+
+		if r.URL.Query().Get("q") == "Nowhere" {
+			w.Write([]byte(`[]`))
+			return
+		}
+		w.Write([]byte(`[{"lat": "39.8", "lon": "-89.6"}]`))
+	}))
+	defer server.Close()
+
+	g := NewNominatimGeocoder(server.URL)
+
+	location, err := g.Geocode("1 Main St, Springfield")
+	assert.Nil(t, err)
+	assert.Equal(t, Location{Latitude: 39.8, Longitude: -89.6}, location)
+
+	_, err = g.Geocode("Nowhere")
+	assert.Equal(t, ErrNotFound, err)
+}
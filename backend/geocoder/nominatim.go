@@ -0,0 +1,76 @@
+package geocoder
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const defaultNominatimURL = "https://nominatim.openstreetmap.org"
+
+// Geocodes with the OpenStreetMap Nominatim search API
+type nominatimGeocoder struct {
+	baseURL string
+	client  *http.Client
+}
+
+func NewNominatimGeocoder(baseURL string) Geocoder {
+	if baseURL == "" {
+		baseURL = defaultNominatimURL
+	}
+
+	return nominatimGeocoder{
+		baseURL: baseURL,
+		client:  &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+func (g nominatimGeocoder) Geocode(address string) (Location, error) {
+	query := url.Values{}
+	query.Set("q", address)
+	query.Set("format", "json")
+	query.Set("limit", "1")
+
+	req, err := http.NewRequest("GET", g.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return Location{}, err
+	}
+	// Nominatim's usage policy requires an identifying user agent
+	req.Header.Set("User-Agent", "VolunteerOne")
+
+	res, err := g.client.Do(req)
+	if err != nil {
+		return Location{}, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return Location{}, fmt.Errorf("geocoder responded with status %d", res.StatusCode)
+	}
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&results); err != nil {
+		return Location{}, err
+	}
+
+	if len(results) == 0 {
+		return Location{}, ErrNotFound
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return Location{}, err
+	}
+	lng, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return Location{}, err
+	}
+
+	return Location{Latitude: lat, Longitude: lng}, nil
+}
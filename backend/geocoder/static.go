@@ -0,0 +1,26 @@
+package geocoder
+
+// Looks addresses up in a fixed table. Used for tests and local development.
+type staticGeocoder struct {
+	locations map[string]Location
+}
+
+func NewStaticGeocoder(locations map[string]Location) Geocoder {
+	normalized := map[string]Location{}
+	for address, location := range locations {
+		normalized[normalizeAddress(address)] = location
+	}
+
+	return staticGeocoder{
+		locations: normalized,
+	}
+}
+
+func (g staticGeocoder) Geocode(address string) (Location, error) {
+	location, ok := g.locations[normalizeAddress(address)]
+	if !ok {
+		return Location{}, ErrNotFound
+	}
+
+	return location, nil
+}
@@ -0,0 +1,182 @@
+// Package ical writes iCalendar (RFC 5545) calendars for events so they can
+// be subscribed to, downloaded or attached to emails as invites.
+//
+// Times in a time zone other than UTC are written with a TZID and the
+// calendar gets a VTIMEZONE built from the tz database, so recurring events
+// keep their local time across daylight saving changes in every client.
+package ical
+
+import (
+	"strings"
+	"time"
+)
+
+// Calendar methods (RFC 5546). Feeds and downloads use Publish, email
+// invites Request and Cancel.
+const (
+	Publish = "PUBLISH"
+	Request = "REQUEST"
+	Cancel  = "CANCEL"
+)
+
+// Event statuses
+const (
+	Confirmed = "CONFIRMED"
+	Cancelled = "CANCELLED"
+)
+
+const productId = "-//VolunteerOne//Events//EN"
+
+// How far ahead time zone rules are written for series without an end
+const openEndedYears = 10
+
+type Calendar struct {
+	Method string
+	Name   string
+	Events []Event
+}
+
+// A VEVENT. An edited occurrence of a recurring event is an Event with the
+// UID of the series and RecurrenceID set to the original start.
+type Event struct {
+	UID         string
+	Created     time.Time
+	Modified    time.Time
+	Sequence    int
+	Status      string
+	Summary     string
+	Description string
+	Address     string
+	Latitude    *float64
+	Longitude   *float64
+	URL         string
+	Start       time.Time
+	End         time.Time
+	// Time zone Start, End and the dates below are written in, UTC if nil
+	TimeZone *time.Location
+
+	RRule        string
+	RecurEnd     time.Time
+	ExDates      []time.Time
+	RecurrenceID *time.Time
+
+	Organizer string
+	Attendees []string
+}
+
+// Returns the calendar as text/calendar content
+func (c Calendar) String() string {
+	w := &writer{}
+	zones := newZoneSet()
+
+	for _, event := range c.Events {
+		zones.add(event)
+	}
+
+	w.line("BEGIN:VCALENDAR")
+	w.line("VERSION:2.0")
+	w.line("PRODID:" + productId)
+	w.line("CALSCALE:GREGORIAN")
+	if c.Method != "" {
+		w.line("METHOD:" + c.Method)
+	}
+	if c.Name != "" {
+		w.line("X-WR-CALNAME:" + escape(c.Name))
+	}
+
+	zones.write(w)
+
+	for _, event := range c.Events {
+		event.write(w)
+	}
+
+	w.line("END:VCALENDAR")
+
+	return w.String()
+}
+
+func (e Event) loc() *time.Location {
+	if e.TimeZone == nil {
+		return time.UTC
+	}
+	return e.TimeZone
+}
+
+func (e Event) write(w *writer) {
+	loc := e.loc()
+	stamp := e.Modified
+	if stamp.IsZero() {
+		stamp = time.Now()
+	}
+
+	w.line("BEGIN:VEVENT")
+	w.line("UID:" + e.UID)
+	w.line("DTSTAMP:" + utcTime(stamp))
+	if !e.Created.IsZero() {
+		w.line("CREATED:" + utcTime(e.Created))
+	}
+	if !e.Modified.IsZero() {
+		w.line("LAST-MODIFIED:" + utcTime(e.Modified))
+	}
+	if e.RecurrenceID != nil {
+		w.line(dateTime("RECURRENCE-ID", *e.RecurrenceID, loc))
+	}
+	w.line(dateTime("DTSTART", e.Start, loc))
+	w.line(dateTime("DTEND", e.End, loc))
+	if e.RRule != "" {
+		w.line("RRULE:" + e.RRule)
+	}
+	for _, date := range e.ExDates {
+		w.line(dateTime("EXDATE", date, loc))
+	}
+	if e.Sequence > 0 {
+		w.line("SEQUENCE:" + itoa(e.Sequence))
+	}
+	if e.Status != "" {
+		w.line("STATUS:" + e.Status)
+	}
+	w.line("SUMMARY:" + escape(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION:" + escape(e.Description))
+	}
+	if e.Address != "" {
+		w.line("LOCATION:" + escape(e.Address))
+	}
+	if e.Latitude != nil && e.Longitude != nil {
+		w.line("GEO:" + formatFloat(*e.Latitude) + ";" + formatFloat(*e.Longitude))
+	}
+	if e.URL != "" {
+		w.line("URL:" + e.URL)
+	}
+	if e.Organizer != "" {
+		w.line("ORGANIZER:mailto:" + e.Organizer)
+	}
+	for _, attendee := range e.Attendees {
+		w.line("ATTENDEE;ROLE=REQ-PARTICIPANT;RSVP=FALSE:mailto:" + attendee)
+	}
+	w.line("END:VEVENT")
+}
+
+// A DATE-TIME property, in UTC or with the TZID of loc
+func dateTime(name string, t time.Time, loc *time.Location) string {
+	if loc == time.UTC {
+		return name + ":" + utcTime(t)
+	}
+	return name + ";TZID=" + loc.String() + ":" + t.In(loc).Format("20060102T150405")
+}
+
+func utcTime(t time.Time) string {
+	return t.UTC().Format("20060102T150405Z")
+}
+
+// Escapes a TEXT value
+func escape(text string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(text)
+}
@@ -0,0 +1,142 @@
+package ical
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func chicago(t *testing.T) *time.Location {
+	loc, err := time.LoadLocation("America/Chicago")
+	assert.Nil(t, err)
+	return loc
+}
+
+func TestCalendar_UTCEvent(t *testing.T) {
+	start := time.Date(2034, 4, 1, 14, 0, 0, 0, time.UTC)
+	cal := Calendar{Method: Publish, Events: []Event{{
+		UID:         "event-1@volunteerone",
+		Modified:    start.AddDate(0, 0, -7),
+		Summary:     "Park cleanup, spring",
+		Description: "Bring gloves;\nwater provided",
+		Start:       start,
+		End:         start.Add(2 * time.Hour),
+	}}}
+
+	text := cal.String()
+
+	assert.True(t, strings.HasPrefix(text, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:"))
+	assert.True(t, strings.HasSuffix(text, "END:VEVENT\r\nEND:VCALENDAR\r\n"))
+	assert.Contains(t, text, "METHOD:PUBLISH\r\n")
+	assert.Contains(t, text, "DTSTAMP:20340325T140000Z\r\n")
+	assert.Contains(t, text, "DTSTART:20340401T140000Z\r\n")
+	assert.Contains(t, text, "DTEND:20340401T160000Z\r\n")
+	assert.Contains(t, text, `SUMMARY:Park cleanup\, spring`+"\r\n")
+	assert.Contains(t, text, `DESCRIPTION:Bring gloves\;\nwater provided`+"\r\n")
+	assert.NotContains(t, text, "VTIMEZONE")
+}
+
+func TestCalendar_RecurringEventInTimeZone(t *testing.T) {
+	loc := chicago(t)
+	start := time.Date(2034, 3, 4, 9, 0, 0, 0, loc)
+	moved := start.AddDate(0, 0, 14)
+	edited := moved.Add(time.Hour)
+
+	cal := Calendar{Events: []Event{
+		{
+			UID:      "event-1@volunteerone",
+			Summary:  "Food bank",
+			Start:    start,
+			End:      start.Add(2 * time.Hour),
+			TimeZone: loc,
+			RRule:    "FREQ=WEEKLY;COUNT=6",
+			RecurEnd: start.AddDate(0, 0, 35).Add(2 * time.Hour),
+			ExDates:  []time.Time{start.AddDate(0, 0, 7)},
+		},
+		{
+			UID:          "event-1@volunteerone",
+			Summary:      "Food bank (late)",
+			Start:        edited,
+			End:          edited.Add(2 * time.Hour),
+			TimeZone:     loc,
+			RecurrenceID: &moved,
+		},
+	}}
+
+	text := cal.String()
+
+	assert.Contains(t, text, "DTSTART;TZID=America/Chicago:20340304T090000\r\n")
+	assert.Contains(t, text, "RRULE:FREQ=WEEKLY;COUNT=6\r\n")
+	assert.Contains(t, text, "EXDATE;TZID=America/Chicago:20340311T090000\r\n")
+	assert.Contains(t, text, "RECURRENCE-ID;TZID=America/Chicago:20340318T090000\r\n")
+	assert.Contains(t, text, "DTSTART;TZID=America/Chicago:20340318T100000\r\n")
+
+	// One time zone, starting in standard time and switching to daylight
+	// saving time on March 12th at 2am
+	assert.Equal(t, 1, strings.Count(text, "BEGIN:VTIMEZONE"))
+	assert.Contains(t, text, "TZID:America/Chicago\r\n")
+	assert.Contains(t, text, "BEGIN:STANDARD\r\nDTSTART:20340304T090000\r\nTZOFFSETFROM:-0600\r\nTZOFFSETTO:-0600\r\nTZNAME:CST\r\nEND:STANDARD\r\n")
+	assert.Contains(t, text, "BEGIN:DAYLIGHT\r\nDTSTART:20340312T020000\r\nTZOFFSETFROM:-0600\r\nTZOFFSETTO:-0500\r\nTZNAME:CDT\r\nEND:DAYLIGHT\r\n")
+	assert.Less(t, strings.Index(text, "END:VTIMEZONE"), strings.Index(text, "BEGIN:VEVENT"))
+}
+
+func TestCalendar_OpenEndedSeriesCoversYears(t *testing.T) {
+	loc := chicago(t)
+	start := time.Date(2034, 3, 4, 9, 0, 0, 0, loc)
+
+	text := Calendar{Events: []Event{{
+		UID:      "event-1@volunteerone",
+		Start:    start,
+		End:      start.Add(time.Hour),
+		TimeZone: loc,
+		RRule:    "FREQ=WEEKLY",
+	}}}.String()
+
+	// Two changes a year
+	assert.Equal(t, 2*openEndedYears, strings.Count(text, "TZOFFSETFROM")-1)
+}
+
+func TestCalendar_CancelledInvite(t *testing.T) {
+	start := time.Date(2034, 4, 1, 14, 0, 0, 0, time.UTC)
+
+	text := Calendar{Method: Cancel, Events: []Event{{
+		UID:       "signup-1@volunteerone",
+		Status:    Cancelled,
+		Sequence:  1,
+		Start:     start,
+		End:       start.Add(time.Hour),
+		Organizer: "events@volunteerone.org",
+		Attendees: []string{"ada@example.com"},
+	}}}.String()
+
+	assert.Contains(t, text, "METHOD:CANCEL\r\n")
+	assert.Contains(t, text, "STATUS:CANCELLED\r\n")
+	assert.Contains(t, text, "SEQUENCE:1\r\n")
+	assert.Contains(t, text, "ORGANIZER:mailto:events@volunteerone.org\r\n")
+	assert.Contains(t, text, "ATTENDEE;ROLE=REQ-PARTICIPANT;RSVP=FALSE:mailto:ada@example.com\r\n")
+}
+
+func TestWriter_FoldsLongLines(t *testing.T) {
+	w := &writer{}
+	w.line("DESCRIPTION:" + strings.Repeat("é", 100))
+
+	lines := strings.Split(strings.TrimSuffix(w.String(), "\r\n"), "\r\n")
+
+	assert.Greater(t, len(lines), 1)
+	for i, line := range lines {
+		assert.LessOrEqual(t, len(line), maxLineOctets)
+		if i > 0 {
+			assert.True(t, strings.HasPrefix(line, " "))
+		}
+	}
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(w.String(), "\r\n"), "\r\n ", "")
+	assert.Equal(t, "DESCRIPTION:"+strings.Repeat("é", 100), unfolded)
+}
+
+func TestFormatOffset(t *testing.T) {
+	assert.Equal(t, "+0530", formatOffset(5*3600+30*60))
+	assert.Equal(t, "-0500", formatOffset(-5*3600))
+	assert.Equal(t, "+001730", formatOffset(17*60+30))
+}
@@ -0,0 +1,161 @@
+package ical
+
+import (
+	"time"
+)
+
+// The time zones used by the events of a calendar and the range of time
+// each one is needed for
+type zoneSet struct {
+	names  []string
+	ranges map[string]*zoneRange
+}
+
+type zoneRange struct {
+	loc      *time.Location
+	from, to time.Time
+}
+
+func newZoneSet() *zoneSet {
+	return &zoneSet{ranges: map[string]*zoneRange{}}
+}
+
+func (z *zoneSet) add(event Event) {
+	loc := event.loc()
+	if loc == time.UTC {
+		return
+	}
+
+	from, to := event.Start, event.End
+	if event.RRule != "" {
+		to = event.RecurEnd
+		if to.IsZero() {
+			to = event.Start.AddDate(openEndedYears, 0, 0)
+		}
+	}
+	dates := append([]time.Time{}, event.ExDates...)
+	if event.RecurrenceID != nil {
+		dates = append(dates, *event.RecurrenceID)
+	}
+	for _, date := range dates {
+		if date.Before(from) {
+			from = date
+		}
+		if date.After(to) {
+			to = date
+		}
+	}
+
+	r, ok := z.ranges[loc.String()]
+	if !ok {
+		z.names = append(z.names, loc.String())
+		z.ranges[loc.String()] = &zoneRange{loc: loc, from: from, to: to}
+		return
+	}
+
+	if from.Before(r.from) {
+		r.from = from
+	}
+	if to.After(r.to) {
+		r.to = to
+	}
+}
+
+// Writes a VTIMEZONE for every zone. Each lists the offset in effect at the
+// start of its range and every transition until the end of it.
+func (z *zoneSet) write(w *writer) {
+	for _, name := range z.names {
+		r := z.ranges[name]
+
+		w.line("BEGIN:VTIMEZONE")
+		w.line("TZID:" + name)
+
+		start := r.from.In(r.loc)
+		_, offset := start.Zone()
+		observance(w, start, offset)
+
+		for _, t := range transitions(r.loc, r.from, r.to) {
+			observance(w, t, offset)
+			_, offset = t.Zone()
+		}
+
+		w.line("END:VTIMEZONE")
+	}
+}
+
+// Writes a STANDARD or DAYLIGHT observance starting at t, when the offset
+// changes from the previous one
+func observance(w *writer, t time.Time, previous int) {
+	name, offset := t.Zone()
+
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+
+	w.line("BEGIN:" + kind)
+	// The local time of the change, in the offset before it
+	w.line("DTSTART:" + t.UTC().Add(time.Duration(previous)*time.Second).Format("20060102T150405"))
+	w.line("TZOFFSETFROM:" + formatOffset(previous))
+	w.line("TZOFFSETTO:" + formatOffset(offset))
+	w.line("TZNAME:" + escape(name))
+	w.line("END:" + kind)
+}
+
+// Times within [from, to] at which loc changes its offset or abbreviation
+func transitions(loc *time.Location, from time.Time, to time.Time) []time.Time {
+	changes := []time.Time{}
+
+	prev := from.In(loc)
+	for prev.Before(to) {
+		next := prev.Add(24 * time.Hour)
+		if sameZone(prev, next) {
+			prev = next
+			continue
+		}
+
+		// Narrow down to the second of the change
+		lo, hi := prev, next
+		for hi.Sub(lo) > time.Second {
+			mid := lo.Add(hi.Sub(lo) / 2).Truncate(time.Second)
+			if sameZone(lo, mid) {
+				lo = mid
+			} else {
+				hi = mid
+			}
+		}
+
+		changes = append(changes, hi)
+		prev = hi
+	}
+
+	return changes
+}
+
+func sameZone(a time.Time, b time.Time) bool {
+	aName, aOffset := a.Zone()
+	bName, bOffset := b.Zone()
+	return aName == bName && aOffset == bOffset
+}
+
+// Formats a UTC offset in seconds as +HHMM, or +HHMMSS when needed
+func formatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	offset := sign + pad(seconds/3600) + pad(seconds/60%60)
+	if seconds%60 != 0 {
+		offset += pad(seconds % 60)
+	}
+	return offset
+}
+
+func pad(n int) string {
+	if n < 10 {
+		return "0" + itoa(n)
+	}
+	return itoa(n)
+}
@@ -0,0 +1,43 @@
+package ical
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Content lines are at most 75 octets, longer ones are folded onto
+// continuation lines starting with a space
+const maxLineOctets = 75
+
+type writer struct {
+	strings.Builder
+}
+
+// Writes a content line, folded and ended with CRLF
+func (w *writer) line(text string) {
+	limit := maxLineOctets
+	for len(text) > limit {
+		// Never split a UTF-8 sequence
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(text[cut]) {
+			cut--
+		}
+
+		w.WriteString(text[:cut])
+		w.WriteString("\r\n ")
+		text = text[cut:]
+		limit = maxLineOctets - 1
+	}
+
+	w.WriteString(text)
+	w.WriteString("\r\n")
+}
+
+func itoa(n int) string {
+	return strconv.Itoa(n)
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', 6, 64)
+}
@@ -0,0 +1,23 @@
+package mailer
+
+import (
+	"log"
+)
+
+// Logs emails instead of sending them
+type logMailer struct {
+	from string
+}
+
+func NewLogMailer(from string) Mailer {
+	return logMailer{from: from}
+}
+
+func (m logMailer) From() string {
+	return m.from
+}
+
+func (m logMailer) Send(message Message) error {
+	log.Printf("[Mailer] Email to %s: %s (%d attachments)", message.To, message.Subject, len(message.Attachments))
+	return nil
+}
@@ -0,0 +1,52 @@
+package mailer
+
+import (
+	"log"
+	"os"
+	"strconv"
+)
+
+type Attachment struct {
+	Name        string
+	ContentType string
+	Data        []byte
+}
+
+type Message struct {
+	To          string
+	Subject     string
+	Body        string
+	Attachments []Attachment
+}
+
+// Sends plain text emails with optional attachments
+type Mailer interface {
+	Send(Message) error
+	// The address emails are sent from
+	From() string
+}
+
+// Picks the mailer from the environment. SMTP_HOST sends through that
+// server (SMTP_PORT, default 465, SMTP_USERNAME, SMTP_PASSWORD) from
+// MAIL_FROM; without it emails are only logged, so running locally never
+// sends anything.
+func FromEnvironment() Mailer {
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = "noreply@localhost"
+	}
+
+	host := os.Getenv("SMTP_HOST")
+	if host == "" {
+		log.Println("Email disabled, logging emails instead")
+		return NewLogMailer(from)
+	}
+
+	port, err := strconv.Atoi(os.Getenv("SMTP_PORT"))
+	if err != nil {
+		port = 465
+	}
+
+	log.Println("Sending email through " + host)
+	return NewSMTPMailer(host, port, os.Getenv("SMTP_USERNAME"), os.Getenv("SMTP_PASSWORD"), from)
+}
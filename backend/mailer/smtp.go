@@ -0,0 +1,43 @@
+package mailer
+
+import (
+	"io"
+
+	"github.com/go-gomail/gomail"
+)
+
+type smtpMailer struct {
+	dialer *gomail.Dialer
+	from   string
+}
+
+func NewSMTPMailer(host string, port int, username string, password string, from string) Mailer {
+	return smtpMailer{
+		dialer: gomail.NewDialer(host, port, username, password),
+		from:   from,
+	}
+}
+
+func (m smtpMailer) From() string {
+	return m.from
+}
+
+func (m smtpMailer) Send(message Message) error {
+	email := gomail.NewMessage()
+	email.SetHeader("From", m.from)
+	email.SetHeader("To", message.To)
+	email.SetHeader("Subject", message.Subject)
+	email.SetBody("text/plain", message.Body)
+
+	for _, attachment := range message.Attachments {
+		data := attachment.Data
+		email.Attach(attachment.Name,
+			gomail.SetHeader(map[string][]string{"Content-Type": {attachment.ContentType}}),
+			gomail.SetCopyFunc(func(w io.Writer) error {
+				_, err := w.Write(data)
+				return err
+			}))
+	}
+
+	return m.dialer.DialAndSend(email)
+}
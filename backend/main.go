@@ -3,10 +3,14 @@ package main
 import (
 	"log"
 	"os"
+	// Event time zones must resolve even where the OS has no zone database
+	_ "time/tzdata"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/database"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 	"github.com/VolunteerOne/volunteer-one-app/backend/server"
+	"github.com/VolunteerOne/volunteer-one-app/backend/service"
 	"github.com/joho/godotenv"
 )
 
@@ -15,6 +19,13 @@ func main() {
 
 	if os.Getenv("DB_MIGRATION") != "" {
 		models.Init()
+
+		// One-time conversion of the free-form interest/skill strings into tags
+		db := database.GetDatabase()
+		tagService := service.NewTagService(repository.NewTagRepository(db), repository.NewEventRepository(db), repository.NewOrgUsersRepository(db))
+		if err := tagService.MigrateLegacyTags(); err != nil {
+			log.Fatalf("Could not migrate legacy tags: %v\n", err)
+		}
 	}
 
 	server.Init() // Start Server
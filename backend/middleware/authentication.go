@@ -58,6 +58,11 @@ func BasicAuth(c *gin.Context) {
 
 		log.Println("good token")
 
+		// Attach the authenticated user's id to the request
+		if sub, ok := claims["sub"].(float64); ok {
+			c.Set("userId", uint(sub))
+		}
+
 		// // Find the user with token "user"
 		// var user models.User
 		// initializers.DB.First(&user, claims["sub"])
@@ -94,3 +99,15 @@ func Validate(token string, secret string) (*jwt.Token, error) {
 		return []byte(secret), nil
 	})
 }
+
+// Authenticates the request like BasicAuth when it carries a token and
+// lets it through anonymously when it does not, for endpoints that show
+// signed in users more
+func OptionalAuth(c *gin.Context) {
+	if _, ok := c.Request.Header["Token"]; !ok {
+		c.Next()
+		return
+	}
+
+	BasicAuth(c)
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+	"github.com/gin-gonic/gin"
+)
+
+// Returns the id of the user authenticated by BasicAuth
+func CurrentUserId(c *gin.Context) (uint, bool) {
+	value, ok := c.Get("userId")
+	if !ok {
+		return 0, false
+	}
+
+	userId, ok := value.(uint)
+	return userId, ok
+}
+
+// Only lets platform administrators through. Must run after BasicAuth.
+func AdminAuth(usersRepository repository.UsersRepository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userId, ok := CurrentUserId(c)
+
+		if !ok {
+			log.Println("No authenticated user on request")
+			c.JSON(http.StatusUnauthorized, gin.H{
+				"message": "Authentication required",
+				"success": false,
+			})
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+
+		var user models.Users
+		user, err := usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), user)
+
+		if err != nil || !user.Admin {
+			log.Println("User is not an administrator")
+			c.JSON(http.StatusForbidden, gin.H{
+				"message": "Administrator access required",
+				"success": false,
+			})
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,53 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// AttendanceController is an autogenerated mock type for the AttendanceController type
+type AttendanceController struct {
+	mock.Mock
+}
+
+// CheckIn provides a mock function with given fields: c
+func (_m *AttendanceController) CheckIn(c *gin.Context) {
+	_m.Called(c)
+}
+
+// CheckInCode provides a mock function with given fields: c
+func (_m *AttendanceController) CheckInCode(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Hours provides a mock function with given fields: c
+func (_m *AttendanceController) Hours(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Roster provides a mock function with given fields: c
+func (_m *AttendanceController) Roster(c *gin.Context) {
+	_m.Called(c)
+}
+
+// VerifyHours provides a mock function with given fields: c
+func (_m *AttendanceController) VerifyHours(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewAttendanceController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAttendanceController creates a new instance of AttendanceController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAttendanceController(t mockConstructorTestingTNewAttendanceController) *AttendanceController {
+	mock := &AttendanceController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,178 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AttendanceRepository is an autogenerated mock type for the AttendanceRepository type
+type AttendanceRepository struct {
+	mock.Mock
+}
+
+// CreateAttendance provides a mock function with given fields: _a0, _a1
+func (_m *AttendanceRepository) CreateAttendance(_a0 models.EventAttendance, _a1 bool) (models.EventAttendance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.EventAttendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventAttendance, bool) (models.EventAttendance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventAttendance, bool) models.EventAttendance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.EventAttendance)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventAttendance, bool) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindAttendance provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AttendanceRepository) FindAttendance(_a0 uint, _a1 uint, _a2 time.Time, _a3 uint) (models.EventAttendance, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.EventAttendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) (models.EventAttendance, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) models.EventAttendance); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.EventAttendance)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindHours provides a mock function with given fields: _a0
+func (_m *AttendanceRepository) FindHours(_a0 uint) (models.VolunteerHours, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.VolunteerHours
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.VolunteerHours, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.VolunteerHours); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.VolunteerHours)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAttendance provides a mock function with given fields: _a0, _a1
+func (_m *AttendanceRepository) GetAttendance(_a0 uint, _a1 time.Time) ([]models.EventAttendance, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.EventAttendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time) ([]models.EventAttendance, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time) []models.EventAttendance); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventAttendance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetHours provides a mock function with given fields: _a0, _a1
+func (_m *AttendanceRepository) GetHours(_a0 uint, _a1 string) ([]models.VolunteerHours, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.VolunteerHours
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string) ([]models.VolunteerHours, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string) []models.VolunteerHours); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VolunteerHours)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateHours provides a mock function with given fields: _a0
+func (_m *AttendanceRepository) UpdateHours(_a0 models.VolunteerHours) (models.VolunteerHours, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.VolunteerHours
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.VolunteerHours) (models.VolunteerHours, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.VolunteerHours) models.VolunteerHours); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.VolunteerHours)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.VolunteerHours) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAttendanceRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAttendanceRepository creates a new instance of AttendanceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAttendanceRepository(t mockConstructorTestingTNewAttendanceRepository) *AttendanceRepository {
+	mock := &AttendanceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
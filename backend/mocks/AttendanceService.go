@@ -0,0 +1,152 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AttendanceService is an autogenerated mock type for the AttendanceService type
+type AttendanceService struct {
+	mock.Mock
+}
+
+// CheckIn provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AttendanceService) CheckIn(_a0 uint, _a1 uint, _a2 string, _a3 bool) (models.EventAttendance, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.EventAttendance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, bool) (models.EventAttendance, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, bool) models.EventAttendance); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.EventAttendance)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckInCode provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *AttendanceService) CheckInCode(_a0 uint, _a1 uint, _a2 time.Time, _a3 uint) (models.CheckInCode, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.CheckInCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) (models.CheckInCode, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) models.CheckInCode); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.CheckInCode)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Hours provides a mock function with given fields: _a0, _a1
+func (_m *AttendanceService) Hours(_a0 uint, _a1 uint) ([]models.VolunteerHours, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.VolunteerHours
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) ([]models.VolunteerHours, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) []models.VolunteerHours); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.VolunteerHours)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Roster provides a mock function with given fields: _a0, _a1, _a2
+func (_m *AttendanceService) Roster(_a0 uint, _a1 uint, _a2 time.Time) (models.AttendanceRoster, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.AttendanceRoster
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) (models.AttendanceRoster, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) models.AttendanceRoster); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.AttendanceRoster)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyHours provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *AttendanceService) VerifyHours(_a0 uint, _a1 uint, _a2 uint, _a3 bool, _a4 *time.Time, _a5 *time.Time) (models.VolunteerHours, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 models.VolunteerHours
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, bool, *time.Time, *time.Time) (models.VolunteerHours, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, bool, *time.Time, *time.Time) models.VolunteerHours); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r0 = ret.Get(0).(models.VolunteerHours)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint, bool, *time.Time, *time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewAttendanceService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewAttendanceService creates a new instance of AttendanceService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewAttendanceService(t mockConstructorTestingTNewAttendanceService) *AttendanceService {
+	mock := &AttendanceService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
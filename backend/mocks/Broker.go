@@ -0,0 +1,47 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	realtime "github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Broker is an autogenerated mock type for the Broker type
+type Broker struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: _a0
+func (_m *Broker) Publish(_a0 realtime.Event) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(realtime.Event) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Subscribe provides a mock function with given fields: deliver
+func (_m *Broker) Subscribe(deliver func(realtime.Event)) {
+	_m.Called(deliver)
+}
+
+type mockConstructorTestingTNewBroker interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewBroker creates a new instance of Broker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewBroker(t mockConstructorTestingTNewBroker) *Broker {
+	mock := &Broker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
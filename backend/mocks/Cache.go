@@ -0,0 +1,73 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	feedcache "github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Cache is an autogenerated mock type for the Cache type
+type Cache struct {
+	mock.Mock
+}
+
+// Forget provides a mock function with given fields: userIds
+func (_m *Cache) Forget(userIds ...uint) {
+	_va := make([]interface{}, len(userIds))
+	for _i := range userIds {
+		_va[_i] = userIds[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	_m.Called(_ca...)
+}
+
+// Get provides a mock function with given fields: userId
+func (_m *Cache) Get(userId uint) (feedcache.Snapshot, bool) {
+	ret := _m.Called(userId)
+
+	var r0 feedcache.Snapshot
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(uint) (feedcache.Snapshot, bool)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) feedcache.Snapshot); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Get(0).(feedcache.Snapshot)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) bool); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Invalidate provides a mock function with given fields: sources
+func (_m *Cache) Invalidate(sources feedcache.Sources) {
+	_m.Called(sources)
+}
+
+// Put provides a mock function with given fields: userId, snapshot
+func (_m *Cache) Put(userId uint, snapshot feedcache.Snapshot) {
+	_m.Called(userId, snapshot)
+}
+
+type mockConstructorTestingTNewCache interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCache creates a new instance of Cache. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCache(t mockConstructorTestingTNewCache) *Cache {
+	mock := &Cache{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CalendarController is an autogenerated mock type for the CalendarController type
+type CalendarController struct {
+	mock.Mock
+}
+
+// CalendarURL provides a mock function with given fields: c
+func (_m *CalendarController) CalendarURL(c *gin.Context) {
+	_m.Called(c)
+}
+
+// EventCalendar provides a mock function with given fields: c
+func (_m *CalendarController) EventCalendar(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ResetCalendarURL provides a mock function with given fields: c
+func (_m *CalendarController) ResetCalendarURL(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UserCalendar provides a mock function with given fields: c
+func (_m *CalendarController) UserCalendar(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewCalendarController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCalendarController creates a new instance of CalendarController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCalendarController(t mockConstructorTestingTNewCalendarController) *CalendarController {
+	mock := &CalendarController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// CalendarService is an autogenerated mock type for the CalendarService type
+type CalendarService struct {
+	mock.Mock
+}
+
+// CalendarToken provides a mock function with given fields: _a0, _a1
+func (_m *CalendarService) CalendarToken(_a0 uint, _a1 bool) (string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, bool) (string, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, bool) string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, bool) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventCalendar provides a mock function with given fields: _a0
+func (_m *CalendarService) EventCalendar(_a0 string) (string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserCalendar provides a mock function with given fields: _a0
+func (_m *CalendarService) UserCalendar(_a0 string) (string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (string, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewCalendarService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewCalendarService creates a new instance of CalendarService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewCalendarService(t mockConstructorTestingTNewCalendarService) *CalendarService {
+	mock := &CalendarService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -12,8 +12,8 @@ type CommentsController struct {
 	mock.Mock
 }
 
-// AllComments provides a mock function with given fields: c
-func (_m *CommentsController) AllComments(c *gin.Context) {
+// CommentHistory provides a mock function with given fields: c
+func (_m *CommentsController) CommentHistory(c *gin.Context) {
 	_m.Called(c)
 }
 
@@ -37,6 +37,11 @@ func (_m *CommentsController) FindComment(c *gin.Context) {
 	_m.Called(c)
 }
 
+// PostComments provides a mock function with given fields: c
+func (_m *CommentsController) PostComments(c *gin.Context) {
+	_m.Called(c)
+}
+
 type mockConstructorTestingTNewCommentsController interface {
 	mock.TestingT
 	Cleanup(func())
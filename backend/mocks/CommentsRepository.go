@@ -12,32 +12,6 @@ type CommentsRepository struct {
 	mock.Mock
 }
 
-// AllComments provides a mock function with given fields:
-func (_m *CommentsRepository) AllComments() ([]models.Comments, error) {
-	ret := _m.Called()
-
-	var r0 []models.Comments
-	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Comments, error)); ok {
-		return rf()
-	}
-	if rf, ok := ret.Get(0).(func() []models.Comments); ok {
-		r0 = rf()
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Comments)
-		}
-	}
-
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
 // CreateComment provides a mock function with given fields: Comment
 func (_m *CommentsRepository) CreateComment(Comment models.Comments) (models.Comments, error) {
 	ret := _m.Called(Comment)
@@ -76,23 +50,23 @@ func (_m *CommentsRepository) DeleteComment(Comment models.Comments) error {
 	return r0
 }
 
-// EditComment provides a mock function with given fields: Comment
-func (_m *CommentsRepository) EditComment(Comment models.Comments) (models.Comments, error) {
-	ret := _m.Called(Comment)
+// EditComment provides a mock function with given fields: Comment, previous
+func (_m *CommentsRepository) EditComment(Comment models.Comments, previous string) (models.Comments, error) {
+	ret := _m.Called(Comment, previous)
 
 	var r0 models.Comments
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Comments) (models.Comments, error)); ok {
-		return rf(Comment)
+	if rf, ok := ret.Get(0).(func(models.Comments, string) (models.Comments, error)); ok {
+		return rf(Comment, previous)
 	}
-	if rf, ok := ret.Get(0).(func(models.Comments) models.Comments); ok {
-		r0 = rf(Comment)
+	if rf, ok := ret.Get(0).(func(models.Comments, string) models.Comments); ok {
+		r0 = rf(Comment, previous)
 	} else {
 		r0 = ret.Get(0).(models.Comments)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Comments) error); ok {
-		r1 = rf(Comment)
+	if rf, ok := ret.Get(1).(func(models.Comments, string) error); ok {
+		r1 = rf(Comment, previous)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,6 +98,84 @@ func (_m *CommentsRepository) FindComment(id string) (models.Comments, error) {
 	return r0, r1
 }
 
+// GetEdits provides a mock function with given fields: commentId
+func (_m *CommentsRepository) GetEdits(commentId uint) ([]models.CommentEdits, error) {
+	ret := _m.Called(commentId)
+
+	var r0 []models.CommentEdits
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.CommentEdits, error)); ok {
+		return rf(commentId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.CommentEdits); ok {
+		r0 = rf(commentId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.CommentEdits)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(commentId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReplies provides a mock function with given fields: threadIds
+func (_m *CommentsRepository) GetReplies(threadIds []uint) ([]models.Comments, error) {
+	ret := _m.Called(threadIds)
+
+	var r0 []models.Comments
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]models.Comments, error)); ok {
+		return rf(threadIds)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []models.Comments); ok {
+		r0 = rf(threadIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(threadIds)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetThreads provides a mock function with given fields: postId, afterId, limit
+func (_m *CommentsRepository) GetThreads(postId uint, afterId uint, limit int) ([]models.Comments, error) {
+	ret := _m.Called(postId, afterId, limit)
+
+	var r0 []models.Comments
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, int) ([]models.Comments, error)); ok {
+		return rf(postId, afterId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, int) []models.Comments); ok {
+		r0 = rf(postId, afterId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Comments)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, int) error); ok {
+		r1 = rf(postId, afterId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewCommentsRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,25 +12,25 @@ type CommentsService struct {
 	mock.Mock
 }
 
-// AllComments provides a mock function with given fields:
-func (_m *CommentsService) AllComments() ([]models.Comments, error) {
-	ret := _m.Called()
+// CommentHistory provides a mock function with given fields: id, viewerId
+func (_m *CommentsService) CommentHistory(id uint, viewerId uint) ([]models.CommentEdits, error) {
+	ret := _m.Called(id, viewerId)
 
-	var r0 []models.Comments
+	var r0 []models.CommentEdits
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Comments, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(uint, uint) ([]models.CommentEdits, error)); ok {
+		return rf(id, viewerId)
 	}
-	if rf, ok := ret.Get(0).(func() []models.Comments); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(uint, uint) []models.CommentEdits); ok {
+		r0 = rf(id, viewerId)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Comments)
+			r0 = ret.Get(0).([]models.CommentEdits)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(id, viewerId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,23 +38,23 @@ func (_m *CommentsService) AllComments() ([]models.Comments, error) {
 	return r0, r1
 }
 
-// CreateComment provides a mock function with given fields: comment
-func (_m *CommentsService) CreateComment(comment models.Comments) (models.Comments, error) {
-	ret := _m.Called(comment)
+// CreateComment provides a mock function with given fields: userId, comment
+func (_m *CommentsService) CreateComment(userId uint, comment models.Comments) (models.Comments, error) {
+	ret := _m.Called(userId, comment)
 
 	var r0 models.Comments
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Comments) (models.Comments, error)); ok {
-		return rf(comment)
+	if rf, ok := ret.Get(0).(func(uint, models.Comments) (models.Comments, error)); ok {
+		return rf(userId, comment)
 	}
-	if rf, ok := ret.Get(0).(func(models.Comments) models.Comments); ok {
-		r0 = rf(comment)
+	if rf, ok := ret.Get(0).(func(uint, models.Comments) models.Comments); ok {
+		r0 = rf(userId, comment)
 	} else {
 		r0 = ret.Get(0).(models.Comments)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Comments) error); ok {
-		r1 = rf(comment)
+	if rf, ok := ret.Get(1).(func(uint, models.Comments) error); ok {
+		r1 = rf(userId, comment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +62,13 @@ func (_m *CommentsService) CreateComment(comment models.Comments) (models.Commen
 	return r0, r1
 }
 
-// DeleteComment provides a mock function with given fields: comment
-func (_m *CommentsService) DeleteComment(comment models.Comments) error {
-	ret := _m.Called(comment)
+// DeleteComment provides a mock function with given fields: userId, id
+func (_m *CommentsService) DeleteComment(userId uint, id uint) error {
+	ret := _m.Called(userId, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Comments) error); ok {
-		r0 = rf(comment)
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userId, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -76,23 +76,23 @@ func (_m *CommentsService) DeleteComment(comment models.Comments) error {
 	return r0
 }
 
-// EditComment provides a mock function with given fields: comment
-func (_m *CommentsService) EditComment(comment models.Comments) (models.Comments, error) {
-	ret := _m.Called(comment)
+// EditComment provides a mock function with given fields: userId, id, description
+func (_m *CommentsService) EditComment(userId uint, id uint, description string) (models.Comments, error) {
+	ret := _m.Called(userId, id, description)
 
 	var r0 models.Comments
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Comments) (models.Comments, error)); ok {
-		return rf(comment)
+	if rf, ok := ret.Get(0).(func(uint, uint, string) (models.Comments, error)); ok {
+		return rf(userId, id, description)
 	}
-	if rf, ok := ret.Get(0).(func(models.Comments) models.Comments); ok {
-		r0 = rf(comment)
+	if rf, ok := ret.Get(0).(func(uint, uint, string) models.Comments); ok {
+		r0 = rf(userId, id, description)
 	} else {
 		r0 = ret.Get(0).(models.Comments)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Comments) error); ok {
-		r1 = rf(comment)
+	if rf, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = rf(userId, id, description)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,23 +100,47 @@ func (_m *CommentsService) EditComment(comment models.Comments) (models.Comments
 	return r0, r1
 }
 
-// FindComment provides a mock function with given fields: id
-func (_m *CommentsService) FindComment(id string) (models.Comments, error) {
-	ret := _m.Called(id)
+// FindComment provides a mock function with given fields: id, viewerId
+func (_m *CommentsService) FindComment(id string, viewerId uint) (models.Comments, error) {
+	ret := _m.Called(id, viewerId)
 
 	var r0 models.Comments
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (models.Comments, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(string, uint) (models.Comments, error)); ok {
+		return rf(id, viewerId)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.Comments); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(string, uint) models.Comments); ok {
+		r0 = rf(id, viewerId)
 	} else {
 		r0 = ret.Get(0).(models.Comments)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(string, uint) error); ok {
+		r1 = rf(id, viewerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostComments provides a mock function with given fields: postId, cursor, limit, viewerId
+func (_m *CommentsService) PostComments(postId uint, cursor string, limit int, viewerId uint) (models.CommentPage, error) {
+	ret := _m.Called(postId, cursor, limit, viewerId)
+
+	var r0 models.CommentPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, int, uint) (models.CommentPage, error)); ok {
+		return rf(postId, cursor, limit, viewerId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, int, uint) models.CommentPage); ok {
+		r0 = rf(postId, cursor, limit, viewerId)
+	} else {
+		r0 = ret.Get(0).(models.CommentPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, int, uint) error); ok {
+		r1 = rf(postId, cursor, limit, viewerId)
 	} else {
 		r1 = ret.Error(1)
 	}
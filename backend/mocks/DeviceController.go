@@ -0,0 +1,43 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeviceController is an autogenerated mock type for the DeviceController type
+type DeviceController struct {
+	mock.Mock
+}
+
+// All provides a mock function with given fields: c
+func (_m *DeviceController) All(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Register provides a mock function with given fields: c
+func (_m *DeviceController) Register(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Unregister provides a mock function with given fields: c
+func (_m *DeviceController) Unregister(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewDeviceController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDeviceController creates a new instance of DeviceController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDeviceController(t mockConstructorTestingTNewDeviceController) *DeviceController {
+	mock := &DeviceController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,116 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DeviceRepository is an autogenerated mock type for the DeviceRepository type
+type DeviceRepository struct {
+	mock.Mock
+}
+
+// DeleteDevice provides a mock function with given fields: _a0, _a1
+func (_m *DeviceRepository) DeleteDevice(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteTokens provides a mock function with given fields: _a0
+func (_m *DeviceRepository) DeleteTokens(_a0 []string) (int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]string) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func([]string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDevices provides a mock function with given fields: _a0
+func (_m *DeviceRepository) GetDevices(_a0 uint) ([]models.DeviceTokens, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.DeviceTokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.DeviceTokens, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.DeviceTokens); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceTokens)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SaveDevice provides a mock function with given fields: _a0
+func (_m *DeviceRepository) SaveDevice(_a0 models.DeviceTokens) (models.DeviceTokens, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.DeviceTokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.DeviceTokens) (models.DeviceTokens, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.DeviceTokens) models.DeviceTokens); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.DeviceTokens)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.DeviceTokens) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDeviceRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDeviceRepository creates a new instance of DeviceRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDeviceRepository(t mockConstructorTestingTNewDeviceRepository) *DeviceRepository {
+	mock := &DeviceRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
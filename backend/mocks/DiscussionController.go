@@ -0,0 +1,53 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DiscussionController is an autogenerated mock type for the DiscussionController type
+type DiscussionController struct {
+	mock.Mock
+}
+
+// DeleteMessage provides a mock function with given fields: c
+func (_m *DiscussionController) DeleteMessage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Messages provides a mock function with given fields: c
+func (_m *DiscussionController) Messages(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PinMessage provides a mock function with given fields: c
+func (_m *DiscussionController) PinMessage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PostMessage provides a mock function with given fields: c
+func (_m *DiscussionController) PostMessage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UnpinMessage provides a mock function with given fields: c
+func (_m *DiscussionController) UnpinMessage(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewDiscussionController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDiscussionController creates a new instance of DiscussionController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDiscussionController(t mockConstructorTestingTNewDiscussionController) *DiscussionController {
+	mock := &DiscussionController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
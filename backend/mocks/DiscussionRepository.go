@@ -0,0 +1,216 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DiscussionRepository is an autogenerated mock type for the DiscussionRepository type
+type DiscussionRepository struct {
+	mock.Mock
+}
+
+// CreateMessage provides a mock function with given fields: message
+func (_m *DiscussionRepository) CreateMessage(message models.DiscussionMessages) (models.DiscussionMessages, error) {
+	ret := _m.Called(message)
+
+	var r0 models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.DiscussionMessages) (models.DiscussionMessages, error)); ok {
+		return rf(message)
+	}
+	if rf, ok := ret.Get(0).(func(models.DiscussionMessages) models.DiscussionMessages); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Get(0).(models.DiscussionMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.DiscussionMessages) error); ok {
+		r1 = rf(message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteMessage provides a mock function with given fields: message
+func (_m *DiscussionRepository) DeleteMessage(message models.DiscussionMessages) error {
+	ret := _m.Called(message)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.DiscussionMessages) error); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// EditMessage provides a mock function with given fields: message
+func (_m *DiscussionRepository) EditMessage(message models.DiscussionMessages) (models.DiscussionMessages, error) {
+	ret := _m.Called(message)
+
+	var r0 models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.DiscussionMessages) (models.DiscussionMessages, error)); ok {
+		return rf(message)
+	}
+	if rf, ok := ret.Get(0).(func(models.DiscussionMessages) models.DiscussionMessages); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Get(0).(models.DiscussionMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.DiscussionMessages) error); ok {
+		r1 = rf(message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindMessage provides a mock function with given fields: id
+func (_m *DiscussionRepository) FindMessage(id uint) (models.DiscussionMessages, error) {
+	ret := _m.Called(id)
+
+	var r0 models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.DiscussionMessages, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.DiscussionMessages); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.DiscussionMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessages provides a mock function with given fields: eventId, beforeId, limit
+func (_m *DiscussionRepository) GetMessages(eventId uint, beforeId uint, limit int) ([]models.DiscussionMessages, error) {
+	ret := _m.Called(eventId, beforeId, limit)
+
+	var r0 []models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, int) ([]models.DiscussionMessages, error)); ok {
+		return rf(eventId, beforeId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, int) []models.DiscussionMessages); ok {
+		r0 = rf(eventId, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DiscussionMessages)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, int) error); ok {
+		r1 = rf(eventId, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPinnedMessages provides a mock function with given fields: eventId
+func (_m *DiscussionRepository) GetPinnedMessages(eventId uint) ([]models.DiscussionMessages, error) {
+	ret := _m.Called(eventId)
+
+	var r0 []models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.DiscussionMessages, error)); ok {
+		return rf(eventId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.DiscussionMessages); ok {
+		r0 = rf(eventId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DiscussionMessages)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsVolunteer provides a mock function with given fields: eventId, userId
+func (_m *DiscussionRepository) IsVolunteer(eventId uint, userId uint) (bool, error) {
+	ret := _m.Called(eventId, userId)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(eventId, userId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(eventId, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(eventId, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Volunteers provides a mock function with given fields: eventId
+func (_m *DiscussionRepository) Volunteers(eventId uint) ([]uint, error) {
+	ret := _m.Called(eventId)
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]uint, error)); ok {
+		return rf(eventId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []uint); ok {
+		r0 = rf(eventId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(eventId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDiscussionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDiscussionRepository creates a new instance of DiscussionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDiscussionRepository(t mockConstructorTestingTNewDiscussionRepository) *DiscussionRepository {
+	mock := &DiscussionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,114 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// DiscussionService is an autogenerated mock type for the DiscussionService type
+type DiscussionService struct {
+	mock.Mock
+}
+
+// DeleteMessage provides a mock function with given fields: userId, id
+func (_m *DiscussionService) DeleteMessage(userId uint, id uint) error {
+	ret := _m.Called(userId, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userId, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Messages provides a mock function with given fields: userId, eventId, cursor, limit
+func (_m *DiscussionService) Messages(userId uint, eventId uint, cursor string, limit int) (models.DiscussionPage, error) {
+	ret := _m.Called(userId, eventId, cursor, limit)
+
+	var r0 models.DiscussionPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, int) (models.DiscussionPage, error)); ok {
+		return rf(userId, eventId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, int) models.DiscussionPage); ok {
+		r0 = rf(userId, eventId, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(models.DiscussionPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, int) error); ok {
+		r1 = rf(userId, eventId, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PinMessage provides a mock function with given fields: userId, id, pinned
+func (_m *DiscussionService) PinMessage(userId uint, id uint, pinned bool) (models.DiscussionMessages, error) {
+	ret := _m.Called(userId, id, pinned)
+
+	var r0 models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) (models.DiscussionMessages, error)); ok {
+		return rf(userId, id, pinned)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) models.DiscussionMessages); ok {
+		r0 = rf(userId, id, pinned)
+	} else {
+		r0 = ret.Get(0).(models.DiscussionMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, bool) error); ok {
+		r1 = rf(userId, id, pinned)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostMessage provides a mock function with given fields: userId, eventId, body
+func (_m *DiscussionService) PostMessage(userId uint, eventId uint, body string) (models.DiscussionMessages, error) {
+	ret := _m.Called(userId, eventId, body)
+
+	var r0 models.DiscussionMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string) (models.DiscussionMessages, error)); ok {
+		return rf(userId, eventId, body)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string) models.DiscussionMessages); ok {
+		r0 = rf(userId, eventId, body)
+	} else {
+		r0 = ret.Get(0).(models.DiscussionMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = rf(userId, eventId, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewDiscussionService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDiscussionService creates a new instance of DiscussionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDiscussionService(t mockConstructorTestingTNewDiscussionService) *DiscussionService {
+	mock := &DiscussionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
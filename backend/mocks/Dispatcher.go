@@ -0,0 +1,44 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	push "github.com/VolunteerOne/volunteer-one-app/backend/push"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Dispatcher is an autogenerated mock type for the Dispatcher type
+type Dispatcher struct {
+	mock.Mock
+}
+
+// Send provides a mock function with given fields: _a0
+func (_m *Dispatcher) Send(_a0 []push.Message) []error {
+	ret := _m.Called(_a0)
+
+	var r0 []error
+	if rf, ok := ret.Get(0).(func([]push.Message) []error); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]error)
+		}
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewDispatcher interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewDispatcher creates a new instance of Dispatcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewDispatcher(t mockConstructorTestingTNewDispatcher) *Dispatcher {
+	mock := &Dispatcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -17,6 +17,11 @@ func (_m *EventController) All(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// CancelOccurrence provides a mock function with given fields: _a0
+func (_m *EventController) CancelOccurrence(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // Create provides a mock function with given fields: _a0
 func (_m *EventController) Create(_a0 *gin.Context) {
 	_m.Called(_a0)
@@ -27,16 +32,46 @@ func (_m *EventController) Delete(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// EventOccurrences provides a mock function with given fields: _a0
+func (_m *EventController) EventOccurrences(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Nearby provides a mock function with given fields: _a0
+func (_m *EventController) Nearby(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Occurrences provides a mock function with given fields: _a0
+func (_m *EventController) Occurrences(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // One provides a mock function with given fields: _a0
 func (_m *EventController) One(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// RestoreOccurrence provides a mock function with given fields: _a0
+func (_m *EventController) RestoreOccurrence(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
+// Search provides a mock function with given fields: _a0
+func (_m *EventController) Search(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 // Update provides a mock function with given fields: _a0
 func (_m *EventController) Update(_a0 *gin.Context) {
 	_m.Called(_a0)
 }
 
+// UpdateOccurrence provides a mock function with given fields: _a0
+func (_m *EventController) UpdateOccurrence(_a0 *gin.Context) {
+	_m.Called(_a0)
+}
+
 type mockConstructorTestingTNewEventController interface {
 	mock.TestingT
 	Cleanup(func())
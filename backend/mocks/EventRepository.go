@@ -5,6 +5,8 @@ package mocks
 import (
 	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // EventRepository is an autogenerated mock type for the EventRepository type
@@ -36,6 +38,30 @@ func (_m *EventRepository) CreateEvent(_a0 models.Event) (models.Event, error) {
 	return r0, r1
 }
 
+// CreateException provides a mock function with given fields: _a0
+func (_m *EventRepository) CreateException(_a0 models.EventExceptions) (models.EventExceptions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventExceptions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventExceptions) (models.EventExceptions, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventExceptions) models.EventExceptions); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventExceptions)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventExceptions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteEvent provides a mock function with given fields: _a0
 func (_m *EventRepository) DeleteEvent(_a0 models.Event) error {
 	ret := _m.Called(_a0)
@@ -50,6 +76,20 @@ func (_m *EventRepository) DeleteEvent(_a0 models.Event) error {
 	return r0
 }
 
+// DeleteException provides a mock function with given fields: _a0, _a1
+func (_m *EventRepository) DeleteException(_a0 uint, _a1 time.Time) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetEventById provides a mock function with given fields: _a0
 func (_m *EventRepository) GetEventById(_a0 string) (models.Event, error) {
 	ret := _m.Called(_a0)
@@ -74,25 +114,236 @@ func (_m *EventRepository) GetEventById(_a0 string) (models.Event, error) {
 	return r0, r1
 }
 
-// GetEvents provides a mock function with given fields:
-func (_m *EventRepository) GetEvents() ([]models.Event, error) {
-	ret := _m.Called()
+// GetEvents provides a mock function with given fields: _a0, _a1
+func (_m *EventRepository) GetEvents(_a0 []string, _a1 uint) ([]models.Event, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, uint) ([]models.Event, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func([]string, uint) []models.Event); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventsBetween provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *EventRepository) GetEventsBetween(_a0 time.Time, _a1 time.Time, _a2 uint, _a3 []string) ([]models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, uint, []string) ([]models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, uint, []string) []models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time, uint, []string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetExceptions provides a mock function with given fields: _a0
+func (_m *EventRepository) GetExceptions(_a0 []uint) ([]models.EventExceptions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.EventExceptions
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]models.EventExceptions, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []models.EventExceptions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventExceptions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOverrides provides a mock function with given fields: _a0
+func (_m *EventRepository) GetOverrides(_a0 []uint) ([]models.Event, error) {
+	ret := _m.Called(_a0)
 
 	var r0 []models.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Event, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func([]uint) ([]models.Event, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []models.Event); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStatusChanges provides a mock function with given fields: _a0
+func (_m *EventRepository) GetStatusChanges(_a0 uint) ([]models.EventStatusChanges, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.EventStatusChanges
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.EventStatusChanges, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.EventStatusChanges); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventStatusChanges)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NearbyEvents provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5, _a6
+func (_m *EventRepository) NearbyEvents(_a0 float64, _a1 float64, _a2 float64, _a3 time.Time, _a4 int, _a5 []string, _a6 uint) ([]models.NearbyEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+
+	var r0 []models.NearbyEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, time.Time, int, []string, uint) ([]models.NearbyEvent, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
 	}
-	if rf, ok := ret.Get(0).(func() []models.Event); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, time.Time, int, []string, uint) []models.NearbyEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NearbyEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(float64, float64, float64, time.Time, int, []string, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchEvents provides a mock function with given fields: _a0
+func (_m *EventRepository) SearchEvents(_a0 models.EventSearchQuery) ([]models.Event, int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Event
+	var r1 int64
+	var r2 error
+	if rf, ok := ret.Get(0).(func(models.EventSearchQuery) ([]models.Event, int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventSearchQuery) []models.Event); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(models.EventSearchQuery) int64); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(int64)
+	}
+
+	if rf, ok := ret.Get(2).(func(models.EventSearchQuery) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetStatus provides a mock function with given fields: _a0, _a1
+func (_m *EventRepository) SetStatus(_a0 models.Event, _a1 models.EventStatusChanges) (models.Event, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Event, models.EventStatusChanges) (models.Event, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(models.Event, models.EventStatusChanges) models.Event); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Event, models.EventStatusChanges) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SplitSeries provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *EventRepository) SplitSeries(_a0 models.Event, _a1 models.Event, _a2 time.Time, _a3 time.Duration) (models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Event, models.Event, time.Time, time.Duration) (models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(models.Event, models.Event, time.Time, time.Duration) models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Event, models.Event, time.Time, time.Duration) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,6 +375,30 @@ func (_m *EventRepository) UpdateEvent(_a0 models.Event) (models.Event, error) {
 	return r0, r1
 }
 
+// UpdateSeries provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EventRepository) UpdateSeries(_a0 models.Event, _a1 time.Time, _a2 time.Duration) (models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, time.Duration) (models.Event, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, time.Duration) models.Event); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Event, time.Time, time.Duration) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewEventRepository interface {
 	mock.TestingT
 	Cleanup(func())
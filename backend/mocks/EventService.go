@@ -5,6 +5,8 @@ package mocks
 import (
 	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // EventService is an autogenerated mock type for the EventService type
@@ -12,23 +14,23 @@ type EventService struct {
 	mock.Mock
 }
 
-// CreateEvent provides a mock function with given fields: _a0
-func (_m *EventService) CreateEvent(_a0 models.Event) (models.Event, error) {
-	ret := _m.Called(_a0)
+// CancelOccurrence provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EventService) CancelOccurrence(_a0 models.Event, _a1 time.Time, _a2 uint) (models.EventExceptions, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
-	var r0 models.Event
+	var r0 models.EventExceptions
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Event) (models.Event, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, uint) (models.EventExceptions, error)); ok {
+		return rf(_a0, _a1, _a2)
 	}
-	if rf, ok := ret.Get(0).(func(models.Event) models.Event); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, uint) models.EventExceptions); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
-		r0 = ret.Get(0).(models.Event)
+		r0 = ret.Get(0).(models.EventExceptions)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Event) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(models.Event, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +38,13 @@ func (_m *EventService) CreateEvent(_a0 models.Event) (models.Event, error) {
 	return r0, r1
 }
 
-// DeleteEvent provides a mock function with given fields: _a0
-func (_m *EventService) DeleteEvent(_a0 models.Event) error {
-	ret := _m.Called(_a0)
+// CheckVisible provides a mock function with given fields: _a0, _a1
+func (_m *EventService) CheckVisible(_a0 models.Event, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Event) error); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Event, uint) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -50,6 +52,30 @@ func (_m *EventService) DeleteEvent(_a0 models.Event) error {
 	return r0
 }
 
+// CreateEvent provides a mock function with given fields: _a0, _a1
+func (_m *EventService) CreateEvent(_a0 models.Event, _a1 uint) (models.Event, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Event, uint) (models.Event, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(models.Event, uint) models.Event); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Event, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEventById provides a mock function with given fields: _a0
 func (_m *EventService) GetEventById(_a0 string) (models.Event, error) {
 	ret := _m.Called(_a0)
@@ -74,25 +100,51 @@ func (_m *EventService) GetEventById(_a0 string) (models.Event, error) {
 	return r0, r1
 }
 
-// GetEvents provides a mock function with given fields:
-func (_m *EventService) GetEvents() ([]models.Event, error) {
-	ret := _m.Called()
+// GetEventOccurrences provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EventService) GetEventOccurrences(_a0 models.Event, _a1 time.Time, _a2 time.Time) ([]models.EventOccurrence, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.EventOccurrence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, time.Time) ([]models.EventOccurrence, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, time.Time) []models.EventOccurrence); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventOccurrence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Event, time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEvents provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EventService) GetEvents(_a0 []string, _a1 uint, _a2 uint) ([]models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 []models.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Event, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func([]string, uint, uint) ([]models.Event, error)); ok {
+		return rf(_a0, _a1, _a2)
 	}
-	if rf, ok := ret.Get(0).(func() []models.Event); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func([]string, uint, uint) []models.Event); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Event)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func([]string, uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,23 +152,137 @@ func (_m *EventService) GetEvents() ([]models.Event, error) {
 	return r0, r1
 }
 
-// UpdateEvent provides a mock function with given fields: _a0
-func (_m *EventService) UpdateEvent(_a0 models.Event) (models.Event, error) {
-	ret := _m.Called(_a0)
+// GetOccurrences provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5
+func (_m *EventService) GetOccurrences(_a0 time.Time, _a1 time.Time, _a2 uint, _a3 int, _a4 []string, _a5 uint) ([]models.EventOccurrence, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5)
+
+	var r0 []models.EventOccurrence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, uint, int, []string, uint) ([]models.EventOccurrence, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time, uint, int, []string, uint) []models.EventOccurrence); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventOccurrence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time, uint, int, []string, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NearbyEvents provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4, _a5, _a6
+func (_m *EventService) NearbyEvents(_a0 float64, _a1 float64, _a2 float64, _a3 int, _a4 []string, _a5 uint, _a6 uint) ([]models.NearbyEvent, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+
+	var r0 []models.NearbyEvent
+	var r1 error
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, int, []string, uint, uint) ([]models.NearbyEvent, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	}
+	if rf, ok := ret.Get(0).(func(float64, float64, float64, int, []string, uint, uint) []models.NearbyEvent); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NearbyEvent)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(float64, float64, float64, int, []string, uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4, _a5, _a6)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreOccurrence provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EventService) RestoreOccurrence(_a0 models.Event, _a1 time.Time, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchEvents provides a mock function with given fields: _a0, _a1, _a2
+func (_m *EventService) SearchEvents(_a0 models.EventSearchQuery, _a1 string, _a2 uint) (models.EventSearchResult, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.EventSearchResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventSearchQuery, string, uint) (models.EventSearchResult, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventSearchQuery, string, uint) models.EventSearchResult); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.EventSearchResult)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventSearchQuery, string, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEvent provides a mock function with given fields: _a0, _a1
+func (_m *EventService) UpdateEvent(_a0 models.Event, _a1 uint) (models.Event, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 models.Event
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Event) (models.Event, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Event, uint) (models.Event, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(models.Event) models.Event); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Event, uint) models.Event); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Get(0).(models.Event)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Event) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(models.Event, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateOccurrence provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *EventService) UpdateOccurrence(_a0 models.Event, _a1 time.Time, _a2 string, _a3 models.Event, _a4 uint) (models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, string, models.Event, uint) (models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(models.Event, time.Time, string, models.Event, uint) models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Get(0).(models.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Event, time.Time, string, models.Event, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,38 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventStatusController is an autogenerated mock type for the EventStatusController type
+type EventStatusController struct {
+	mock.Mock
+}
+
+// ChangeStatus provides a mock function with given fields: c
+func (_m *EventStatusController) ChangeStatus(c *gin.Context) {
+	_m.Called(c)
+}
+
+// StatusHistory provides a mock function with given fields: c
+func (_m *EventStatusController) StatusHistory(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewEventStatusController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewEventStatusController creates a new instance of EventStatusController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewEventStatusController(t mockConstructorTestingTNewEventStatusController) *EventStatusController {
+	mock := &EventStatusController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
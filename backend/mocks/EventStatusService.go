@@ -0,0 +1,78 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EventStatusService is an autogenerated mock type for the EventStatusService type
+type EventStatusService struct {
+	mock.Mock
+}
+
+// ChangeStatus provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *EventStatusService) ChangeStatus(_a0 uint, _a1 uint, _a2 string, _a3 string) (models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string) (models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string) models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.Event)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StatusHistory provides a mock function with given fields: _a0, _a1
+func (_m *EventStatusService) StatusHistory(_a0 uint, _a1 uint) ([]models.EventStatusChanges, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.EventStatusChanges
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) ([]models.EventStatusChanges, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) []models.EventStatusChanges); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventStatusChanges)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewEventStatusService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewEventStatusService creates a new instance of EventStatusService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewEventStatusService(t mockConstructorTestingTNewEventStatusService) *EventStatusService {
+	mock := &EventStatusService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,33 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedController is an autogenerated mock type for the FeedController type
+type FeedController struct {
+	mock.Mock
+}
+
+// UserFeed provides a mock function with given fields: c
+func (_m *FeedController) UserFeed(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewFeedController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFeedController creates a new instance of FeedController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFeedController(t mockConstructorTestingTNewFeedController) *FeedController {
+	mock := &FeedController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
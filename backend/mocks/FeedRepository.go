@@ -0,0 +1,392 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// FeedRepository is an autogenerated mock type for the FeedRepository type
+type FeedRepository struct {
+	mock.Mock
+}
+
+// CommentCounts provides a mock function with given fields: _a0
+func (_m *FeedRepository) CommentCounts(_a0 []uint) (map[uint]int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 map[uint]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) (map[uint]int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) map[uint]int64); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EventTagIds provides a mock function with given fields: _a0
+func (_m *FeedRepository) EventTagIds(_a0 []uint) (map[uint][]uint, error) {
+	ret := _m.Called(_a0)
+
+	var r0 map[uint][]uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) (map[uint][]uint, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) map[uint][]uint); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint][]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindViewer provides a mock function with given fields: _a0
+func (_m *FeedRepository) FindViewer(_a0 uint) (models.PostViewer, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.PostViewer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.PostViewer, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.PostViewer); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.PostViewer)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowedOrganizationIds provides a mock function with given fields: _a0
+func (_m *FeedRepository) FollowedOrganizationIds(_a0 uint) ([]uint, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]uint, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []uint); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowerIds provides a mock function with given fields:
+func (_m *FeedRepository) FollowerIds() ([]uint, error) {
+	ret := _m.Called()
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]uint, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []uint); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InterestTagIds provides a mock function with given fields: _a0
+func (_m *FeedRepository) InterestTagIds(_a0 uint) ([]uint, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]uint, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []uint); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LatestPosts provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *FeedRepository) LatestPosts(_a0 []string, _a1 []uint, _a2 models.PostViewer, _a3 models.FeedCursor, _a4 int) ([]models.Posts, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, []uint, models.PostViewer, models.FeedCursor, int) ([]models.Posts, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func([]string, []uint, models.PostViewer, models.FeedCursor, int) []models.Posts); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, []uint, models.PostViewer, models.FeedCursor, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEvents provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *FeedRepository) NewEvents(_a0 []uint, _a1 []uint, _a2 time.Time, _a3 time.Time) ([]models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint, []uint, time.Time, time.Time) ([]models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func([]uint, []uint, time.Time, time.Time) []models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint, []uint, time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationPosts provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *FeedRepository) OrganizationPosts(_a0 []uint, _a1 models.PostViewer, _a2 time.Time, _a3 int) ([]models.Posts, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint, models.PostViewer, time.Time, int) ([]models.Posts, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func([]uint, models.PostViewer, time.Time, int) []models.Posts); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint, models.PostViewer, time.Time, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationTagIds provides a mock function with given fields: _a0
+func (_m *FeedRepository) OrganizationTagIds(_a0 []uint) (map[uint][]uint, error) {
+	ret := _m.Called(_a0)
+
+	var r0 map[uint][]uint
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) (map[uint][]uint, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) map[uint][]uint); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint][]uint)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostsByHandles provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *FeedRepository) PostsByHandles(_a0 []string, _a1 models.PostViewer, _a2 models.FeedCursor, _a3 int) ([]models.Posts, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]string, models.PostViewer, models.FeedCursor, int) ([]models.Posts, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func([]string, models.PostViewer, models.FeedCursor, int) []models.Posts); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]string, models.PostViewer, models.FeedCursor, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RelevantEvents provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *FeedRepository) RelevantEvents(_a0 []uint, _a1 []uint, _a2 time.Time, _a3 int) ([]models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint, []uint, time.Time, int) ([]models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func([]uint, []uint, time.Time, int) []models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint, []uint, time.Time, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignupCounts provides a mock function with given fields: _a0
+func (_m *FeedRepository) SignupCounts(_a0 []uint) (map[uint]int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 map[uint]int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) (map[uint]int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) map[uint]int64); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint]int64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpcomingEvents provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *FeedRepository) UpcomingEvents(_a0 []uint, _a1 time.Time, _a2 models.FeedCursor, _a3 int) ([]models.Event, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint, time.Time, models.FeedCursor, int) ([]models.Event, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func([]uint, time.Time, models.FeedCursor, int) []models.Event); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint, time.Time, models.FeedCursor, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewFeedRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFeedRepository creates a new instance of FeedRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFeedRepository(t mockConstructorTestingTNewFeedRepository) *FeedRepository {
+	mock := &FeedRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,76 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FeedService is an autogenerated mock type for the FeedService type
+type FeedService struct {
+	mock.Mock
+}
+
+// GetFeed provides a mock function with given fields: _a0, _a1, _a2
+func (_m *FeedService) GetFeed(_a0 uint, _a1 string, _a2 int) (models.FeedPage, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.FeedPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, int) (models.FeedPage, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, int) models.FeedPage); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.FeedPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RankedFeed provides a mock function with given fields: _a0, _a1, _a2
+func (_m *FeedService) RankedFeed(_a0 uint, _a1 string, _a2 int) (models.FeedPage, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.FeedPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, int) (models.FeedPage, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, int) models.FeedPage); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.FeedPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewFeedService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFeedService creates a new instance of FeedService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFeedService(t mockConstructorTestingTNewFeedService) *FeedService {
+	mock := &FeedService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,42 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	contentfilter "github.com/VolunteerOne/volunteer-one-app/backend/contentfilter"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Filter is an autogenerated mock type for the Filter type
+type Filter struct {
+	mock.Mock
+}
+
+// Check provides a mock function with given fields: text
+func (_m *Filter) Check(text string) contentfilter.Verdict {
+	ret := _m.Called(text)
+
+	var r0 contentfilter.Verdict
+	if rf, ok := ret.Get(0).(func(string) contentfilter.Verdict); ok {
+		r0 = rf(text)
+	} else {
+		r0 = ret.Get(0).(contentfilter.Verdict)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewFilter interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFilter creates a new instance of Filter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFilter(t mockConstructorTestingTNewFilter) *Filter {
+	mock := &Filter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FollowController is an autogenerated mock type for the FollowController type
+type FollowController struct {
+	mock.Mock
+}
+
+// Follow provides a mock function with given fields: c
+func (_m *FollowController) Follow(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Followers provides a mock function with given fields: c
+func (_m *FollowController) Followers(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Following provides a mock function with given fields: c
+func (_m *FollowController) Following(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Unfollow provides a mock function with given fields: c
+func (_m *FollowController) Unfollow(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewFollowController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFollowController creates a new instance of FollowController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFollowController(t mockConstructorTestingTNewFollowController) *FollowController {
+	mock := &FollowController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
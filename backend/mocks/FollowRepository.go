@@ -0,0 +1,166 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FollowRepository is an autogenerated mock type for the FollowRepository type
+type FollowRepository struct {
+	mock.Mock
+}
+
+// CountFollowers provides a mock function with given fields: _a0
+func (_m *FollowRepository) CountFollowers(_a0 uint) (int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindFollow provides a mock function with given fields: _a0, _a1
+func (_m *FollowRepository) FindFollow(_a0 uint, _a1 uint) (models.OrgFollowers, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.OrgFollowers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (models.OrgFollowers, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) models.OrgFollowers); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.OrgFollowers)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowOrganization provides a mock function with given fields: _a0
+func (_m *FollowRepository) FollowOrganization(_a0 models.OrgFollowers) (models.OrgFollowers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.OrgFollowers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.OrgFollowers) (models.OrgFollowers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.OrgFollowers) models.OrgFollowers); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.OrgFollowers)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.OrgFollowers) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowers provides a mock function with given fields: _a0
+func (_m *FollowRepository) GetFollowers(_a0 uint) ([]models.PublicUser, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.PublicUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.PublicUser, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.PublicUser); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PublicUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowing provides a mock function with given fields: _a0
+func (_m *FollowRepository) GetFollowing(_a0 uint) ([]models.OrgFollowers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.OrgFollowers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.OrgFollowers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.OrgFollowers); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrgFollowers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnfollowOrganization provides a mock function with given fields: _a0, _a1
+func (_m *FollowRepository) UnfollowOrganization(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewFollowRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFollowRepository creates a new instance of FollowRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFollowRepository(t mockConstructorTestingTNewFollowRepository) *FollowRepository {
+	mock := &FollowRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FollowService is an autogenerated mock type for the FollowService type
+type FollowService struct {
+	mock.Mock
+}
+
+// CountFollowers provides a mock function with given fields: _a0
+func (_m *FollowService) CountFollowers(_a0 uint) (int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FollowOrganization provides a mock function with given fields: _a0, _a1
+func (_m *FollowService) FollowOrganization(_a0 uint, _a1 uint) (models.OrgFollowers, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.OrgFollowers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (models.OrgFollowers, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) models.OrgFollowers); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.OrgFollowers)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowers provides a mock function with given fields: _a0
+func (_m *FollowService) GetFollowers(_a0 uint) ([]models.PublicUser, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.PublicUser
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.PublicUser, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.PublicUser); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.PublicUser)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFollowing provides a mock function with given fields: _a0
+func (_m *FollowService) GetFollowing(_a0 uint) ([]models.OrgFollowers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.OrgFollowers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.OrgFollowers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.OrgFollowers); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrgFollowers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnfollowOrganization provides a mock function with given fields: _a0, _a1
+func (_m *FollowService) UnfollowOrganization(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewFollowService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewFollowService creates a new instance of FollowService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewFollowService(t mockConstructorTestingTNewFollowService) *FollowService {
+	mock := &FollowService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
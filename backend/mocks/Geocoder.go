@@ -0,0 +1,52 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	geocoder "github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Geocoder is an autogenerated mock type for the Geocoder type
+type Geocoder struct {
+	mock.Mock
+}
+
+// Geocode provides a mock function with given fields: address
+func (_m *Geocoder) Geocode(address string) (geocoder.Location, error) {
+	ret := _m.Called(address)
+
+	var r0 geocoder.Location
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (geocoder.Location, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) geocoder.Location); ok {
+		r0 = rf(address)
+	} else {
+		r0 = ret.Get(0).(geocoder.Location)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewGeocoder interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewGeocoder creates a new instance of Geocoder. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewGeocoder(t mockConstructorTestingTNewGeocoder) *Geocoder {
+	mock := &Geocoder{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
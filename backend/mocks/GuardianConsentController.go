@@ -0,0 +1,48 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GuardianConsentController is an autogenerated mock type for the GuardianConsentController type
+type GuardianConsentController struct {
+	mock.Mock
+}
+
+// ConsentRequest provides a mock function with given fields: c
+func (_m *GuardianConsentController) ConsentRequest(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Consents provides a mock function with given fields: c
+func (_m *GuardianConsentController) Consents(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RequestConsent provides a mock function with given fields: c
+func (_m *GuardianConsentController) RequestConsent(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RespondToConsent provides a mock function with given fields: c
+func (_m *GuardianConsentController) RespondToConsent(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewGuardianConsentController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewGuardianConsentController creates a new instance of GuardianConsentController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewGuardianConsentController(t mockConstructorTestingTNewGuardianConsentController) *GuardianConsentController {
+	mock := &GuardianConsentController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
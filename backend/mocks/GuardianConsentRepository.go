@@ -0,0 +1,150 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GuardianConsentRepository is an autogenerated mock type for the GuardianConsentRepository type
+type GuardianConsentRepository struct {
+	mock.Mock
+}
+
+// CreateConsent provides a mock function with given fields: _a0
+func (_m *GuardianConsentRepository) CreateConsent(_a0 models.GuardianConsents) (models.GuardianConsents, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.GuardianConsents) (models.GuardianConsents, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.GuardianConsents) models.GuardianConsents); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.GuardianConsents)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.GuardianConsents) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindApprovedConsent provides a mock function with given fields: _a0, _a1
+func (_m *GuardianConsentRepository) FindApprovedConsent(_a0 uint, _a1 uint) (models.GuardianConsents, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (models.GuardianConsents, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) models.GuardianConsents); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.GuardianConsents)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindConsentByToken provides a mock function with given fields: _a0
+func (_m *GuardianConsentRepository) FindConsentByToken(_a0 string) (models.GuardianConsents, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.GuardianConsents, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.GuardianConsents); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.GuardianConsents)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetConsents provides a mock function with given fields: _a0
+func (_m *GuardianConsentRepository) GetConsents(_a0 uint) ([]models.GuardianConsents, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.GuardianConsents, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.GuardianConsents); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.GuardianConsents)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetConsentStatus provides a mock function with given fields: _a0, _a1
+func (_m *GuardianConsentRepository) SetConsentStatus(_a0 models.GuardianConsents, _a1 models.GuardianConsentChanges) (models.GuardianConsents, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.GuardianConsents, models.GuardianConsentChanges) (models.GuardianConsents, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(models.GuardianConsents, models.GuardianConsentChanges) models.GuardianConsents); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.GuardianConsents)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.GuardianConsents, models.GuardianConsentChanges) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewGuardianConsentRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewGuardianConsentRepository creates a new instance of GuardianConsentRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewGuardianConsentRepository(t mockConstructorTestingTNewGuardianConsentRepository) *GuardianConsentRepository {
+	mock := &GuardianConsentRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,126 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// GuardianConsentService is an autogenerated mock type for the GuardianConsentService type
+type GuardianConsentService struct {
+	mock.Mock
+}
+
+// ConsentRequest provides a mock function with given fields: _a0
+func (_m *GuardianConsentService) ConsentRequest(_a0 string) (models.ConsentRequest, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.ConsentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.ConsentRequest, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.ConsentRequest); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.ConsentRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Consents provides a mock function with given fields: _a0
+func (_m *GuardianConsentService) Consents(_a0 uint) ([]models.GuardianConsents, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.GuardianConsents, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.GuardianConsents); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.GuardianConsents)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RequestConsent provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *GuardianConsentService) RequestConsent(_a0 uint, _a1 string, _a2 string, _a3 *uint) (models.GuardianConsents, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.GuardianConsents
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, *uint) (models.GuardianConsents, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, *uint) models.GuardianConsents); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.GuardianConsents)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, *uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RespondToConsent provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *GuardianConsentService) RespondToConsent(_a0 string, _a1 bool, _a2 string, _a3 string) (models.ConsentRequest, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.ConsentRequest
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, bool, string, string) (models.ConsentRequest, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(string, bool, string, string) models.ConsentRequest); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.ConsentRequest)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, bool, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewGuardianConsentService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewGuardianConsentService creates a new instance of GuardianConsentService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewGuardianConsentService(t mockConstructorTestingTNewGuardianConsentService) *GuardianConsentService {
+	mock := &GuardianConsentService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
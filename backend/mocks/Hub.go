@@ -0,0 +1,74 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	realtime "github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Hub is an autogenerated mock type for the Hub type
+type Hub struct {
+	mock.Mock
+}
+
+// Publish provides a mock function with given fields: topic, eventType, data
+func (_m *Hub) Publish(topic string, eventType string, data interface{}) {
+	_m.Called(topic, eventType, data)
+}
+
+// Subscribe provides a mock function with given fields: topics
+func (_m *Hub) Subscribe(topics ...string) *realtime.Subscription {
+	_va := make([]interface{}, len(topics))
+	for _i := range topics {
+		_va[_i] = topics[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *realtime.Subscription
+	if rf, ok := ret.Get(0).(func(...string) *realtime.Subscription); ok {
+		r0 = rf(topics...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realtime.Subscription)
+		}
+	}
+
+	return r0
+}
+
+// Subscribed provides a mock function with given fields: topic
+func (_m *Hub) Subscribed(topic string) bool {
+	ret := _m.Called(topic)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(topic)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// Unsubscribe provides a mock function with given fields: _a0
+func (_m *Hub) Unsubscribe(_a0 *realtime.Subscription) {
+	_m.Called(_a0)
+}
+
+type mockConstructorTestingTNewHub interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewHub creates a new instance of Hub. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewHub(t mockConstructorTestingTNewHub) *Hub {
+	mock := &Hub{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
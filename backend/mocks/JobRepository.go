@@ -0,0 +1,122 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// JobRepository is an autogenerated mock type for the JobRepository type
+type JobRepository struct {
+	mock.Mock
+}
+
+// ClaimJobs provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *JobRepository) ClaimJobs(_a0 string, _a1 time.Time, _a2 time.Duration, _a3 int) ([]models.ScheduledJobs, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.ScheduledJobs
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Duration, int) ([]models.ScheduledJobs, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, time.Duration, int) []models.ScheduledJobs); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ScheduledJobs)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, time.Duration, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepository) CompleteJob(_a0 models.ScheduledJobs, _a1 time.Time) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.ScheduledJobs, time.Time) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailJob provides a mock function with given fields: _a0, _a1
+func (_m *JobRepository) FailJob(_a0 models.ScheduledJobs, _a1 string) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.ScheduledJobs, string) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RetryJob provides a mock function with given fields: _a0, _a1, _a2
+func (_m *JobRepository) RetryJob(_a0 models.ScheduledJobs, _a1 string, _a2 time.Time) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.ScheduledJobs, string, time.Time) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ScheduleJob provides a mock function with given fields: _a0
+func (_m *JobRepository) ScheduleJob(_a0 models.ScheduledJobs) (bool, error) {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ScheduledJobs) (bool, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.ScheduledJobs) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ScheduledJobs) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewJobRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewJobRepository creates a new instance of JobRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewJobRepository(t mockConstructorTestingTNewJobRepository) *JobRepository {
+	mock := &JobRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,68 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MessageController is an autogenerated mock type for the MessageController type
+type MessageController struct {
+	mock.Mock
+}
+
+// Block provides a mock function with given fields: c
+func (_m *MessageController) Block(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Conversations provides a mock function with given fields: c
+func (_m *MessageController) Conversations(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MarkRead provides a mock function with given fields: c
+func (_m *MessageController) MarkRead(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Messages provides a mock function with given fields: c
+func (_m *MessageController) Messages(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SendMessage provides a mock function with given fields: c
+func (_m *MessageController) SendMessage(c *gin.Context) {
+	_m.Called(c)
+}
+
+// StartConversation provides a mock function with given fields: c
+func (_m *MessageController) StartConversation(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Unblock provides a mock function with given fields: c
+func (_m *MessageController) Unblock(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Unread provides a mock function with given fields: c
+func (_m *MessageController) Unread(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewMessageController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMessageController creates a new instance of MessageController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMessageController(t mockConstructorTestingTNewMessageController) *MessageController {
+	mock := &MessageController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,328 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// MessageRepository is an autogenerated mock type for the MessageRepository type
+type MessageRepository struct {
+	mock.Mock
+}
+
+// AreFriends provides a mock function with given fields: handle, otherHandle
+func (_m *MessageRepository) AreFriends(handle string, otherHandle string) (bool, error) {
+	ret := _m.Called(handle, otherHandle)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (bool, error)); ok {
+		return rf(handle, otherHandle)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(handle, otherHandle)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(handle, otherHandle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Block provides a mock function with given fields: blockerId, blockedId
+func (_m *MessageRepository) Block(blockerId uint, blockedId uint) error {
+	ret := _m.Called(blockerId, blockedId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(blockerId, blockedId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Blocked provides a mock function with given fields: userId, otherId
+func (_m *MessageRepository) Blocked(userId uint, otherId uint) (bool, error) {
+	ret := _m.Called(userId, otherId)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (bool, error)); ok {
+		return rf(userId, otherId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) bool); ok {
+		r0 = rf(userId, otherId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(userId, otherId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateMessage provides a mock function with given fields: message
+func (_m *MessageRepository) CreateMessage(message models.Messages) (models.Messages, error) {
+	ret := _m.Called(message)
+
+	var r0 models.Messages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Messages) (models.Messages, error)); ok {
+		return rf(message)
+	}
+	if rf, ok := ret.Get(0).(func(models.Messages) models.Messages); ok {
+		r0 = rf(message)
+	} else {
+		r0 = ret.Get(0).(models.Messages)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Messages) error); ok {
+		r1 = rf(message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindConversation provides a mock function with given fields: id
+func (_m *MessageRepository) FindConversation(id uint) (models.Conversations, error) {
+	ret := _m.Called(id)
+
+	var r0 models.Conversations
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.Conversations, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.Conversations); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.Conversations)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMessages provides a mock function with given fields: conversationId, beforeId, limit
+func (_m *MessageRepository) GetMessages(conversationId uint, beforeId uint, limit int) ([]models.Messages, error) {
+	ret := _m.Called(conversationId, beforeId, limit)
+
+	var r0 []models.Messages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, int) ([]models.Messages, error)); ok {
+		return rf(conversationId, beforeId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, int) []models.Messages); ok {
+		r0 = rf(conversationId, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Messages)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, int) error); ok {
+		r1 = rf(conversationId, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkOrganizationRead provides a mock function with given fields: conversationId, orgId, at
+func (_m *MessageRepository) MarkOrganizationRead(conversationId uint, orgId uint, at time.Time) (int64, error) {
+	ret := _m.Called(conversationId, orgId, at)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) (int64, error)); ok {
+		return rf(conversationId, orgId, at)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) int64); ok {
+		r0 = rf(conversationId, orgId, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time) error); ok {
+		r1 = rf(conversationId, orgId, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: conversationId, userId, at
+func (_m *MessageRepository) MarkRead(conversationId uint, userId uint, at time.Time) (int64, error) {
+	ret := _m.Called(conversationId, userId, at)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) (int64, error)); ok {
+		return rf(conversationId, userId, at)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) int64); ok {
+		r0 = rf(conversationId, userId, at)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time) error); ok {
+		r1 = rf(conversationId, userId, at)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationConversations provides a mock function with given fields: orgId, cursor, limit
+func (_m *MessageRepository) OrganizationConversations(orgId uint, cursor models.ConversationCursor, limit int) ([]models.Conversations, error) {
+	ret := _m.Called(orgId, cursor, limit)
+
+	var r0 []models.Conversations
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, models.ConversationCursor, int) ([]models.Conversations, error)); ok {
+		return rf(orgId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, models.ConversationCursor, int) []models.Conversations); ok {
+		r0 = rf(orgId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Conversations)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, models.ConversationCursor, int) error); ok {
+		r1 = rf(orgId, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartConversation provides a mock function with given fields: conversation
+func (_m *MessageRepository) StartConversation(conversation models.Conversations) (models.Conversations, error) {
+	ret := _m.Called(conversation)
+
+	var r0 models.Conversations
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Conversations) (models.Conversations, error)); ok {
+		return rf(conversation)
+	}
+	if rf, ok := ret.Get(0).(func(models.Conversations) models.Conversations); ok {
+		r0 = rf(conversation)
+	} else {
+		r0 = ret.Get(0).(models.Conversations)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Conversations) error); ok {
+		r1 = rf(conversation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unblock provides a mock function with given fields: blockerId, blockedId
+func (_m *MessageRepository) Unblock(blockerId uint, blockedId uint) error {
+	ret := _m.Called(blockerId, blockedId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(blockerId, blockedId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnreadCounts provides a mock function with given fields: userId
+func (_m *MessageRepository) UnreadCounts(userId uint) (models.UnreadMessages, error) {
+	ret := _m.Called(userId)
+
+	var r0 models.UnreadMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.UnreadMessages, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.UnreadMessages); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Get(0).(models.UnreadMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserConversations provides a mock function with given fields: userId, cursor, limit
+func (_m *MessageRepository) UserConversations(userId uint, cursor models.ConversationCursor, limit int) ([]models.Conversations, error) {
+	ret := _m.Called(userId, cursor, limit)
+
+	var r0 []models.Conversations
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, models.ConversationCursor, int) ([]models.Conversations, error)); ok {
+		return rf(userId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, models.ConversationCursor, int) []models.Conversations); ok {
+		r0 = rf(userId, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Conversations)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, models.ConversationCursor, int) error); ok {
+		r1 = rf(userId, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewMessageRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMessageRepository creates a new instance of MessageRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMessageRepository(t mockConstructorTestingTNewMessageRepository) *MessageRepository {
+	mock := &MessageRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
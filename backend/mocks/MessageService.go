@@ -0,0 +1,190 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// MessageService is an autogenerated mock type for the MessageService type
+type MessageService struct {
+	mock.Mock
+}
+
+// Block provides a mock function with given fields: userId, otherUserId
+func (_m *MessageService) Block(userId uint, otherUserId uint) error {
+	ret := _m.Called(userId, otherUserId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userId, otherUserId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Conversations provides a mock function with given fields: userId, orgId, cursor, limit
+func (_m *MessageService) Conversations(userId uint, orgId uint, cursor string, limit int) (models.ConversationPage, error) {
+	ret := _m.Called(userId, orgId, cursor, limit)
+
+	var r0 models.ConversationPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, int) (models.ConversationPage, error)); ok {
+		return rf(userId, orgId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, int) models.ConversationPage); ok {
+		r0 = rf(userId, orgId, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(models.ConversationPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, int) error); ok {
+		r1 = rf(userId, orgId, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: userId, conversationId
+func (_m *MessageService) MarkRead(userId uint, conversationId uint) error {
+	ret := _m.Called(userId, conversationId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userId, conversationId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Messages provides a mock function with given fields: userId, conversationId, cursor, limit
+func (_m *MessageService) Messages(userId uint, conversationId uint, cursor string, limit int) (models.MessagePage, error) {
+	ret := _m.Called(userId, conversationId, cursor, limit)
+
+	var r0 models.MessagePage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, int) (models.MessagePage, error)); ok {
+		return rf(userId, conversationId, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, int) models.MessagePage); ok {
+		r0 = rf(userId, conversationId, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(models.MessagePage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, int) error); ok {
+		r1 = rf(userId, conversationId, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SendMessage provides a mock function with given fields: userId, conversationId, body
+func (_m *MessageService) SendMessage(userId uint, conversationId uint, body string) (models.Messages, error) {
+	ret := _m.Called(userId, conversationId, body)
+
+	var r0 models.Messages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string) (models.Messages, error)); ok {
+		return rf(userId, conversationId, body)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string) models.Messages); ok {
+		r0 = rf(userId, conversationId, body)
+	} else {
+		r0 = ret.Get(0).(models.Messages)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = rf(userId, conversationId, body)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartConversation provides a mock function with given fields: userId, otherUserId, orgId
+func (_m *MessageService) StartConversation(userId uint, otherUserId uint, orgId uint) (models.Conversations, error) {
+	ret := _m.Called(userId, otherUserId, orgId)
+
+	var r0 models.Conversations
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) (models.Conversations, error)); ok {
+		return rf(userId, otherUserId, orgId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) models.Conversations); ok {
+		r0 = rf(userId, otherUserId, orgId)
+	} else {
+		r0 = ret.Get(0).(models.Conversations)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
+		r1 = rf(userId, otherUserId, orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unblock provides a mock function with given fields: userId, otherUserId
+func (_m *MessageService) Unblock(userId uint, otherUserId uint) error {
+	ret := _m.Called(userId, otherUserId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userId, otherUserId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Unread provides a mock function with given fields: userId
+func (_m *MessageService) Unread(userId uint) (models.UnreadMessages, error) {
+	ret := _m.Called(userId)
+
+	var r0 models.UnreadMessages
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.UnreadMessages, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.UnreadMessages); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Get(0).(models.UnreadMessages)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewMessageService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewMessageService creates a new instance of MessageService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewMessageService(t mockConstructorTestingTNewMessageService) *MessageService {
+	mock := &MessageService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
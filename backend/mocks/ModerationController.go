@@ -0,0 +1,68 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ModerationController is an autogenerated mock type for the ModerationController type
+type ModerationController struct {
+	mock.Mock
+}
+
+// Act provides a mock function with given fields: c
+func (_m *ModerationController) Act(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Case provides a mock function with given fields: c
+func (_m *ModerationController) Case(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Cases provides a mock function with given fields: c
+func (_m *ModerationController) Cases(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportComment provides a mock function with given fields: c
+func (_m *ModerationController) ReportComment(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportEvent provides a mock function with given fields: c
+func (_m *ModerationController) ReportEvent(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportOrganization provides a mock function with given fields: c
+func (_m *ModerationController) ReportOrganization(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportPost provides a mock function with given fields: c
+func (_m *ModerationController) ReportPost(c *gin.Context) {
+	_m.Called(c)
+}
+
+// ReportUser provides a mock function with given fields: c
+func (_m *ModerationController) ReportUser(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewModerationController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewModerationController creates a new instance of ModerationController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewModerationController(t mockConstructorTestingTNewModerationController) *ModerationController {
+	mock := &ModerationController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
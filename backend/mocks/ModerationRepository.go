@@ -0,0 +1,180 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ModerationRepository is an autogenerated mock type for the ModerationRepository type
+type ModerationRepository struct {
+	mock.Mock
+}
+
+// CloseCase provides a mock function with given fields: moderationCase, action
+func (_m *ModerationRepository) CloseCase(moderationCase models.ModerationCases, action models.ModerationActions) (models.ModerationCases, error) {
+	ret := _m.Called(moderationCase, action)
+
+	var r0 models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.ModerationCases, models.ModerationActions) (models.ModerationCases, error)); ok {
+		return rf(moderationCase, action)
+	}
+	if rf, ok := ret.Get(0).(func(models.ModerationCases, models.ModerationActions) models.ModerationCases); ok {
+		r0 = rf(moderationCase, action)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCases)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.ModerationCases, models.ModerationActions) error); ok {
+		r1 = rf(moderationCase, action)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FileReport provides a mock function with given fields: subjectType, subjectId, report
+func (_m *ModerationRepository) FileReport(subjectType string, subjectId uint, report models.Reports) (models.ModerationCases, error) {
+	ret := _m.Called(subjectType, subjectId, report)
+
+	var r0 models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint, models.Reports) (models.ModerationCases, error)); ok {
+		return rf(subjectType, subjectId, report)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint, models.Reports) models.ModerationCases); ok {
+		r0 = rf(subjectType, subjectId, report)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCases)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint, models.Reports) error); ok {
+		r1 = rf(subjectType, subjectId, report)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCase provides a mock function with given fields: id
+func (_m *ModerationRepository) GetCase(id uint) (models.ModerationCases, error) {
+	ret := _m.Called(id)
+
+	var r0 models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.ModerationCases, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.ModerationCases); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCases)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCases provides a mock function with given fields: status, afterId, limit
+func (_m *ModerationRepository) GetCases(status string, afterId uint, limit int) ([]models.ModerationCases, error) {
+	ret := _m.Called(status, afterId, limit)
+
+	var r0 []models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint, int) ([]models.ModerationCases, error)); ok {
+		return rf(status, afterId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint, int) []models.ModerationCases); ok {
+		r0 = rf(status, afterId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ModerationCases)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint, int) error); ok {
+		r1 = rf(status, afterId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HoldContent provides a mock function with given fields: subjectType, subjectId, reason
+func (_m *ModerationRepository) HoldContent(subjectType string, subjectId uint, reason string) (models.ModerationCases, error) {
+	ret := _m.Called(subjectType, subjectId, reason)
+
+	var r0 models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, uint, string) (models.ModerationCases, error)); ok {
+		return rf(subjectType, subjectId, reason)
+	}
+	if rf, ok := ret.Get(0).(func(string, uint, string) models.ModerationCases); ok {
+		r0 = rf(subjectType, subjectId, reason)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCases)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, uint, string) error); ok {
+		r1 = rf(subjectType, subjectId, reason)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetModeration provides a mock function with given fields: subjectType, subjectId, moderation
+func (_m *ModerationRepository) SetModeration(subjectType string, subjectId uint, moderation string) error {
+	ret := _m.Called(subjectType, subjectId, moderation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, uint, string) error); ok {
+		r0 = rf(subjectType, subjectId, moderation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SuspendUser provides a mock function with given fields: userId, until
+func (_m *ModerationRepository) SuspendUser(userId uint, until time.Time) error {
+	ret := _m.Called(userId, until)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time) error); ok {
+		r0 = rf(userId, until)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewModerationRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewModerationRepository creates a new instance of ModerationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewModerationRepository(t mockConstructorTestingTNewModerationRepository) *ModerationRepository {
+	mock := &ModerationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,124 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ModerationService is an autogenerated mock type for the ModerationService type
+type ModerationService struct {
+	mock.Mock
+}
+
+// Act provides a mock function with given fields: moderatorId, caseId, action, note, days
+func (_m *ModerationService) Act(moderatorId uint, caseId uint, action string, note string, days int) (models.ModerationCases, error) {
+	ret := _m.Called(moderatorId, caseId, action, note, days)
+
+	var r0 models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string, int) (models.ModerationCases, error)); ok {
+		return rf(moderatorId, caseId, action, note, days)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string, int) models.ModerationCases); ok {
+		r0 = rf(moderatorId, caseId, action, note, days)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCases)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, string, int) error); ok {
+		r1 = rf(moderatorId, caseId, action, note, days)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Case provides a mock function with given fields: id
+func (_m *ModerationService) Case(id uint) (models.ModerationCases, error) {
+	ret := _m.Called(id)
+
+	var r0 models.ModerationCases
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.ModerationCases, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.ModerationCases); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCases)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Cases provides a mock function with given fields: status, cursor, limit
+func (_m *ModerationService) Cases(status string, cursor string, limit int) (models.ModerationCasePage, error) {
+	ret := _m.Called(status, cursor, limit)
+
+	var r0 models.ModerationCasePage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int) (models.ModerationCasePage, error)); ok {
+		return rf(status, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int) models.ModerationCasePage); ok {
+		r0 = rf(status, cursor, limit)
+	} else {
+		r0 = ret.Get(0).(models.ModerationCasePage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(status, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Report provides a mock function with given fields: userId, subjectType, subjectId, reason, details
+func (_m *ModerationService) Report(userId uint, subjectType string, subjectId uint, reason string, details string) (models.Reports, error) {
+	ret := _m.Called(userId, subjectType, subjectId, reason, details)
+
+	var r0 models.Reports
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, uint, string, string) (models.Reports, error)); ok {
+		return rf(userId, subjectType, subjectId, reason, details)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, uint, string, string) models.Reports); ok {
+		r0 = rf(userId, subjectType, subjectId, reason, details)
+	} else {
+		r0 = ret.Get(0).(models.Reports)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, uint, string, string) error); ok {
+		r1 = rf(userId, subjectType, subjectId, reason, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewModerationService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewModerationService creates a new instance of ModerationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewModerationService(t mockConstructorTestingTNewModerationService) *ModerationService {
+	mock := &ModerationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationController is an autogenerated mock type for the NotificationController type
+type NotificationController struct {
+	mock.Mock
+}
+
+// Delete provides a mock function with given fields: c
+func (_m *NotificationController) Delete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Inbox provides a mock function with given fields: c
+func (_m *NotificationController) Inbox(c *gin.Context) {
+	_m.Called(c)
+}
+
+// MarkRead provides a mock function with given fields: c
+func (_m *NotificationController) MarkRead(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Preferences provides a mock function with given fields: c
+func (_m *NotificationController) Preferences(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SetPreference provides a mock function with given fields: c
+func (_m *NotificationController) SetPreference(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UnreadCount provides a mock function with given fields: c
+func (_m *NotificationController) UnreadCount(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewNotificationController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNotificationController creates a new instance of NotificationController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNotificationController(t mockConstructorTestingTNewNotificationController) *NotificationController {
+	mock := &NotificationController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
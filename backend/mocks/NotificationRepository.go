@@ -0,0 +1,192 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CountUnread provides a mock function with given fields: _a0
+func (_m *NotificationRepository) CountUnread(_a0 uint) (int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateNotification provides a mock function with given fields: _a0
+func (_m *NotificationRepository) CreateNotification(_a0 models.Notifications) (models.Notifications, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Notifications
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Notifications) (models.Notifications, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Notifications) models.Notifications); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Notifications)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Notifications) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteNotification provides a mock function with given fields: _a0, _a1
+func (_m *NotificationRepository) DeleteNotification(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetNotifications provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *NotificationRepository) GetNotifications(_a0 uint, _a1 uint, _a2 int, _a3 bool) ([]models.Notifications, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Notifications
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, int, bool) ([]models.Notifications, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, int, bool) []models.Notifications); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Notifications)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, int, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPreferences provides a mock function with given fields: _a0
+func (_m *NotificationRepository) GetPreferences(_a0 uint) ([]models.NotificationPreferences, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.NotificationPreferences, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.NotificationPreferences); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: _a0, _a1, _a2
+func (_m *NotificationRepository) MarkRead(_a0 uint, _a1 []uint, _a2 time.Time) (int64, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint, time.Time) (int64, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint, time.Time) int64); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SavePreference provides a mock function with given fields: _a0
+func (_m *NotificationRepository) SavePreference(_a0 models.NotificationPreferences) (models.NotificationPreferences, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NotificationPreferences) (models.NotificationPreferences, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.NotificationPreferences) models.NotificationPreferences); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.NotificationPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NotificationPreferences) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewNotificationRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNotificationRepository(t mockConstructorTestingTNewNotificationRepository) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
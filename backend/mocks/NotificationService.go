@@ -0,0 +1,171 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	mailer "github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+)
+
+// NotificationService is an autogenerated mock type for the NotificationService type
+type NotificationService struct {
+	mock.Mock
+}
+
+// DeleteNotification provides a mock function with given fields: _a0, _a1
+func (_m *NotificationService) DeleteNotification(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Inbox provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *NotificationService) Inbox(_a0 uint, _a1 string, _a2 int, _a3 bool) (models.NotificationPage, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.NotificationPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, int, bool) (models.NotificationPage, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, int, bool) models.NotificationPage); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.NotificationPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, int, bool) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkRead provides a mock function with given fields: _a0, _a1
+func (_m *NotificationService) MarkRead(_a0 uint, _a1 []uint) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) (int64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Notify provides a mock function with given fields: _a0, _a1
+func (_m *NotificationService) Notify(_a0 models.Notifications, _a1 *mailer.Message) {
+	_m.Called(_a0, _a1)
+}
+
+// Preferences provides a mock function with given fields: _a0
+func (_m *NotificationService) Preferences(_a0 uint) ([]models.NotificationPreferences, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.NotificationPreferences, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.NotificationPreferences); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.NotificationPreferences)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetPreference provides a mock function with given fields: _a0
+func (_m *NotificationService) SetPreference(_a0 models.NotificationPreferences) (models.NotificationPreferences, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.NotificationPreferences
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.NotificationPreferences) (models.NotificationPreferences, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.NotificationPreferences) models.NotificationPreferences); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.NotificationPreferences)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.NotificationPreferences) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnreadCount provides a mock function with given fields: _a0
+func (_m *NotificationService) UnreadCount(_a0 uint) (int64, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (int64, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) int64); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewNotificationService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewNotificationService creates a new instance of NotificationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewNotificationService(t mockConstructorTestingTNewNotificationService) *NotificationService {
+	mock := &NotificationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -74,6 +74,32 @@ func (_m *OrgUsersRepository) FindOrgUser(_a0 uint, _a1 uint) (models.OrgUsers,
 	return r0, r1
 }
 
+// GetOrgMembers provides a mock function with given fields: _a0
+func (_m *OrgUsersRepository) GetOrgMembers(_a0 uint) ([]models.OrgUsers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.OrgUsers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.OrgUsers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.OrgUsers); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.OrgUsers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListAllOrgUsers provides a mock function with given fields:
 func (_m *OrgUsersRepository) ListAllOrgUsers() ([]models.OrgUsers, error) {
 	ret := _m.Called()
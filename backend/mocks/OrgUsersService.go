@@ -12,23 +12,23 @@ type OrgUsersService struct {
 	mock.Mock
 }
 
-// CreateOrgUser provides a mock function with given fields: _a0
-func (_m *OrgUsersService) CreateOrgUser(_a0 models.OrgUsers) (models.OrgUsers, error) {
-	ret := _m.Called(_a0)
+// CreateOrgUser provides a mock function with given fields: _a0, _a1
+func (_m *OrgUsersService) CreateOrgUser(_a0 models.OrgUsers, _a1 uint) (models.OrgUsers, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 models.OrgUsers
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.OrgUsers) (models.OrgUsers, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.OrgUsers, uint) (models.OrgUsers, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(models.OrgUsers) models.OrgUsers); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.OrgUsers, uint) models.OrgUsers); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Get(0).(models.OrgUsers)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.OrgUsers) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(models.OrgUsers, uint) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -36,13 +36,13 @@ func (_m *OrgUsersService) CreateOrgUser(_a0 models.OrgUsers) (models.OrgUsers,
 	return r0, r1
 }
 
-// DeleteOrgUser provides a mock function with given fields: _a0, _a1
-func (_m *OrgUsersService) DeleteOrgUser(_a0 uint, _a1 uint) error {
-	ret := _m.Called(_a0, _a1)
+// DeleteOrgUser provides a mock function with given fields: _a0, _a1, _a2
+func (_m *OrgUsersService) DeleteOrgUser(_a0 uint, _a1 uint, _a2 uint) error {
+	ret := _m.Called(_a0, _a1, _a2)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
-		r0 = rf(_a0, _a1)
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -100,23 +100,23 @@ func (_m *OrgUsersService) ListAllOrgUsers() ([]models.OrgUsers, error) {
 	return r0, r1
 }
 
-// UpdateOrgUser provides a mock function with given fields: _a0, _a1, _a2
-func (_m *OrgUsersService) UpdateOrgUser(_a0 uint, _a1 uint, _a2 uint) (models.OrgUsers, error) {
-	ret := _m.Called(_a0, _a1, _a2)
+// UpdateOrgUser provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *OrgUsersService) UpdateOrgUser(_a0 uint, _a1 uint, _a2 uint, _a3 uint) (models.OrgUsers, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
 
 	var r0 models.OrgUsers
 	var r1 error
-	if rf, ok := ret.Get(0).(func(uint, uint, uint) (models.OrgUsers, error)); ok {
-		return rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, uint) (models.OrgUsers, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
 	}
-	if rf, ok := ret.Get(0).(func(uint, uint, uint) models.OrgUsers); ok {
-		r0 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, uint) models.OrgUsers); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r0 = ret.Get(0).(models.OrgUsers)
 	}
 
-	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
-		r1 = rf(_a0, _a1, _a2)
+	if rf, ok := ret.Get(1).(func(uint, uint, uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
 	} else {
 		r1 = ret.Error(1)
 	}
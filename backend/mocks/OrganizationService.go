@@ -12,23 +12,23 @@ type OrganizationService struct {
 	mock.Mock
 }
 
-// CreateOrganization provides a mock function with given fields: _a0
-func (_m *OrganizationService) CreateOrganization(_a0 models.Organization) (models.Organization, error) {
-	ret := _m.Called(_a0)
+// CreateOrganization provides a mock function with given fields: _a0, _a1
+func (_m *OrganizationService) CreateOrganization(_a0 models.Organization, _a1 uint) (models.Organization, error) {
+	ret := _m.Called(_a0, _a1)
 
 	var r0 models.Organization
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Organization) (models.Organization, error)); ok {
-		return rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Organization, uint) (models.Organization, error)); ok {
+		return rf(_a0, _a1)
 	}
-	if rf, ok := ret.Get(0).(func(models.Organization) models.Organization); ok {
-		r0 = rf(_a0)
+	if rf, ok := ret.Get(0).(func(models.Organization, uint) models.Organization); ok {
+		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Get(0).(models.Organization)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Organization) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(models.Organization, uint) error); ok {
+		r1 = rf(_a0, _a1)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -37,6 +37,21 @@ func (_m *PostsController) FindPost(c *gin.Context) {
 	_m.Called(c)
 }
 
+// OrganizationPosts provides a mock function with given fields: c
+func (_m *PostsController) OrganizationPosts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// PinPost provides a mock function with given fields: c
+func (_m *PostsController) PinPost(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UnpinPost provides a mock function with given fields: c
+func (_m *PostsController) UnpinPost(c *gin.Context) {
+	_m.Called(c)
+}
+
 type mockConstructorTestingTNewPostsController interface {
 	mock.TestingT
 	Cleanup(func())
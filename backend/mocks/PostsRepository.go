@@ -12,25 +12,25 @@ type PostsRepository struct {
 	mock.Mock
 }
 
-// AllPosts provides a mock function with given fields:
-func (_m *PostsRepository) AllPosts() ([]models.Posts, error) {
-	ret := _m.Called()
+// AllPosts provides a mock function with given fields: viewer
+func (_m *PostsRepository) AllPosts(viewer models.PostViewer) ([]models.Posts, error) {
+	ret := _m.Called(viewer)
 
 	var r0 []models.Posts
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Posts, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(models.PostViewer) ([]models.Posts, error)); ok {
+		return rf(viewer)
 	}
-	if rf, ok := ret.Get(0).(func() []models.Posts); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(models.PostViewer) []models.Posts); ok {
+		r0 = rf(viewer)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Posts)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(models.PostViewer) error); ok {
+		r1 = rf(viewer)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -124,6 +124,82 @@ func (_m *PostsRepository) FindPost(id string) (models.Posts, error) {
 	return r0, r1
 }
 
+// FindViewer provides a mock function with given fields: userId
+func (_m *PostsRepository) FindViewer(userId uint) (models.PostViewer, error) {
+	ret := _m.Called(userId)
+
+	var r0 models.PostViewer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.PostViewer, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.PostViewer); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Get(0).(models.PostViewer)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrgPosts provides a mock function with given fields: orgId, viewer, beforeId, limit
+func (_m *PostsRepository) GetOrgPosts(orgId uint, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	ret := _m.Called(orgId, viewer, beforeId, limit)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, models.PostViewer, uint, int) ([]models.Posts, error)); ok {
+		return rf(orgId, viewer, beforeId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(uint, models.PostViewer, uint, int) []models.Posts); ok {
+		r0 = rf(orgId, viewer, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, models.PostViewer, uint, int) error); ok {
+		r1 = rf(orgId, viewer, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPinnedPosts provides a mock function with given fields: orgId
+func (_m *PostsRepository) GetPinnedPosts(orgId uint) ([]models.Posts, error) {
+	ret := _m.Called(orgId)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.Posts, error)); ok {
+		return rf(orgId)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.Posts); ok {
+		r0 = rf(orgId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(orgId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewPostsRepository interface {
 	mock.TestingT
 	Cleanup(func())
@@ -12,25 +12,25 @@ type PostsService struct {
 	mock.Mock
 }
 
-// AllPosts provides a mock function with given fields:
-func (_m *PostsService) AllPosts() ([]models.Posts, error) {
-	ret := _m.Called()
+// AllPosts provides a mock function with given fields: viewerId
+func (_m *PostsService) AllPosts(viewerId uint) ([]models.Posts, error) {
+	ret := _m.Called(viewerId)
 
 	var r0 []models.Posts
 	var r1 error
-	if rf, ok := ret.Get(0).(func() ([]models.Posts, error)); ok {
-		return rf()
+	if rf, ok := ret.Get(0).(func(uint) ([]models.Posts, error)); ok {
+		return rf(viewerId)
 	}
-	if rf, ok := ret.Get(0).(func() []models.Posts); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(uint) []models.Posts); ok {
+		r0 = rf(viewerId)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Posts)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func() error); ok {
-		r1 = rf()
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(viewerId)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -38,23 +38,23 @@ func (_m *PostsService) AllPosts() ([]models.Posts, error) {
 	return r0, r1
 }
 
-// CreatePost provides a mock function with given fields: post
-func (_m *PostsService) CreatePost(post models.Posts) (models.Posts, error) {
-	ret := _m.Called(post)
+// CreatePost provides a mock function with given fields: userId, post
+func (_m *PostsService) CreatePost(userId uint, post models.Posts) (models.Posts, error) {
+	ret := _m.Called(userId, post)
 
 	var r0 models.Posts
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Posts) (models.Posts, error)); ok {
-		return rf(post)
+	if rf, ok := ret.Get(0).(func(uint, models.Posts) (models.Posts, error)); ok {
+		return rf(userId, post)
 	}
-	if rf, ok := ret.Get(0).(func(models.Posts) models.Posts); ok {
-		r0 = rf(post)
+	if rf, ok := ret.Get(0).(func(uint, models.Posts) models.Posts); ok {
+		r0 = rf(userId, post)
 	} else {
 		r0 = ret.Get(0).(models.Posts)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Posts) error); ok {
-		r1 = rf(post)
+	if rf, ok := ret.Get(1).(func(uint, models.Posts) error); ok {
+		r1 = rf(userId, post)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -62,13 +62,13 @@ func (_m *PostsService) CreatePost(post models.Posts) (models.Posts, error) {
 	return r0, r1
 }
 
-// DeletePost provides a mock function with given fields: post
-func (_m *PostsService) DeletePost(post models.Posts) error {
-	ret := _m.Called(post)
+// DeletePost provides a mock function with given fields: userId, id
+func (_m *PostsService) DeletePost(userId uint, id uint) error {
+	ret := _m.Called(userId, id)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(models.Posts) error); ok {
-		r0 = rf(post)
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(userId, id)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -76,23 +76,23 @@ func (_m *PostsService) DeletePost(post models.Posts) error {
 	return r0
 }
 
-// EditPost provides a mock function with given fields: post
-func (_m *PostsService) EditPost(post models.Posts) (models.Posts, error) {
-	ret := _m.Called(post)
+// EditPost provides a mock function with given fields: userId, id, description
+func (_m *PostsService) EditPost(userId uint, id uint, description string) (models.Posts, error) {
+	ret := _m.Called(userId, id, description)
 
 	var r0 models.Posts
 	var r1 error
-	if rf, ok := ret.Get(0).(func(models.Posts) (models.Posts, error)); ok {
-		return rf(post)
+	if rf, ok := ret.Get(0).(func(uint, uint, string) (models.Posts, error)); ok {
+		return rf(userId, id, description)
 	}
-	if rf, ok := ret.Get(0).(func(models.Posts) models.Posts); ok {
-		r0 = rf(post)
+	if rf, ok := ret.Get(0).(func(uint, uint, string) models.Posts); ok {
+		r0 = rf(userId, id, description)
 	} else {
 		r0 = ret.Get(0).(models.Posts)
 	}
 
-	if rf, ok := ret.Get(1).(func(models.Posts) error); ok {
-		r1 = rf(post)
+	if rf, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = rf(userId, id, description)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -100,23 +100,71 @@ func (_m *PostsService) EditPost(post models.Posts) (models.Posts, error) {
 	return r0, r1
 }
 
-// FindPost provides a mock function with given fields: id
-func (_m *PostsService) FindPost(id string) (models.Posts, error) {
-	ret := _m.Called(id)
+// FindPost provides a mock function with given fields: id, viewerId
+func (_m *PostsService) FindPost(id string, viewerId uint) (models.Posts, error) {
+	ret := _m.Called(id, viewerId)
 
 	var r0 models.Posts
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) (models.Posts, error)); ok {
-		return rf(id)
+	if rf, ok := ret.Get(0).(func(string, uint) (models.Posts, error)); ok {
+		return rf(id, viewerId)
 	}
-	if rf, ok := ret.Get(0).(func(string) models.Posts); ok {
-		r0 = rf(id)
+	if rf, ok := ret.Get(0).(func(string, uint) models.Posts); ok {
+		r0 = rf(id, viewerId)
 	} else {
 		r0 = ret.Get(0).(models.Posts)
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(id)
+	if rf, ok := ret.Get(1).(func(string, uint) error); ok {
+		r1 = rf(id, viewerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrgPosts provides a mock function with given fields: orgId, cursor, limit, viewerId
+func (_m *PostsService) OrgPosts(orgId uint, cursor string, limit int, viewerId uint) (models.OrgPostPage, error) {
+	ret := _m.Called(orgId, cursor, limit, viewerId)
+
+	var r0 models.OrgPostPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, int, uint) (models.OrgPostPage, error)); ok {
+		return rf(orgId, cursor, limit, viewerId)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, int, uint) models.OrgPostPage); ok {
+		r0 = rf(orgId, cursor, limit, viewerId)
+	} else {
+		r0 = ret.Get(0).(models.OrgPostPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, int, uint) error); ok {
+		r1 = rf(orgId, cursor, limit, viewerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PinPost provides a mock function with given fields: userId, id, pinned
+func (_m *PostsService) PinPost(userId uint, id uint, pinned bool) (models.Posts, error) {
+	ret := _m.Called(userId, id, pinned)
+
+	var r0 models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) (models.Posts, error)); ok {
+		return rf(userId, id, pinned)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, bool) models.Posts); ok {
+		r0 = rf(userId, id, pinned)
+	} else {
+		r0 = ret.Get(0).(models.Posts)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, bool) error); ok {
+		r1 = rf(userId, id, pinned)
 	} else {
 		r1 = ret.Error(1)
 	}
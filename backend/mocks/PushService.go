@@ -0,0 +1,111 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PushService is an autogenerated mock type for the PushService type
+type PushService struct {
+	mock.Mock
+}
+
+// Devices provides a mock function with given fields: _a0
+func (_m *PushService) Devices(_a0 uint) ([]models.DeviceTokens, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.DeviceTokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.DeviceTokens, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.DeviceTokens); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.DeviceTokens)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Push provides a mock function with given fields: _a0
+func (_m *PushService) Push(_a0 models.Notifications) {
+	_m.Called(_a0)
+}
+
+// RegisterDevice provides a mock function with given fields: _a0, _a1, _a2
+func (_m *PushService) RegisterDevice(_a0 uint, _a1 string, _a2 string) (models.DeviceTokens, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.DeviceTokens
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string) (models.DeviceTokens, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string) models.DeviceTokens); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.DeviceTokens)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Retry provides a mock function with given fields: _a0
+func (_m *PushService) Retry(_a0 models.PushJob) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.PushJob) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnregisterDevice provides a mock function with given fields: _a0, _a1
+func (_m *PushService) UnregisterDevice(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewPushService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewPushService creates a new instance of PushService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewPushService(t mockConstructorTestingTNewPushService) *PushService {
+	mock := &PushService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
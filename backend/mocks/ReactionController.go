@@ -0,0 +1,48 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionController is an autogenerated mock type for the ReactionController type
+type ReactionController struct {
+	mock.Mock
+}
+
+// React provides a mock function with given fields: c
+func (_m *ReactionController) React(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Reactions provides a mock function with given fields: c
+func (_m *ReactionController) Reactions(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Reactors provides a mock function with given fields: c
+func (_m *ReactionController) Reactors(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Unreact provides a mock function with given fields: c
+func (_m *ReactionController) Unreact(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewReactionController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReactionController creates a new instance of ReactionController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReactionController(t mockConstructorTestingTNewReactionController) *ReactionController {
+	mock := &ReactionController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
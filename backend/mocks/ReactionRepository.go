@@ -0,0 +1,128 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionRepository is an autogenerated mock type for the ReactionRepository type
+type ReactionRepository struct {
+	mock.Mock
+}
+
+// DeleteReaction provides a mock function with given fields: _a0, _a1
+func (_m *ReactionRepository) DeleteReaction(_a0 uint, _a1 uint) (string, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (string, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) string); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReactionCounts provides a mock function with given fields: _a0
+func (_m *ReactionRepository) GetReactionCounts(_a0 uint) ([]models.ReactionCounts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.ReactionCounts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.ReactionCounts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.ReactionCounts); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ReactionCounts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetReactions provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ReactionRepository) GetReactions(_a0 uint, _a1 string, _a2 uint, _a3 int) ([]models.Reactions, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 []models.Reactions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, uint, int) ([]models.Reactions, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, uint, int) []models.Reactions); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Reactions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, uint, int) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetReaction provides a mock function with given fields: _a0
+func (_m *ReactionRepository) SetReaction(_a0 models.Reactions) (string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Reactions) (string, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Reactions) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Reactions) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewReactionRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReactionRepository creates a new instance of ReactionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReactionRepository(t mockConstructorTestingTNewReactionRepository) *ReactionRepository {
+	mock := &ReactionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,124 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ReactionService is an autogenerated mock type for the ReactionService type
+type ReactionService struct {
+	mock.Mock
+}
+
+// React provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ReactionService) React(_a0 uint, _a1 uint, _a2 string) (models.ReactionSummary, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string) (models.ReactionSummary, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string) models.ReactionSummary); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.ReactionSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reactions provides a mock function with given fields: _a0, _a1
+func (_m *ReactionService) Reactions(_a0 uint, _a1 uint) (models.ReactionSummary, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (models.ReactionSummary, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) models.ReactionSummary); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.ReactionSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Reactors provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *ReactionService) Reactors(_a0 uint, _a1 string, _a2 string, _a3 int, _a4 uint) (models.ReactionPage, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 models.ReactionPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, string, string, int, uint) (models.ReactionPage, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(uint, string, string, int, uint) models.ReactionPage); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Get(0).(models.ReactionPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, string, string, int, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unreact provides a mock function with given fields: _a0, _a1
+func (_m *ReactionService) Unreact(_a0 uint, _a1 uint) (models.ReactionSummary, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.ReactionSummary
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (models.ReactionSummary, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) models.ReactionSummary); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.ReactionSummary)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewReactionService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewReactionService creates a new instance of ReactionService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewReactionService(t mockConstructorTestingTNewReactionService) *ReactionService {
+	mock := &ReactionService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
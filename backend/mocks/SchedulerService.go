@@ -0,0 +1,74 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SchedulerService is an autogenerated mock type for the SchedulerService type
+type SchedulerService struct {
+	mock.Mock
+}
+
+// RunDue provides a mock function with given fields: _a0
+func (_m *SchedulerService) RunDue(_a0 time.Time) (int, error) {
+	ret := _m.Called(_a0)
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) (int, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) int); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Seed provides a mock function with given fields: _a0
+func (_m *SchedulerService) Seed(_a0 time.Time) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Start provides a mock function with given fields: _a0
+func (_m *SchedulerService) Start(_a0 context.Context) {
+	_m.Called(_a0)
+}
+
+type mockConstructorTestingTNewSchedulerService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSchedulerService creates a new instance of SchedulerService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSchedulerService(t mockConstructorTestingTNewSchedulerService) *SchedulerService {
+	mock := &SchedulerService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
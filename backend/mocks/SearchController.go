@@ -0,0 +1,43 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SearchController is an autogenerated mock type for the SearchController type
+type SearchController struct {
+	mock.Mock
+}
+
+// HashtagPosts provides a mock function with given fields: c
+func (_m *SearchController) HashtagPosts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SearchPosts provides a mock function with given fields: c
+func (_m *SearchController) SearchPosts(c *gin.Context) {
+	_m.Called(c)
+}
+
+// TrendingHashtags provides a mock function with given fields: c
+func (_m *SearchController) TrendingHashtags(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewSearchController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSearchController creates a new instance of SearchController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSearchController(t mockConstructorTestingTNewSearchController) *SearchController {
+	mock := &SearchController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,162 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SearchRepository is an autogenerated mock type for the SearchRepository type
+type SearchRepository struct {
+	mock.Mock
+}
+
+// IndexComment provides a mock function with given fields: comment, tags, handles
+func (_m *SearchRepository) IndexComment(comment models.Comments, tags []string, handles []string) error {
+	ret := _m.Called(comment, tags, handles)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Comments, []string, []string) error); ok {
+		r0 = rf(comment, tags, handles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IndexPost provides a mock function with given fields: post, tags, handles
+func (_m *SearchRepository) IndexPost(post models.Posts, tags []string, handles []string) error {
+	ret := _m.Called(post, tags, handles)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Posts, []string, []string) error); ok {
+		r0 = rf(post, tags, handles)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MentioningPosts provides a mock function with given fields: handle, viewer, beforeId, limit
+func (_m *SearchRepository) MentioningPosts(handle string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	ret := _m.Called(handle, viewer, beforeId, limit)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.PostViewer, uint, int) ([]models.Posts, error)); ok {
+		return rf(handle, viewer, beforeId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.PostViewer, uint, int) []models.Posts); ok {
+		r0 = rf(handle, viewer, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.PostViewer, uint, int) error); ok {
+		r1 = rf(handle, viewer, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchPosts provides a mock function with given fields: text, viewer, beforeId, limit
+func (_m *SearchRepository) SearchPosts(text string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	ret := _m.Called(text, viewer, beforeId, limit)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.PostViewer, uint, int) ([]models.Posts, error)); ok {
+		return rf(text, viewer, beforeId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.PostViewer, uint, int) []models.Posts); ok {
+		r0 = rf(text, viewer, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.PostViewer, uint, int) error); ok {
+		r1 = rf(text, viewer, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaggedPosts provides a mock function with given fields: tag, viewer, beforeId, limit
+func (_m *SearchRepository) TaggedPosts(tag string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	ret := _m.Called(tag, viewer, beforeId, limit)
+
+	var r0 []models.Posts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, models.PostViewer, uint, int) ([]models.Posts, error)); ok {
+		return rf(tag, viewer, beforeId, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, models.PostViewer, uint, int) []models.Posts); ok {
+		r0 = rf(tag, viewer, beforeId, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Posts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, models.PostViewer, uint, int) error); ok {
+		r1 = rf(tag, viewer, beforeId, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrendingHashtags provides a mock function with given fields: since, limit
+func (_m *SearchRepository) TrendingHashtags(since time.Time, limit int) ([]models.TrendingHashtag, error) {
+	ret := _m.Called(since, limit)
+
+	var r0 []models.TrendingHashtag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, int) ([]models.TrendingHashtag, error)); ok {
+		return rf(since, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, int) []models.TrendingHashtag); ok {
+		r0 = rf(since, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrendingHashtag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, int) error); ok {
+		r1 = rf(since, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSearchRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSearchRepository creates a new instance of SearchRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSearchRepository(t mockConstructorTestingTNewSearchRepository) *SearchRepository {
+	mock := &SearchRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,104 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SearchService is an autogenerated mock type for the SearchService type
+type SearchService struct {
+	mock.Mock
+}
+
+// SearchPosts provides a mock function with given fields: query, cursor, limit, viewerId
+func (_m *SearchService) SearchPosts(query string, cursor string, limit int, viewerId uint) (models.PostPage, error) {
+	ret := _m.Called(query, cursor, limit, viewerId)
+
+	var r0 models.PostPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, uint) (models.PostPage, error)); ok {
+		return rf(query, cursor, limit, viewerId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, uint) models.PostPage); ok {
+		r0 = rf(query, cursor, limit, viewerId)
+	} else {
+		r0 = ret.Get(0).(models.PostPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, uint) error); ok {
+		r1 = rf(query, cursor, limit, viewerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TaggedPosts provides a mock function with given fields: tag, cursor, limit, viewerId
+func (_m *SearchService) TaggedPosts(tag string, cursor string, limit int, viewerId uint) (models.PostPage, error) {
+	ret := _m.Called(tag, cursor, limit, viewerId)
+
+	var r0 models.PostPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, uint) (models.PostPage, error)); ok {
+		return rf(tag, cursor, limit, viewerId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, uint) models.PostPage); ok {
+		r0 = rf(tag, cursor, limit, viewerId)
+	} else {
+		r0 = ret.Get(0).(models.PostPage)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, uint) error); ok {
+		r1 = rf(tag, cursor, limit, viewerId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrendingHashtags provides a mock function with given fields: window, limit
+func (_m *SearchService) TrendingHashtags(window time.Duration, limit int) ([]models.TrendingHashtag, error) {
+	ret := _m.Called(window, limit)
+
+	var r0 []models.TrendingHashtag
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Duration, int) ([]models.TrendingHashtag, error)); ok {
+		return rf(window, limit)
+	}
+	if rf, ok := ret.Get(0).(func(time.Duration, int) []models.TrendingHashtag); ok {
+		r0 = rf(window, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.TrendingHashtag)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Duration, int) error); ok {
+		r1 = rf(window, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSearchService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSearchService creates a new instance of SearchService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSearchService(t mockConstructorTestingTNewSearchService) *SearchService {
+	mock := &SearchService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ShiftController is an autogenerated mock type for the ShiftController type
+type ShiftController struct {
+	mock.Mock
+}
+
+// All provides a mock function with given fields: c
+func (_m *ShiftController) All(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Create provides a mock function with given fields: c
+func (_m *ShiftController) Create(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Delete provides a mock function with given fields: c
+func (_m *ShiftController) Delete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Update provides a mock function with given fields: c
+func (_m *ShiftController) Update(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewShiftController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewShiftController creates a new instance of ShiftController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewShiftController(t mockConstructorTestingTNewShiftController) *ShiftController {
+	mock := &ShiftController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,216 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ShiftRepository is an autogenerated mock type for the ShiftRepository type
+type ShiftRepository struct {
+	mock.Mock
+}
+
+// CountShiftSignups provides a mock function with given fields: _a0, _a1
+func (_m *ShiftRepository) CountShiftSignups(_a0 uint, _a1 time.Time) (int64, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time) (int64, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time) int64); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateShift provides a mock function with given fields: _a0
+func (_m *ShiftRepository) CreateShift(_a0 models.EventShifts) (models.EventShifts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventShifts) (models.EventShifts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventShifts) models.EventShifts); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventShifts)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventShifts) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteShift provides a mock function with given fields: _a0
+func (_m *ShiftRepository) DeleteShift(_a0 models.EventShifts) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.EventShifts) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetShiftById provides a mock function with given fields: _a0
+func (_m *ShiftRepository) GetShiftById(_a0 uint) (models.EventShifts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.EventShifts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.EventShifts); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventShifts)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShifts provides a mock function with given fields: _a0
+func (_m *ShiftRepository) GetShifts(_a0 uint) ([]models.EventShifts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.EventShifts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.EventShifts); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventShifts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShiftsByIds provides a mock function with given fields: _a0
+func (_m *ShiftRepository) GetShiftsByIds(_a0 []uint) ([]models.EventShifts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]models.EventShifts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []models.EventShifts); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventShifts)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HasSignups provides a mock function with given fields: _a0
+func (_m *ShiftRepository) HasSignups(_a0 uint) (bool, error) {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (bool, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateShift provides a mock function with given fields: _a0
+func (_m *ShiftRepository) UpdateShift(_a0 models.EventShifts) (models.EventShifts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventShifts) (models.EventShifts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventShifts) models.EventShifts); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventShifts)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventShifts) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewShiftRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewShiftRepository creates a new instance of ShiftRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewShiftRepository(t mockConstructorTestingTNewShiftRepository) *ShiftRepository {
+	mock := &ShiftRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,142 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ShiftService is an autogenerated mock type for the ShiftService type
+type ShiftService struct {
+	mock.Mock
+}
+
+// CreateShift provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ShiftService) CreateShift(_a0 uint, _a1 models.EventShifts, _a2 []uint, _a3 uint) (models.EventShifts, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, models.EventShifts, []uint, uint) (models.EventShifts, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, models.EventShifts, []uint, uint) models.EventShifts); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.EventShifts)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, models.EventShifts, []uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteShift provides a mock function with given fields: _a0, _a1
+func (_m *ShiftService) DeleteShift(_a0 models.EventShifts, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.EventShifts, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetShiftById provides a mock function with given fields: _a0
+func (_m *ShiftService) GetShiftById(_a0 uint) (models.EventShifts, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.EventShifts, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.EventShifts); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventShifts)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShifts provides a mock function with given fields: _a0, _a1, _a2
+func (_m *ShiftService) GetShifts(_a0 uint, _a1 time.Time, _a2 uint) ([]models.ShiftOccurrence, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.ShiftOccurrence
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time, uint) ([]models.ShiftOccurrence, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time, uint) []models.ShiftOccurrence); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.ShiftOccurrence)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateShift provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *ShiftService) UpdateShift(_a0 models.EventShifts, _a1 models.EventShifts, _a2 []uint, _a3 uint) (models.EventShifts, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.EventShifts
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventShifts, models.EventShifts, []uint, uint) (models.EventShifts, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventShifts, models.EventShifts, []uint, uint) models.EventShifts); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.EventShifts)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventShifts, models.EventShifts, []uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewShiftService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewShiftService creates a new instance of ShiftService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewShiftService(t mockConstructorTestingTNewShiftService) *ShiftService {
+	mock := &ShiftService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
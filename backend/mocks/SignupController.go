@@ -0,0 +1,43 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SignupController is an autogenerated mock type for the SignupController type
+type SignupController struct {
+	mock.Mock
+}
+
+// SignUp provides a mock function with given fields: c
+func (_m *SignupController) SignUp(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Signups provides a mock function with given fields: c
+func (_m *SignupController) Signups(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Withdraw provides a mock function with given fields: c
+func (_m *SignupController) Withdraw(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewSignupController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSignupController creates a new instance of SignupController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSignupController(t mockConstructorTestingTNewSignupController) *SignupController {
+	mock := &SignupController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
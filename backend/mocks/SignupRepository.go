@@ -0,0 +1,218 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SignupRepository is an autogenerated mock type for the SignupRepository type
+type SignupRepository struct {
+	mock.Mock
+}
+
+// CreateSignup provides a mock function with given fields: _a0
+func (_m *SignupRepository) CreateSignup(_a0 models.EventSignups) (models.EventSignups, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventSignups) (models.EventSignups, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventSignups) models.EventSignups); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventSignups)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventSignups) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSignup provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SignupRepository) DeleteSignup(_a0 uint, _a1 uint, _a2 time.Time, _a3 uint) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindSignup provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SignupRepository) FindSignup(_a0 uint, _a1 uint, _a2 time.Time, _a3 uint) (models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) (models.EventSignups, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) models.EventSignups); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.EventSignups)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSignupByToken provides a mock function with given fields: _a0
+func (_m *SignupRepository) FindSignupByToken(_a0 string) (models.EventSignups, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.EventSignups, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.EventSignups); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.EventSignups)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSignups provides a mock function with given fields: _a0, _a1
+func (_m *SignupRepository) GetSignups(_a0 uint, _a1 time.Time) ([]models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time) ([]models.EventSignups, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time) []models.EventSignups); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventSignups)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSignupsBetween provides a mock function with given fields: _a0, _a1
+func (_m *SignupRepository) GetSignupsBetween(_a0 time.Time, _a1 time.Time) ([]models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) ([]models.EventSignups, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) []models.EventSignups); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventSignups)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserSignups provides a mock function with given fields: _a0, _a1
+func (_m *SignupRepository) GetUserSignups(_a0 uint, _a1 time.Time) ([]models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time) ([]models.EventSignups, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time) []models.EventSignups); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventSignups)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetCheckInToken provides a mock function with given fields: _a0, _a1
+func (_m *SignupRepository) SetCheckInToken(_a0 models.EventSignups, _a1 string) (models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.EventSignups, string) (models.EventSignups, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(models.EventSignups, string) models.EventSignups); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.EventSignups)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.EventSignups, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewSignupRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSignupRepository creates a new instance of SignupRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSignupRepository(t mockConstructorTestingTNewSignupRepository) *SignupRepository {
+	mock := &SignupRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,94 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SignupService is an autogenerated mock type for the SignupService type
+type SignupService struct {
+	mock.Mock
+}
+
+// GetSignups provides a mock function with given fields: _a0, _a1, _a2
+func (_m *SignupService) GetSignups(_a0 uint, _a1 time.Time, _a2 uint) ([]models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, time.Time, uint) ([]models.EventSignups, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, time.Time, uint) []models.EventSignups); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.EventSignups)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SignUp provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SignupService) SignUp(_a0 uint, _a1 uint, _a2 time.Time, _a3 uint) (models.EventSignups, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.EventSignups
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) (models.EventSignups, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) models.EventSignups); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.EventSignups)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Withdraw provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *SignupService) Withdraw(_a0 uint, _a1 uint, _a2 time.Time, _a3 uint) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time, uint) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewSignupService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewSignupService creates a new instance of SignupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewSignupService(t mockConstructorTestingTNewSignupService) *SignupService {
+	mock := &SignupService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
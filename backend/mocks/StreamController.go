@@ -0,0 +1,38 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreamController is an autogenerated mock type for the StreamController type
+type StreamController struct {
+	mock.Mock
+}
+
+// Stream provides a mock function with given fields: c
+func (_m *StreamController) Stream(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Ticket provides a mock function with given fields: c
+func (_m *StreamController) Ticket(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewStreamController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStreamController creates a new instance of StreamController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStreamController(t mockConstructorTestingTNewStreamController) *StreamController {
+	mock := &StreamController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,107 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	realtime "github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// StreamService is an autogenerated mock type for the StreamService type
+type StreamService struct {
+	mock.Mock
+}
+
+// IssueTicket provides a mock function with given fields: _a0
+func (_m *StreamService) IssueTicket(_a0 uint) (string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (string, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedeemTicket provides a mock function with given fields: _a0
+func (_m *StreamService) RedeemTicket(_a0 string) (uint, bool) {
+	ret := _m.Called(_a0)
+
+	var r0 uint
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(string) (uint, bool)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) uint); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) bool); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// Subscribe provides a mock function with given fields: _a0, _a1, _a2
+func (_m *StreamService) Subscribe(_a0 uint, _a1 []uint, _a2 []uint) (*realtime.Subscription, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *realtime.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint, []uint) (*realtime.Subscription, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint, []uint) *realtime.Subscription); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*realtime.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint, []uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unsubscribe provides a mock function with given fields: _a0
+func (_m *StreamService) Unsubscribe(_a0 *realtime.Subscription) {
+	_m.Called(_a0)
+}
+
+type mockConstructorTestingTNewStreamService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStreamService creates a new instance of StreamService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStreamService(t mockConstructorTestingTNewStreamService) *StreamService {
+	mock := &StreamService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
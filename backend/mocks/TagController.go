@@ -0,0 +1,88 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagController is an autogenerated mock type for the TagController type
+type TagController struct {
+	mock.Mock
+}
+
+// All provides a mock function with given fields: c
+func (_m *TagController) All(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Autocomplete provides a mock function with given fields: c
+func (_m *TagController) Autocomplete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Create provides a mock function with given fields: c
+func (_m *TagController) Create(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Delete provides a mock function with given fields: c
+func (_m *TagController) Delete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// EventTags provides a mock function with given fields: c
+func (_m *TagController) EventTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+// One provides a mock function with given fields: c
+func (_m *TagController) One(c *gin.Context) {
+	_m.Called(c)
+}
+
+// OrganizationTags provides a mock function with given fields: c
+func (_m *TagController) OrganizationTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SetEventTags provides a mock function with given fields: c
+func (_m *TagController) SetEventTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SetOrganizationTags provides a mock function with given fields: c
+func (_m *TagController) SetOrganizationTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+// SetUserTags provides a mock function with given fields: c
+func (_m *TagController) SetUserTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Update provides a mock function with given fields: c
+func (_m *TagController) Update(c *gin.Context) {
+	_m.Called(c)
+}
+
+// UserTags provides a mock function with given fields: c
+func (_m *TagController) UserTags(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewTagController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTagController creates a new instance of TagController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTagController(t mockConstructorTestingTNewTagController) *TagController {
+	mock := &TagController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,386 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagRepository is an autogenerated mock type for the TagRepository type
+type TagRepository struct {
+	mock.Mock
+}
+
+// AllEvents provides a mock function with given fields:
+func (_m *TagRepository) AllEvents() ([]models.Event, error) {
+	ret := _m.Called()
+
+	var r0 []models.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Event, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Event); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllOrganizations provides a mock function with given fields:
+func (_m *TagRepository) AllOrganizations() ([]models.Organization, error) {
+	ret := _m.Called()
+
+	var r0 []models.Organization
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Organization, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Organization); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Organization)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AllUsers provides a mock function with given fields:
+func (_m *TagRepository) AllUsers() ([]models.Users, error) {
+	ret := _m.Called()
+
+	var r0 []models.Users
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]models.Users, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []models.Users); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Users)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppendOwnerTags provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) AppendOwnerTags(_a0 models.Model, _a1 []models.Tags) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Model, []models.Tags) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateTag provides a mock function with given fields: _a0
+func (_m *TagRepository) CreateTag(_a0 models.Tags) (models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Tags) (models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Tags) models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Tags) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTag provides a mock function with given fields: _a0
+func (_m *TagRepository) DeleteTag(_a0 models.Tags) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Tags) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindOrCreateTag provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) FindOrCreateTag(_a0 string, _a1 string) (models.Tags, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (models.Tags, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) models.Tags); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOwnerTags provides a mock function with given fields: _a0
+func (_m *TagRepository) GetOwnerTags(_a0 models.Model) ([]models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Model) ([]models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Model) []models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Model) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagById provides a mock function with given fields: _a0
+func (_m *TagRepository) GetTagById(_a0 string) (models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTags provides a mock function with given fields: _a0
+func (_m *TagRepository) GetTags(_a0 string) ([]models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagsByIds provides a mock function with given fields: _a0
+func (_m *TagRepository) GetTagsByIds(_a0 []uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func([]uint) ([]models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func([]uint) []models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func([]uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrationApplied provides a mock function with given fields: _a0
+func (_m *TagRepository) MigrationApplied(_a0 string) (bool, error) {
+	ret := _m.Called(_a0)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordMigration provides a mock function with given fields: _a0
+func (_m *TagRepository) RecordMigration(_a0 string) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ReplaceOwnerTags provides a mock function with given fields: _a0, _a1
+func (_m *TagRepository) ReplaceOwnerTags(_a0 models.Model, _a1 []models.Tags) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Model, []models.Tags) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchTags provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagRepository) SearchTags(_a0 string, _a1 string, _a2 int) ([]models.Tags, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int) ([]models.Tags, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int) []models.Tags); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTag provides a mock function with given fields: _a0
+func (_m *TagRepository) UpdateTag(_a0 models.Tags) (models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Tags) (models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Tags) models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Tags) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewTagRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTagRepository creates a new instance of TagRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTagRepository(t mockConstructorTestingTNewTagRepository) *TagRepository {
+	mock := &TagRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
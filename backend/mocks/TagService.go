@@ -0,0 +1,336 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TagService is an autogenerated mock type for the TagService type
+type TagService struct {
+	mock.Mock
+}
+
+// Autocomplete provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagService) Autocomplete(_a0 string, _a1 string, _a2 int) ([]models.Tags, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int) ([]models.Tags, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int) []models.Tags); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateTag provides a mock function with given fields: _a0, _a1
+func (_m *TagService) CreateTag(_a0 string, _a1 string) (models.Tags, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (models.Tags, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) models.Tags); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTag provides a mock function with given fields: _a0
+func (_m *TagService) DeleteTag(_a0 models.Tags) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Tags) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetEventTags provides a mock function with given fields: _a0, _a1
+func (_m *TagService) GetEventTags(_a0 uint, _a1 uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) ([]models.Tags, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) []models.Tags); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrganizationTags provides a mock function with given fields: _a0
+func (_m *TagService) GetOrganizationTags(_a0 uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTagById provides a mock function with given fields: _a0
+func (_m *TagService) GetTagById(_a0 string) (models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTags provides a mock function with given fields: _a0
+func (_m *TagService) GetTags(_a0 string) ([]models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(string) []models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserTags provides a mock function with given fields: _a0
+func (_m *TagService) GetUserTags(_a0 uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.Tags, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.Tags); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MigrateLegacyTags provides a mock function with given fields:
+func (_m *TagService) MigrateLegacyTags() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetEventTags provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagService) SetEventTags(_a0 uint, _a1 []uint, _a2 uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint, uint) ([]models.Tags, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint, uint) []models.Tags); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetOrganizationTags provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagService) SetOrganizationTags(_a0 uint, _a1 []uint, _a2 uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint, uint) ([]models.Tags, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint, uint) []models.Tags); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetUserTags provides a mock function with given fields: _a0, _a1
+func (_m *TagService) SetUserTags(_a0 uint, _a1 []uint) ([]models.Tags, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) ([]models.Tags, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint) []models.Tags); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Tags)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTag provides a mock function with given fields: _a0, _a1, _a2
+func (_m *TagService) UpdateTag(_a0 models.Tags, _a1 string, _a2 string) (models.Tags, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.Tags
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Tags, string, string) (models.Tags, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(models.Tags, string, string) models.Tags); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.Tags)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Tags, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewTagService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewTagService creates a new instance of TagService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewTagService(t mockConstructorTestingTNewTagService) *TagService {
+	mock := &TagService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
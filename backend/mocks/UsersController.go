@@ -27,6 +27,11 @@ func (_m *UsersController) One(c *gin.Context) {
 	_m.Called(c)
 }
 
+// SetBirthdate provides a mock function with given fields: c
+func (_m *UsersController) SetBirthdate(c *gin.Context) {
+	_m.Called(c)
+}
+
 // Update provides a mock function with given fields: c
 func (_m *UsersController) Update(c *gin.Context) {
 	_m.Called(c)
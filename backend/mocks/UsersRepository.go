@@ -60,6 +60,54 @@ func (_m *UsersRepository) DeleteUser(user models.Users) (models.Users, error) {
 	return r0, r1
 }
 
+// FindUserByCalendarToken provides a mock function with given fields: token
+func (_m *UsersRepository) FindUserByCalendarToken(token string) (models.Users, error) {
+	ret := _m.Called(token)
+
+	var r0 models.Users
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.Users, error)); ok {
+		return rf(token)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.Users); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Get(0).(models.Users)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUserByHandle provides a mock function with given fields: handle
+func (_m *UsersRepository) FindUserByHandle(handle string) (models.Users, error) {
+	ret := _m.Called(handle)
+
+	var r0 models.Users
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (models.Users, error)); ok {
+		return rf(handle)
+	}
+	if rf, ok := ret.Get(0).(func(string) models.Users); ok {
+		r0 = rf(handle)
+	} else {
+		r0 = ret.Get(0).(models.Users)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(handle)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // OneUser provides a mock function with given fields: id, user
 func (_m *UsersRepository) OneUser(id string, user models.Users) (models.Users, error) {
 	ret := _m.Called(id, user)
@@ -84,6 +132,30 @@ func (_m *UsersRepository) OneUser(id string, user models.Users) (models.Users,
 	return r0, r1
 }
 
+// SetCalendarToken provides a mock function with given fields: user, token
+func (_m *UsersRepository) SetCalendarToken(user models.Users, token string) (models.Users, error) {
+	ret := _m.Called(user, token)
+
+	var r0 models.Users
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Users, string) (models.Users, error)); ok {
+		return rf(user, token)
+	}
+	if rf, ok := ret.Get(0).(func(models.Users, string) models.Users); ok {
+		r0 = rf(user, token)
+	} else {
+		r0 = ret.Get(0).(models.Users)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Users, string) error); ok {
+		r1 = rf(user, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: user
 func (_m *UsersRepository) UpdateUser(user models.Users) (models.Users, error) {
 	ret := _m.Called(user)
@@ -110,6 +110,30 @@ func (_m *UsersService) OneUser(id string, user models.Users) (models.Users, err
 	return r0, r1
 }
 
+// SetBirthdate provides a mock function with given fields: id, birthdate
+func (_m *UsersService) SetBirthdate(id string, birthdate string) (models.Users, error) {
+	ret := _m.Called(id, birthdate)
+
+	var r0 models.Users
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (models.Users, error)); ok {
+		return rf(id, birthdate)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) models.Users); ok {
+		r0 = rf(id, birthdate)
+	} else {
+		r0 = ret.Get(0).(models.Users)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(id, birthdate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateUser provides a mock function with given fields: user
 func (_m *UsersService) UpdateUser(user models.Users) (models.Users, error) {
 	ret := _m.Called(user)
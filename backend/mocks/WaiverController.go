@@ -0,0 +1,73 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	gin "github.com/gin-gonic/gin"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WaiverController is an autogenerated mock type for the WaiverController type
+type WaiverController struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: c
+func (_m *WaiverController) Accept(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Create provides a mock function with given fields: c
+func (_m *WaiverController) Create(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Delete provides a mock function with given fields: c
+func (_m *WaiverController) Delete(c *gin.Context) {
+	_m.Called(c)
+}
+
+// One provides a mock function with given fields: c
+func (_m *WaiverController) One(c *gin.Context) {
+	_m.Called(c)
+}
+
+// OrganizationWaivers provides a mock function with given fields: c
+func (_m *WaiverController) OrganizationWaivers(c *gin.Context) {
+	_m.Called(c)
+}
+
+// RecordBackgroundCheck provides a mock function with given fields: c
+func (_m *WaiverController) RecordBackgroundCheck(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Requirements provides a mock function with given fields: c
+func (_m *WaiverController) Requirements(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Update provides a mock function with given fields: c
+func (_m *WaiverController) Update(c *gin.Context) {
+	_m.Called(c)
+}
+
+// Versions provides a mock function with given fields: c
+func (_m *WaiverController) Versions(c *gin.Context) {
+	_m.Called(c)
+}
+
+type mockConstructorTestingTNewWaiverController interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWaiverController creates a new instance of WaiverController. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWaiverController(t mockConstructorTestingTNewWaiverController) *WaiverController {
+	mock := &WaiverController{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
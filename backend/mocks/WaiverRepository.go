@@ -0,0 +1,290 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WaiverRepository is an autogenerated mock type for the WaiverRepository type
+type WaiverRepository struct {
+	mock.Mock
+}
+
+// CreateAcceptance provides a mock function with given fields: _a0
+func (_m *WaiverRepository) CreateAcceptance(_a0 models.WaiverAcceptances) (models.WaiverAcceptances, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.WaiverAcceptances
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.WaiverAcceptances) (models.WaiverAcceptances, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.WaiverAcceptances) models.WaiverAcceptances); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.WaiverAcceptances)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.WaiverAcceptances) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateBackgroundCheck provides a mock function with given fields: _a0
+func (_m *WaiverRepository) CreateBackgroundCheck(_a0 models.BackgroundChecks) (models.BackgroundChecks, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.BackgroundChecks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.BackgroundChecks) (models.BackgroundChecks, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.BackgroundChecks) models.BackgroundChecks); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.BackgroundChecks)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.BackgroundChecks) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWaiver provides a mock function with given fields: _a0
+func (_m *WaiverRepository) CreateWaiver(_a0 models.Waivers) (models.Waivers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Waivers) (models.Waivers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Waivers) models.Waivers); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Waivers)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Waivers) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWaiver provides a mock function with given fields: _a0
+func (_m *WaiverRepository) DeleteWaiver(_a0 models.Waivers) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(models.Waivers) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FindBackgroundCheck provides a mock function with given fields: _a0, _a1
+func (_m *WaiverRepository) FindBackgroundCheck(_a0 uint, _a1 uint) (models.BackgroundChecks, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 models.BackgroundChecks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) (models.BackgroundChecks, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) models.BackgroundChecks); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Get(0).(models.BackgroundChecks)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAcceptances provides a mock function with given fields: _a0, _a1
+func (_m *WaiverRepository) GetAcceptances(_a0 uint, _a1 []uint) ([]models.WaiverAcceptances, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.WaiverAcceptances
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, []uint) ([]models.WaiverAcceptances, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, []uint) []models.WaiverAcceptances); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WaiverAcceptances)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, []uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventWaivers provides a mock function with given fields: _a0, _a1
+func (_m *WaiverRepository) GetEventWaivers(_a0 uint, _a1 uint) ([]models.Waivers, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) ([]models.Waivers, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) []models.Waivers); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Waivers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrganizationWaivers provides a mock function with given fields: _a0
+func (_m *WaiverRepository) GetOrganizationWaivers(_a0 uint) ([]models.Waivers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.Waivers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.Waivers); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Waivers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWaiverById provides a mock function with given fields: _a0
+func (_m *WaiverRepository) GetWaiverById(_a0 uint) (models.Waivers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.Waivers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.Waivers); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Waivers)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWaiverVersions provides a mock function with given fields: _a0
+func (_m *WaiverRepository) GetWaiverVersions(_a0 uint) ([]models.WaiverVersions, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.WaiverVersions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.WaiverVersions, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.WaiverVersions); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WaiverVersions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWaiver provides a mock function with given fields: _a0
+func (_m *WaiverRepository) UpdateWaiver(_a0 models.Waivers) (models.Waivers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(models.Waivers) (models.Waivers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(models.Waivers) models.Waivers); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Waivers)
+	}
+
+	if rf, ok := ret.Get(1).(func(models.Waivers) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewWaiverRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWaiverRepository creates a new instance of WaiverRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWaiverRepository(t mockConstructorTestingTNewWaiverRepository) *WaiverRepository {
+	mock := &WaiverRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,240 @@
+// Code generated by mockery v2.23.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	models "github.com/VolunteerOne/volunteer-one-app/backend/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// WaiverService is an autogenerated mock type for the WaiverService type
+type WaiverService struct {
+	mock.Mock
+}
+
+// Accept provides a mock function with given fields: _a0, _a1, _a2
+func (_m *WaiverService) Accept(_a0 uint, _a1 uint, _a2 uint) (models.WaiverAcceptances, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.WaiverAcceptances
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) (models.WaiverAcceptances, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint) models.WaiverAcceptances); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.WaiverAcceptances)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWaiver provides a mock function with given fields: _a0, _a1, _a2
+func (_m *WaiverService) CreateWaiver(_a0 uint, _a1 uint, _a2 models.Waivers) (models.Waivers, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, models.Waivers) (models.Waivers, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, models.Waivers) models.Waivers); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.Waivers)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, models.Waivers) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWaiver provides a mock function with given fields: _a0, _a1
+func (_m *WaiverService) DeleteWaiver(_a0 uint, _a1 uint) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint, uint) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetWaiver provides a mock function with given fields: _a0
+func (_m *WaiverService) GetWaiver(_a0 uint) (models.Waivers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) (models.Waivers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) models.Waivers); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(models.Waivers)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OrganizationWaivers provides a mock function with given fields: _a0
+func (_m *WaiverService) OrganizationWaivers(_a0 uint) ([]models.Waivers, error) {
+	ret := _m.Called(_a0)
+
+	var r0 []models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint) ([]models.Waivers, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(uint) []models.Waivers); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Waivers)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordBackgroundCheck provides a mock function with given fields: _a0, _a1, _a2, _a3, _a4
+func (_m *WaiverService) RecordBackgroundCheck(_a0 uint, _a1 uint, _a2 uint, _a3 time.Time, _a4 *time.Time) (models.BackgroundChecks, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3, _a4)
+
+	var r0 models.BackgroundChecks
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, time.Time, *time.Time) (models.BackgroundChecks, error)); ok {
+		return rf(_a0, _a1, _a2, _a3, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, uint, time.Time, *time.Time) models.BackgroundChecks); ok {
+		r0 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r0 = ret.Get(0).(models.BackgroundChecks)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, uint, time.Time, *time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Requirements provides a mock function with given fields: _a0, _a1, _a2
+func (_m *WaiverService) Requirements(_a0 uint, _a1 uint, _a2 time.Time) (models.EventRequirements, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 models.EventRequirements
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) (models.EventRequirements, error)); ok {
+		return rf(_a0, _a1, _a2)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, time.Time) models.EventRequirements); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Get(0).(models.EventRequirements)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, time.Time) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWaiver provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *WaiverService) UpdateWaiver(_a0 uint, _a1 uint, _a2 string, _a3 string) (models.Waivers, error) {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 models.Waivers
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string) (models.Waivers, error)); ok {
+		return rf(_a0, _a1, _a2, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint, string, string) models.Waivers); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Get(0).(models.Waivers)
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint, string, string) error); ok {
+		r1 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaiverVersions provides a mock function with given fields: _a0, _a1
+func (_m *WaiverService) WaiverVersions(_a0 uint, _a1 uint) ([]models.WaiverVersions, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 []models.WaiverVersions
+	var r1 error
+	if rf, ok := ret.Get(0).(func(uint, uint) ([]models.WaiverVersions, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(uint, uint) []models.WaiverVersions); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.WaiverVersions)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(uint, uint) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewWaiverService interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewWaiverService creates a new instance of WaiverService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewWaiverService(t mockConstructorTestingTNewWaiverService) *WaiverService {
+	mock := &WaiverService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
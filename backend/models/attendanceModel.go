@@ -0,0 +1,90 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// A volunteer checked in at an occurrence of an event, or at a shift of
+// it, by scanning their sign-up's QR code. One per sign-up.
+type EventAttendance struct {
+	gorm.Model
+	EventID        uint      `gorm:"not null;uniqueIndex:idx_event_attendance"`
+	UsersID        uint      `gorm:"not null;uniqueIndex:idx_event_attendance"`
+	OccurrenceDate time.Time `gorm:"not null;uniqueIndex:idx_event_attendance"`
+	ShiftID        uint      `gorm:"not null;default:0;uniqueIndex:idx_event_attendance"`
+	CheckedInAt    time.Time `gorm:"not null"`
+	// The manager who scanned the code
+	CheckedInBy uint `gorm:"not null"`
+
+	Users Users `gorm:"foreignkey:UsersID"`
+}
+
+// Volunteer hours statuses. Hours are tracked until their event is
+// completed, then wait for a manager to verify or reject them.
+const (
+	HoursTracking = "tracking"
+	HoursPending  = "pending"
+	HoursVerified = "verified"
+	HoursRejected = "rejected"
+)
+
+// Time a volunteer spent at an occurrence of an event or shift. Started
+// on check-in, End stays nil while the volunteer is still there.
+type VolunteerHours struct {
+	gorm.Model
+	UsersID        uint      `gorm:"not null;index"`
+	EventID        uint      `gorm:"not null;index"`
+	OccurrenceDate time.Time `gorm:"not null"`
+	ShiftID        uint      `gorm:"not null;default:0"`
+	Start          time.Time `gorm:"not null"`
+	End            *time.Time
+	Status         string `gorm:"size:16;not null;default:tracking;index"`
+	// The manager who verified or rejected the hours
+	VerifiedBy uint
+	VerifiedAt *time.Time
+
+	Users Users `gorm:"foreignkey:UsersID"`
+}
+
+// Hours are sent with only what anyone may see of their volunteer
+func (h VolunteerHours) MarshalJSON() ([]byte, error) {
+	type hours VolunteerHours
+
+	out := struct {
+		hours
+		Users *PublicUser `json:",omitempty"`
+	}{hours: hours(h)}
+
+	if h.Users.ID != 0 {
+		user := h.Users.Public()
+		out.Users = &user
+	}
+
+	return json.Marshal(out)
+}
+
+// A row of the attendance roster: a sign-up and when the volunteer
+// checked in, nil while they have not
+type AttendanceEntry struct {
+	Signup      EventSignups `json:"signup"`
+	CheckedInAt *time.Time   `json:"checkedInAt"`
+	CheckedInBy uint         `json:"checkedInBy,omitempty"`
+}
+
+type AttendanceRoster struct {
+	EventID        uint              `json:"eventId"`
+	OccurrenceDate time.Time         `json:"occurrenceDate"`
+	SignedUp       int               `json:"signedUp"`
+	CheckedIn      int               `json:"checkedIn"`
+	Entries        []AttendanceEntry `json:"entries"`
+}
+
+// What a volunteer shows to check in. QR is the text to encode in the QR
+// code, the token can be typed in when scanning fails.
+type CheckInCode struct {
+	Token string `json:"token"`
+	QR    string `json:"qr"`
+}
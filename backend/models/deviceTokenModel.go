@@ -0,0 +1,16 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// A phone the user gets push notifications on. Registered under the
+// session it was signed in with, so signing out everywhere stops pushes.
+type DeviceTokens struct {
+	gorm.Model
+	UsersID       uint `gorm:"not null;index"`
+	DelegationsID uint `gorm:"not null;index"`
+	// One of push.Expo, push.FCM or push.APNs
+	Platform string `gorm:"size:16;not null"`
+	Token    string `gorm:"size:255;not null;uniqueIndex"`
+}
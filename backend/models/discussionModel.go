@@ -0,0 +1,28 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// A message on the discussion board of an event, where its volunteers and
+// the staff of its organization coordinate. Boards of recurring events are
+// kept with the series.
+type DiscussionMessages struct {
+	gorm.Model
+	EventID uint   `gorm:"not null;index"`
+	UsersID uint   `gorm:"not null"`
+	Handle  string `gorm:"not null"`
+	Body    string `gorm:"not null"`
+	// When staff pinned it to the top of the board, nil otherwise
+	PinnedAt *time.Time
+}
+
+// A page of an event's discussion, latest first. Pinned messages, latest
+// pinned first, come with the first page only.
+type DiscussionPage struct {
+	Pinned     []DiscussionMessages `json:"pinned,omitempty"`
+	Messages   []DiscussionMessages `json:"messages"`
+	NextCursor string               `json:"nextCursor"`
+}
@@ -6,17 +6,79 @@ import (
 	"gorm.io/gorm"
 )
 
+// Event lifecycle statuses. Drafts are only visible to organization staff;
+// cancelled and completed events are kept along with their sign-ups.
+const (
+	EventDraft		= "draft"
+	EventPublished	= "published"
+	EventCancelled	= "cancelled"
+	EventCompleted	= "completed"
+)
+
 type Event struct {
 	gorm.Model
 	Organization    Organization `gorm:"foreignkey:OrganizationID"`
 	OrganizationID  uint
-	Name        	string
+	Name        	string `gorm:"index:idx_event_search,class:FULLTEXT"`
+	// One of EventDraft, EventPublished, EventCancelled or EventCompleted.
+	// Edited occurrences follow their series.
+	Status			string `gorm:"size:16;not null;default:published;index"`
 	Address			string
-	Date 			time.Time
-	Description 	string
+	// Geocoded from Address, nil when it could not be located
+	Latitude		*float64 `gorm:"index:idx_event_location"`
+	Longitude		*float64 `gorm:"index:idx_event_location"`
+	// Stored in UTC. Recurrences repeat at the same local time in TimeZone
+	Start 			time.Time `gorm:"index"`
+	End				time.Time
+	// IANA time zone the event takes place in, e.g. America/Chicago
+	TimeZone		string `gorm:"not null;default:UTC"`
+	// RFC 5545 RRULE, e.g. FREQ=WEEKLY;BYDAY=SA. Empty for one-off events
+	Recurrence		string
+	// Last occurrence of the series, nil when it repeats forever
+	RecurrenceEnd	*time.Time `gorm:"index"`
+	// Set on an edited occurrence of a series: the series it belongs to
+	// and the start of the occurrence it replaces
+	SeriesID		*uint `gorm:"index"`
+	OccurrenceDate	*time.Time
+	Description 	string `gorm:"index:idx_event_search,class:FULLTEXT"`
 	Interests		string
 	Skills			string
 	GoodFor			string
 	CauseAreas		string
 	Requirements 	string
+	// Volunteers must be at least this old on the day, 0 for any age
+	MinimumAge		uint
+	// Volunteers need a background check cleared by the organization
+	BackgroundCheck	bool
+
+	Tags 			[]Tags `gorm:"many2many:event_tags"`
+}
+
+// The time zone of the event, UTC when it is not set or unknown
+func (e Event) Location() *time.Location {
+	loc, err := time.LoadLocation(e.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// How long the event, or each of its occurrences, lasts
+func (e Event) Duration() time.Duration {
+	return e.End.Sub(e.Start)
+}
+
+// Returns the event with its times converted to loc for display
+func (e Event) In(loc *time.Location) Event {
+	e.Start = e.Start.In(loc)
+	e.End = e.End.In(loc)
+	if e.RecurrenceEnd != nil {
+		end := e.RecurrenceEnd.In(loc)
+		e.RecurrenceEnd = &end
+	}
+	if e.OccurrenceDate != nil {
+		date := e.OccurrenceDate.In(loc)
+		e.OccurrenceDate = &date
+	}
+	return e
 }
@@ -0,0 +1,56 @@
+package models
+
+import "time"
+
+// Event search sort orders
+const (
+	EventSortDate      = "date"
+	EventSortRelevance = "relevance"
+)
+
+// Filters for an event search. Zero values mean "no filter", except for
+// Statuses: only events in one of them are found. Events must have at
+// least one of the given tags in each tag list that is not empty.
+type EventSearchQuery struct {
+	Text           string
+	Statuses       []string
+	From           time.Time
+	To             time.Time
+	OrganizationID uint
+	CauseAreas     []uint
+	Skills         []uint
+	GoodFor        []uint
+	VerifiedOnly   bool
+	Sort           string
+	Limit          int
+	Cursor         EventSearchCursor
+}
+
+// Position in a search. Date sorting pages by (Start, ID); relevance scores
+// are not stable enough to compare, so relevance sorting pages by Offset.
+type EventSearchCursor struct {
+	Start  time.Time `json:"d"`
+	ID     uint      `json:"i"`
+	Offset int       `json:"o"`
+}
+
+// A page of search results. Total is the number of events matching the
+// filters across all pages.
+type EventSearchResult struct {
+	Events     []Event `json:"events"`
+	Total      int64   `json:"total"`
+	NextCursor string  `json:"nextCursor"`
+}
+
+// An event found by a radius search. DistanceKm is measured from the
+// event's coordinates, or its organization's when the event has none.
+type NearbyEvent struct {
+	Event      Event   `json:"event"`
+	DistanceKm float64 `json:"distanceKm"`
+}
+
+// Returns the result with its times converted to loc for display
+func (n NearbyEvent) In(loc *time.Location) NearbyEvent {
+	n.Event = n.Event.In(loc)
+	return n
+}
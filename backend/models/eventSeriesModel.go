@@ -0,0 +1,43 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Scopes of an edit to one occurrence of a recurring event
+const (
+	EditThisOccurrence = "this"
+	EditFollowing      = "following"
+	EditWholeSeries    = "all"
+)
+
+// A cancelled occurrence of a recurring event
+type EventExceptions struct {
+	gorm.Model
+	EventID        uint      `gorm:"uniqueIndex:idx_event_exception"`
+	OccurrenceDate time.Time `gorm:"uniqueIndex:idx_event_exception"`
+}
+
+// A single occurrence of an event. One-off events have exactly one, where
+// SeriesID is the event's id and OccurrenceDate and Start its start.
+type EventOccurrence struct {
+	// The series, or the edited copy when this occurrence was changed
+	Event Event `json:"event"`
+	// The event sign-ups for this occurrence attach to
+	SeriesID uint `json:"seriesId"`
+	// Original start of the occurrence, which identifies it in the series
+	OccurrenceDate time.Time `json:"occurrenceDate"`
+	Start          time.Time `json:"start"`
+	End            time.Time `json:"end"`
+}
+
+// Returns the occurrence with its times converted to loc for display
+func (o EventOccurrence) In(loc *time.Location) EventOccurrence {
+	o.Event = o.Event.In(loc)
+	o.OccurrenceDate = o.OccurrenceDate.In(loc)
+	o.Start = o.Start.In(loc)
+	o.End = o.End.In(loc)
+	return o
+}
@@ -0,0 +1,15 @@
+package models
+
+import "gorm.io/gorm"
+
+// A change of an event's status, kept as its history
+type EventStatusChanges struct {
+	gorm.Model
+	EventID uint   `gorm:"not null;index"`
+	From    string `gorm:"size:16;not null"`
+	To      string `gorm:"size:16;not null"`
+	// Who changed it
+	UsersID uint `gorm:"not null"`
+	// Given when cancelling, sent to the volunteers signed up
+	Reason string
+}
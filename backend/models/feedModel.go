@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// Feed item types, also used as the tiebreaker when two items share a
+// timestamp (events sort before posts).
+const (
+	FeedItemEvent = "event"
+	FeedItemPost  = "post"
+)
+
+// A single entry in a user's feed. Exactly one of Event or Post is set
+// depending on Type. Not stored in the database.
+type FeedItem struct {
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Event     *Event    `json:"event,omitempty"`
+	Post      *Posts    `json:"post,omitempty"`
+	// How the ranked feed ranked it, higher first. 0 in the latest feed.
+	Score float64 `json:"score,omitempty"`
+}
+
+// Position in a feed. Items are ordered by Timestamp (newest first), then
+// by Type, then by ID (highest first). The zero value is the start.
+type FeedCursor struct {
+	Timestamp time.Time
+	Type      string
+	ID        uint
+}
+
+// A page of feed items. NextCursor is empty when there are no more items.
+type FeedPage struct {
+	Items      []FeedItem `json:"items"`
+	NextCursor string     `json:"nextCursor"`
+}
@@ -0,0 +1,14 @@
+package models
+
+import "gorm.io/gorm"
+
+// A user following an organization. Unfollowing hard deletes the row so
+// the user can follow the organization again later.
+type OrgFollowers struct {
+	gorm.Model
+	UsersID        uint `gorm:"not null;uniqueIndex:idx_org_follower"`
+	OrganizationID uint `gorm:"not null;uniqueIndex:idx_org_follower"`
+
+	Users        Users        `gorm:"foreignkey:UsersID" json:"-"`
+	Organization Organization `gorm:"foreignkey:OrganizationID"`
+}
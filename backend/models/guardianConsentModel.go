@@ -0,0 +1,55 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Statuses of a guardian consent. Pending requests wait for the guardian,
+// who approves or declines them; approved consents can later be revoked.
+const (
+	ConsentPending  = "pending"
+	ConsentApproved = "approved"
+	ConsentDeclined = "declined"
+	ConsentRevoked  = "revoked"
+)
+
+// A guardian's consent for a minor to volunteer, at one event or series
+// or, without EventID, at any event
+type GuardianConsents struct {
+	gorm.Model
+	UsersID       uint   `gorm:"not null;index"`
+	GuardianName  string `gorm:"not null"`
+	GuardianEmail string `gorm:"not null"`
+	EventID       *uint  `gorm:"index"`
+	Status        string `gorm:"size:16;not null;default:pending"`
+	// Secret in the link emailed to the guardian, the only credential
+	// needed to respond, so never shown to the minor
+	Token string `gorm:"size:64;uniqueIndex" json:"-"`
+	// The link stops working after this while pending
+	ExpiresAt   time.Time `gorm:"not null"`
+	RespondedAt *time.Time
+}
+
+// A change of status of a consent, kept for audit along with where the
+// guardian responded from
+type GuardianConsentChanges struct {
+	gorm.Model
+	ConsentID uint   `gorm:"not null;index"`
+	From      string `gorm:"size:16"`
+	To        string `gorm:"size:16;not null"`
+	IP        string `gorm:"size:64"`
+	UserAgent string
+}
+
+// What the guardian is shown when opening their link
+type ConsentRequest struct {
+	ID           uint      `json:"id"`
+	Volunteer    string    `json:"volunteer"`
+	GuardianName string    `json:"guardianName"`
+	EventID      *uint     `json:"eventId"`
+	EventName    string    `json:"eventName"`
+	Status       string    `json:"status"`
+	ExpiresAt    time.Time `json:"expiresAt"`
+}
@@ -0,0 +1,41 @@
+package models
+
+import "time"
+
+// A #hashtag used in a post, or in a comment on it. Rows are replaced
+// whenever what the post or comment says changes.
+type Hashtags struct {
+	ID uint `gorm:"primaryKey"`
+	// Lowercase, without the #
+	Tag     string `gorm:"size:64;not null;index:idx_hashtags_tag_created"`
+	PostsID uint   `gorm:"not null;index"`
+	// Set when it was used in a comment on the post
+	CommentsID *uint `gorm:"index"`
+	// When the post or comment was written
+	CreatedAt time.Time `gorm:"index:idx_hashtags_tag_created"`
+}
+
+// An @handle mentioned in a post, or in a comment on it. Rows are replaced
+// whenever what the post or comment says changes.
+type Mentions struct {
+	ID uint `gorm:"primaryKey"`
+	// Lowercase, without the @
+	Handle  string `gorm:"size:64;not null;index"`
+	PostsID uint   `gorm:"not null;index"`
+	// Set when it was mentioned in a comment on the post
+	CommentsID *uint `gorm:"index"`
+	// When the post or comment was written
+	CreatedAt time.Time
+}
+
+// A hashtag and how many times public posts and their comments used it
+type TrendingHashtag struct {
+	Tag  string `json:"tag"`
+	Uses int64  `json:"uses"`
+}
+
+// A page of posts, latest first
+type PostPage struct {
+	Posts      []Posts `json:"posts"`
+	NextCursor string  `json:"nextCursor"`
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/database"
+	"gorm.io/gorm"
 )
 
 type Model interface {
@@ -19,10 +20,51 @@ var tables = []Model{
 	&Delegations{},
 	&Posts{},
 	&Comments{},
-	&Likes{},
+	&CommentEdits{},
+	&Reactions{},
+	&ReactionCounts{},
+	&OrgFollowers{},
+	&Tags{},
+	&SchemaMigrations{},
+	&EventExceptions{},
+	&EventSignups{},
+	&EventShifts{},
+	&EventAttendance{},
+	&VolunteerHours{},
+	&EventStatusChanges{},
+	&Waivers{},
+	&WaiverVersions{},
+	&WaiverAcceptances{},
+	&BackgroundChecks{},
+	&GuardianConsents{},
+	&GuardianConsentChanges{},
+	&Notifications{},
+	&NotificationPreferences{},
+	&ScheduledJobs{},
+	&DeviceTokens{},
+	&ModerationCases{},
+	&Reports{},
+	&ModerationActions{},
+	&Hashtags{},
+	&Mentions{},
+	&Conversations{},
+	&Messages{},
+	&Blocks{},
+	&DiscussionMessages{},
 }
 
 func Init() {
+	// Event.Date became Start, next to a new End
+	migrator := database.GetDatabase().Migrator()
+	if migrator.HasColumn(&Event{}, "date") && !migrator.HasColumn(&Event{}, "start") {
+		if migrator.RenameColumn(&Event{}, "date", "start") != nil {
+			log.Fatalf("Could not rename events.date to events.start.\n")
+		}
+	}
+
+	// Likes became reactions, counted as they change
+	migrateLikes := migrator.HasTable("likes") && !migrator.HasTable(&Reactions{})
+
 	// Create migration for all of our tables
 	for _, model := range tables {
 		log.Printf("Database Migration -> %T", model)
@@ -30,5 +72,40 @@ func Init() {
 			log.Fatalf("Could not complete database migration.\n")
 		}
 	}
+	// Events created before End existed last no time at all
+	database.GetDatabase().Model(&Event{}).Where("`end` IS NULL").Update("end", gorm.Expr("start"))
+
+	if migrateLikes {
+		migrateLikesToReactions(database.GetDatabase())
+	}
+
 	log.Printf("Database migration successful.\n")
 }
+
+// Turns each user's likes into like reactions, once per post, and counts
+// them
+func migrateLikesToReactions(db *gorm.DB) {
+	err := db.Transaction(func(tx *gorm.DB) error {
+		steps := []string{
+			"INSERT INTO reactions (created_at, updated_at, posts_id, users_id, handle, type) " +
+				"SELECT MIN(likes.created_at), MIN(likes.created_at), likes.posts_id, users.id, users.handle, 'like' " +
+				"FROM likes JOIN users ON users.handle = likes.handle " +
+				"WHERE likes.deleted_at IS NULL GROUP BY likes.posts_id, users.id, users.handle",
+			"INSERT INTO reaction_counts (posts_id, type, count) " +
+				"SELECT posts_id, type, COUNT(*) FROM reactions GROUP BY posts_id, type",
+			"UPDATE posts SET reaction_count = (SELECT COUNT(*) FROM reactions WHERE reactions.posts_id = posts.id)",
+		}
+
+		for _, step := range steps {
+			if err := tx.Exec(step).Error; err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		log.Fatalf("Could not turn likes into reactions.\n")
+	}
+}
@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// A private conversation, either between two users or between a user and an
+// organization, whose managers answer from its inbox
+type Conversations struct {
+	gorm.Model
+	// The lower id of the two users, or the user writing to the organization
+	UserOneID uint `gorm:"not null;index"`
+	// The other user, nil for conversations with an organization
+	UserTwoID *uint `gorm:"index"`
+	// The organization, nil for conversations between two users
+	OrganizationID *uint `gorm:"index"`
+	// Tells conversations apart, so each pair only has one
+	Key string `gorm:"size:64;not null;uniqueIndex" json:"-"`
+	// When the latest message was sent, or the conversation started
+	LastMessageAt time.Time `gorm:"not null;index"`
+
+	// Messages the user listing it has not read yet
+	Unread int64 `gorm:"-"`
+}
+
+// Whether the user takes part in the conversation as one of its users, not
+// as a manager of its organization
+func (c Conversations) HasUser(userId uint) bool {
+	return c.UserOneID == userId || (c.UserTwoID != nil && *c.UserTwoID == userId)
+}
+
+// A message in a conversation. It is for either a user or the inbox of an
+// organization.
+type Messages struct {
+	gorm.Model
+	ConversationsID uint `gorm:"not null;index"`
+	// Who wrote it, a manager for messages of the organization
+	SenderID uint `gorm:"not null"`
+	// Set when a manager wrote it for the organization
+	SenderOrganizationID *uint `json:",omitempty"`
+	// The user it is for, or the organization whose managers read it
+	RecipientID             *uint  `gorm:"index:idx_messages_recipient_read" json:",omitempty"`
+	RecipientOrganizationID *uint  `gorm:"index:idx_messages_organization_read" json:",omitempty"`
+	Body                    string `gorm:"not null"`
+	// When the recipient read it, nil until they have
+	ReadAt *time.Time `gorm:"index:idx_messages_recipient_read;index:idx_messages_organization_read"`
+}
+
+// A user stopping another from messaging them. Removed rows are deleted for
+// good so they can block again.
+type Blocks struct {
+	gorm.Model
+	BlockerID uint `gorm:"not null;uniqueIndex:idx_blocks_pair"`
+	BlockedID uint `gorm:"not null;uniqueIndex:idx_blocks_pair;index"`
+}
+
+// Position in a list of conversations, latest message first
+type ConversationCursor struct {
+	LastMessageAt time.Time `json:"t"`
+	ID            uint      `json:"i"`
+}
+
+// A page of conversations, latest message first
+type ConversationPage struct {
+	Conversations []Conversations `json:"conversations"`
+	NextCursor    string          `json:"nextCursor"`
+}
+
+// A page of a conversation's messages, latest first
+type MessagePage struct {
+	Messages   []Messages `json:"messages"`
+	NextCursor string     `json:"nextCursor"`
+}
+
+// Messages a user has not read: their own, and those in the inbox of each
+// organization they manage that has any
+type UnreadMessages struct {
+	Personal      int64          `json:"personal"`
+	Organizations map[uint]int64 `json:"organizations"`
+}
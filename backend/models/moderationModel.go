@@ -0,0 +1,137 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Why content was reported
+const (
+	ReportSpam       = "spam"
+	ReportHarassment = "harassment"
+	ReportHate       = "hate"
+	ReportViolence   = "violence"
+	ReportSexual     = "sexual"
+	ReportScam       = "scam"
+	// Needs details
+	ReportOther = "other"
+)
+
+var ReportReasons = []string{
+	ReportSpam,
+	ReportHarassment,
+	ReportHate,
+	ReportViolence,
+	ReportSexual,
+	ReportScam,
+	ReportOther,
+}
+
+func IsReportReason(reason string) bool {
+	for _, r := range ReportReasons {
+		if r == reason {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Whether posts and comments are shown. Visible content has none.
+const (
+	// Held by the content filter or after many reports, until a moderator
+	// looks at it
+	ModerationHeld = "held"
+	// Hidden by a moderator
+	ModerationHidden = "hidden"
+)
+
+// What moderators can do about a case
+const (
+	// Nothing was wrong. Held content is shown again.
+	ModerateDismiss = "dismiss"
+	// Hides the post or comment
+	ModerateHide = "hide"
+	// Deletes the post or comment
+	ModerateRemove = "remove"
+	// Tells whoever is responsible that they broke the guidelines
+	ModerateWarn = "warn"
+	// Keeps the user responsible from signing in for a number of days
+	ModerateSuspend = "suspend"
+)
+
+var ModerationActionTypes = []string{
+	ModerateDismiss,
+	ModerateHide,
+	ModerateRemove,
+	ModerateWarn,
+	ModerateSuspend,
+}
+
+func IsModerationAction(action string) bool {
+	for _, a := range ModerationActionTypes {
+		if a == action {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Statuses of moderation cases
+const (
+	CaseOpen   = "open"
+	CaseClosed = "closed"
+)
+
+// Everything reported about one post, comment, user, organization or event
+// until a moderator acts on it. Reports after that open a new case.
+type ModerationCases struct {
+	gorm.Model
+	SubjectType string `gorm:"size:32;not null;index:idx_moderation_cases_subject"`
+	SubjectID   uint   `gorm:"not null;index:idx_moderation_cases_subject"`
+	// CaseOpen or CaseClosed
+	Status      string `gorm:"size:16;not null;default:open;index"`
+	ReportCount uint   `gorm:"not null;default:0"`
+	// Why the content is held, empty if it isn't
+	HeldReason string
+	// The moderator's action that closed the case
+	Action   string     `gorm:"size:16"`
+	ClosedAt *time.Time `json:",omitempty"`
+
+	Reports []Reports           `json:",omitempty"`
+	Actions []ModerationActions `gorm:"foreignKey:ModerationCasesID" json:",omitempty"`
+	// The post, comment, user, organization or event, when shown to
+	// moderators
+	Subject any `gorm:"-" json:",omitempty"`
+}
+
+// A user's report, at most one per user and case
+type Reports struct {
+	gorm.Model
+	ModerationCasesID uint `gorm:"not null;uniqueIndex:idx_reports_case_reporter"`
+	ReporterID        uint `gorm:"not null;uniqueIndex:idx_reports_case_reporter"`
+	// One of ReportReasons
+	Reason  string `gorm:"size:16;not null"`
+	Details string `gorm:"size:1000"`
+}
+
+// What a moderator did about a case
+type ModerationActions struct {
+	gorm.Model
+	ModerationCasesID uint   `gorm:"not null;index"`
+	ModeratorID       uint   `gorm:"not null"`
+	Action            string `gorm:"size:16;not null"`
+	// The user warned or suspended, 0 for other actions
+	UsersID uint `gorm:"index"`
+	// Shown to the user it concerns
+	Note           string     `gorm:"size:1000"`
+	SuspendedUntil *time.Time `json:",omitempty"`
+}
+
+// A page of the moderation queue, oldest first
+type ModerationCasePage struct {
+	Cases      []ModerationCases `json:"cases"`
+	NextCursor string            `json:"nextCursor"`
+}
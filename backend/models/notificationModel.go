@@ -0,0 +1,125 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Notification types, each with its own channel preferences
+const (
+	NotifyFriendRequest  = "friend_request"
+	NotifyFriendAccepted = "friend_accepted"
+	NotifySignup         = "signup"
+	NotifyEventChanged   = "event_changed"
+	NotifyEventCancelled = "event_cancelled"
+	NotifyComment        = "comment"
+	NotifyReply          = "reply"
+	NotifyMention        = "mention"
+	NotifyReaction       = "reaction"
+	NotifyOrgInvite      = "org_invite"
+	NotifyAnnouncement   = "announcement"
+	NotifyReminder       = "reminder"
+	NotifyDigest         = "digest"
+	NotifyModeration     = "moderation"
+	NotifyMessage        = "message"
+	NotifyDiscussion     = "discussion"
+)
+
+// Every notification type, in the order preferences are listed
+var NotificationTypes = []string{
+	NotifyFriendRequest,
+	NotifyFriendAccepted,
+	NotifySignup,
+	NotifyEventChanged,
+	NotifyEventCancelled,
+	NotifyComment,
+	NotifyReply,
+	NotifyMention,
+	NotifyReaction,
+	NotifyOrgInvite,
+	NotifyAnnouncement,
+	NotifyReminder,
+	NotifyDigest,
+	NotifyModeration,
+	NotifyMessage,
+	NotifyDiscussion,
+}
+
+// Kinds of things notifications link to
+const (
+	SubjectEvent        = "event"
+	SubjectPost         = "post"
+	SubjectFriend       = "friend"
+	SubjectOrganization = "organization"
+	SubjectComment      = "comment"
+	SubjectUser         = "user"
+	SubjectConversation = "conversation"
+)
+
+// A notification in the inbox of a user
+type Notifications struct {
+	gorm.Model
+	UsersID uint   `gorm:"not null;index"`
+	Type    string `gorm:"size:32;not null"`
+	Title   string `gorm:"not null"`
+	Body    string
+	// What the notification is about, for the app to open
+	SubjectType string `gorm:"size:32"`
+	SubjectID   uint
+	// The user who caused it, 0 for the app itself
+	ActorID uint
+	ReadAt  *time.Time
+}
+
+// A page of a user's inbox, latest first
+type NotificationPage struct {
+	Notifications []Notifications `json:"notifications"`
+	Unread        int64           `json:"unread"`
+	NextCursor    string          `json:"nextCursor"`
+}
+
+// How a user wants to receive one type of notification. Types without a
+// saved preference use DefaultNotificationPreference.
+type NotificationPreferences struct {
+	gorm.Model
+	UsersID uint   `gorm:"not null;uniqueIndex:idx_notification_preference"`
+	Type    string `gorm:"size:32;not null;uniqueIndex:idx_notification_preference"`
+	InApp   bool   `gorm:"not null"`
+	Email   bool   `gorm:"not null"`
+	Push    bool   `gorm:"not null"`
+}
+
+// Everything shows in the inbox and goes to phones; only what happens to
+// events the user signed up for, and invites, are emailed too. The weekly
+// digest is only emailed.
+func DefaultNotificationPreference(userId uint, notificationType string) NotificationPreferences {
+	if notificationType == NotifyDigest {
+		return NotificationPreferences{UsersID: userId, Type: notificationType, Email: true}
+	}
+
+	email := false
+	switch notificationType {
+	case NotifyEventChanged, NotifyEventCancelled, NotifyOrgInvite, NotifyReminder:
+		email = true
+	}
+
+	return NotificationPreferences{
+		UsersID: userId,
+		Type:    notificationType,
+		InApp:   true,
+		Email:   email,
+		Push:    true,
+	}
+}
+
+// Whether notificationType is one of NotificationTypes
+func IsNotificationType(notificationType string) bool {
+	for _, t := range NotificationTypes {
+		if t == notificationType {
+			return true
+		}
+	}
+
+	return false
+}
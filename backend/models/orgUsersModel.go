@@ -2,6 +2,13 @@ package models
 
 import "gorm.io/gorm"
 
+// Organization roles, see OrgUsers.Role
+const (
+	RoleOwner   uint = 0
+	RoleManager uint = 1
+	RoleMember  uint = 10
+)
+
 type OrgUsers struct {
 	gorm.Model
 	UsersID        uint `gorm:"not null"`
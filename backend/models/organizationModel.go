@@ -6,6 +6,12 @@ type Organization struct {
 	gorm.Model
 	Name        string `gorm:"unique"`
 	Description string
-	Verified    bool
-	Interests   string
+	Address     string
+	// Geocoded from Address, nil when it could not be located
+	Latitude  *float64
+	Longitude *float64
+	Verified  bool
+	Interests string
+
+	Tags []Tags `gorm:"many2many:organization_tags"`
 }
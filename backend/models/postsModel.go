@@ -1,29 +1,133 @@
 package models
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
+// Kinds of posts
+const (
+	PostTypePost = "post"
+	// Only organizations post announcements, which notify their members and
+	// followers
+	PostTypeAnnouncement = "announcement"
+)
+
+// Who can see a post besides its author
+const (
+	// Everyone, signed in or not
+	AudiencePublic = "public"
+	// The author's friends, for posts of users only
+	AudienceFriends = "friends"
+	// Members of the organization posting it, for posts of organizations
+	// only
+	AudienceMembers = "members"
+	// Members of the organization in AudienceOrganizationID, for posts of
+	// users only
+	AudienceOrganization = "organization"
+)
+
+var PostAudiences = []string{AudiencePublic, AudienceFriends, AudienceMembers, AudienceOrganization}
+
 type Posts struct {
 	gorm.Model
+	// Who wrote it, a manager for posts of an organization
 	Handle          string `gorm:"NOT NULL"`
-	PostDescription string
-	Likes           uint `gorm:"default:0"`
+	PostDescription string `gorm:"index:idx_post_search,class:FULLTEXT"`
+	// How many users reacted to it, kept up to date with each reaction
+	ReactionCount uint `gorm:"not null;default:0"`
+	// Set on posts of an organization, whose managers moderate them
+	OrganizationID *uint `gorm:"index"`
+	// PostTypePost or PostTypeAnnouncement
+	Type string `gorm:"size:16;not null;default:post"`
+	// When it was pinned to the top of its organization's page, nil if not
+	PinnedAt *time.Time
+	// The event the post is about, if any
+	EventID *uint `gorm:"index"`
+	// ModerationHeld or ModerationHidden keep it from being shown
+	Moderation string `gorm:"size:16;not null;default:''" json:",omitempty"`
+	// Who can see it, AudiencePublic by default
+	Audience string `gorm:"size:16;not null;default:public;index"`
+	// Whose members can see it, for AudienceMembers and AudienceOrganization
+	AudienceOrganizationID *uint `gorm:"index" json:",omitempty"`
+}
+
+// Who is looking at posts, deciding which they see. The zero value is
+// someone signed out, who only sees public posts.
+type PostViewer struct {
+	Handle string
+	// Handles of their friends
+	FriendHandles []string
+	// Organizations they have a role in
+	OrganizationIDs []uint
+}
+
+// Whether the viewer may see the post. Lists are narrowed the same way in
+// the repository.
+func (v PostViewer) CanSee(post Posts) bool {
+	if post.Audience == AudiencePublic || post.Audience == "" {
+		return true
+	}
+
+	if v.Handle != "" && v.Handle == post.Handle {
+		return true
+	}
+
+	switch post.Audience {
+	case AudienceFriends:
+		for _, handle := range v.FriendHandles {
+			if handle == post.Handle {
+				return true
+			}
+		}
+	case AudienceMembers, AudienceOrganization:
+		for _, orgId := range v.OrganizationIDs {
+			if post.AudienceOrganizationID != nil && orgId == *post.AudienceOrganizationID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// A page of an organization's posts, latest first. The pinned posts come
+// before, on the first page only.
+type OrgPostPage struct {
+	Pinned     []Posts `json:"pinned"`
+	Posts      []Posts `json:"posts"`
+	NextCursor string  `json:"nextCursor"`
 }
 
 type Comments struct {
 	gorm.Model
-	PostsID            uint   `gorm:"NOT NULL"`
+	PostsID uint `gorm:"NOT NULL;index"`
+	// The comment this replies to and the top level comment of its thread,
+	// both nil for top level comments
+	ParentID           *uint  `gorm:"index"`
+	ThreadID           *uint  `gorm:"index"`
 	Handle             string `gorm:"NOT NULL"`
 	CommentDescription string `gorm:"NOT NULL"`
+	// When it was last edited, nil if never
+	EditedAt *time.Time
+	// ModerationHeld or ModerationHidden keep it from being shown
+	Moderation string `gorm:"size:16;not null;default:''" json:",omitempty"`
 
 	Posts Posts `gorm:"foreignkey:PostsID"`
+	// Replies, oldest first, when listed as a thread
+	Replies []Comments `gorm:"-"`
 }
 
-type Likes struct {
+// What a comment said before an edit
+type CommentEdits struct {
 	gorm.Model
-	PostsID uint   `gorm:"NOT NULL"`
-	Handle  string `gorm:"NOT NULL"`
+	CommentsID         uint   `gorm:"not null;index"`
+	CommentDescription string `gorm:"not null"`
+}
 
-	Posts Posts `gorm:"foreignkey:PostsID"`
+// A page of a post's comment threads, oldest first
+type CommentPage struct {
+	Comments   []Comments `json:"comments"`
+	NextCursor string     `json:"nextCursor"`
 }
@@ -0,0 +1,65 @@
+package models
+
+import (
+	"gorm.io/gorm"
+)
+
+// Ways to react to a post
+const (
+	ReactionLike      = "like"
+	ReactionLove      = "love"
+	ReactionCelebrate = "celebrate"
+	ReactionSupport   = "support"
+)
+
+// Every reaction, in the order the app shows them
+var ReactionTypes = []string{
+	ReactionLike,
+	ReactionLove,
+	ReactionCelebrate,
+	ReactionSupport,
+}
+
+func IsReaction(reactionType string) bool {
+	for _, t := range ReactionTypes {
+		if t == reactionType {
+			return true
+		}
+	}
+
+	return false
+}
+
+// How a user reacted to a post. Each user has at most one reaction per post,
+// removed rows are deleted for good so they can react again.
+type Reactions struct {
+	gorm.Model
+	PostsID uint   `gorm:"not null;uniqueIndex:idx_reactions_post_user"`
+	UsersID uint   `gorm:"not null;uniqueIndex:idx_reactions_post_user;index"`
+	Handle  string `gorm:"not null"`
+	// One of ReactionTypes
+	Type string `gorm:"size:16;not null"`
+}
+
+// How many users reacted to a post one way, kept up to date with each
+// reaction
+type ReactionCounts struct {
+	PostsID uint   `gorm:"primaryKey;autoIncrement:false"`
+	Type    string `gorm:"primaryKey;size:16"`
+	Count   uint   `gorm:"not null;default:0"`
+}
+
+// The reactions to a post, counted by type
+type ReactionSummary struct {
+	PostsID uint            `json:"postsId"`
+	Counts  map[string]uint `json:"counts"`
+	Total   uint            `json:"total"`
+	// The signed in user's reaction, empty when they have none
+	Mine string `json:"mine,omitempty"`
+}
+
+// A page of who reacted to a post, latest first
+type ReactionPage struct {
+	Reactions  []Reactions `json:"reactions"`
+	NextCursor string      `json:"nextCursor"`
+}
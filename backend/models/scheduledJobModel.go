@@ -0,0 +1,83 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Statuses of a scheduled job
+const (
+	JobPending = "pending"
+	JobRunning = "running"
+	JobDone    = "done"
+	JobFailed  = "failed"
+)
+
+// Types of scheduled jobs
+const (
+	// Schedules reminders for the sign-ups starting soon, then itself again
+	JobPlanReminders = "plan_reminders"
+	// Reminds a volunteer of one sign-up
+	JobReminder = "reminder"
+	// Schedules the weekly digest of every follower, then itself again
+	JobPlanDigests = "plan_digests"
+	// Emails one user the events new this week
+	JobDigest = "digest"
+	// Sends a push notification again to the devices it failed to reach
+	JobPush = "push"
+)
+
+// A job for the scheduler, kept in the database so restarts don't lose it.
+// Key identifies what the job does, scheduling the same work twice keeps
+// the first job. Instances claim due jobs by locking their rows, a job is
+// running until LockedUntil and can be claimed again after that if its
+// instance stopped.
+type ScheduledJobs struct {
+	gorm.Model
+	Key         string    `gorm:"size:191;not null;uniqueIndex"`
+	Type        string    `gorm:"size:32;not null"`
+	Payload     string    `gorm:"type:text"`
+	RunAt       time.Time `gorm:"not null;index:idx_job_due"`
+	Status      string    `gorm:"size:16;not null;default:pending;index:idx_job_due"`
+	Attempts    uint      `gorm:"not null;default:0"`
+	LockedBy    string    `gorm:"size:64"`
+	LockedUntil *time.Time
+	LastError   string `gorm:"type:text"`
+	DoneAt      *time.Time
+}
+
+// Payload of a reminder: the sign-up and when its occurrence, or shift,
+// started when the reminder was scheduled
+type ReminderJob struct {
+	EventID        uint
+	UsersID        uint
+	OccurrenceDate time.Time
+	ShiftID        uint
+	Start          time.Time
+	// How long before Start the reminder is sent
+	Lead time.Duration
+}
+
+// Payload of a digest: the user and the week of new events
+type DigestJob struct {
+	UsersID uint
+	Since   time.Time
+	Until   time.Time
+}
+
+// Payload of a push retry: the notification and the devices it has yet to
+// reach
+type PushJob struct {
+	Title   string
+	Body    string
+	Data    map[string]string
+	Devices []PushDevice
+	// How many times it was sent before
+	Attempt uint
+}
+
+type PushDevice struct {
+	Platform string
+	Token    string
+}
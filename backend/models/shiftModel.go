@@ -0,0 +1,42 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// A shift or role within an event, such as setup from 8 to 10 or cook.
+// Start and End are given for the event's first occurrence; later
+// occurrences of a recurring event repeat them at the same offset from
+// the occurrence's start.
+type EventShifts struct {
+	gorm.Model
+	EventID      uint `gorm:"not null;index"`
+	Role         string
+	Description  string
+	Requirements string
+	Start        time.Time
+	End          time.Time
+	// Maximum number of volunteers, 0 for no limit
+	Capacity int
+	// Skill tags a volunteer needs to sign up
+	Skills []Tags `gorm:"many2many:shift_skills"`
+}
+
+// A shift at one occurrence of its event
+type ShiftOccurrence struct {
+	Shift    EventShifts `json:"shift"`
+	Start    time.Time   `json:"start"`
+	End      time.Time   `json:"end"`
+	SignedUp int64       `json:"signedUp"`
+}
+
+// Returns the shift with its times converted to loc for display
+func (s ShiftOccurrence) In(loc *time.Location) ShiftOccurrence {
+	s.Shift.Start = s.Shift.Start.In(loc)
+	s.Shift.End = s.Shift.End.In(loc)
+	s.Start = s.Start.In(loc)
+	s.End = s.End.In(loc)
+	return s
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// A user signed up to volunteer at an event. Sign-ups to a recurring event
+// are for one occurrence, identified by its original start, and stay with
+// the series when the occurrence is edited. Sign-ups to an event with
+// shifts are for one shift, ShiftID is 0 otherwise. Withdrawing hard
+// deletes the row so the user can sign up again later.
+type EventSignups struct {
+	gorm.Model
+	EventID        uint      `gorm:"not null;uniqueIndex:idx_event_signup"`
+	UsersID        uint      `gorm:"not null;uniqueIndex:idx_event_signup"`
+	OccurrenceDate time.Time `gorm:"not null;uniqueIndex:idx_event_signup"`
+	ShiftID        uint      `gorm:"not null;default:0;uniqueIndex:idx_event_signup"`
+	// Secret the volunteer shows as a QR code to check in
+	CheckInToken string `gorm:"size:64;index" json:"-"`
+
+	Event Event `gorm:"foreignkey:EventID"`
+	Users Users `gorm:"foreignkey:UsersID"`
+}
+
+// Sign-ups are sent with only what anyone may see of their user
+func (s EventSignups) MarshalJSON() ([]byte, error) {
+	type signup EventSignups
+
+	out := struct {
+		signup
+		Users *PublicUser `json:",omitempty"`
+	}{signup: signup(s)}
+
+	if s.Users.ID != 0 {
+		user := s.Users.Public()
+		out.Users = &user
+	}
+
+	return json.Marshal(out)
+}
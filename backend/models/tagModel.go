@@ -0,0 +1,33 @@
+package models
+
+import "gorm.io/gorm"
+
+// Tag categories
+const (
+	TagInterest  = "interest"
+	TagSkill     = "skill"
+	TagCauseArea = "cause_area"
+	TagGoodFor   = "good_for"
+)
+
+// A curated tag. Users, organizations and events are linked to tags through
+// the users_tags, organization_tags and event_tags join tables.
+type Tags struct {
+	gorm.Model
+	Name     string `gorm:"not null;uniqueIndex:idx_tag_category_name"`
+	Category string `gorm:"not null;uniqueIndex:idx_tag_category_name"`
+}
+
+func ValidTagCategory(category string) bool {
+	switch category {
+	case TagInterest, TagSkill, TagCauseArea, TagGoodFor:
+		return true
+	}
+	return false
+}
+
+// Records one-time data migrations that have already been applied
+type SchemaMigrations struct {
+	gorm.Model
+	Name string `gorm:"unique;not null"`
+}
@@ -1,6 +1,9 @@
 package models
 
 import (
+	"errors"
+	"time"
+
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
@@ -17,6 +20,87 @@ type Users struct {
 	// profilePic mediumblob,
 	Interests string
 	Verified  uint
+	// Platform administrator, can curate tags
+	Admin bool `gorm:"default:false;not null"`
 	// Password forgotten reset code
 	ResetCode uuid.UUID
+	// Secret in the URL of the user's calendar feed
+	CalendarToken string `gorm:"size:64;index" json:"-"`
+	// Set by moderators, the user cannot sign in or post until then
+	SuspendedUntil *time.Time `json:"suspendedUntil,omitempty"`
+
+	Tags []Tags `gorm:"many2many:users_tags"`
+}
+
+// What anyone may see of a user, without their credentials, contact
+// details or birthdate
+type PublicUser struct {
+	ID        uint
+	Handle    string
+	FirstName string `json:"first"`
+	LastName  string `json:"last"`
+}
+
+func (u Users) Public() PublicUser {
+	return PublicUser{ID: u.ID, Handle: u.Handle, FirstName: u.FirstName, LastName: u.LastName}
+}
+
+// Volunteers younger than this need a guardian's consent to sign up
+const AdultAge = 18
+
+// Layouts birthdates are accepted in
+var birthdateLayouts = []string{"2006-01-02", "01/02/2006"}
+
+var (
+	ErrBirthdateFormat      = errors.New("birthdate must be a date like 2006-01-02 or 01/02/2006")
+	ErrBirthdateImplausible = errors.New("birthdate is not a plausible date of birth")
+)
+
+// Parses a birthdate in one of the accepted layouts. It must be in the
+// past and at most 130 years ago.
+func ParseBirthdate(birthdate string) (time.Time, error) {
+	for _, layout := range birthdateLayouts {
+		born, err := time.Parse(layout, birthdate)
+		if err != nil {
+			continue
+		}
+
+		if born.After(time.Now()) || born.Before(time.Now().AddDate(-130, 0, 0)) {
+			return time.Time{}, ErrBirthdateImplausible
+		}
+
+		return born, nil
+	}
+
+	return time.Time{}, ErrBirthdateFormat
+}
+
+// The user's age in whole years on the given day
+func (u Users) Age(on time.Time) (int, error) {
+	born, err := ParseBirthdate(u.Birthdate)
+	if err != nil {
+		return 0, errors.New("birthdate is missing or invalid")
+	}
+
+	age := on.Year() - born.Year()
+	if on.Month() < born.Month() || (on.Month() == born.Month() && on.Day() < born.Day()) {
+		age--
+	}
+
+	return age, nil
+}
+
+// Whether the user is suspended on the given day
+func (u Users) Suspended(on time.Time) bool {
+	return u.SuspendedUntil != nil && on.Before(*u.SuspendedUntil)
+}
+
+// Whether the user is younger than AdultAge on the given day
+func (u Users) IsMinor(on time.Time) (bool, error) {
+	age, err := u.Age(on)
+	if err != nil {
+		return false, err
+	}
+
+	return age < AdultAge, nil
 }
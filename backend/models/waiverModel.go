@@ -0,0 +1,76 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// A waiver volunteers accept before signing up, for every event of an
+// organization or, with EventID set, for one event or series. Editing the
+// text publishes a new Version that has to be accepted again.
+type Waivers struct {
+	gorm.Model
+	OrganizationID uint  `gorm:"not null;index"`
+	EventID        *uint `gorm:"index"`
+	Title          string
+	Body           string `gorm:"type:text"`
+	Version        uint   `gorm:"not null;default:1"`
+}
+
+// The text of a waiver at one of its versions, kept so acceptances can be
+// traced back to what was accepted
+type WaiverVersions struct {
+	gorm.Model
+	WaiverID uint `gorm:"not null;uniqueIndex:idx_waiver_version"`
+	Version  uint `gorm:"not null;uniqueIndex:idx_waiver_version"`
+	Title    string
+	Body     string `gorm:"type:text"`
+}
+
+// A user accepting a version of a waiver
+type WaiverAcceptances struct {
+	gorm.Model
+	WaiverID   uint      `gorm:"not null;uniqueIndex:idx_waiver_acceptance"`
+	UsersID    uint      `gorm:"not null;uniqueIndex:idx_waiver_acceptance"`
+	Version    uint      `gorm:"not null;uniqueIndex:idx_waiver_acceptance"`
+	AcceptedAt time.Time `gorm:"not null"`
+}
+
+// A background check of a user cleared by an organization, valid until
+// ExpiresAt when set
+type BackgroundChecks struct {
+	gorm.Model
+	UsersID        uint      `gorm:"not null;index"`
+	OrganizationID uint      `gorm:"not null;index"`
+	ClearedAt      time.Time `gorm:"not null"`
+	ExpiresAt      *time.Time
+	// The manager who recorded it
+	RecordedBy uint `gorm:"not null"`
+}
+
+// Whether a background check is valid at the given time
+func (b BackgroundChecks) ValidAt(at time.Time) bool {
+	return b.ExpiresAt == nil || at.Before(*b.ExpiresAt)
+}
+
+// A waiver that applies to an event and the latest version of it the user
+// accepted, 0 for none
+type WaiverRequirement struct {
+	Waiver          Waivers `json:"waiver"`
+	AcceptedVersion uint    `json:"acceptedVersion"`
+	Accepted        bool    `json:"accepted"`
+}
+
+// What a user needs to sign up to an event and which of it they meet
+type EventRequirements struct {
+	Waivers            []WaiverRequirement `json:"waivers"`
+	Birthdate          bool                `json:"birthdate"`
+	MinimumAge         uint                `json:"minimumAge"`
+	OldEnough          bool                `json:"oldEnough"`
+	Minor              bool                `json:"minor"`
+	GuardianConsent    bool                `json:"guardianConsent"`
+	BackgroundCheck    bool                `json:"backgroundCheck"`
+	HasBackgroundCheck bool                `json:"hasBackgroundCheck"`
+	Met                bool                `json:"met"`
+}
@@ -0,0 +1,162 @@
+package push
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultAPNsURL = "https://api.push.apple.com"
+	apnsSandboxURL = "https://api.sandbox.push.apple.com"
+	// Apple rejects provider tokens older than an hour
+	apnsTokenAge = 50 * time.Minute
+)
+
+// Sends straight to Apple Push Notification service, signed with a token
+// based key
+type apnsDispatcher struct {
+	baseURL string
+	key     *ecdsa.PrivateKey
+	keyId   string
+	teamId  string
+	// The app's bundle ID
+	topic  string
+	client *http.Client
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// Takes the .p8 key from the Apple developer account
+func NewAPNsDispatcher(key []byte, keyId string, teamId string, topic string, baseURL string) (Dispatcher, error) {
+	if baseURL == "" {
+		baseURL = defaultAPNsURL
+	}
+
+	if keyId == "" || teamId == "" || topic == "" {
+		return nil, errors.New("key ID, team ID and topic are required")
+	}
+
+	parsed, err := parsePrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	ecKey, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("key is not an ECDSA key")
+	}
+
+	return &apnsDispatcher{
+		baseURL: baseURL,
+		key:     ecKey,
+		keyId:   keyId,
+		teamId:  teamId,
+		topic:   topic,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// APNs takes one message per request, over a single HTTP/2 connection
+func (d *apnsDispatcher) Send(messages []Message) []error {
+	token, err := d.providerToken()
+	if err != nil {
+		return failAll(len(messages), err)
+	}
+
+	errs := make([]error, len(messages))
+	for i, message := range messages {
+		errs[i] = d.send(token, message)
+	}
+
+	return errs
+}
+
+func (d *apnsDispatcher) send(token string, message Message) error {
+	body := map[string]any{
+		"aps": map[string]any{
+			"alert": map[string]string{
+				"title": message.Title,
+				"body":  message.Body,
+			},
+			"sound": "default",
+		},
+	}
+	for key, value := range message.Data {
+		if key != "aps" {
+			body[key] = value
+		}
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", d.baseURL+"/3/device/"+message.Token, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+token)
+	req.Header.Set("apns-topic", d.topic)
+	req.Header.Set("apns-push-type", "alert")
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var result struct {
+		Reason string `json:"reason"`
+	}
+	_ = json.NewDecoder(res.Body).Decode(&result)
+
+	switch {
+	case res.StatusCode == http.StatusGone,
+		result.Reason == "BadDeviceToken",
+		result.Reason == "DeviceTokenNotForTopic":
+		return ErrInvalidToken
+	}
+
+	return fmt.Errorf("apns responded with status %d: %s", res.StatusCode, result.Reason)
+}
+
+// The signed provider token, reused until it gets close to expiring
+func (d *apnsDispatcher) providerToken() (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	if d.token != "" && now.Sub(d.issuedAt) < apnsTokenAge {
+		return d.token, nil
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"iss": d.teamId,
+		"iat": now.Unix(),
+	})
+	token.Header["kid"] = d.keyId
+
+	signed, err := token.SignedString(d.key)
+	if err != nil {
+		return "", err
+	}
+
+	d.token, d.issuedAt = signed, now
+
+	return d.token, nil
+}
@@ -0,0 +1,124 @@
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultExpoURL = "https://exp.host/--/api/v2/push/send"
+	// Most messages Expo takes in one request
+	expoBatch = 100
+)
+
+// Sends through the Expo push service, which passes them on to FCM or APNs
+type expoDispatcher struct {
+	url         string
+	accessToken string
+	client      *http.Client
+}
+
+func NewExpoDispatcher(url string, accessToken string) Dispatcher {
+	if url == "" {
+		url = defaultExpoURL
+	}
+
+	return expoDispatcher{
+		url:         url,
+		accessToken: accessToken,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+type expoMessage struct {
+	To    string            `json:"to"`
+	Title string            `json:"title"`
+	Body  string            `json:"body,omitempty"`
+	Data  map[string]string `json:"data,omitempty"`
+	Sound string            `json:"sound"`
+}
+
+type expoTicket struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+	Details struct {
+		Error string `json:"error"`
+	} `json:"details"`
+}
+
+func (d expoDispatcher) Send(messages []Message) []error {
+	errs := []error{}
+
+	for _, batch := range batches(messages, expoBatch) {
+		errs = append(errs, d.send(batch)...)
+	}
+
+	return errs
+}
+
+func (d expoDispatcher) send(messages []Message) []error {
+	body := []expoMessage{}
+	for _, message := range messages {
+		body = append(body, expoMessage{
+			To:    message.Token,
+			Title: message.Title,
+			Body:  message.Body,
+			Data:  message.Data,
+			Sound: "default",
+		})
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return failAll(len(messages), err)
+	}
+
+	req, err := http.NewRequest("POST", d.url, bytes.NewReader(data))
+	if err != nil {
+		return failAll(len(messages), err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	if d.accessToken != "" {
+		req.Header.Set("Authorization", "Bearer "+d.accessToken)
+	}
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return failAll(len(messages), err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return failAll(len(messages), fmt.Errorf("expo responded with status %d", res.StatusCode))
+	}
+
+	// One ticket per message, in order
+	var result struct {
+		Data []expoTicket `json:"data"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return failAll(len(messages), err)
+	}
+
+	if len(result.Data) != len(messages) {
+		return failAll(len(messages), errors.New("expo returned the wrong number of tickets"))
+	}
+
+	errs := make([]error, len(messages))
+	for i, ticket := range result.Data {
+		switch {
+		case ticket.Status == "ok":
+		case ticket.Details.Error == "DeviceNotRegistered":
+			errs[i] = ErrInvalidToken
+		default:
+			errs[i] = errors.New("expo: " + ticket.Message)
+		}
+	}
+
+	return errs
+}
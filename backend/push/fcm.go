@@ -0,0 +1,192 @@
+package push
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	defaultFCMURL = "https://fcm.googleapis.com"
+	fcmScope      = "https://www.googleapis.com/auth/firebase.messaging"
+)
+
+// Sends through Firebase Cloud Messaging's HTTP v1 API, signed in as a
+// service account
+type fcmDispatcher struct {
+	baseURL     string
+	projectId   string
+	clientEmail string
+	tokenURI    string
+	key         any
+	client      *http.Client
+
+	mu          sync.Mutex
+	accessToken string
+	expires     time.Time
+}
+
+// Takes the service account key file downloaded from the Firebase console
+func NewFCMDispatcher(credentials []byte, baseURL string) (Dispatcher, error) {
+	if baseURL == "" {
+		baseURL = defaultFCMURL
+	}
+
+	var account struct {
+		ProjectID   string `json:"project_id"`
+		PrivateKey  string `json:"private_key"`
+		ClientEmail string `json:"client_email"`
+		TokenURI    string `json:"token_uri"`
+	}
+	if err := json.Unmarshal(credentials, &account); err != nil {
+		return nil, err
+	}
+
+	if account.ProjectID == "" || account.ClientEmail == "" || account.TokenURI == "" {
+		return nil, errors.New("service account key is incomplete")
+	}
+
+	key, err := parsePrivateKey([]byte(account.PrivateKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return &fcmDispatcher{
+		baseURL:     baseURL,
+		projectId:   account.ProjectID,
+		clientEmail: account.ClientEmail,
+		tokenURI:    account.TokenURI,
+		key:         key,
+		client:      &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// FCM takes one message per request
+func (d *fcmDispatcher) Send(messages []Message) []error {
+	accessToken, err := d.token()
+	if err != nil {
+		return failAll(len(messages), err)
+	}
+
+	errs := make([]error, len(messages))
+	for i, message := range messages {
+		errs[i] = d.send(accessToken, message)
+	}
+
+	return errs
+}
+
+func (d *fcmDispatcher) send(accessToken string, message Message) error {
+	body := map[string]any{
+		"message": map[string]any{
+			"token": message.Token,
+			"notification": map[string]string{
+				"title": message.Title,
+				"body":  message.Body,
+			},
+			"data": message.Data,
+		},
+	}
+
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest("POST", d.baseURL+"/v1/projects/"+d.projectId+"/messages:send", bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	res, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var result struct {
+		Error struct {
+			Message string `json:"message"`
+			Details []struct {
+				ErrorCode string `json:"errorCode"`
+			} `json:"details"`
+		} `json:"error"`
+	}
+	_ = json.NewDecoder(res.Body).Decode(&result)
+
+	for _, detail := range result.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" || detail.ErrorCode == "INVALID_ARGUMENT" {
+			return ErrInvalidToken
+		}
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return ErrInvalidToken
+	}
+
+	return fmt.Errorf("fcm responded with status %d: %s", res.StatusCode, result.Error.Message)
+}
+
+// An OAuth access token for the service account, reused until shortly
+// before it expires
+func (d *fcmDispatcher) token() (string, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := time.Now()
+	if d.accessToken != "" && now.Before(d.expires) {
+		return d.accessToken, nil
+	}
+
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   d.clientEmail,
+		"scope": fcmScope,
+		"aud":   d.tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(d.key)
+	if err != nil {
+		return "", err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+	form.Set("assertion", assertion)
+
+	res, err := d.client.Post(d.tokenURI, "application/x-www-form-urlencoded", strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fcm sign in responded with status %d", res.StatusCode)
+	}
+
+	var result struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&result); err != nil {
+		return "", err
+	}
+
+	d.accessToken = result.AccessToken
+	d.expires = now.Add(time.Duration(result.ExpiresIn)*time.Second - time.Minute)
+
+	return d.accessToken, nil
+}
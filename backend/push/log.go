@@ -0,0 +1,20 @@
+package push
+
+import (
+	"log"
+)
+
+// Logs push notifications instead of sending them
+type logDispatcher struct{}
+
+func NewLogDispatcher() Dispatcher {
+	return logDispatcher{}
+}
+
+func (d logDispatcher) Send(messages []Message) []error {
+	for _, message := range messages {
+		log.Printf("[Push] %s push to %s: %s", message.Platform, message.Token, message.Title)
+	}
+
+	return make([]error, len(messages))
+}
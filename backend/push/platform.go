@@ -0,0 +1,42 @@
+package push
+
+import (
+	"errors"
+)
+
+// Hands each message to the dispatcher of its platform
+type platformDispatcher struct {
+	dispatchers map[string]Dispatcher
+}
+
+func NewPlatformDispatcher(dispatchers map[string]Dispatcher) Dispatcher {
+	return platformDispatcher{
+		dispatchers: dispatchers,
+	}
+}
+
+func (d platformDispatcher) Send(messages []Message) []error {
+	errs := make([]error, len(messages))
+
+	// Sends each platform's messages together, remembering where they were
+	byPlatform := map[string][]Message{}
+	positions := map[string][]int{}
+
+	for i, message := range messages {
+		if _, ok := d.dispatchers[message.Platform]; !ok {
+			errs[i] = errors.New("no dispatcher for platform " + message.Platform)
+			continue
+		}
+
+		byPlatform[message.Platform] = append(byPlatform[message.Platform], message)
+		positions[message.Platform] = append(positions[message.Platform], i)
+	}
+
+	for platform, platformMessages := range byPlatform {
+		for j, err := range d.dispatchers[platform].Send(platformMessages) {
+			errs[positions[platform][j]] = err
+		}
+	}
+
+	return errs
+}
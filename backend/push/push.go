@@ -0,0 +1,144 @@
+package push
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"log"
+	"os"
+)
+
+// Platforms devices register their tokens for
+const (
+	// Expo push tokens, ExponentPushToken[...]
+	Expo = "expo"
+	// Firebase Cloud Messaging registration tokens
+	FCM = "fcm"
+	// Apple Push Notification service device tokens
+	APNs = "apns"
+)
+
+var Platforms = []string{Expo, FCM, APNs}
+
+// The device uninstalled the app or the token expired, it should be
+// forgotten
+var ErrInvalidToken = errors.New("device token is no longer valid")
+
+type Message struct {
+	Platform string
+	Token    string
+	Title    string
+	Body     string
+	// Passed to the app along with the notification
+	Data map[string]string
+}
+
+// Sends push notifications to devices
+type Dispatcher interface {
+	// Sends the messages in as few requests as the provider allows. Returns
+	// an error for each message: nil once sent, ErrInvalidToken when its
+	// token should be forgotten, anything else may succeed when retried.
+	Send([]Message) []error
+}
+
+// Whether platform is one of Platforms
+func IsPlatform(platform string) bool {
+	for _, p := range Platforms {
+		if p == platform {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Picks a dispatcher for each platform from the environment.
+// EXPO_PUSH=true sends through Expo (with EXPO_ACCESS_TOKEN when the project
+// requires one), FCM_CREDENTIALS, the path of a service account key, sends
+// through FCM and APNS_KEY_FILE, the path of a .p8 key, sends through APNs
+// with APNS_KEY_ID, APNS_TEAM_ID, APNS_TOPIC and APNS_SANDBOX=true for
+// development builds. Pushes for other platforms are only logged, so
+// running locally never sends anything.
+func FromEnvironment() Dispatcher {
+	dispatchers := map[string]Dispatcher{}
+
+	for _, platform := range Platforms {
+		dispatchers[platform] = NewLogDispatcher()
+	}
+
+	if os.Getenv("EXPO_PUSH") == "true" {
+		log.Println("Sending Expo push notifications")
+		dispatchers[Expo] = NewExpoDispatcher("", os.Getenv("EXPO_ACCESS_TOKEN"))
+	}
+
+	if path := os.Getenv("FCM_CREDENTIALS"); path != "" {
+		credentials, err := os.ReadFile(path)
+		if err == nil {
+			var dispatcher Dispatcher
+			if dispatcher, err = NewFCMDispatcher(credentials, ""); err == nil {
+				log.Println("Sending FCM push notifications")
+				dispatchers[FCM] = dispatcher
+			}
+		}
+		if err != nil {
+			log.Println("FCM push disabled, could not load credentials:", err)
+		}
+	}
+
+	if path := os.Getenv("APNS_KEY_FILE"); path != "" {
+		key, err := os.ReadFile(path)
+		if err == nil {
+			baseURL := ""
+			if os.Getenv("APNS_SANDBOX") == "true" {
+				baseURL = apnsSandboxURL
+			}
+
+			var dispatcher Dispatcher
+			if dispatcher, err = NewAPNsDispatcher(key, os.Getenv("APNS_KEY_ID"), os.Getenv("APNS_TEAM_ID"), os.Getenv("APNS_TOPIC"), baseURL); err == nil {
+				log.Println("Sending APNs push notifications")
+				dispatchers[APNs] = dispatcher
+			}
+		}
+		if err != nil {
+			log.Println("APNs push disabled, could not load key:", err)
+		}
+	}
+
+	return NewPlatformDispatcher(dispatchers)
+}
+
+// Parses a PEM encoded PKCS #8 private key, as Apple and Google hand out
+func parsePrivateKey(data []byte) (any, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("key is not PEM encoded")
+	}
+
+	return x509.ParsePKCS8PrivateKey(block.Bytes)
+}
+
+// Splits messages into batches of at most size
+func batches(messages []Message, size int) [][]Message {
+	result := [][]Message{}
+
+	for len(messages) > size {
+		result = append(result, messages[:size])
+		messages = messages[size:]
+	}
+
+	if len(messages) > 0 {
+		result = append(result, messages)
+	}
+
+	return result
+}
+
+// The same error for each of count messages
+func failAll(count int, err error) []error {
+	errs := make([]error, count)
+	for i := range errs {
+		errs[i] = err
+	}
+
+	return errs
+}
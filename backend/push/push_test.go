@@ -0,0 +1,234 @@
+package push
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// A PEM encoded PKCS #8 key, as providers hand out
+func pemKey(t *testing.T, key any) []byte {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	assert.Nil(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})
+}
+
+func messages(platform string, count int) []Message {
+	result := []Message{}
+	for i := 0; i < count; i++ {
+		result = append(result, Message{Platform: platform, Token: fmt.Sprintf("token-%d", i), Title: "Park cleanup has changed"})
+	}
+
+	return result
+}
+
+func TestExpoDispatcher(t *testing.T) {
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "Bearer secret", r.Header.Get("Authorization"))
+
+		var body []expoMessage
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.LessOrEqual(t, len(body), expoBatch)
+
+		tickets := []string{}
+		for _, message := range body {
+			if message.To == "token-3" {
+				tickets = append(tickets, `{"status": "error", "message": "not registered", "details": {"error": "DeviceNotRegistered"}}`)
+			} else if message.To == "token-4" {
+				tickets = append(tickets, `{"status": "error", "message": "rate limited", "details": {"error": "MessageRateExceeded"}}`)
+			} else {
+				tickets = append(tickets, `{"status": "ok", "id": "abc"}`)
+			}
+		}
+		w.Write([]byte(`{"data": [` + strings.Join(tickets, ",") + `]}`))
+	}))
+	defer server.Close()
+
+	errs := NewExpoDispatcher(server.URL, "secret").Send(messages(Expo, 150))
+
+	// Two batches, the errors in order
+	assert.Equal(t, 2, requests)
+	assert.Len(t, errs, 150)
+	assert.Nil(t, errs[0])
+	assert.Equal(t, ErrInvalidToken, errs[3])
+	assert.EqualError(t, errs[4], "expo: rate limited")
+	assert.Nil(t, errs[149])
+}
+
+func TestExpoDispatcher_Unavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	errs := NewExpoDispatcher(server.URL, "").Send(messages(Expo, 2))
+
+	assert.EqualError(t, errs[0], "expo responded with status 503")
+	assert.EqualError(t, errs[1], "expo responded with status 503")
+}
+
+func TestFCMDispatcher(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	signIns := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			signIns++
+			assert.Nil(t, r.ParseForm())
+			assert.Equal(t, "urn:ietf:params:oauth:grant-type:jwt-bearer", r.Form.Get("grant_type"))
+			w.Write([]byte(`{"access_token": "access", "expires_in": 3600}`))
+			return
+		}
+
+		assert.Equal(t, "/v1/projects/volunteer-one/messages:send", r.URL.Path)
+		assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+
+		var body struct {
+			Message struct {
+				Token string `json:"token"`
+			} `json:"message"`
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		switch body.Message.Token {
+		case "token-1":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": {"code": 404, "details": [{"errorCode": "UNREGISTERED"}]}}`))
+		case "token-2":
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": {"code": 503, "message": "unavailable"}}`))
+		default:
+			w.Write([]byte(`{"name": "projects/volunteer-one/messages/1"}`))
+		}
+	}))
+	defer server.Close()
+
+	credentials, _ := json.Marshal(map[string]string{
+		"project_id":   "volunteer-one",
+		"client_email": "push@volunteer-one.iam.gserviceaccount.com",
+		"private_key":  string(pemKey(t, key)),
+		"token_uri":    server.URL + "/token",
+	})
+
+	dispatcher, err := NewFCMDispatcher(credentials, server.URL)
+	assert.Nil(t, err)
+
+	errs := dispatcher.Send(messages(FCM, 3))
+
+	assert.Nil(t, errs[0])
+	assert.Equal(t, ErrInvalidToken, errs[1])
+	assert.EqualError(t, errs[2], "fcm responded with status 503: unavailable")
+
+	// The access token is reused
+	dispatcher.Send(messages(FCM, 1))
+	assert.Equal(t, 1, signIns)
+}
+
+func TestFCMDispatcher_BadCredentials(t *testing.T) {
+	_, err := NewFCMDispatcher([]byte(`{"project_id": "volunteer-one"}`), "")
+
+	assert.EqualError(t, err, "service account key is incomplete")
+}
+
+func TestAPNsDispatcher(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.Nil(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "org.volunteerone.app", r.Header.Get("apns-topic"))
+		assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "bearer "))
+
+		var body map[string]any
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+		assert.Equal(t, "event", body["subjectType"])
+
+		switch r.URL.Path {
+		case "/3/device/token-1":
+			w.WriteHeader(http.StatusGone)
+			w.Write([]byte(`{"reason": "Unregistered"}`))
+		case "/3/device/token-2":
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"reason": "BadDeviceToken"}`))
+		case "/3/device/token-3":
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"reason": "TooManyRequests"}`))
+		}
+	}))
+	defer server.Close()
+
+	dispatcher, err := NewAPNsDispatcher(pemKey(t, key), "KEY123", "TEAM123", "org.volunteerone.app", server.URL)
+	assert.Nil(t, err)
+
+	sent := messages(APNs, 4)
+	for i := range sent {
+		sent[i].Data = map[string]string{"subjectType": "event"}
+	}
+
+	errs := dispatcher.Send(sent)
+
+	assert.Nil(t, errs[0])
+	assert.Equal(t, ErrInvalidToken, errs[1])
+	assert.Equal(t, ErrInvalidToken, errs[2])
+	assert.EqualError(t, errs[3], "apns responded with status 429: TooManyRequests")
+}
+
+func TestAPNsDispatcher_NotECDSA(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.Nil(t, err)
+
+	_, err = NewAPNsDispatcher(pemKey(t, key), "KEY123", "TEAM123", "org.volunteerone.app", "")
+
+	assert.EqualError(t, err, "key is not an ECDSA key")
+}
+
+func TestPlatformDispatcher(t *testing.T) {
+	expo, apns := NewRecorder(), NewRecorder()
+	apns.Invalid["b"] = true
+
+	dispatcher := NewPlatformDispatcher(map[string]Dispatcher{Expo: expo, APNs: apns})
+
+	errs := dispatcher.Send([]Message{
+		{Platform: APNs, Token: "a"},
+		{Platform: Expo, Token: "c"},
+		{Platform: APNs, Token: "b"},
+		{Platform: "pager", Token: "d"},
+	})
+
+	// Each platform gets its messages in one go, the errors stay in order
+	assert.Nil(t, errs[0])
+	assert.Nil(t, errs[1])
+	assert.Equal(t, ErrInvalidToken, errs[2])
+	assert.EqualError(t, errs[3], "no dispatcher for platform pager")
+	assert.Equal(t, 1, apns.Batches())
+	assert.Equal(t, []Message{{Platform: Expo, Token: "c"}}, expo.Sent())
+}
+
+func TestRecorder_Failing(t *testing.T) {
+	recorder := NewRecorder()
+	recorder.Failing["a"] = 1
+
+	errs := recorder.Send([]Message{{Token: "a"}})
+	assert.NotNil(t, errs[0])
+	assert.Empty(t, recorder.Sent())
+
+	errs = recorder.Send([]Message{{Token: "a"}})
+	assert.Nil(t, errs[0])
+	assert.Len(t, recorder.Sent(), 1)
+}
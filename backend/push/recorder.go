@@ -0,0 +1,69 @@
+package push
+
+import (
+	"errors"
+	"sync"
+)
+
+// Records pushes instead of sending them, for tests. Tokens in Invalid are
+// rejected as ErrInvalidToken and tokens in Failing fail that many times
+// before going through.
+type Recorder struct {
+	Invalid map[string]bool
+	Failing map[string]int
+
+	mu      sync.Mutex
+	batches [][]Message
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		Invalid: map[string]bool{},
+		Failing: map[string]int{},
+	}
+}
+
+func (r *Recorder) Send(messages []Message) []error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	errs := make([]error, len(messages))
+	sent := []Message{}
+
+	for i, message := range messages {
+		switch {
+		case r.Invalid[message.Token]:
+			errs[i] = ErrInvalidToken
+		case r.Failing[message.Token] > 0:
+			r.Failing[message.Token]--
+			errs[i] = errors.New("push provider unavailable")
+		default:
+			sent = append(sent, message)
+		}
+	}
+
+	r.batches = append(r.batches, sent)
+
+	return errs
+}
+
+// The messages that went through, in order
+func (r *Recorder) Sent() []Message {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	sent := []Message{}
+	for _, batch := range r.batches {
+		sent = append(sent, batch...)
+	}
+
+	return sent
+}
+
+// How many times Send was called
+func (r *Recorder) Batches() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return len(r.batches)
+}
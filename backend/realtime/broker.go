@@ -0,0 +1,42 @@
+package realtime
+
+import "sync"
+
+// Carries events between the hubs of every instance of the server. The
+// local broker only reaches this instance; one backed by Redis pub/sub,
+// NATS or similar lets several instances share subscribers.
+type Broker interface {
+	// Sends the event to the hubs of every instance, this one included
+	Publish(Event) error
+	// Hands every event published through the broker to deliver
+	Subscribe(deliver func(Event))
+}
+
+type localBroker struct {
+	mutex    sync.RWMutex
+	handlers []func(Event)
+}
+
+// Delivers events within this instance only, enough while a single one
+// serves the app
+func NewLocalBroker() Broker {
+	return &localBroker{}
+}
+
+func (b *localBroker) Publish(event Event) error {
+	b.mutex.RLock()
+	defer b.mutex.RUnlock()
+
+	for _, deliver := range b.handlers {
+		deliver(event)
+	}
+
+	return nil
+}
+
+func (b *localBroker) Subscribe(deliver func(Event)) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers = append(b.handlers, deliver)
+}
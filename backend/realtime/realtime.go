@@ -0,0 +1,140 @@
+package realtime
+
+import (
+	"encoding/json"
+	"log"
+	"strconv"
+	"sync"
+)
+
+// Events a subscriber has not read yet are held up to this many, newer
+// ones are dropped for slow subscribers
+const subscriptionBuffer = 32
+
+// Something that happened, sent to everyone subscribed to its topic
+type Event struct {
+	Topic string          `json:"topic"`
+	Type  string          `json:"type"`
+	Data  json.RawMessage `json:"data"`
+}
+
+// What happens to a user: their notifications and messages
+func UserTopic(userId uint) string {
+	return "user:" + strconv.FormatUint(uint64(userId), 10)
+}
+
+// What happens on a post: its comments and likes
+func PostTopic(postId uint) string {
+	return "post:" + strconv.FormatUint(uint64(postId), 10)
+}
+
+// What happens to the roster of an event (the series for recurring ones):
+// sign-ups, withdrawals and check-ins
+func EventTopic(eventId uint) string {
+	return "event:" + strconv.FormatUint(uint64(eventId), 10)
+}
+
+// A subscriber's interest in some topics
+type Subscription struct {
+	Topics []string
+	// Events published to the topics, closed once unsubscribed
+	Events chan Event
+}
+
+// Publishes events to the subscribers of their topics, on every instance
+// through its broker
+type Hub interface {
+	// Publishes data, sent as JSON, to the topic. Failures are only
+	// logged, publishing never fails what caused it.
+	Publish(topic string, eventType string, data any)
+	Subscribe(topics ...string) *Subscription
+	Unsubscribe(*Subscription)
+	// Whether anyone is subscribed to the topic on this instance. Those
+	// subscribed through other instances are not known of.
+	Subscribed(topic string) bool
+}
+
+type hub struct {
+	broker      Broker
+	mutex       sync.RWMutex
+	subscribers map[string]map[*Subscription]bool
+}
+
+// Instantiated in router.go
+func NewHub(b Broker) Hub {
+	h := &hub{
+		broker:      b,
+		subscribers: map[string]map[*Subscription]bool{},
+	}
+
+	b.Subscribe(h.deliver)
+
+	return h
+}
+
+func (h *hub) Publish(topic string, eventType string, data any) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		log.Println("[Hub] Could not encode event:", err)
+		return
+	}
+
+	if err := h.broker.Publish(Event{Topic: topic, Type: eventType, Data: encoded}); err != nil {
+		log.Println("[Hub] Could not publish event:", err)
+	}
+}
+
+func (h *hub) Subscribe(topics ...string) *Subscription {
+	subscription := &Subscription{
+		Topics: topics,
+		Events: make(chan Event, subscriptionBuffer),
+	}
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, topic := range topics {
+		if h.subscribers[topic] == nil {
+			h.subscribers[topic] = map[*Subscription]bool{}
+		}
+		h.subscribers[topic][subscription] = true
+	}
+
+	return subscription
+}
+
+func (h *hub) Unsubscribe(subscription *Subscription) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	for _, topic := range subscription.Topics {
+		delete(h.subscribers[topic], subscription)
+		if len(h.subscribers[topic]) == 0 {
+			delete(h.subscribers, topic)
+		}
+	}
+
+	close(subscription.Events)
+}
+
+func (h *hub) Subscribed(topic string) bool {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	return len(h.subscribers[topic]) > 0
+}
+
+// Hands an event from the broker to the subscribers of its topic on this
+// instance
+func (h *hub) deliver(event Event) {
+	h.mutex.RLock()
+	defer h.mutex.RUnlock()
+
+	for subscription := range h.subscribers[event.Topic] {
+		select {
+		case subscription.Events <- event:
+		default:
+			log.Println("[Hub] Subscriber is too slow, dropped event on", event.Topic)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package realtime
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHub(t *testing.T) {
+	h := NewHub(NewLocalBroker())
+
+	user := h.Subscribe(UserTopic(4))
+	viewer := h.Subscribe(PostTopic(9), EventTopic(1))
+
+	h.Publish(PostTopic(9), "comment", map[string]string{"text": "Great work"})
+	h.Publish(UserTopic(5), "notification", map[string]string{})
+
+	event := <-viewer.Events
+	assert.Equal(t, "post:9", event.Topic)
+	assert.Equal(t, "comment", event.Type)
+	assert.JSONEq(t, `{"text": "Great work"}`, string(event.Data))
+
+	assert.Len(t, user.Events, 0)
+	assert.Len(t, viewer.Events, 0)
+
+	h.Unsubscribe(viewer)
+	_, open := <-viewer.Events
+	assert.False(t, open)
+
+	// Nobody left to tell
+	h.Publish(PostTopic(9), "comment", nil)
+	h.Unsubscribe(user)
+}
+
+func TestHub_Subscribed(t *testing.T) {
+	h := NewHub(NewLocalBroker())
+	phone := h.Subscribe(UserTopic(4))
+	laptop := h.Subscribe(UserTopic(4))
+
+	assert.True(t, h.Subscribed(UserTopic(4)))
+	assert.False(t, h.Subscribed(UserTopic(5)))
+
+	// Until the last of their connections closes
+	h.Unsubscribe(phone)
+	assert.True(t, h.Subscribed(UserTopic(4)))
+
+	h.Unsubscribe(laptop)
+	assert.False(t, h.Subscribed(UserTopic(4)))
+}
+
+func TestHub_SlowSubscriber(t *testing.T) {
+	h := NewHub(NewLocalBroker())
+	subscription := h.Subscribe(UserTopic(4))
+
+	for i := 0; i < subscriptionBuffer+5; i++ {
+		h.Publish(UserTopic(4), "notification", i)
+	}
+
+	// The latest are dropped rather than blocking publishers
+	assert.Len(t, subscription.Events, subscriptionBuffer)
+	first := <-subscription.Events
+	assert.Equal(t, json.RawMessage("0"), first.Data)
+}
+
+// A broker that cannot be reached when err is set
+type failingBroker struct {
+	Broker
+	err error
+}
+
+func (b failingBroker) Publish(event Event) error {
+	if b.err != nil {
+		return b.err
+	}
+	return b.Broker.Publish(event)
+}
+
+func TestHub_SharedBroker(t *testing.T) {
+	// Hubs of two instances
+	broker := NewLocalBroker()
+	one := NewHub(broker)
+	other := NewHub(broker)
+
+	subscription := other.Subscribe(EventTopic(1))
+	one.Publish(EventTopic(1), "signup", map[string]uint{"UsersID": 4})
+
+	event := <-subscription.Events
+	assert.Equal(t, "signup", event.Type)
+
+	// Failures are only logged
+	failing := NewHub(failingBroker{Broker: NewLocalBroker(), err: errors.New("down")})
+	failing.Publish(EventTopic(1), "signup", nil)
+}
@@ -0,0 +1,326 @@
+// Package recurrence implements the subset of RFC 5545 recurrence rules
+// (RRULE) used for repeating events:
+//
+//	FREQ       DAILY, WEEKLY, MONTHLY or YEARLY
+//	INTERVAL   every n days/weeks/months/years
+//	COUNT      number of occurrences, including the first
+//	UNTIL      last possible occurrence, YYYYMMDD or YYYYMMDDTHHMMSSZ
+//	BYDAY      weekdays of a WEEKLY rule, e.g. MO,WE (no ordinals)
+//	BYMONTHDAY days of the month of a MONTHLY rule, 1 to 31
+//
+// e.g. "FREQ=WEEKLY;BYDAY=SA;COUNT=10". As in RFC 5545 the start of the
+// series always counts as its first occurrence.
+package recurrence
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	Daily   = "DAILY"
+	Weekly  = "WEEKLY"
+	Monthly = "MONTHLY"
+	Yearly  = "YEARLY"
+)
+
+// Stops rules without an end from being expanded forever
+const maxPeriods = 100000
+
+var weekdays = map[string]time.Weekday{
+	"SU": time.Sunday,
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+}
+
+type Rule struct {
+	Freq       string
+	Interval   int
+	Count      int
+	Until      time.Time
+	ByDay      []time.Weekday
+	ByMonthDay []int
+}
+
+// Parses a rule such as "FREQ=WEEKLY;BYDAY=SA". An "RRULE:" prefix is
+// accepted.
+func Parse(rule string) (Rule, error) {
+	r := Rule{Interval: 1}
+
+	rule = strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(rule)), "RRULE:")
+
+	for _, part := range strings.Split(rule, ";") {
+		if part == "" {
+			continue
+		}
+
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return Rule{}, fmt.Errorf("invalid recurrence rule part %q", part)
+		}
+
+		var err error
+
+		switch key {
+		case "FREQ":
+			switch value {
+			case Daily, Weekly, Monthly, Yearly:
+				r.Freq = value
+			default:
+				return Rule{}, fmt.Errorf("unsupported recurrence frequency %q", value)
+			}
+		case "INTERVAL":
+			if r.Interval, err = strconv.Atoi(value); err != nil || r.Interval < 1 {
+				return Rule{}, errors.New("INTERVAL must be a positive integer")
+			}
+		case "COUNT":
+			if r.Count, err = strconv.Atoi(value); err != nil || r.Count < 1 {
+				return Rule{}, errors.New("COUNT must be a positive integer")
+			}
+		case "UNTIL":
+			if r.Until, err = parseUntil(value); err != nil {
+				return Rule{}, errors.New("UNTIL must be YYYYMMDD or YYYYMMDDTHHMMSSZ")
+			}
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := weekdays[day]
+				if !ok {
+					return Rule{}, fmt.Errorf("unsupported BYDAY value %q", day)
+				}
+				r.ByDay = append(r.ByDay, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(day)
+				if err != nil || monthDay < 1 || monthDay > 31 {
+					return Rule{}, fmt.Errorf("unsupported BYMONTHDAY value %q", day)
+				}
+				r.ByMonthDay = append(r.ByMonthDay, monthDay)
+			}
+		default:
+			return Rule{}, fmt.Errorf("unsupported recurrence rule part %q", key)
+		}
+	}
+
+	if r.Freq == "" {
+		return Rule{}, errors.New("recurrence rule must have a FREQ")
+	}
+	if r.Count > 0 && !r.Until.IsZero() {
+		return Rule{}, errors.New("recurrence rule cannot have both COUNT and UNTIL")
+	}
+	if len(r.ByDay) > 0 && r.Freq != Weekly {
+		return Rule{}, errors.New("BYDAY is only supported with FREQ=WEEKLY")
+	}
+	if len(r.ByMonthDay) > 0 && r.Freq != Monthly {
+		return Rule{}, errors.New("BYMONTHDAY is only supported with FREQ=MONTHLY")
+	}
+
+	// Monday first, matching the default week start (WKST=MO)
+	sort.Slice(r.ByDay, func(i, j int) bool {
+		return mondayOffset(r.ByDay[i]) < mondayOffset(r.ByDay[j])
+	})
+	sort.Ints(r.ByMonthDay)
+
+	return r, nil
+}
+
+// A date-only UNTIL includes the whole day
+func parseUntil(value string) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse("20060102", value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return t.Add(24*time.Hour - time.Second), nil
+}
+
+// Formats the rule back into RRULE syntax
+func (r Rule) String() string {
+	parts := []string{"FREQ=" + r.Freq}
+
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.ByDay) > 0 {
+		days := []string{}
+		for _, weekday := range r.ByDay {
+			for name, day := range weekdays {
+				if day == weekday {
+					days = append(days, name)
+				}
+			}
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if len(r.ByMonthDay) > 0 {
+		days := []string{}
+		for _, day := range r.ByMonthDay {
+			days = append(days, strconv.Itoa(day))
+		}
+		parts = append(parts, "BYMONTHDAY="+strings.Join(days, ","))
+	}
+	if r.Count > 0 {
+		parts = append(parts, "COUNT="+strconv.Itoa(r.Count))
+	}
+	if !r.Until.IsZero() {
+		parts = append(parts, "UNTIL="+r.Until.UTC().Format("20060102T150405Z"))
+	}
+
+	return strings.Join(parts, ";")
+}
+
+// Occurrences of a series starting at start that fall within [from, to]
+func (r Rule) Between(start time.Time, from time.Time, to time.Time) []time.Time {
+	occurrences := []time.Time{}
+
+	r.each(start, func(t time.Time) bool {
+		if t.After(to) {
+			return false
+		}
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+		return true
+	})
+
+	return occurrences
+}
+
+// Whether t is an occurrence of a series starting at start
+func (r Rule) Includes(start time.Time, t time.Time) bool {
+	return len(r.Between(start, t, t)) == 1
+}
+
+// The last occurrence of a series starting at start. False when the rule
+// repeats forever.
+func (r Rule) Last(start time.Time) (time.Time, bool) {
+	if r.Count == 0 && r.Until.IsZero() {
+		return time.Time{}, false
+	}
+
+	last := start
+	r.each(start, func(t time.Time) bool {
+		last = t
+		return true
+	})
+
+	return last, true
+}
+
+// Number of occurrences of a series starting at start before t
+func (r Rule) CountBefore(start time.Time, t time.Time) int {
+	count := 0
+
+	r.each(start, func(occurrence time.Time) bool {
+		if !occurrence.Before(t) {
+			return false
+		}
+		count++
+		return true
+	})
+
+	return count
+}
+
+// Calls fn with every occurrence in order until it returns false or the
+// series ends
+func (r Rule) each(start time.Time, fn func(time.Time) bool) {
+	emitted := 0
+
+	emit := func(t time.Time) bool {
+		if !r.Until.IsZero() && t.After(r.Until) {
+			return false
+		}
+		if r.Count > 0 && emitted >= r.Count {
+			return false
+		}
+		emitted++
+		return fn(t)
+	}
+
+	if !emit(start) {
+		return
+	}
+
+	for period := 0; period < maxPeriods; period++ {
+		for _, t := range r.period(start, period) {
+			if !t.After(start) {
+				continue
+			}
+			if !emit(t) {
+				return
+			}
+		}
+	}
+}
+
+// Candidate occurrences in the nth day/week/month/year of the series, in
+// order. Invalid dates such as February 30th are skipped.
+func (r Rule) period(start time.Time, n int) []time.Time {
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	n *= interval
+
+	switch r.Freq {
+	case Daily:
+		return []time.Time{start.AddDate(0, 0, n)}
+	case Weekly:
+		if len(r.ByDay) == 0 {
+			return []time.Time{start.AddDate(0, 0, 7*n)}
+		}
+
+		monday := start.AddDate(0, 0, 7*n-mondayOffset(start.Weekday()))
+		times := []time.Time{}
+		for _, weekday := range r.ByDay {
+			times = append(times, monday.AddDate(0, 0, mondayOffset(weekday)))
+		}
+		return times
+	case Monthly:
+		days := r.ByMonthDay
+		if len(days) == 0 {
+			days = []int{start.Day()}
+		}
+
+		month := time.Date(start.Year(), start.Month()+time.Month(n), 1, 0, 0, 0, 0, start.Location()).Month()
+		times := []time.Time{}
+		for _, day := range days {
+			t := onDay(start, start.Year(), start.Month()+time.Month(n), day)
+			if t.Month() == month {
+				times = append(times, t)
+			}
+		}
+		return times
+	case Yearly:
+		t := onDay(start, start.Year()+n, start.Month(), start.Day())
+		if t.Month() != start.Month() {
+			return []time.Time{}
+		}
+		return []time.Time{t}
+	}
+
+	return []time.Time{}
+}
+
+// The given day at the clock time of start
+func onDay(start time.Time, year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, start.Hour(), start.Minute(), start.Second(), start.Nanosecond(), start.Location())
+}
+
+// Days since the Monday of the week
+func mondayOffset(weekday time.Weekday) int {
+	return (int(weekday) + 6) % 7
+}
@@ -0,0 +1,83 @@
+package recurrence
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Saturday
+var start = time.Date(2023, 4, 1, 9, 0, 0, 0, time.UTC)
+
+func day(month time.Month, d int) time.Time {
+	return time.Date(2023, month, d, 9, 0, 0, 0, time.UTC)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	for _, rule := range []string{
+		"",
+		"INTERVAL=2",
+		"FREQ=HOURLY",
+		"FREQ=DAILY;INTERVAL=0",
+		"FREQ=DAILY;COUNT=3;UNTIL=20230501",
+		"FREQ=DAILY;BYDAY=MO",
+		"FREQ=WEEKLY;BYDAY=1MO",
+		"FREQ=MONTHLY;BYMONTHDAY=32",
+		"FREQ=WEEKLY;BYSETPOS=1",
+	} {
+		_, err := Parse(rule)
+		assert.NotNil(t, err, rule)
+	}
+}
+
+func TestParse_String(t *testing.T) {
+	r, err := Parse("rrule:freq=weekly;byday=sa,mo;interval=2;until=20230531")
+
+	assert.Nil(t, err)
+	assert.Equal(t, "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,SA;UNTIL=20230531T235959Z", r.String())
+}
+
+func TestBetween_Weekly(t *testing.T) {
+	r, _ := Parse("FREQ=WEEKLY;BYDAY=SA,SU;COUNT=5")
+
+	assert.Equal(t, []time.Time{
+		day(4, 1), day(4, 2), day(4, 8), day(4, 9), day(4, 15),
+	}, r.Between(start, start, day(12, 31)))
+}
+
+func TestBetween_WindowAndInterval(t *testing.T) {
+	r, _ := Parse("FREQ=DAILY;INTERVAL=3")
+
+	assert.Equal(t, []time.Time{day(4, 7), day(4, 10)}, r.Between(start, day(4, 5), day(4, 10)))
+}
+
+func TestBetween_MonthlySkipsMissingDays(t *testing.T) {
+	r, _ := Parse("FREQ=MONTHLY;BYMONTHDAY=31;UNTIL=20230731")
+	jan := time.Date(2023, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, []time.Time{
+		jan, day(3, 31), day(5, 31), day(7, 31),
+	}, r.Between(jan, jan, day(12, 31)))
+}
+
+func TestIncludes(t *testing.T) {
+	r, _ := Parse("FREQ=WEEKLY")
+
+	assert.True(t, r.Includes(start, day(4, 29)))
+	assert.False(t, r.Includes(start, day(4, 28)))
+	assert.False(t, r.Includes(start, day(4, 29).Add(time.Hour)))
+}
+
+func TestLastAndCountBefore(t *testing.T) {
+	r, _ := Parse("FREQ=WEEKLY;COUNT=4")
+
+	last, ok := r.Last(start)
+	assert.True(t, ok)
+	assert.Equal(t, day(4, 22), last)
+	assert.Equal(t, 2, r.CountBefore(start, day(4, 15)))
+
+	forever, _ := Parse("FREQ=DAILY")
+	_, ok = forever.Last(start)
+	assert.False(t, ok)
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type AttendanceRepository interface {
+	CreateAttendance(models.EventAttendance, bool) (models.EventAttendance, error)
+	FindAttendance(uint, uint, time.Time, uint) (models.EventAttendance, error)
+	GetAttendance(uint, time.Time) ([]models.EventAttendance, error)
+	GetHours(uint, string) ([]models.VolunteerHours, error)
+	FindHours(uint) (models.VolunteerHours, error)
+	UpdateHours(models.VolunteerHours) (models.VolunteerHours, error)
+}
+
+type attendanceRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewAttendanceRepository(db *gorm.DB) AttendanceRepository {
+	return attendanceRepository{
+		DB: db,
+	}
+}
+
+// Records the check-in and, when startHours is set, starts tracking the
+// volunteer's hours from it
+func (r attendanceRepository) CreateAttendance(attendance models.EventAttendance, startHours bool) (models.EventAttendance, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&attendance).Error; err != nil {
+			return err
+		}
+
+		if !startHours {
+			return nil
+		}
+
+		return tx.Create(&models.VolunteerHours{
+			UsersID:        attendance.UsersID,
+			EventID:        attendance.EventID,
+			OccurrenceDate: attendance.OccurrenceDate,
+			ShiftID:        attendance.ShiftID,
+			Start:          attendance.CheckedInAt,
+			Status:         models.HoursTracking,
+		}).Error
+	})
+
+	if err != nil {
+		return models.EventAttendance{}, errors.New("could not check in")
+	}
+
+	return attendance, nil
+}
+
+// Finds the check-in of a user at an occurrence of an event, or of a shift
+func (r attendanceRepository) FindAttendance(eventId uint, userId uint, occurrence time.Time, shiftId uint) (models.EventAttendance, error) {
+	var attendance models.EventAttendance
+
+	err := r.DB.Where("event_id = ? AND users_id = ? AND occurrence_date = ? AND shift_id = ?",
+		eventId, userId, occurrence, shiftId).
+		First(&attendance).Error
+
+	return attendance, err
+}
+
+// Lists the check-ins at an occurrence of an event, earliest first
+func (r attendanceRepository) GetAttendance(eventId uint, occurrence time.Time) ([]models.EventAttendance, error) {
+	var attendance []models.EventAttendance
+
+	result := r.DB.Where("event_id = ? AND occurrence_date = ?", eventId, occurrence).
+		Order("checked_in_at, id").
+		Find(&attendance)
+
+	if result.Error != nil {
+		return []models.EventAttendance{}, errors.New("could not get attendance")
+	}
+
+	return attendance, nil
+}
+
+// Lists the hours volunteered at any occurrence of an event along with
+// their volunteer, only those in status unless it is empty
+func (r attendanceRepository) GetHours(eventId uint, status string) ([]models.VolunteerHours, error) {
+	var hours []models.VolunteerHours
+
+	query := r.DB.Preload("Users").Where("event_id = ?", eventId)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	result := query.Order("occurrence_date, start, id").Find(&hours)
+
+	if result.Error != nil {
+		return []models.VolunteerHours{}, errors.New("could not get hours")
+	}
+
+	return hours, nil
+}
+
+// Finds hours by their id
+func (r attendanceRepository) FindHours(id uint) (models.VolunteerHours, error) {
+	var hours models.VolunteerHours
+
+	err := r.DB.First(&hours, id).Error
+
+	return hours, err
+}
+
+// Saves changed hours
+func (r attendanceRepository) UpdateHours(hours models.VolunteerHours) (models.VolunteerHours, error) {
+	if err := r.DB.Save(&hours).Error; err != nil {
+		return models.VolunteerHours{}, errors.New("could not update hours")
+	}
+
+	return hours, nil
+}
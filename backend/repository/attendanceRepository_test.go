@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type AttendanceRepositoryUnitTestSuite struct {
+	suite.Suite
+	db         *sql.DB
+	mock       sqlmock.Sqlmock
+	err        error
+	gormDB     *gorm.DB
+	repo       AttendanceRepository
+	attendance models.EventAttendance
+}
+
+func (suite *AttendanceRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewAttendanceRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+
+	occurrence := time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	suite.attendance = models.EventAttendance{
+		EventID:        1,
+		UsersID:        4,
+		OccurrenceDate: occurrence,
+		CheckedInAt:    occurrence.Add(-10 * time.Minute),
+		CheckedInBy:    2,
+	}
+}
+
+func (suite *AttendanceRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestAttendanceRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceRepositoryUnitTestSuite))
+}
+
+func (suite *AttendanceRepositoryUnitTestSuite) TestAttendanceRepository_CreateAttendance_StartsHours() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `event_attendances`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `volunteer_hours`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(4), uint(1), suite.attendance.OccurrenceDate,
+			uint(0), suite.attendance.CheckedInAt, nil, models.HoursTracking, uint(0), nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.CreateAttendance(suite.attendance, true)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), res.ID)
+}
+
+func (suite *AttendanceRepositoryUnitTestSuite) TestAttendanceRepository_CreateAttendance_WithoutHours() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `event_attendances`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	_, err := suite.repo.CreateAttendance(suite.attendance, false)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *AttendanceRepositoryUnitTestSuite) TestAttendanceRepository_CreateAttendance_Duplicate() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `event_attendances`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.CreateAttendance(suite.attendance, true)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *AttendanceRepositoryUnitTestSuite) TestAttendanceRepository_GetAttendance() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `event_attendances` WHERE (event_id = ? AND occurrence_date = ?) AND `event_attendances`.`deleted_at` IS NULL ORDER BY checked_in_at, id")).
+		WithArgs(1, suite.attendance.OccurrenceDate).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id"}).AddRow(1, 4))
+
+	res, err := suite.repo.GetAttendance(1, suite.attendance.OccurrenceDate)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+}
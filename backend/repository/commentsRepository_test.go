@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type CommentsRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   CommentsRepository
+}
+
+func (suite *CommentsRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewCommentsRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *CommentsRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestCommentsRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentsRepositoryUnitTestSuite))
+}
+
+func (suite *CommentsRepositoryUnitTestSuite) TestCommentsRepository_GetThreads() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `comments` WHERE (posts_id = ? AND parent_id IS NULL) AND (deleted_at IS NULL OR EXISTS (SELECT 1 FROM comments AS replies WHERE replies.thread_id = comments.id AND replies.deleted_at IS NULL)) AND id > ? ORDER BY id LIMIT 21")).
+		WithArgs(3, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "posts_id"}).AddRow(11, 3))
+
+	res, err := suite.repo.GetThreads(3, 10, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+}
+
+func (suite *CommentsRepositoryUnitTestSuite) TestCommentsRepository_GetReplies() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `comments` WHERE thread_id IN (?,?) ORDER BY id")).
+		WithArgs(1, 2).
+		WillReturnError(suite.err)
+
+	_, err := suite.repo.GetReplies([]uint{1, 2})
+
+	assert.EqualError(suite.T(), err, "could not retrive comments")
+}
+
+func (suite *CommentsRepositoryUnitTestSuite) TestCommentsRepository_EditComment() {
+	defer suite.db.Close()
+
+	comment := models.Comments{PostsID: 3, Handle: "ada", CommentDescription: "hello again"}
+	comment.ID = 10
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `comment_edits`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, 10, "hello").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `comments`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.EditComment(comment, "hello")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "hello again", res.CommentDescription)
+}
+
+func (suite *CommentsRepositoryUnitTestSuite) TestCommentsRepository_EditComment_RollsBack() {
+	defer suite.db.Close()
+
+	comment := models.Comments{CommentDescription: "hello again"}
+	comment.ID = 10
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `comment_edits`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.EditComment(comment, "hello")
+
+	assert.EqualError(suite.T(), err, "could not update comment")
+}
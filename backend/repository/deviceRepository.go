@@ -0,0 +1,96 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type DeviceRepository interface {
+	SaveDevice(models.DeviceTokens) (models.DeviceTokens, error)
+	GetDevices(uint) ([]models.DeviceTokens, error)
+	DeleteDevice(uint, uint) error
+	DeleteTokens([]string) (int64, error)
+}
+
+type deviceRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewDeviceRepository(db *gorm.DB) DeviceRepository {
+	return deviceRepository{
+		DB: db,
+	}
+}
+
+// Registers the token, moving it to the user and session given when the
+// device registered it before, e.g. after switching accounts
+func (r deviceRepository) SaveDevice(device models.DeviceTokens) (models.DeviceTokens, error) {
+	result := r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "token"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "users_id", "delegations_id", "platform"}),
+	}).Create(&device)
+
+	if result.Error != nil {
+		return models.DeviceTokens{}, errors.New("could not register device")
+	}
+
+	// The ID of the existing row isn't returned when it was updated
+	var saved models.DeviceTokens
+	if err := r.DB.Where("token = ?", device.Token).First(&saved).Error; err != nil {
+		return models.DeviceTokens{}, errors.New("could not register device")
+	}
+
+	return saved, nil
+}
+
+// Lists the user's devices that are still signed in
+func (r deviceRepository) GetDevices(userId uint) ([]models.DeviceTokens, error) {
+	var devices []models.DeviceTokens
+
+	result := r.DB.
+		Joins("JOIN delegations ON delegations.id = device_tokens.delegations_id AND delegations.users_id = device_tokens.users_id").
+		Where("device_tokens.users_id = ?", userId).
+		Order("device_tokens.id").
+		Find(&devices)
+
+	if result.Error != nil {
+		return []models.DeviceTokens{}, errors.New("could not get devices")
+	}
+
+	return devices, nil
+}
+
+// Hard deletes the user's device so that its token can be registered again
+func (r deviceRepository) DeleteDevice(userId uint, id uint) error {
+	result := r.DB.Unscoped().Where("users_id = ?", userId).Delete(&models.DeviceTokens{}, id)
+
+	if result.Error != nil {
+		return errors.New("delete failed")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("device not found")
+	}
+
+	return nil
+}
+
+// Forgets tokens the push providers no longer accept. Returns how many
+// were removed.
+func (r deviceRepository) DeleteTokens(tokens []string) (int64, error) {
+	if len(tokens) == 0 {
+		return 0, nil
+	}
+
+	result := r.DB.Unscoped().Where("token IN ?", tokens).Delete(&models.DeviceTokens{})
+
+	if result.Error != nil {
+		return 0, errors.New("delete failed")
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type DeviceRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   DeviceRepository
+}
+
+func (suite *DeviceRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewDeviceRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *DeviceRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestDeviceRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(DeviceRepositoryUnitTestSuite))
+}
+
+func (suite *DeviceRepositoryUnitTestSuite) TestDeviceRepository_SaveDevice() {
+	defer suite.db.Close()
+
+	// The token moves to the user signing in on the device
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"ON DUPLICATE KEY UPDATE `updated_at`=VALUES(`updated_at`),`users_id`=VALUES(`users_id`),`delegations_id`=VALUES(`delegations_id`),`platform`=VALUES(`platform`)")).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `device_tokens` WHERE token = ? AND `device_tokens`.`deleted_at` IS NULL ORDER BY `device_tokens`.`id` LIMIT 1")).
+		WithArgs("abc").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id", "delegations_id", "token"}).AddRow(3, 4, 2, "abc"))
+
+	res, err := suite.repo.SaveDevice(models.DeviceTokens{UsersID: 4, DelegationsID: 2, Platform: "expo", Token: "abc"})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(3), res.ID)
+}
+
+func (suite *DeviceRepositoryUnitTestSuite) TestDeviceRepository_GetDevices() {
+	defer suite.db.Close()
+
+	// Only devices of a session that is still signed in
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT `device_tokens`.`id`,`device_tokens`.`created_at`,`device_tokens`.`updated_at`,`device_tokens`.`deleted_at`,`device_tokens`.`users_id`,`device_tokens`.`delegations_id`,`device_tokens`.`platform`,`device_tokens`.`token` FROM `device_tokens` JOIN delegations ON delegations.id = device_tokens.delegations_id AND delegations.users_id = device_tokens.users_id WHERE device_tokens.users_id = ? AND `device_tokens`.`deleted_at` IS NULL ORDER BY device_tokens.id")).
+		WithArgs(4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id", "token"}).AddRow(3, 4, "abc"))
+
+	res, err := suite.repo.GetDevices(4)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+}
+
+func (suite *DeviceRepositoryUnitTestSuite) TestDeviceRepository_DeleteDevice_NotFound() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `device_tokens` WHERE users_id = ? AND `device_tokens`.`id` = ?")).
+		WithArgs(4, 3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.DeleteDevice(4, 3)
+
+	assert.EqualError(suite.T(), err, "device not found")
+}
+
+func (suite *DeviceRepositoryUnitTestSuite) TestDeviceRepository_DeleteTokens() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `device_tokens` WHERE token IN (?,?)")).
+		WithArgs("abc", "def").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+
+	count, err := suite.repo.DeleteTokens([]string{"abc", "def"})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), count)
+}
@@ -0,0 +1,127 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type DiscussionRepository interface {
+	CreateMessage(message models.DiscussionMessages) (models.DiscussionMessages, error)
+	FindMessage(id uint) (models.DiscussionMessages, error)
+	EditMessage(message models.DiscussionMessages) (models.DiscussionMessages, error)
+	DeleteMessage(message models.DiscussionMessages) error
+	GetMessages(eventId uint, beforeId uint, limit int) ([]models.DiscussionMessages, error)
+	GetPinnedMessages(eventId uint) ([]models.DiscussionMessages, error)
+	IsVolunteer(eventId uint, userId uint) (bool, error)
+	Volunteers(eventId uint) ([]uint, error)
+}
+
+type discussionRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewDiscussionRepository(db *gorm.DB) DiscussionRepository {
+	return discussionRepository{
+		DB: db,
+	}
+}
+
+func (r discussionRepository) CreateMessage(message models.DiscussionMessages) (models.DiscussionMessages, error) {
+	if err := r.DB.Create(&message).Error; err != nil {
+		return models.DiscussionMessages{}, errors.New("could not post message")
+	}
+
+	return message, nil
+}
+
+func (r discussionRepository) FindMessage(id uint) (models.DiscussionMessages, error) {
+	var message models.DiscussionMessages
+
+	if err := r.DB.First(&message, id).Error; err != nil {
+		return models.DiscussionMessages{}, errors.New("message not found")
+	}
+
+	return message, nil
+}
+
+func (r discussionRepository) EditMessage(message models.DiscussionMessages) (models.DiscussionMessages, error) {
+	if err := r.DB.Save(&message).Error; err != nil {
+		return models.DiscussionMessages{}, errors.New("could not save message")
+	}
+
+	return message, nil
+}
+
+func (r discussionRepository) DeleteMessage(message models.DiscussionMessages) error {
+	if err := r.DB.Delete(&message).Error; err != nil {
+		return errors.New("could not delete message")
+	}
+
+	return nil
+}
+
+// Lists up to limit of the event's messages that are not pinned, before the
+// message beforeId, latest first
+func (r discussionRepository) GetMessages(eventId uint, beforeId uint, limit int) ([]models.DiscussionMessages, error) {
+	var messages []models.DiscussionMessages
+
+	query := r.DB.Where("event_id = ? AND pinned_at IS NULL", eventId)
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	if err := query.Order("id DESC").Limit(limit).Find(&messages).Error; err != nil {
+		return []models.DiscussionMessages{}, errors.New("could not retrieve messages")
+	}
+
+	return messages, nil
+}
+
+// Lists the event's pinned messages, latest pinned first
+func (r discussionRepository) GetPinnedMessages(eventId uint) ([]models.DiscussionMessages, error) {
+	var messages []models.DiscussionMessages
+
+	result := r.DB.Where("event_id = ? AND pinned_at IS NOT NULL", eventId).Order("pinned_at DESC").Find(&messages)
+
+	if result.Error != nil {
+		return []models.DiscussionMessages{}, errors.New("could not retrieve messages")
+	}
+
+	return messages, nil
+}
+
+// Whether the user is signed up for the event, any occurrence or shift of
+// it. Every sign-up holds a place: there is no waitlist or approval, and
+// withdrawing deletes the sign-up.
+func (r discussionRepository) IsVolunteer(eventId uint, userId uint) (bool, error) {
+	var count int64
+
+	result := r.DB.Model(&models.EventSignups{}).
+		Where("event_id = ? AND users_id = ?", eventId, userId).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, errors.New("could not retrieve sign-ups")
+	}
+
+	return count > 0, nil
+}
+
+// Ids of the users signed up for the event, any occurrence or shift of it
+func (r discussionRepository) Volunteers(eventId uint) ([]uint, error) {
+	var ids []uint
+
+	result := r.DB.Model(&models.EventSignups{}).
+		Where("event_id = ?", eventId).
+		Distinct().
+		Pluck("users_id", &ids)
+
+	if result.Error != nil {
+		return []uint{}, errors.New("could not retrieve sign-ups")
+	}
+
+	return ids, nil
+}
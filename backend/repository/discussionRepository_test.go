@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type DiscussionRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   DiscussionRepository
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewDiscussionRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestDiscussionRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(DiscussionRepositoryUnitTestSuite))
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) TestGetMessages() {
+	defer suite.db.Close()
+
+	// Pinned messages are listed apart
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `discussion_messages` WHERE (event_id = ? AND pinned_at IS NULL) AND id < ? AND `discussion_messages`.`deleted_at` IS NULL ORDER BY id DESC LIMIT 51")).
+		WithArgs(5, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(29).AddRow(28))
+
+	messages, err := suite.repo.GetMessages(5, 30, 51)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), messages, 2)
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) TestGetPinnedMessages_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `discussion_messages` WHERE (event_id = ? AND pinned_at IS NOT NULL) AND `discussion_messages`.`deleted_at` IS NULL ORDER BY pinned_at DESC")).
+		WithArgs(5).
+		WillReturnError(suite.err)
+
+	_, err := suite.repo.GetPinnedMessages(5)
+
+	assert.EqualError(suite.T(), err, "could not retrieve messages")
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) TestIsVolunteer() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `event_signups` WHERE (event_id = ? AND users_id = ?) AND `event_signups`.`deleted_at` IS NULL")).
+		WithArgs(5, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(2))
+
+	volunteer, err := suite.repo.IsVolunteer(5, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), volunteer)
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) TestIsVolunteer_Withdrawn() {
+	defer suite.db.Close()
+
+	// Withdrawing deletes the sign-up, so nothing is left to count
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `event_signups` WHERE (event_id = ? AND users_id = ?) AND `event_signups`.`deleted_at` IS NULL")).
+		WithArgs(5, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+
+	volunteer, err := suite.repo.IsVolunteer(5, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), volunteer)
+}
+
+func (suite *DiscussionRepositoryUnitTestSuite) TestVolunteers() {
+	defer suite.db.Close()
+
+	// Volunteers of several occurrences or shifts are listed once
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT DISTINCT `users_id` FROM `event_signups` WHERE event_id = ? AND `event_signups`.`deleted_at` IS NULL")).
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"users_id"}).AddRow(4).AddRow(6))
+
+	volunteers, err := suite.repo.Volunteers(5)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []uint{4, 6}, volunteers)
+}
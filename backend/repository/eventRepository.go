@@ -2,17 +2,30 @@ package repository
 
 import (
 	"errors"
+	"time"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type EventRepository interface {
 	CreateEvent(models.Event) (models.Event, error)
-	GetEvents() ([]models.Event, error)
+	GetEvents([]string, uint) ([]models.Event, error)
 	GetEventById(string) (models.Event, error)
 	UpdateEvent(models.Event) (models.Event, error)
 	DeleteEvent(models.Event) error
+	SearchEvents(models.EventSearchQuery) ([]models.Event, int64, error)
+	NearbyEvents(float64, float64, float64, time.Time, int, []string, uint) ([]models.NearbyEvent, error)
+	GetEventsBetween(time.Time, time.Time, uint, []string) ([]models.Event, error)
+	GetOverrides([]uint) ([]models.Event, error)
+	GetExceptions([]uint) ([]models.EventExceptions, error)
+	CreateException(models.EventExceptions) (models.EventExceptions, error)
+	DeleteException(uint, time.Time) error
+	UpdateSeries(models.Event, time.Time, time.Duration) (models.Event, error)
+	SplitSeries(models.Event, models.Event, time.Time, time.Duration) (models.Event, error)
+	SetStatus(models.Event, models.EventStatusChanges) (models.Event, error)
+	GetStatusChanges(uint) ([]models.EventStatusChanges, error)
 }
 
 type eventRepository struct {
@@ -33,9 +46,16 @@ func (r eventRepository) CreateEvent(event models.Event) (models.Event, error) {
 
 // DeleteEvent implements EventRepository
 func (r eventRepository) DeleteEvent(event models.Event) error {
-	result := r.DB.Delete(&event)
+	// Edited occurrences go with their series
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("series_id = ?", event.ID).Delete(&models.Event{}).Error; err != nil {
+			return err
+		}
 
-	if result.Error != nil {
+		return tx.Delete(&event).Error
+	})
+
+	if err != nil {
 		return errors.New("deletion failed");
 	}
 
@@ -57,10 +77,18 @@ func (r eventRepository) GetEventById(id string) (models.Event, error) {
 }
 
 // GetEvents implements EventRepository
-func (r eventRepository) GetEvents() ([]models.Event, error) {
+//
+// Returns the events in one of the statuses, optionally only those of one
+// organization
+func (r eventRepository) GetEvents(statuses []string, organizationId uint) ([]models.Event, error) {
 	var events []models.Event
-	result := r.DB.Find(&events)
-	r.DB.Preload("Organization").Find(&events)
+
+	query := r.DB.Preload("Organization").Where("events.status IN ?", statuses)
+	if organizationId != 0 {
+		query = query.Where("events.organization_id = ?", organizationId)
+	}
+
+	result := query.Find(&events)
 
 	if result.Error != nil {
 		return []models.Event{}, errors.New("get failed");
@@ -81,8 +109,397 @@ func (r eventRepository) UpdateEvent(event models.Event) (models.Event, error) {
 	return event, nil
 }	
 
+// SearchEvents implements EventRepository
+func (r eventRepository) SearchEvents(query models.EventSearchQuery) ([]models.Event, int64, error) {
+	var events []models.Event
+	var total int64
+
+	// Edited occurrences are listed with their series
+	filtered := r.DB.Model(&models.Event{}).
+		Where("events.series_id IS NULL").
+		Where("events.status IN ?", query.Statuses)
+
+	if query.Text != "" {
+		filtered = filtered.Where("MATCH(events.name, events.description) AGAINST (? IN NATURAL LANGUAGE MODE)", query.Text)
+	}
+	if !query.From.IsZero() {
+		filtered = filtered.Where(upcomingEventSQL, query.From, query.From)
+	}
+	if !query.To.IsZero() {
+		filtered = filtered.Where("events.start <= ?", query.To)
+	}
+	if query.OrganizationID != 0 {
+		filtered = filtered.Where("events.organization_id = ?", query.OrganizationID)
+	}
+	if query.VerifiedOnly {
+		filtered = filtered.Where("events.organization_id IN (?)",
+			r.DB.Model(&models.Organization{}).Select("id").Where("verified = ?", true))
+	}
+
+	// Any of the given tags within a category, every category that is given
+	for _, tagIds := range [][]uint{query.CauseAreas, query.Skills, query.GoodFor} {
+		if len(tagIds) > 0 {
+			filtered = filtered.Where("events.id IN (?)",
+				r.DB.Table("event_tags").Select("event_id").Where("tags_id IN ?", tagIds))
+		}
+	}
+
+	if err := filtered.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return []models.Event{}, 0, errors.New("search failed")
+	}
+
+	page := filtered.Preload("Organization").Limit(query.Limit)
+
+	if query.Sort == models.EventSortRelevance && query.Text != "" {
+		page = page.
+			Clauses(clause.OrderBy{Expression: clause.Expr{
+				SQL:  "MATCH(events.name, events.description) AGAINST (? IN NATURAL LANGUAGE MODE) DESC, events.id DESC",
+				Vars: []interface{}{query.Text},
+			}}).
+			Offset(query.Cursor.Offset)
+	} else {
+		if !query.Cursor.Start.IsZero() {
+			page = page.Where("(events.start > ? OR (events.start = ? AND events.id > ?))",
+				query.Cursor.Start, query.Cursor.Start, query.Cursor.ID)
+		}
+		page = page.Order("events.start ASC, events.id ASC")
+	}
+
+	if err := page.Find(&events).Error; err != nil {
+		return []models.Event{}, 0, errors.New("search failed")
+	}
+
+	return events, total, nil
+}
+
+// Great-circle distance in km between the event (falling back to its
+// organization's location) and the point given by the two parameters
+const eventDistanceSQL = `6371 * 2 * ASIN(SQRT(
+	POWER(SIN(RADIANS(COALESCE(events.latitude, organizations.latitude) - ?) / 2), 2) +
+	COS(RADIANS(?)) * COS(RADIANS(COALESCE(events.latitude, organizations.latitude))) *
+	POWER(SIN(RADIANS(COALESCE(events.longitude, organizations.longitude) - ?) / 2), 2)))`
+
+// NearbyEvents implements EventRepository
+func (r eventRepository) NearbyEvents(lat float64, lng float64, radiusKm float64, from time.Time, limit int, statuses []string, organizationId uint) ([]models.NearbyEvent, error) {
+	var rows []struct {
+		ID         uint
+		DistanceKm float64
+	}
+
+	query := r.DB.Model(&models.Event{})
+	if organizationId != 0 {
+		query = query.Where("events.organization_id = ?", organizationId)
+	}
+
+	result := query.
+		Select("events.id, "+eventDistanceSQL+" AS distance_km", lat, lat, lng).
+		Joins("LEFT JOIN organizations ON organizations.id = events.organization_id").
+		Where("COALESCE(events.latitude, organizations.latitude) IS NOT NULL").
+		Where("COALESCE(events.longitude, organizations.longitude) IS NOT NULL").
+		Where("events.series_id IS NULL").
+		Where("events.status IN ?", statuses).
+		Where(upcomingEventSQL, from, from).
+		Having("distance_km <= ?", radiusKm).
+		Order("distance_km, events.id").
+		Limit(limit).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return []models.NearbyEvent{}, errors.New("get failed")
+	}
+
+	if len(rows) == 0 {
+		return []models.NearbyEvent{}, nil
+	}
+
+	ids := []uint{}
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+
+	var events []models.Event
+	result = r.DB.Preload("Organization").Where("id IN ?", ids).Find(&events)
+
+	if result.Error != nil {
+		return []models.NearbyEvent{}, errors.New("get failed")
+	}
+
+	byId := map[uint]models.Event{}
+	for _, event := range events {
+		byId[event.ID] = event
+	}
+
+	// Keep the distance ordering of the first query
+	nearby := []models.NearbyEvent{}
+	for _, row := range rows {
+		if event, ok := byId[row.ID]; ok {
+			nearby = append(nearby, models.NearbyEvent{Event: event, DistanceKm: row.DistanceKm})
+		}
+	}
+
+	return nearby, nil
+}
+
 func NewEventRepository(db *gorm.DB) EventRepository {
 	return eventRepository{
 		DB: db,
 	}
 }
+
+// GetEventsBetween implements EventRepository
+//
+// Returns the one-off events (including edited occurrences) starting in
+// [from, to] and the recurring series that may have occurrences in it, in
+// one of the statuses, optionally only those of one organization.
+func (r eventRepository) GetEventsBetween(from time.Time, to time.Time, organizationId uint, statuses []string) ([]models.Event, error) {
+	var events []models.Event
+
+	query := r.DB.Preload("Organization").
+		Where("((events.recurrence = '' AND events.start BETWEEN ? AND ?) OR "+
+			"(events.recurrence <> '' AND events.start <= ? AND (events.recurrence_end IS NULL OR events.recurrence_end >= ?)))",
+			from, to, to, from).
+		Where("events.status IN ?", statuses)
+
+	if organizationId != 0 {
+		query = query.Where("events.organization_id = ?", organizationId)
+	}
+
+	if err := query.Order("events.start, events.id").Find(&events).Error; err != nil {
+		return []models.Event{}, errors.New("get failed")
+	}
+
+	return events, nil
+}
+
+// GetOverrides implements EventRepository
+func (r eventRepository) GetOverrides(seriesIds []uint) ([]models.Event, error) {
+	var events []models.Event
+
+	if len(seriesIds) == 0 {
+		return []models.Event{}, nil
+	}
+
+	result := r.DB.Preload("Organization").Where("series_id IN ?", seriesIds).Find(&events)
+
+	if result.Error != nil {
+		return []models.Event{}, errors.New("get failed")
+	}
+
+	return events, nil
+}
+
+// GetExceptions implements EventRepository
+func (r eventRepository) GetExceptions(eventIds []uint) ([]models.EventExceptions, error) {
+	var exceptions []models.EventExceptions
+
+	if len(eventIds) == 0 {
+		return []models.EventExceptions{}, nil
+	}
+
+	result := r.DB.Where("event_id IN ?", eventIds).Find(&exceptions)
+
+	if result.Error != nil {
+		return []models.EventExceptions{}, errors.New("get failed")
+	}
+
+	return exceptions, nil
+}
+
+// CreateException implements EventRepository
+func (r eventRepository) CreateException(exception models.EventExceptions) (models.EventExceptions, error) {
+	result := r.DB.Create(&exception)
+
+	if result.Error != nil {
+		return models.EventExceptions{}, errors.New("creation failed")
+	}
+
+	return exception, nil
+}
+
+// DeleteException implements EventRepository
+func (r eventRepository) DeleteException(eventId uint, occurrence time.Time) error {
+	result := r.DB.Unscoped().
+		Where("event_id = ? AND occurrence_date = ?", eventId, occurrence).
+		Delete(&models.EventExceptions{})
+
+	if result.Error != nil {
+		return errors.New("deletion failed")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("occurrence is not cancelled")
+	}
+
+	return nil
+}
+
+// UpdateSeries implements EventRepository
+//
+// Saves the series and shifts the sign-ups, cancellations and edited
+// occurrences from the occurrence at from on by delta, so they follow
+// their occurrences when the series is moved.
+func (r eventRepository) UpdateSeries(series models.Event, from time.Time, delta time.Duration) (models.Event, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&series).Error; err != nil {
+			return err
+		}
+
+		if err := moveOccurrences(tx, series.ID, series.ID, from, delta); err != nil {
+			return err
+		}
+
+		if delta == 0 {
+			return nil
+		}
+
+		return tx.Model(&models.EventShifts{}).Where("event_id = ?", series.ID).
+			Updates(map[string]interface{}{
+				"start": gorm.Expr("DATE_ADD(`start`, INTERVAL ? MICROSECOND)", delta.Microseconds()),
+				"end":   gorm.Expr("DATE_ADD(`end`, INTERVAL ? MICROSECOND)", delta.Microseconds()),
+			}).Error
+	})
+
+	if err != nil {
+		return models.Event{}, errors.New("update failed")
+	}
+
+	r.DB.Preload("Organization").Find(&series)
+
+	return series, nil
+}
+
+// SplitSeries implements EventRepository
+//
+// Saves the series ended before from and creates next as the series of
+// the occurrences from then on, moving their sign-ups, cancellations and
+// edited occurrences over shifted by delta. The shifts of the series are
+// copied to next, and the moved sign-ups to the copies.
+func (r eventRepository) SplitSeries(series models.Event, next models.Event, from time.Time, delta time.Duration) (models.Event, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&series).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Create(&next).Error; err != nil {
+			return err
+		}
+
+		if err := moveOccurrences(tx, series.ID, next.ID, from, delta); err != nil {
+			return err
+		}
+
+		return copyShifts(tx, series, next)
+	})
+
+	if err != nil {
+		return models.Event{}, errors.New("update failed")
+	}
+
+	r.DB.Preload("Organization").Find(&next)
+
+	return next, nil
+}
+
+// Moves everything attached to the occurrences of series fromId starting
+// at or after from to series toId, shifting their occurrence dates by delta
+func moveOccurrences(tx *gorm.DB, fromId uint, toId uint, from time.Time, delta time.Duration) error {
+	if fromId == toId && delta == 0 {
+		return nil
+	}
+
+	shifted := gorm.Expr("DATE_ADD(occurrence_date, INTERVAL ? MICROSECOND)", delta.Microseconds())
+
+	attached := []struct {
+		model  interface{}
+		column string
+	}{
+		{&models.EventSignups{}, "event_id"},
+		{&models.EventExceptions{}, "event_id"},
+		{&models.Event{}, "series_id"},
+	}
+
+	for _, a := range attached {
+		result := tx.Model(a.model).
+			Where(a.column+" = ? AND occurrence_date >= ?", fromId, from).
+			Updates(map[string]interface{}{a.column: toId, "occurrence_date": shifted})
+
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
+// Copies the shifts of series to next at the same offset from its start
+// and points the sign-ups of next at the copies
+func copyShifts(tx *gorm.DB, series models.Event, next models.Event) error {
+	var shifts []models.EventShifts
+
+	if err := tx.Preload("Skills").Where("event_id = ?", series.ID).Find(&shifts).Error; err != nil {
+		return err
+	}
+
+	offset := next.Start.Sub(series.Start)
+
+	for _, shift := range shifts {
+		copied := shift
+		copied.Model = gorm.Model{}
+		copied.EventID = next.ID
+		copied.Start = shift.Start.Add(offset)
+		copied.End = shift.End.Add(offset)
+
+		if err := tx.Create(&copied).Error; err != nil {
+			return err
+		}
+
+		result := tx.Model(&models.EventSignups{}).
+			Where("event_id = ? AND shift_id = ?", next.ID, shift.ID).
+			Update("shift_id", copied.ID)
+
+		if result.Error != nil {
+			return result.Error
+		}
+	}
+
+	return nil
+}
+
+// SetStatus implements EventRepository
+//
+// Moves the series, along with its edited occurrences, to the status in
+// change and records the change
+func (r eventRepository) SetStatus(event models.Event, change models.EventStatusChanges) (models.Event, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Event{}).
+			Where("id = ? OR series_id = ?", event.ID, event.ID).
+			Update("status", change.To)
+
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.Create(&change).Error
+	})
+
+	if err != nil {
+		return models.Event{}, errors.New("status change failed")
+	}
+
+	event.Status = change.To
+
+	return event, nil
+}
+
+// GetStatusChanges implements EventRepository
+func (r eventRepository) GetStatusChanges(eventId uint) ([]models.EventStatusChanges, error) {
+	var changes []models.EventStatusChanges
+
+	result := r.DB.Where("event_id = ?", eventId).Order("created_at, id").Find(&changes)
+
+	if result.Error != nil {
+		return []models.EventStatusChanges{}, errors.New("get failed")
+	}
+
+	return changes, nil
+}
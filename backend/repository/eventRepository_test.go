@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type EventRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   EventRepository
+}
+
+func (suite *EventRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewEventRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *EventRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestEventRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(EventRepositoryUnitTestSuite))
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_GetEvents_SingleQuery() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `events` WHERE events.status IN (?)")).
+		WithArgs(models.EventPublished).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id"}).AddRow(1, 2))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `organizations`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	if _, suite.err = suite.repo.GetEvents([]string{models.EventPublished}, 0); suite.err != nil {
+		suite.T().Errorf("error was not expected while getting events: %s", suite.err)
+	}
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_SearchEvents_ByDate() {
+	defer suite.db.Close()
+
+	after := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `events` WHERE events.series_id IS NULL AND events.status IN (?,?) AND events.organization_id = ? AND events.id IN (SELECT event_id FROM `event_tags` WHERE tags_id IN (?,?))")).
+		WithArgs(models.EventDraft, models.EventPublished, uint(2), uint(7), uint(8)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(4))
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"AND ((events.start > ? OR (events.start = ? AND events.id > ?)))")+
+		".*"+regexp.QuoteMeta("ORDER BY events.start ASC, events.id ASC LIMIT 3")).
+		WithArgs(models.EventDraft, models.EventPublished, uint(2), uint(7), uint(8), after, after, uint(9)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id"}).AddRow(10, 2))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `organizations`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	events, total, err := suite.repo.SearchEvents(models.EventSearchQuery{
+		Statuses:       []string{models.EventDraft, models.EventPublished},
+		OrganizationID: 2,
+		Skills:         []uint{7, 8},
+		Limit:          3,
+		Cursor:         models.EventSearchCursor{Start: after, ID: 9},
+	})
+
+	suite.Nil(err)
+	suite.Equal(int64(4), total)
+	suite.Len(events, 1)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_SearchEvents_ByRelevance() {
+	defer suite.db.Close()
+
+	match := "MATCH(events.name, events.description) AGAINST (? IN NATURAL LANGUAGE MODE)"
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `events` WHERE events.series_id IS NULL AND events.status IN (?) AND "+match)).
+		WithArgs(models.EventPublished, "food").
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("ORDER BY "+match+" DESC, events.id DESC LIMIT 5 OFFSET 10")).
+		WithArgs(models.EventPublished, "food", "food").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	_, _, err := suite.repo.SearchEvents(models.EventSearchQuery{
+		Text:     "food",
+		Statuses: []string{models.EventPublished},
+		Sort:     models.EventSortRelevance,
+		Limit:    5,
+		Cursor:   models.EventSearchCursor{Offset: 10},
+	})
+
+	suite.Nil(err)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_SearchEvents_Fail() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery("SELECT count").WillReturnError(suite.err)
+
+	if _, _, suite.err = suite.repo.SearchEvents(models.EventSearchQuery{Limit: 5}); suite.err == nil {
+		suite.T().Errorf("error was expected while searching")
+	}
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_NearbyEvents() {
+	defer suite.db.Close()
+
+	from := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("LEFT JOIN organizations ON organizations.id = events.organization_id")+
+		".*"+regexp.QuoteMeta("HAVING distance_km <= ? ORDER BY distance_km, events.id LIMIT 10")).
+		WithArgs(39.8, 39.8, -89.6, models.EventPublished, from, from, 25.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "distance_km"}).AddRow(3, 1.2).AddRow(1, 4.5))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `events` WHERE id IN (?,?)")).
+		WithArgs(3, 1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id"}).AddRow(1, 2).AddRow(3, 2))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `organizations`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	nearby, err := suite.repo.NearbyEvents(39.8, -89.6, 25, from, 10, []string{models.EventPublished}, 0)
+
+	suite.Nil(err)
+	suite.Len(nearby, 2)
+	suite.Equal(uint(3), nearby[0].Event.ID)
+	suite.Equal(1.2, nearby[0].DistanceKm)
+	suite.Equal(uint(1), nearby[1].Event.ID)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_NearbyEvents_Organization() {
+	defer suite.db.Close()
+
+	from := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("WHERE events.organization_id = ?")).
+		WithArgs(39.8, 39.8, -89.6, 2, models.EventDraft, from, from, 25.0).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "distance_km"}))
+
+	nearby, err := suite.repo.NearbyEvents(39.8, -89.6, 25, from, 10, []string{models.EventDraft}, 2)
+
+	suite.Nil(err)
+	suite.Empty(nearby)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_NearbyEvents_Fail() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery("SELECT events.id").WillReturnError(suite.err)
+
+	if _, suite.err = suite.repo.NearbyEvents(39.8, -89.6, 25, time.Now(), 10, []string{models.EventPublished}, 0); suite.err == nil {
+		suite.T().Errorf("error was expected while getting nearby events")
+	}
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_GetEventsBetween() {
+	defer suite.db.Close()
+
+	from := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"WHERE (((events.recurrence = '' AND events.start BETWEEN ? AND ?) OR "+
+			"(events.recurrence <> '' AND events.start <= ? AND (events.recurrence_end IS NULL OR events.recurrence_end >= ?)))) "+
+			"AND events.status IN (?,?) AND events.organization_id = ?")).
+		WithArgs(from, to, to, from, models.EventPublished, models.EventCompleted, uint(2)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id"}).AddRow(1, 2))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `organizations`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+
+	events, err := suite.repo.GetEventsBetween(from, to, 2, []string{models.EventPublished, models.EventCompleted})
+
+	suite.Nil(err)
+	suite.Len(events, 1)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_SetStatus() {
+	defer suite.db.Close()
+
+	var event models.Event
+	event.ID = 1
+	event.Status = models.EventPublished
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `events` SET `status`=?,`updated_at`=? WHERE (id = ? OR series_id = ?)")).
+		WithArgs(models.EventCancelled, sqlmock.AnyArg(), uint(1), uint(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `event_status_changes`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.SetStatus(event, models.EventStatusChanges{
+		EventID: 1,
+		From:    models.EventPublished,
+		To:      models.EventCancelled,
+		UsersID: 2,
+	})
+
+	suite.Nil(err)
+	suite.Equal(models.EventCancelled, res.Status)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_SetStatus_Fail() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec("UPDATE `events`").WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	if _, suite.err = suite.repo.SetStatus(models.Event{}, models.EventStatusChanges{To: models.EventCancelled}); suite.err == nil {
+		suite.T().Errorf("error was expected while changing the status")
+	}
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_DeleteException_NotCancelled() {
+	defer suite.db.Close()
+
+	occurrence := time.Date(2023, 5, 6, 9, 0, 0, 0, time.UTC)
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `event_exceptions` WHERE event_id = ? AND occurrence_date = ?")).
+		WithArgs(uint(1), occurrence).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	suite.NotNil(suite.repo.DeleteException(1, occurrence))
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_SplitSeries() {
+	defer suite.db.Close()
+
+	from := time.Date(2023, 5, 6, 9, 0, 0, 0, time.UTC)
+
+	var series models.Event
+	series.ID = 1
+	series.Recurrence = "FREQ=WEEKLY;UNTIL=20230506T085959Z"
+	next := models.Event{Recurrence: "FREQ=WEEKLY", Start: from.Add(time.Hour)}
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec("UPDATE `events` SET").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectExec("INSERT INTO `events`").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	shifted := "`occurrence_date`=DATE_ADD(occurrence_date, INTERVAL ? MICROSECOND)"
+	for _, table := range []string{"event_signups", "event_exceptions"} {
+		suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `"+table+"` SET `event_id`=?,"+shifted)+
+			".*"+regexp.QuoteMeta("WHERE (event_id = ? AND occurrence_date >= ?)")).
+			WithArgs(uint(5), int64(3600000000), sqlmock.AnyArg(), uint(1), from).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+	}
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `events` SET "+shifted+",`series_id`=?")+
+		".*"+regexp.QuoteMeta("WHERE (series_id = ? AND occurrence_date >= ?)")).
+		WithArgs(int64(3600000000), uint(5), sqlmock.AnyArg(), uint(1), from).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `event_shifts` WHERE event_id = ?")).
+		WithArgs(uint(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_id"}))
+	suite.mock.ExpectCommit()
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `events`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(5))
+
+	res, err := suite.repo.SplitSeries(series, next, from, time.Hour)
+
+	suite.Nil(err)
+	suite.Equal(uint(5), res.ID)
+}
+
+func (suite *EventRepositoryUnitTestSuite) TestEventRepository_UpdateSeries_Fail() {
+	defer suite.db.Close()
+
+	var series models.Event
+	series.ID = 1
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec("UPDATE `events` SET").WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	if _, suite.err = suite.repo.UpdateSeries(series, time.Now(), time.Hour); suite.err == nil {
+		suite.T().Errorf("error was expected while updating the series")
+	}
+}
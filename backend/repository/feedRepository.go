@@ -0,0 +1,359 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type FeedRepository interface {
+	FindViewer(uint) (models.PostViewer, error)
+	FollowedOrganizationIds(uint) ([]uint, error)
+	UpcomingEvents([]uint, time.Time, models.FeedCursor, int) ([]models.Event, error)
+	PostsByHandles([]string, models.PostViewer, models.FeedCursor, int) ([]models.Posts, error)
+	LatestPosts([]string, []uint, models.PostViewer, models.FeedCursor, int) ([]models.Posts, error)
+	FollowerIds() ([]uint, error)
+	InterestTagIds(uint) ([]uint, error)
+	NewEvents([]uint, []uint, time.Time, time.Time) ([]models.Event, error)
+	OrganizationPosts([]uint, models.PostViewer, time.Time, int) ([]models.Posts, error)
+	RelevantEvents([]uint, []uint, time.Time, int) ([]models.Event, error)
+	CommentCounts([]uint) (map[uint]int64, error)
+	SignupCounts([]uint) (map[uint]int64, error)
+	EventTagIds([]uint) (map[uint][]uint, error)
+	OrganizationTagIds([]uint) (map[uint][]uint, error)
+}
+
+// A count per row, for the ranking
+type idCount struct {
+	ID    uint
+	Count int64
+}
+
+// A tag of a row, for the ranking
+type idTag struct {
+	ID     uint
+	TagsID uint
+}
+
+type feedRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewFeedRepository(db *gorm.DB) FeedRepository {
+	return feedRepository{
+		DB: db,
+	}
+}
+
+// Restricts a query of one feed item type to the rows that come after the
+// cursor. Rows are ordered by created_at desc, then type, then id desc, so
+// whether rows with the cursor's timestamp are included depends on how
+// itemType compares to the cursor's type.
+func afterFeedCursor(db *gorm.DB, itemType string, cursor models.FeedCursor) *gorm.DB {
+	if cursor.Timestamp.IsZero() {
+		return db
+	}
+
+	switch {
+	case itemType > cursor.Type:
+		return db.Where("created_at <= ?", cursor.Timestamp)
+	case itemType == cursor.Type:
+		return db.Where("(created_at < ? OR (created_at = ? AND id < ?))",
+			cursor.Timestamp, cursor.Timestamp, cursor.ID)
+	default:
+		return db.Where("created_at < ?", cursor.Timestamp)
+	}
+}
+
+// The user with their friends and organizations, who decide which posts
+// they see
+func (r feedRepository) FindViewer(userId uint) (models.PostViewer, error) {
+	return findViewer(r.DB, userId)
+}
+
+// Ids of every organization the user follows
+func (r feedRepository) FollowedOrganizationIds(userId uint) ([]uint, error) {
+	var ids []uint
+
+	result := r.DB.Model(&models.OrgFollowers{}).
+		Where("users_id = ?", userId).
+		Pluck("organization_id", &ids)
+
+	if result.Error != nil {
+		return []uint{}, errors.New("could not retrieve followed organizations")
+	}
+
+	return ids, nil
+}
+
+// Handles of every accepted friend of the given handle
+func friendHandles(db *gorm.DB, handle string) ([]string, error) {
+	var friends []models.Friend
+
+	result := db.
+		Where("relationship_bit = ?", "friends").
+		Where("(friend_one_handle = ? OR friend_two_handle = ?)", handle, handle).
+		Find(&friends)
+
+	if result.Error != nil {
+		return []string{}, errors.New("could not retrieve friends")
+	}
+
+	handles := []string{}
+	for _, friend := range friends {
+		if friend.FriendOneHandle == handle {
+			handles = append(handles, friend.FriendTwoHandle)
+		} else {
+			handles = append(handles, friend.FriendOneHandle)
+		}
+	}
+
+	return handles, nil
+}
+
+// Published events of the given organizations that have not happened yet,
+// newest first, starting after the cursor
+func (r feedRepository) UpcomingEvents(orgIds []uint, now time.Time, cursor models.FeedCursor, limit int) ([]models.Event, error) {
+	var events []models.Event
+
+	query := r.DB.Preload("Organization").
+		Where("organization_id IN ?", orgIds).
+		Where("series_id IS NULL").
+		Where("status = ?", models.EventPublished).
+		Where(upcomingEventSQL, now, now)
+
+	result := afterFeedCursor(query, models.FeedItemEvent, cursor).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&events)
+
+	if result.Error != nil {
+		return []models.Event{}, errors.New("could not retrieve events")
+	}
+
+	return events, nil
+}
+
+// Shown posts written by the given handles that the viewer can see, newest
+// first, starting after the cursor
+func (r feedRepository) PostsByHandles(handles []string, viewer models.PostViewer, cursor models.FeedCursor, limit int) ([]models.Posts, error) {
+	var posts []models.Posts
+
+	query := visiblePosts(r.DB.Where("handle IN ? AND moderation = ''", handles), viewer)
+
+	result := afterFeedCursor(query, models.FeedItemPost, cursor).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&posts)
+
+	if result.Error != nil {
+		return []models.Posts{}, errors.New("could not retrieve posts")
+	}
+
+	return posts, nil
+}
+
+// Posts of the users with handles and of the organizations with orgIds
+// that the viewer can see, newest first, after cursor
+func (r feedRepository) LatestPosts(handles []string, orgIds []uint, viewer models.PostViewer, cursor models.FeedCursor, limit int) ([]models.Posts, error) {
+	var posts []models.Posts
+
+	authors := r.DB.Where("handle IN ?", handles).Or("organization_id IN ?", orgIds)
+	query := visiblePosts(r.DB.Where(authors).Where("moderation = ''"), viewer)
+
+	result := afterFeedCursor(query, models.FeedItemPost, cursor).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&posts)
+
+	if result.Error != nil {
+		return []models.Posts{}, errors.New("could not retrieve posts")
+	}
+
+	return posts, nil
+}
+
+// Ids of the users following at least one organization
+func (r feedRepository) FollowerIds() ([]uint, error) {
+	var ids []uint
+
+	result := r.DB.Model(&models.OrgFollowers{}).Distinct().Order("users_id").Pluck("users_id", &ids)
+
+	if result.Error != nil {
+		return []uint{}, errors.New("could not retrieve followers")
+	}
+
+	return ids, nil
+}
+
+// Ids of the tags the user is interested in
+func (r feedRepository) InterestTagIds(userId uint) ([]uint, error) {
+	var ids []uint
+
+	result := r.DB.Table("users_tags").Where("users_id = ?", userId).Pluck("tags_id", &ids)
+
+	if result.Error != nil {
+		return []uint{}, errors.New("could not retrieve interests")
+	}
+
+	return ids, nil
+}
+
+// Published events of the given organizations created from since until
+// until that have not happened by until, soonest first. With tagIds only
+// those with one of the tags.
+func (r feedRepository) NewEvents(orgIds []uint, tagIds []uint, since time.Time, until time.Time) ([]models.Event, error) {
+	var events []models.Event
+
+	query := r.DB.Preload("Organization").
+		Where("organization_id IN ?", orgIds).
+		Where("series_id IS NULL").
+		Where("status = ?", models.EventPublished).
+		Where("created_at >= ? AND created_at < ?", since, until).
+		Where(upcomingEventSQL, until, until)
+
+	if len(tagIds) > 0 {
+		query = query.Where("events.id IN (?)",
+			r.DB.Table("event_tags").Select("event_id").Where("tags_id IN ?", tagIds))
+	}
+
+	result := query.Order("start, id").Find(&events)
+
+	if result.Error != nil {
+		return []models.Event{}, errors.New("could not retrieve events")
+	}
+
+	return events, nil
+}
+
+// Shown posts of the given organizations that the viewer can see since
+// since, newest first
+func (r feedRepository) OrganizationPosts(orgIds []uint, viewer models.PostViewer, since time.Time, limit int) ([]models.Posts, error) {
+	var posts []models.Posts
+
+	result := visiblePosts(r.DB.Where("organization_id IN ? AND moderation = ''", orgIds), viewer).
+		Where("created_at >= ?", since).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&posts)
+
+	if result.Error != nil {
+		return []models.Posts{}, errors.New("could not retrieve posts")
+	}
+
+	return posts, nil
+}
+
+// Published events that have not happened yet, of the given organizations
+// or tagged with one of tagIds, newest first
+func (r feedRepository) RelevantEvents(orgIds []uint, tagIds []uint, now time.Time, limit int) ([]models.Event, error) {
+	var events []models.Event
+
+	relevant := r.DB.Where("organization_id IN ?", orgIds)
+	if len(tagIds) > 0 {
+		relevant = relevant.Or("events.id IN (?)",
+			r.DB.Table("event_tags").Select("event_id").Where("tags_id IN ?", tagIds))
+	}
+
+	result := r.DB.Preload("Organization").
+		Where(relevant).
+		Where("series_id IS NULL").
+		Where("status = ?", models.EventPublished).
+		Where(upcomingEventSQL, now, now).
+		Order("created_at desc, id desc").
+		Limit(limit).
+		Find(&events)
+
+	if result.Error != nil {
+		return []models.Event{}, errors.New("could not retrieve events")
+	}
+
+	return events, nil
+}
+
+// How many comments each of the posts has, by post id
+func (r feedRepository) CommentCounts(postIds []uint) (map[uint]int64, error) {
+	var rows []idCount
+
+	result := r.DB.Model(&models.Comments{}).
+		Select("posts_id AS id, COUNT(*) AS count").
+		Where("posts_id IN ?", postIds).
+		Group("posts_id").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return map[uint]int64{}, errors.New("could not count comments")
+	}
+
+	return countsById(rows), nil
+}
+
+// How many sign-ups each of the events has, by event id
+func (r feedRepository) SignupCounts(eventIds []uint) (map[uint]int64, error) {
+	var rows []idCount
+
+	result := r.DB.Model(&models.EventSignups{}).
+		Select("event_id AS id, COUNT(*) AS count").
+		Where("event_id IN ?", eventIds).
+		Group("event_id").
+		Scan(&rows)
+
+	if result.Error != nil {
+		return map[uint]int64{}, errors.New("could not count sign-ups")
+	}
+
+	return countsById(rows), nil
+}
+
+// Ids of the tags of each of the events, by event id
+func (r feedRepository) EventTagIds(eventIds []uint) (map[uint][]uint, error) {
+	var rows []idTag
+
+	result := r.DB.Table("event_tags").
+		Select("event_id AS id, tags_id").
+		Where("event_id IN ?", eventIds).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return map[uint][]uint{}, errors.New("could not retrieve tags")
+	}
+
+	return tagsById(rows), nil
+}
+
+// Ids of the tags of each of the organizations, by organization id
+func (r feedRepository) OrganizationTagIds(orgIds []uint) (map[uint][]uint, error) {
+	var rows []idTag
+
+	result := r.DB.Table("organization_tags").
+		Select("organization_id AS id, tags_id").
+		Where("organization_id IN ?", orgIds).
+		Scan(&rows)
+
+	if result.Error != nil {
+		return map[uint][]uint{}, errors.New("could not retrieve tags")
+	}
+
+	return tagsById(rows), nil
+}
+
+func countsById(rows []idCount) map[uint]int64 {
+	counts := map[uint]int64{}
+	for _, row := range rows {
+		counts[row.ID] = row.Count
+	}
+
+	return counts
+}
+
+func tagsById(rows []idTag) map[uint][]uint {
+	tags := map[uint][]uint{}
+	for _, row := range rows {
+		tags[row.ID] = append(tags[row.ID], row.TagsID)
+	}
+
+	return tags
+}
@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type FeedRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   FeedRepository
+	now    time.Time
+}
+
+func (suite *FeedRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewFeedRepository(suite.gormDB)
+	suite.now = time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *FeedRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestFeedRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedRepositoryUnitTestSuite))
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestOrganizationPosts() {
+	since := suite.now.Add(-time.Hour)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE (organization_id IN (?,?) AND moderation = '') AND posts.audience = ? AND created_at >= ? AND `posts`.`deleted_at` IS NULL ORDER BY created_at desc, id desc LIMIT 50")).
+		WithArgs(3, 4, models.AudiencePublic, since).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id"}).AddRow(7, 3))
+
+	posts, err := suite.repo.OrganizationPosts([]uint{3, 4}, models.PostViewer{}, since, 50)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 1)
+	assert.Equal(suite.T(), uint(7), posts[0].ID)
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestOrganizationPosts_Viewer() {
+	since := suite.now.Add(-time.Hour)
+	viewer := models.PostViewer{Handle: "ada", FriendHandles: []string{"grace"}, OrganizationIDs: []uint{3}}
+
+	// Besides public posts, the viewer's own, friends' friends only posts and
+	// those for organizations the viewer belongs to
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE (organization_id IN (?,?) AND moderation = '') AND (posts.audience = ? OR posts.handle = ? OR (posts.audience = ? AND posts.handle IN (?)) OR (posts.audience IN (?,?) AND posts.audience_organization_id IN (?))) AND created_at >= ?")).
+		WithArgs(3, 4, models.AudiencePublic, "ada", models.AudienceFriends, "grace", models.AudienceMembers, models.AudienceOrganization, 3, since).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "organization_id"}))
+
+	posts, err := suite.repo.OrganizationPosts([]uint{3, 4}, viewer, since, 50)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), posts)
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestUpcomingEvents() {
+	cursor := models.FeedCursor{Timestamp: suite.now.Add(-time.Hour), ID: 9, Type: models.FeedItemEvent}
+
+	// Drafts and cancelled events stay out of the feed
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `events` WHERE organization_id IN (?,?) AND series_id IS NULL AND status = ? AND ((events.start >= ? OR (events.recurrence <> '' AND (events.recurrence_end IS NULL OR events.recurrence_end >= ?)))) AND ((created_at < ? OR (created_at = ? AND id < ?))) AND `events`.`deleted_at` IS NULL ORDER BY created_at desc, id desc LIMIT 21")).
+		WithArgs(3, 4, models.EventPublished, suite.now, suite.now, cursor.Timestamp, cursor.Timestamp, 9).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	events, err := suite.repo.UpcomingEvents([]uint{3, 4}, suite.now, cursor, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), events)
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestRelevantEvents() {
+	// Followed organizations' events, or events on the user's interests
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `events` WHERE (organization_id IN (?) OR events.id IN (SELECT event_id FROM `event_tags` WHERE tags_id IN (?,?))) AND series_id IS NULL AND status = ?")).
+		WithArgs(3, 5, 6, "published", sqlmock.AnyArg(), sqlmock.AnyArg()).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	events, err := suite.repo.RelevantEvents([]uint{3}, []uint{5, 6}, suite.now, 50)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), events)
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestRelevantEvents_Fail() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `events`")).WillReturnError(suite.err)
+
+	_, err := suite.repo.RelevantEvents([]uint{3}, []uint{}, suite.now, 50)
+
+	assert.EqualError(suite.T(), err, "could not retrieve events")
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestCommentCounts() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT posts_id AS id, COUNT(*) AS count FROM `comments` WHERE posts_id IN (?,?) AND `comments`.`deleted_at` IS NULL GROUP BY `posts_id`")).
+		WithArgs(7, 8).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "count"}).AddRow(7, 12))
+
+	counts, err := suite.repo.CommentCounts([]uint{7, 8})
+
+	// Posts without comments are left out
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), map[uint]int64{7: 12}, counts)
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestEventTagIds() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT event_id AS id, tags_id FROM `event_tags` WHERE event_id IN (?,?)")).
+		WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "tags_id"}).AddRow(1, 5).AddRow(1, 6).AddRow(2, 5))
+
+	tags, err := suite.repo.EventTagIds([]uint{1, 2})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), map[uint][]uint{1: {5, 6}, 2: {5}}, tags)
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestOrganizationTagIds_Fail() {
+	suite.mock.ExpectQuery(regexp.QuoteMeta("FROM `organization_tags`")).WillReturnError(suite.err)
+
+	_, err := suite.repo.OrganizationTagIds([]uint{3})
+
+	assert.EqualError(suite.T(), err, "could not retrieve tags")
+}
+
+func (suite *FeedRepositoryUnitTestSuite) TestLatestPosts() {
+	cursor := models.FeedCursor{Timestamp: suite.now.Add(-time.Hour), ID: 9, Type: models.FeedItemPost}
+
+	// Friends' posts and followed organizations' posts, in one page
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE (handle IN (?) OR organization_id IN (?,?)) AND moderation = '' AND posts.audience = ?")).
+		WithArgs("grace", 3, 4, models.AudiencePublic, cursor.Timestamp, cursor.Timestamp, 9).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "handle", "organization_id"}).AddRow(8, "grace", nil).AddRow(7, "ada", 3))
+
+	posts, err := suite.repo.LatestPosts([]string{"grace"}, []uint{3, 4}, models.PostViewer{}, cursor, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 2)
+	assert.Equal(suite.T(), uint(8), posts[0].ID)
+	assert.Equal(suite.T(), uint(7), posts[1].ID)
+}
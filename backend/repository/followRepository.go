@@ -0,0 +1,109 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type FollowRepository interface {
+	FollowOrganization(models.OrgFollowers) (models.OrgFollowers, error)
+	UnfollowOrganization(uint, uint) error
+	FindFollow(uint, uint) (models.OrgFollowers, error)
+	CountFollowers(uint) (int64, error)
+	GetFollowers(uint) ([]models.PublicUser, error)
+	GetFollowing(uint) ([]models.OrgFollowers, error)
+}
+
+type followRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewFollowRepository(db *gorm.DB) FollowRepository {
+	return followRepository{
+		DB: db,
+	}
+}
+
+// Adds a follower row for the user and organization
+func (r followRepository) FollowOrganization(follow models.OrgFollowers) (models.OrgFollowers, error) {
+	result := r.DB.Create(&follow)
+
+	if result.Error != nil {
+		return models.OrgFollowers{}, errors.New("could not follow organization")
+	}
+
+	return follow, nil
+}
+
+// Hard deletes the follower row so that the unique index allows a re-follow
+func (r followRepository) UnfollowOrganization(userId uint, orgId uint) error {
+	result := r.DB.Unscoped().
+		Where("users_id = ? AND organization_id = ?", userId, orgId).
+		Delete(&models.OrgFollowers{})
+
+	if result.Error != nil {
+		return errors.New("could not unfollow organization")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("not following organization")
+	}
+
+	return nil
+}
+
+// Finds the follower row for the user and organization
+func (r followRepository) FindFollow(userId uint, orgId uint) (models.OrgFollowers, error) {
+	var follow models.OrgFollowers
+
+	err := r.DB.Where("users_id = ? AND organization_id = ?", userId, orgId).First(&follow).Error
+
+	return follow, err
+}
+
+// Counts the followers of an organization
+func (r followRepository) CountFollowers(orgId uint) (int64, error) {
+	var count int64
+
+	result := r.DB.Model(&models.OrgFollowers{}).Where("organization_id = ?", orgId).Count(&count)
+
+	if result.Error != nil {
+		return 0, errors.New("could not count followers")
+	}
+
+	return count, nil
+}
+
+// Lists the followers of an organization, latest first
+func (r followRepository) GetFollowers(orgId uint) ([]models.PublicUser, error) {
+	followers := []models.PublicUser{}
+
+	result := r.DB.Model(&models.Users{}).
+		Select("users.id, users.handle, users.first_name, users.last_name").
+		Joins("JOIN org_followers ON org_followers.users_id = users.id AND org_followers.deleted_at IS NULL").
+		Where("org_followers.organization_id = ?", orgId).
+		Order("org_followers.id DESC").
+		Scan(&followers)
+
+	if result.Error != nil {
+		return []models.PublicUser{}, errors.New("could not retrieve followers")
+	}
+
+	return followers, nil
+}
+
+// Lists the organizations a user follows
+func (r followRepository) GetFollowing(userId uint) ([]models.OrgFollowers, error) {
+	var following []models.OrgFollowers
+
+	result := r.DB.Preload("Organization").Where("users_id = ?", userId).Find(&following)
+
+	if result.Error != nil {
+		return []models.OrgFollowers{}, errors.New("could not retrieve followed organizations")
+	}
+
+	return following, nil
+}
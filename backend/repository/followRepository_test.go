@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type FollowRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   FollowRepository
+}
+
+func (suite *FollowRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewFollowRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *FollowRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestFollowRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(FollowRepositoryUnitTestSuite))
+}
+
+func (suite *FollowRepositoryUnitTestSuite) TestFollowRepository_FollowOrganization() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec("INSERT").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sqlmock.AnyArg(),
+		uint(5), uint(3)).WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	follow := models.OrgFollowers{UsersID: 5, OrganizationID: 3}
+	if _, suite.err = suite.repo.FollowOrganization(follow); suite.err != nil {
+		suite.T().Errorf("error was not expected while following: %s", suite.err)
+	}
+}
+
+func (suite *FollowRepositoryUnitTestSuite) TestFollowRepository_UnfollowOrganization_Success() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `org_followers` WHERE users_id = ? AND organization_id = ?")).
+		WithArgs(uint(5), uint(3)).WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	if suite.err = suite.repo.UnfollowOrganization(5, 3); suite.err != nil {
+		suite.T().Errorf("error was not expected while unfollowing: %s", suite.err)
+	}
+}
+
+func (suite *FollowRepositoryUnitTestSuite) TestFollowRepository_UnfollowOrganization_NotFollowing() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec("DELETE").
+		WithArgs(uint(5), uint(3)).WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	if suite.err = suite.repo.UnfollowOrganization(5, 3); suite.err == nil {
+		suite.T().Errorf("error was expected while unfollowing")
+	}
+}
+
+func (suite *FollowRepositoryUnitTestSuite) TestFollowRepository_CountFollowers() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `org_followers`")).
+		WithArgs(uint(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	count, err := suite.repo.CountFollowers(3)
+	if err != nil {
+		suite.T().Errorf("error was not expected while counting: %s", err)
+	}
+	suite.Equal(int64(2), count)
+}
+
+func (suite *FollowRepositoryUnitTestSuite) TestFollowRepository_GetFollowing_Fail() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery("SELECT(.*)").WithArgs(uint(5)).WillReturnError(suite.err)
+
+	if _, suite.err = suite.repo.GetFollowing(5); suite.err == nil {
+		suite.T().Errorf("error was expected while listing followed organizations")
+	}
+}
+
+func (suite *FollowRepositoryUnitTestSuite) TestFollowRepository_GetFollowers() {
+	defer suite.db.Close()
+
+	// Only what anyone may see of each follower is read
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT users.id, users.handle, users.first_name, users.last_name FROM `users` JOIN org_followers ON org_followers.users_id = users.id AND org_followers.deleted_at IS NULL WHERE org_followers.organization_id = ? AND `users`.`deleted_at` IS NULL ORDER BY org_followers.id DESC")).
+		WithArgs(uint(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "handle", "first_name", "last_name"}).AddRow(5, "ada", "Ada", "Lovelace"))
+
+	followers, err := suite.repo.GetFollowers(3)
+	if err != nil {
+		suite.T().Errorf("error was not expected while listing followers: %s", err)
+	}
+	suite.Equal([]models.PublicUser{{ID: 5, Handle: "ada", FirstName: "Ada", LastName: "Lovelace"}}, followers)
+}
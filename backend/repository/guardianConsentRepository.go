@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type GuardianConsentRepository interface {
+	CreateConsent(models.GuardianConsents) (models.GuardianConsents, error)
+	GetConsents(uint) ([]models.GuardianConsents, error)
+	FindConsentByToken(string) (models.GuardianConsents, error)
+	FindApprovedConsent(uint, uint) (models.GuardianConsents, error)
+	SetConsentStatus(models.GuardianConsents, models.GuardianConsentChanges) (models.GuardianConsents, error)
+}
+
+type guardianConsentRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewGuardianConsentRepository(db *gorm.DB) GuardianConsentRepository {
+	return guardianConsentRepository{
+		DB: db,
+	}
+}
+
+// Creates a pending consent and records it was requested
+func (r guardianConsentRepository) CreateConsent(consent models.GuardianConsents) (models.GuardianConsents, error) {
+	consent.Status = models.ConsentPending
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&consent).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.GuardianConsentChanges{
+			ConsentID: consent.ID,
+			To:        consent.Status,
+		}).Error
+	})
+
+	if err != nil {
+		return models.GuardianConsents{}, errors.New("creation failed")
+	}
+
+	return consent, nil
+}
+
+// Lists the consents asked for by a user, latest first
+func (r guardianConsentRepository) GetConsents(userId uint) ([]models.GuardianConsents, error) {
+	var consents []models.GuardianConsents
+
+	result := r.DB.Where("users_id = ?", userId).Order("id DESC").Find(&consents)
+
+	if result.Error != nil {
+		return []models.GuardianConsents{}, errors.New("get failed")
+	}
+
+	return consents, nil
+}
+
+func (r guardianConsentRepository) FindConsentByToken(token string) (models.GuardianConsents, error) {
+	var consent models.GuardianConsents
+
+	result := r.DB.Where("token = ?", token).First(&consent)
+
+	if result.Error != nil {
+		return models.GuardianConsents{}, errors.New("consent not found")
+	}
+
+	return consent, nil
+}
+
+// Finds an approved consent of the user for the event or for every event
+func (r guardianConsentRepository) FindApprovedConsent(userId uint, eventId uint) (models.GuardianConsents, error) {
+	var consent models.GuardianConsents
+
+	result := r.DB.
+		Where("users_id = ? AND status = ? AND (event_id IS NULL OR event_id = ?)", userId, models.ConsentApproved, eventId).
+		First(&consent)
+
+	if result.Error != nil {
+		return models.GuardianConsents{}, errors.New("consent not found")
+	}
+
+	return consent, nil
+}
+
+// Saves the consent's new status along with the change
+func (r guardianConsentRepository) SetConsentStatus(consent models.GuardianConsents, change models.GuardianConsentChanges) (models.GuardianConsents, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&consent).Updates(map[string]interface{}{
+			"status":       change.To,
+			"responded_at": consent.RespondedAt,
+		})
+		if result.Error != nil {
+			return result.Error
+		}
+
+		return tx.Create(&change).Error
+	})
+
+	if err != nil {
+		return models.GuardianConsents{}, errors.New("update failed")
+	}
+
+	consent.Status = change.To
+
+	return consent, nil
+}
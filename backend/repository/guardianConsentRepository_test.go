@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type GuardianConsentRepositoryUnitTestSuite struct {
+	suite.Suite
+	db      *sql.DB
+	mock    sqlmock.Sqlmock
+	err     error
+	gormDB  *gorm.DB
+	repo    GuardianConsentRepository
+	consent models.GuardianConsents
+}
+
+func (suite *GuardianConsentRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewGuardianConsentRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+
+	suite.consent = models.GuardianConsents{
+		UsersID:       4,
+		GuardianName:  "Alex Lee",
+		GuardianEmail: "alex@example.com",
+		Token:         "secret",
+		ExpiresAt:     time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC),
+	}
+}
+
+func (suite *GuardianConsentRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestGuardianConsentRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(GuardianConsentRepositoryUnitTestSuite))
+}
+
+func (suite *GuardianConsentRepositoryUnitTestSuite) TestGuardianConsentRepository_CreateConsent() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `guardian_consents`")).
+		WillReturnResult(sqlmock.NewResult(9, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `guardian_consent_changes`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(9), "", models.ConsentPending, "", "").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.CreateConsent(suite.consent)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(9), res.ID)
+	assert.Equal(suite.T(), models.ConsentPending, res.Status)
+}
+
+func (suite *GuardianConsentRepositoryUnitTestSuite) TestGuardianConsentRepository_FindApprovedConsent() {
+	defer suite.db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "users_id", "event_id", "status"}).
+		AddRow(9, 4, nil, models.ConsentApproved)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `guardian_consents` WHERE (users_id = ? AND status = ? AND (event_id IS NULL OR event_id = ?))")).
+		WithArgs(uint(4), models.ConsentApproved, uint(1)).
+		WillReturnRows(rows)
+
+	res, err := suite.repo.FindApprovedConsent(4, 1)
+
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), res.EventID)
+}
+
+func (suite *GuardianConsentRepositoryUnitTestSuite) TestGuardianConsentRepository_SetConsentStatus_Fail() {
+	defer suite.db.Close()
+
+	consent := suite.consent
+	consent.ID = 9
+	consent.Status = models.ConsentPending
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `guardian_consents`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `guardian_consent_changes`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.SetConsentStatus(consent, models.GuardianConsentChanges{
+		ConsentID: 9,
+		From:      models.ConsentPending,
+		To:        models.ConsentApproved,
+	})
+
+	assert.EqualError(suite.T(), err, "update failed")
+}
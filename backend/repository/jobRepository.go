@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type JobRepository interface {
+	ScheduleJob(models.ScheduledJobs) (bool, error)
+	ClaimJobs(string, time.Time, time.Duration, int) ([]models.ScheduledJobs, error)
+	CompleteJob(models.ScheduledJobs, time.Time) error
+	RetryJob(models.ScheduledJobs, string, time.Time) error
+	FailJob(models.ScheduledJobs, string) error
+}
+
+type jobRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewJobRepository(db *gorm.DB) JobRepository {
+	return jobRepository{
+		DB: db,
+	}
+}
+
+// Saves the job unless one with its key exists. Returns whether it was new.
+func (r jobRepository) ScheduleJob(job models.ScheduledJobs) (bool, error) {
+	job.Status = models.JobPending
+
+	result := r.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&job)
+
+	if result.Error != nil {
+		return false, errors.New("could not schedule job")
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// Locks up to limit jobs due at now for the worker until now+lease, along
+// with running jobs whose lock expired. Rows locked by another instance's
+// claim are skipped, so no job is claimed twice.
+func (r jobRepository) ClaimJobs(worker string, now time.Time, lease time.Duration, limit int) ([]models.ScheduledJobs, error) {
+	var jobs []models.ScheduledJobs
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)",
+				models.JobPending, now, models.JobRunning, now).
+			Order("run_at, id").
+			Limit(limit).
+			Find(&jobs)
+
+		if result.Error != nil || len(jobs) == 0 {
+			return result.Error
+		}
+
+		ids := []uint{}
+		for i := range jobs {
+			ids = append(ids, jobs[i].ID)
+		}
+
+		lockedUntil := now.Add(lease)
+
+		result = tx.Model(&models.ScheduledJobs{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"status":       models.JobRunning,
+			"locked_by":    worker,
+			"locked_until": lockedUntil,
+			"attempts":     gorm.Expr("attempts + 1"),
+		})
+
+		for i := range jobs {
+			jobs[i].Status = models.JobRunning
+			jobs[i].LockedBy = worker
+			jobs[i].LockedUntil = &lockedUntil
+			jobs[i].Attempts++
+		}
+
+		return result.Error
+	})
+
+	if err != nil {
+		return []models.ScheduledJobs{}, errors.New("could not claim jobs")
+	}
+
+	return jobs, nil
+}
+
+// Finishes the job if its worker still holds it
+func (r jobRepository) CompleteJob(job models.ScheduledJobs, at time.Time) error {
+	return r.release(job, map[string]interface{}{
+		"status":       models.JobDone,
+		"done_at":      at,
+		"locked_until": nil,
+	})
+}
+
+// Puts the job back to run again at runAt
+func (r jobRepository) RetryJob(job models.ScheduledJobs, message string, runAt time.Time) error {
+	return r.release(job, map[string]interface{}{
+		"status":       models.JobPending,
+		"run_at":       runAt,
+		"last_error":   message,
+		"locked_until": nil,
+	})
+}
+
+// Gives up on the job
+func (r jobRepository) FailJob(job models.ScheduledJobs, message string) error {
+	return r.release(job, map[string]interface{}{
+		"status":       models.JobFailed,
+		"last_error":   message,
+		"locked_until": nil,
+	})
+}
+
+// Updates the job unless another worker claimed it since
+func (r jobRepository) release(job models.ScheduledJobs, updates map[string]interface{}) error {
+	result := r.DB.Model(&models.ScheduledJobs{}).
+		Where("id = ? AND status = ? AND locked_by = ?", job.ID, models.JobRunning, job.LockedBy).
+		Updates(updates)
+
+	if result.Error != nil {
+		return errors.New("update failed")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("job was claimed by another worker")
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type JobRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   JobRepository
+	now    time.Time
+}
+
+func (suite *JobRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewJobRepository(suite.gormDB)
+	suite.now = time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *JobRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestJobRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(JobRepositoryUnitTestSuite))
+}
+
+func (suite *JobRepositoryUnitTestSuite) TestJobRepository_ScheduleJob_Exists() {
+	defer suite.db.Close()
+
+	// Another instance scheduled it first
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("ON DUPLICATE KEY UPDATE `id`=`id`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	created, err := suite.repo.ScheduleJob(models.ScheduledJobs{Key: "plan_digests:2034-04-03", Type: models.JobPlanDigests})
+
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), created)
+}
+
+func (suite *JobRepositoryUnitTestSuite) TestJobRepository_ClaimJobs() {
+	defer suite.db.Close()
+
+	lockedUntil := suite.now.Add(5 * time.Minute)
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `scheduled_jobs` WHERE ((status = ? AND run_at <= ?) OR (status = ? AND locked_until < ?)) AND `scheduled_jobs`.`deleted_at` IS NULL ORDER BY run_at, id LIMIT 20 FOR UPDATE SKIP LOCKED")).
+		WithArgs(models.JobPending, suite.now, models.JobRunning, suite.now).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "key", "status", "attempts"}).
+			AddRow(3, "digest:4:2034-04-03", models.JobPending, 0).
+			AddRow(5, "digest:6:2034-04-03", models.JobRunning, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `scheduled_jobs` SET `attempts`=attempts + 1,`locked_by`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE id IN (?,?) AND `scheduled_jobs`.`deleted_at` IS NULL")).
+		WithArgs("web:1", lockedUntil, models.JobRunning, sqlmock.AnyArg(), 3, 5).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+
+	jobs, err := suite.repo.ClaimJobs("web:1", suite.now, 5*time.Minute, 20)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), jobs, 2)
+	assert.Equal(suite.T(), models.JobRunning, jobs[0].Status)
+	assert.Equal(suite.T(), "web:1", jobs[0].LockedBy)
+	assert.Equal(suite.T(), uint(2), jobs[1].Attempts)
+}
+
+func (suite *JobRepositoryUnitTestSuite) TestJobRepository_ClaimJobs_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `scheduled_jobs`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.ClaimJobs("web:1", suite.now, 5*time.Minute, 20)
+
+	assert.EqualError(suite.T(), err, "could not claim jobs")
+}
+
+func (suite *JobRepositoryUnitTestSuite) TestJobRepository_CompleteJob_ClaimedElsewhere() {
+	defer suite.db.Close()
+
+	job := models.ScheduledJobs{LockedBy: "web:1"}
+	job.ID = 3
+
+	// The lease ran out and another instance claimed it
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `scheduled_jobs` SET `done_at`=?,`locked_until`=?,`status`=?,`updated_at`=? WHERE (id = ? AND status = ? AND locked_by = ?) AND `scheduled_jobs`.`deleted_at` IS NULL")).
+		WithArgs(suite.now, nil, models.JobDone, sqlmock.AnyArg(), 3, models.JobRunning, "web:1").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.CompleteJob(job, suite.now)
+
+	assert.EqualError(suite.T(), err, "job was claimed by another worker")
+}
@@ -80,7 +80,7 @@ func (suite *SQLMockSuite) TestLoginRepository_SaveResetCodeToUser() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.ExpectExec("INSERT").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(),
-		sqlmock.AnyArg(), "", "", "", "", "", "", "", 0, sqlmock.AnyArg()).
+		sqlmock.AnyArg(), "", "", "", "", "", "", "", 0, false, sqlmock.AnyArg(), "", nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	suite.mock.ExpectCommit()
 
@@ -98,7 +98,7 @@ func (suite *SQLMockSuite) TestLoginRepository_ChangePassword() {
 
 	suite.mock.ExpectBegin()
 	suite.mock.ExpectExec("INSERT").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(),
-		sqlmock.AnyArg(), "", "", "", "", "", "", "", 0, sqlmock.AnyArg()).
+		sqlmock.AnyArg(), "", "", "", "", "", "", "", 0, false, sqlmock.AnyArg(), "", nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	suite.mock.ExpectCommit()
 
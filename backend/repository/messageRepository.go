@@ -0,0 +1,272 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type MessageRepository interface {
+	FindConversation(id uint) (models.Conversations, error)
+	StartConversation(conversation models.Conversations) (models.Conversations, error)
+	UserConversations(userId uint, cursor models.ConversationCursor, limit int) ([]models.Conversations, error)
+	OrganizationConversations(orgId uint, cursor models.ConversationCursor, limit int) ([]models.Conversations, error)
+	CreateMessage(message models.Messages) (models.Messages, error)
+	GetMessages(conversationId uint, beforeId uint, limit int) ([]models.Messages, error)
+	MarkRead(conversationId uint, userId uint, at time.Time) (int64, error)
+	MarkOrganizationRead(conversationId uint, orgId uint, at time.Time) (int64, error)
+	UnreadCounts(userId uint) (models.UnreadMessages, error)
+	Block(blockerId uint, blockedId uint) error
+	Unblock(blockerId uint, blockedId uint) error
+	Blocked(userId uint, otherId uint) (bool, error)
+	AreFriends(handle string, otherHandle string) (bool, error)
+}
+
+type messageRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewMessageRepository(db *gorm.DB) MessageRepository {
+	return messageRepository{
+		DB: db,
+	}
+}
+
+func (r messageRepository) FindConversation(id uint) (models.Conversations, error) {
+	var conversation models.Conversations
+
+	if err := r.DB.First(&conversation, id).Error; err != nil {
+		return models.Conversations{}, errors.New("conversation not found")
+	}
+
+	return conversation, nil
+}
+
+// Finds the conversation with the same Key, starting it if there is none
+func (r messageRepository) StartConversation(conversation models.Conversations) (models.Conversations, error) {
+	result := r.DB.Where(models.Conversations{Key: conversation.Key}).Attrs(conversation).FirstOrCreate(&conversation)
+
+	if result.Error != nil {
+		return models.Conversations{}, errors.New("could not start conversation")
+	}
+
+	return conversation, nil
+}
+
+// Lists up to limit of the user's own conversations after the cursor,
+// latest message first, with how many messages they have not read in each
+func (r messageRepository) UserConversations(userId uint, cursor models.ConversationCursor, limit int) ([]models.Conversations, error) {
+	query := r.DB.Where("user_one_id = ? OR user_two_id = ?", userId, userId)
+
+	return r.conversationPage(query, cursor, limit, "recipient_id = ?", userId)
+}
+
+// Lists up to limit of the conversations in the organization's inbox after
+// the cursor, latest message first, with how many messages its managers
+// have not read in each
+func (r messageRepository) OrganizationConversations(orgId uint, cursor models.ConversationCursor, limit int) ([]models.Conversations, error) {
+	query := r.DB.Where("organization_id = ?", orgId)
+
+	return r.conversationPage(query, cursor, limit, "recipient_organization_id = ?", orgId)
+}
+
+func (r messageRepository) conversationPage(query *gorm.DB, cursor models.ConversationCursor, limit int, recipient string, recipientId uint) ([]models.Conversations, error) {
+	var conversations []models.Conversations
+
+	if !cursor.LastMessageAt.IsZero() {
+		query = query.Where("last_message_at < ? OR (last_message_at = ? AND id < ?)",
+			cursor.LastMessageAt, cursor.LastMessageAt, cursor.ID)
+	}
+
+	if err := query.Order("last_message_at desc, id desc").Limit(limit).Find(&conversations).Error; err != nil {
+		return []models.Conversations{}, errors.New("could not retrieve conversations")
+	}
+
+	if len(conversations) == 0 {
+		return conversations, nil
+	}
+
+	ids := []uint{}
+	for _, conversation := range conversations {
+		ids = append(ids, conversation.ID)
+	}
+
+	var counts []struct {
+		ConversationsID uint
+		Unread          int64
+	}
+
+	result := r.DB.Model(&models.Messages{}).
+		Select("conversations_id, COUNT(*) AS unread").
+		Where("conversations_id IN ? AND read_at IS NULL", ids).
+		Where(recipient, recipientId).
+		Group("conversations_id").
+		Scan(&counts)
+
+	if result.Error != nil {
+		return []models.Conversations{}, errors.New("could not count unread messages")
+	}
+
+	unread := map[uint]int64{}
+	for _, count := range counts {
+		unread[count.ConversationsID] = count.Unread
+	}
+	for i := range conversations {
+		conversations[i].Unread = unread[conversations[i].ID]
+	}
+
+	return conversations, nil
+}
+
+// Saves the message, moving its conversation to the top of the lists
+func (r messageRepository) CreateMessage(message models.Messages) (models.Messages, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&message).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&models.Conversations{}).Where("id = ?", message.ConversationsID).
+			Update("last_message_at", message.CreatedAt).Error
+	})
+
+	if err != nil {
+		return models.Messages{}, errors.New("could not send message")
+	}
+
+	return message, nil
+}
+
+// Lists up to limit of the conversation's messages before the message
+// beforeId, latest first
+func (r messageRepository) GetMessages(conversationId uint, beforeId uint, limit int) ([]models.Messages, error) {
+	var messages []models.Messages
+
+	query := r.DB.Where("conversations_id = ?", conversationId)
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	if err := query.Order("id desc").Limit(limit).Find(&messages).Error; err != nil {
+		return []models.Messages{}, errors.New("could not retrieve messages")
+	}
+
+	return messages, nil
+}
+
+// Marks the messages for the user in the conversation read, returning how
+// many were not yet
+func (r messageRepository) MarkRead(conversationId uint, userId uint, at time.Time) (int64, error) {
+	result := r.DB.Model(&models.Messages{}).
+		Where("conversations_id = ? AND recipient_id = ? AND read_at IS NULL", conversationId, userId).
+		Update("read_at", at)
+
+	if result.Error != nil {
+		return 0, errors.New("could not mark messages read")
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Marks the messages for the organization in the conversation read,
+// returning how many were not yet
+func (r messageRepository) MarkOrganizationRead(conversationId uint, orgId uint, at time.Time) (int64, error) {
+	result := r.DB.Model(&models.Messages{}).
+		Where("conversations_id = ? AND recipient_organization_id = ? AND read_at IS NULL", conversationId, orgId).
+		Update("read_at", at)
+
+	if result.Error != nil {
+		return 0, errors.New("could not mark messages read")
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Counts the messages for the user, and for each organization they manage,
+// that were not read yet
+func (r messageRepository) UnreadCounts(userId uint) (models.UnreadMessages, error) {
+	unread := models.UnreadMessages{Organizations: map[uint]int64{}}
+
+	result := r.DB.Model(&models.Messages{}).
+		Where("recipient_id = ? AND read_at IS NULL", userId).
+		Count(&unread.Personal)
+	if result.Error != nil {
+		return models.UnreadMessages{}, errors.New("could not count unread messages")
+	}
+
+	var counts []struct {
+		RecipientOrganizationID uint
+		Unread                  int64
+	}
+
+	result = r.DB.Model(&models.Messages{}).
+		Select("messages.recipient_organization_id, COUNT(*) AS unread").
+		Joins("JOIN org_users ON org_users.organization_id = messages.recipient_organization_id AND org_users.deleted_at IS NULL").
+		Where("org_users.users_id = ? AND org_users.role <= ?", userId, models.RoleManager).
+		Where("messages.read_at IS NULL").
+		Group("messages.recipient_organization_id").
+		Scan(&counts)
+	if result.Error != nil {
+		return models.UnreadMessages{}, errors.New("could not count unread messages")
+	}
+
+	for _, count := range counts {
+		unread.Organizations[count.RecipientOrganizationID] = count.Unread
+	}
+
+	return unread, nil
+}
+
+func (r messageRepository) Block(blockerId uint, blockedId uint) error {
+	block := models.Blocks{BlockerID: blockerId, BlockedID: blockedId}
+
+	if err := r.DB.Where(block).FirstOrCreate(&block).Error; err != nil {
+		return errors.New("could not block user")
+	}
+
+	return nil
+}
+
+func (r messageRepository) Unblock(blockerId uint, blockedId uint) error {
+	result := r.DB.Unscoped().Where("blocker_id = ? AND blocked_id = ?", blockerId, blockedId).Delete(&models.Blocks{})
+
+	if result.Error != nil {
+		return errors.New("could not unblock user")
+	}
+
+	return nil
+}
+
+// Whether either user blocked the other
+func (r messageRepository) Blocked(userId uint, otherId uint) (bool, error) {
+	var count int64
+
+	result := r.DB.Model(&models.Blocks{}).
+		Where("(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)", userId, otherId, otherId, userId).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, errors.New("could not retrieve blocks")
+	}
+
+	return count > 0, nil
+}
+
+// Whether the two users accepted each other as friends
+func (r messageRepository) AreFriends(handle string, otherHandle string) (bool, error) {
+	var count int64
+
+	result := r.DB.Model(&models.Friend{}).
+		Where("relationship_bit = ?", "friends").
+		Where("(friend_one_handle = ? AND friend_two_handle = ?) OR (friend_one_handle = ? AND friend_two_handle = ?)",
+			handle, otherHandle, otherHandle, handle).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, errors.New("could not retrieve friends")
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,191 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type MessageRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   MessageRepository
+	now    time.Time
+}
+
+func (suite *MessageRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewMessageRepository(suite.gormDB)
+	suite.now = time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *MessageRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestMessageRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageRepositoryUnitTestSuite))
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestFindConversation_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `conversations` WHERE `conversations`.`id` = ?")).
+		WithArgs(11).
+		WillReturnError(gorm.ErrRecordNotFound)
+
+	_, err := suite.repo.FindConversation(11)
+
+	assert.EqualError(suite.T(), err, "conversation not found")
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestUserConversations() {
+	defer suite.db.Close()
+
+	cursor := models.ConversationCursor{LastMessageAt: suite.now, ID: 20}
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `conversations` WHERE (user_one_id = ? OR user_two_id = ?) AND (last_message_at < ? OR (last_message_at = ? AND id < ?)) AND `conversations`.`deleted_at` IS NULL ORDER BY last_message_at desc, id desc LIMIT 21")).
+		WithArgs(4, 4, suite.now, suite.now, 20).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_one_id"}).AddRow(11, 4).AddRow(12, 4))
+
+	// Only messages for the user count as unread
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT conversations_id, COUNT(*) AS unread FROM `messages` WHERE (conversations_id IN (?,?) AND read_at IS NULL) AND recipient_id = ? AND `messages`.`deleted_at` IS NULL GROUP BY `conversations_id`")).
+		WithArgs(11, 12, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"conversations_id", "unread"}).AddRow(12, 3))
+
+	conversations, err := suite.repo.UserConversations(4, cursor, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(0), conversations[0].Unread)
+	assert.Equal(suite.T(), int64(3), conversations[1].Unread)
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestOrganizationConversations_Empty() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `conversations` WHERE organization_id = ? AND `conversations`.`deleted_at` IS NULL ORDER BY last_message_at desc, id desc LIMIT 21")).
+		WithArgs(2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	conversations, err := suite.repo.OrganizationConversations(2, models.ConversationCursor{}, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), conversations)
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestCreateMessage_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `messages`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.CreateMessage(models.Messages{ConversationsID: 11, SenderID: 4, Body: "Hello"})
+
+	assert.EqualError(suite.T(), err, "could not send message")
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestGetMessages() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `messages` WHERE conversations_id = ? AND id < ? AND `messages`.`deleted_at` IS NULL ORDER BY id desc LIMIT 51")).
+		WithArgs(11, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(29).AddRow(28))
+
+	messages, err := suite.repo.GetMessages(11, 30, 51)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), messages, 2)
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestMarkRead() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `messages` SET `read_at`=?,`updated_at`=? WHERE (conversations_id = ? AND recipient_id = ? AND read_at IS NULL) AND `messages`.`deleted_at` IS NULL")).
+		WithArgs(suite.now, sqlmock.AnyArg(), 11, 4).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectCommit()
+
+	read, err := suite.repo.MarkRead(11, 4, suite.now)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(2), read)
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestBlocked() {
+	defer suite.db.Close()
+
+	// Either user blocking the other counts
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `blocks` WHERE ((blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)) AND `blocks`.`deleted_at` IS NULL")).
+		WithArgs(4, 6, 6, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(1))
+
+	blocked, err := suite.repo.Blocked(4, 6)
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), blocked)
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestUnblock() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `blocks` WHERE blocker_id = ? AND blocked_id = ?")).
+		WithArgs(4, 6).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.Unblock(4, 6)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageRepositoryUnitTestSuite) TestAreFriends() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `friends` WHERE relationship_bit = ? AND ((friend_one_handle = ? AND friend_two_handle = ?) OR (friend_one_handle = ? AND friend_two_handle = ?))")).
+		WithArgs("friends", "ada", "grace", "grace", "ada").
+		WillReturnRows(sqlmock.NewRows([]string{"count(*)"}).AddRow(0))
+
+	friends, err := suite.repo.AreFriends("ada", "grace")
+
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), friends)
+}
@@ -0,0 +1,234 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrAlreadyReported = errors.New("you already reported this")
+
+type ModerationRepository interface {
+	FileReport(subjectType string, subjectId uint, report models.Reports) (models.ModerationCases, error)
+	HoldContent(subjectType string, subjectId uint, reason string) (models.ModerationCases, error)
+	GetCases(status string, afterId uint, limit int) ([]models.ModerationCases, error)
+	GetCase(id uint) (models.ModerationCases, error)
+	CloseCase(moderationCase models.ModerationCases, action models.ModerationActions) (models.ModerationCases, error)
+	SetModeration(subjectType string, subjectId uint, moderation string) error
+	SuspendUser(userId uint, until time.Time) error
+}
+
+type moderationRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewModerationRepository(db *gorm.DB) ModerationRepository {
+	return moderationRepository{
+		DB: db,
+	}
+}
+
+// Adds the report to the subject's open case, opening one if there is
+// none. Each user reports a case once.
+func (r moderationRepository) FileReport(subjectType string, subjectId uint, report models.Reports) (models.ModerationCases, error) {
+	var moderationCase models.ModerationCases
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		var err error
+		if moderationCase, err = openCase(tx, subjectType, subjectId); err != nil {
+			return err
+		}
+
+		var count int64
+		if err := tx.Model(&models.Reports{}).
+			Where("moderation_cases_id = ? AND reporter_id = ?", moderationCase.ID, report.ReporterID).
+			Count(&count).Error; err != nil {
+			return err
+		}
+
+		if count > 0 {
+			return ErrAlreadyReported
+		}
+
+		report.ModerationCasesID = moderationCase.ID
+		if err := tx.Create(&report).Error; err != nil {
+			return err
+		}
+
+		moderationCase.ReportCount++
+
+		return tx.Model(&moderationCase).UpdateColumn("report_count", gorm.Expr("report_count + 1")).Error
+	})
+
+	if errors.Is(err, ErrAlreadyReported) {
+		return models.ModerationCases{}, err
+	}
+
+	if err != nil {
+		return models.ModerationCases{}, errors.New("could not file report")
+	}
+
+	return moderationCase, nil
+}
+
+// Keeps the post or comment from being shown and opens a case for it,
+// unless a moderator already hid it
+func (r moderationRepository) HoldContent(subjectType string, subjectId uint, reason string) (models.ModerationCases, error) {
+	var moderationCase models.ModerationCases
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		model, err := moderatedModel(subjectType)
+		if err != nil {
+			return err
+		}
+
+		if err := tx.Model(model).Where("id = ? AND moderation = ''", subjectId).
+			UpdateColumn("moderation", models.ModerationHeld).Error; err != nil {
+			return err
+		}
+
+		if moderationCase, err = openCase(tx, subjectType, subjectId); err != nil {
+			return err
+		}
+
+		moderationCase.HeldReason = reason
+
+		return tx.Model(&moderationCase).UpdateColumn("held_reason", reason).Error
+	})
+
+	if err != nil {
+		return models.ModerationCases{}, errors.New("could not hold content")
+	}
+
+	return moderationCase, nil
+}
+
+// Lists up to limit cases with the status after the case afterId, oldest
+// first
+func (r moderationRepository) GetCases(status string, afterId uint, limit int) ([]models.ModerationCases, error) {
+	var cases []models.ModerationCases
+
+	query := r.DB.Where("status = ?", status)
+	if afterId != 0 {
+		query = query.Where("id > ?", afterId)
+	}
+
+	result := query.Order("id").Limit(limit).Find(&cases)
+
+	if result.Error != nil {
+		return []models.ModerationCases{}, errors.New("could not retrieve cases")
+	}
+
+	return cases, nil
+}
+
+// Finds the case with its reports and the actions taken on it
+func (r moderationRepository) GetCase(id uint) (models.ModerationCases, error) {
+	var moderationCase models.ModerationCases
+
+	result := r.DB.Preload("Reports").Preload("Actions").First(&moderationCase, id)
+
+	if result.Error != nil {
+		return models.ModerationCases{}, errors.New("case not found")
+	}
+
+	return moderationCase, nil
+}
+
+// Records the moderator's action and closes the case with it
+func (r moderationRepository) CloseCase(moderationCase models.ModerationCases, action models.ModerationActions) (models.ModerationCases, error) {
+	now := time.Now()
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		action.ModerationCasesID = moderationCase.ID
+		if err := tx.Create(&action).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&moderationCase).Updates(map[string]any{
+			"status":    models.CaseClosed,
+			"action":    action.Action,
+			"closed_at": now,
+		}).Error
+	})
+
+	if err != nil {
+		return models.ModerationCases{}, errors.New("could not close case")
+	}
+
+	moderationCase.Status = models.CaseClosed
+	moderationCase.Action = action.Action
+	moderationCase.ClosedAt = &now
+	moderationCase.Actions = append(moderationCase.Actions, action)
+
+	return moderationCase, nil
+}
+
+// Sets whether the post or comment is shown, empty to show it
+func (r moderationRepository) SetModeration(subjectType string, subjectId uint, moderation string) error {
+	model, err := moderatedModel(subjectType)
+	if err != nil {
+		return err
+	}
+
+	result := r.DB.Model(model).Where("id = ?", subjectId).UpdateColumn("moderation", moderation)
+
+	if result.Error != nil {
+		return errors.New("could not update moderation")
+	}
+
+	return nil
+}
+
+func (r moderationRepository) SuspendUser(userId uint, until time.Time) error {
+	result := r.DB.Model(&models.Users{}).Where("id = ?", userId).UpdateColumn("suspended_until", until)
+
+	if result.Error != nil {
+		return errors.New("could not suspend user")
+	}
+
+	return nil
+}
+
+// Finds the subject's open case, locked, or opens one. The lock also keeps
+// others from opening another case for the subject meanwhile.
+func openCase(tx *gorm.DB, subjectType string, subjectId uint) (models.ModerationCases, error) {
+	var moderationCase models.ModerationCases
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("subject_type = ? AND subject_id = ? AND status = ?", subjectType, subjectId, models.CaseOpen).
+		Limit(1).
+		Find(&moderationCase)
+
+	if result.Error != nil {
+		return models.ModerationCases{}, result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		return moderationCase, nil
+	}
+
+	moderationCase = models.ModerationCases{
+		SubjectType: subjectType,
+		SubjectID:   subjectId,
+		Status:      models.CaseOpen,
+	}
+
+	return moderationCase, tx.Create(&moderationCase).Error
+}
+
+// The model of the kind of content that can be held or hidden
+func moderatedModel(subjectType string) (any, error) {
+	switch subjectType {
+	case models.SubjectPost:
+		return &models.Posts{}, nil
+	case models.SubjectComment:
+		return &models.Comments{}, nil
+	}
+
+	return nil, errors.New("only posts and comments can be held or hidden")
+}
@@ -0,0 +1,157 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type ModerationRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   ModerationRepository
+	report models.Reports
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewModerationRepository(suite.gormDB)
+	suite.report = models.Reports{ReporterID: 4, Reason: models.ReportSpam}
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestModerationRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(ModerationRepositoryUnitTestSuite))
+}
+
+// Expects the open case about post 3 to be locked, returning rows
+func (suite *ModerationRepositoryUnitTestSuite) expectOpenCase(rows *sqlmock.Rows) {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `moderation_cases` WHERE (subject_type = ? AND subject_id = ? AND status = ?) AND `moderation_cases`.`deleted_at` IS NULL LIMIT 1 FOR UPDATE")).
+		WithArgs(models.SubjectPost, 3, models.CaseOpen).
+		WillReturnRows(rows)
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) TestModerationRepository_FileReport_NewCase() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectOpenCase(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `moderation_cases`")).
+		WillReturnResult(sqlmock.NewResult(7, 1))
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `reports` WHERE (moderation_cases_id = ? AND reporter_id = ?) AND `reports`.`deleted_at` IS NULL")).
+		WithArgs(7, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reports`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `moderation_cases` SET `report_count`=report_count + 1 WHERE `moderation_cases`.`deleted_at` IS NULL AND `id` = ?")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.FileReport(models.SubjectPost, 3, suite.report)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(7), res.ID)
+	assert.Equal(suite.T(), uint(1), res.ReportCount)
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) TestModerationRepository_FileReport_Twice() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectOpenCase(sqlmock.NewRows([]string{"id", "subject_type", "subject_id", "status", "report_count"}).
+		AddRow(7, models.SubjectPost, 3, models.CaseOpen, 2))
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT count(*) FROM `reports`")).
+		WithArgs(7, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.FileReport(models.SubjectPost, 3, suite.report)
+
+	assert.Equal(suite.T(), ErrAlreadyReported, err)
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) TestModerationRepository_HoldContent() {
+	defer suite.db.Close()
+
+	// A post a moderator already hid stays hidden
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `posts` SET `moderation`=? WHERE (id = ? AND moderation = '') AND `posts`.`deleted_at` IS NULL")).
+		WithArgs(models.ModerationHeld, 3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.expectOpenCase(sqlmock.NewRows([]string{"id", "subject_type", "subject_id", "status"}).
+		AddRow(7, models.SubjectPost, 3, models.CaseOpen))
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `moderation_cases` SET `held_reason`=?")).
+		WithArgs(`contains "spoiler"`, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.HoldContent(models.SubjectPost, 3, `contains "spoiler"`)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), `contains "spoiler"`, res.HeldReason)
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) TestModerationRepository_CloseCase() {
+	defer suite.db.Close()
+
+	moderationCase := models.ModerationCases{SubjectType: models.SubjectPost, SubjectID: 3, Status: models.CaseOpen}
+	moderationCase.ID = 7
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `moderation_actions`")).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `moderation_cases` SET `action`=?,`closed_at`=?,`status`=?")).
+		WithArgs(models.ModerateHide, sqlmock.AnyArg(), models.CaseClosed, sqlmock.AnyArg(), 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.CloseCase(moderationCase, models.ModerationActions{ModeratorID: 1, Action: models.ModerateHide})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.CaseClosed, res.Status)
+	assert.Len(suite.T(), res.Actions, 1)
+	assert.Equal(suite.T(), uint(7), res.Actions[0].ModerationCasesID)
+}
+
+func (suite *ModerationRepositoryUnitTestSuite) TestModerationRepository_SetModeration_Event() {
+	defer suite.db.Close()
+
+	err := suite.repo.SetModeration(models.SubjectEvent, 3, models.ModerationHidden)
+
+	assert.EqualError(suite.T(), err, "only posts and comments can be held or hidden")
+}
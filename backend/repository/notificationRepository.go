@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type NotificationRepository interface {
+	CreateNotification(models.Notifications) (models.Notifications, error)
+	GetNotifications(uint, uint, int, bool) ([]models.Notifications, error)
+	CountUnread(uint) (int64, error)
+	MarkRead(uint, []uint, time.Time) (int64, error)
+	DeleteNotification(uint, uint) error
+	GetPreferences(uint) ([]models.NotificationPreferences, error)
+	SavePreference(models.NotificationPreferences) (models.NotificationPreferences, error)
+}
+
+type notificationRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewNotificationRepository(db *gorm.DB) NotificationRepository {
+	return notificationRepository{
+		DB: db,
+	}
+}
+
+func (r notificationRepository) CreateNotification(notification models.Notifications) (models.Notifications, error) {
+	result := r.DB.Create(&notification)
+
+	if result.Error != nil {
+		return models.Notifications{}, errors.New("creation failed")
+	}
+
+	return notification, nil
+}
+
+// Lists up to limit notifications of the user, latest first, older than
+// the notification beforeId unless it is 0
+func (r notificationRepository) GetNotifications(userId uint, beforeId uint, limit int, unreadOnly bool) ([]models.Notifications, error) {
+	var notifications []models.Notifications
+
+	query := r.DB.Where("users_id = ?", userId)
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+
+	result := query.Order("id DESC").Limit(limit).Find(&notifications)
+
+	if result.Error != nil {
+		return []models.Notifications{}, errors.New("get failed")
+	}
+
+	return notifications, nil
+}
+
+func (r notificationRepository) CountUnread(userId uint) (int64, error) {
+	var count int64
+
+	result := r.DB.Model(&models.Notifications{}).
+		Where("users_id = ? AND read_at IS NULL", userId).
+		Count(&count)
+
+	if result.Error != nil {
+		return 0, errors.New("could not count notifications")
+	}
+
+	return count, nil
+}
+
+// Marks the user's unread notifications with the given ids, or all of
+// them without ids, as read at
+func (r notificationRepository) MarkRead(userId uint, ids []uint, at time.Time) (int64, error) {
+	query := r.DB.Model(&models.Notifications{}).Where("users_id = ? AND read_at IS NULL", userId)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+
+	result := query.Update("read_at", at)
+
+	if result.Error != nil {
+		return 0, errors.New("update failed")
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Deletes a notification of the user
+func (r notificationRepository) DeleteNotification(userId uint, id uint) error {
+	result := r.DB.Where("users_id = ?", userId).Delete(&models.Notifications{}, id)
+
+	if result.Error != nil {
+		return errors.New("deletion failed")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("notification not found")
+	}
+
+	return nil
+}
+
+// Lists the preferences the user saved, one per type at most
+func (r notificationRepository) GetPreferences(userId uint) ([]models.NotificationPreferences, error) {
+	var preferences []models.NotificationPreferences
+
+	result := r.DB.Where("users_id = ?", userId).Find(&preferences)
+
+	if result.Error != nil {
+		return []models.NotificationPreferences{}, errors.New("get failed")
+	}
+
+	return preferences, nil
+}
+
+// Saves the user's preference for its type, replacing any previous one
+func (r notificationRepository) SavePreference(preference models.NotificationPreferences) (models.NotificationPreferences, error) {
+	result := r.DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "users_id"}, {Name: "type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "in_app", "email", "push"}),
+	}).Create(&preference)
+
+	if result.Error != nil {
+		return models.NotificationPreferences{}, errors.New("update failed")
+	}
+
+	return preference, nil
+}
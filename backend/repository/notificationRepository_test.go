@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type NotificationRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   NotificationRepository
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewNotificationRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestNotificationRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationRepositoryUnitTestSuite))
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) TestNotificationRepository_GetNotifications() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `notifications` WHERE users_id = ? AND id < ? AND read_at IS NULL AND `notifications`.`deleted_at` IS NULL ORDER BY id DESC LIMIT 3")).
+		WithArgs(4, 10).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "users_id", "type"}).
+			AddRow(9, 4, models.NotifyReaction).
+			AddRow(7, 4, models.NotifyComment))
+
+	res, err := suite.repo.GetNotifications(4, 10, 3, true)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 2)
+	assert.Equal(suite.T(), uint(9), res[0].ID)
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) TestNotificationRepository_GetNotifications_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `notifications`")).
+		WillReturnError(suite.err)
+
+	_, err := suite.repo.GetNotifications(4, 0, 20, false)
+
+	assert.EqualError(suite.T(), err, "get failed")
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) TestNotificationRepository_MarkRead_All() {
+	defer suite.db.Close()
+
+	at := time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `notifications` SET `read_at`=?,`updated_at`=? WHERE (users_id = ? AND read_at IS NULL) AND `notifications`.`deleted_at` IS NULL")).
+		WithArgs(at, sqlmock.AnyArg(), 4).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	suite.mock.ExpectCommit()
+
+	count, err := suite.repo.MarkRead(4, []uint{}, at)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(3), count)
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) TestNotificationRepository_MarkRead_Ids() {
+	defer suite.db.Close()
+
+	at := time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `notifications` SET `read_at`=?,`updated_at`=? WHERE (users_id = ? AND read_at IS NULL) AND id IN (?,?) AND `notifications`.`deleted_at` IS NULL")).
+		WithArgs(at, sqlmock.AnyArg(), 4, 7, 9).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	count, err := suite.repo.MarkRead(4, []uint{7, 9}, at)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), int64(1), count)
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) TestNotificationRepository_DeleteNotification_NotFound() {
+	defer suite.db.Close()
+
+	// Another user's notification is left alone
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `notifications` SET `deleted_at`=? WHERE users_id = ? AND `notifications`.`id` = ?")).
+		WithArgs(sqlmock.AnyArg(), 4, 9).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.DeleteNotification(4, 9)
+
+	assert.EqualError(suite.T(), err, "notification not found")
+}
+
+func (suite *NotificationRepositoryUnitTestSuite) TestNotificationRepository_SavePreference() {
+	defer suite.db.Close()
+
+	preference := models.NotificationPreferences{UsersID: 4, Type: models.NotifyReaction, Push: true}
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"ON DUPLICATE KEY UPDATE `updated_at`=VALUES(`updated_at`),`in_app`=VALUES(`in_app`),`email`=VALUES(`email`),`push`=VALUES(`push`)")).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.SavePreference(preference)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), res.ID)
+	assert.True(suite.T(), res.Push)
+}
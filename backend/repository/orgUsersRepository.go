@@ -11,6 +11,7 @@ import (
 type OrgUsersRepository interface {
 	CreateOrgUser(models.OrgUsers) (models.OrgUsers, error)
 	ListAllOrgUsers() ([]models.OrgUsers, error)
+	GetOrgMembers(uint) ([]models.OrgUsers, error)
 	FindOrgUser(uint, uint) (models.OrgUsers, error)
 	UpdateOrgUser(uint, uint, uint) (models.OrgUsers, error)
 	DeleteOrgUser(uint, uint) error
@@ -52,6 +53,15 @@ func (o orgUsersRepository) ListAllOrgUsers() ([]models.OrgUsers, error) {
 	return orgUsers, err
 }
 
+// Lists everyone with a role in the organization
+func (o orgUsersRepository) GetOrgMembers(orgId uint) ([]models.OrgUsers, error) {
+	var orgUsers []models.OrgUsers
+
+	err := o.DB.Where("organization_id = ?", orgId).Find(&orgUsers).Error
+
+	return orgUsers, err
+}
+
 // Finds a user with a role in an organization by User ID
 func (o orgUsersRepository) FindOrgUser(userId uint, orgId uint) (models.OrgUsers, error) {
 	userIdStr := strconv.FormatUint(uint64(userId), 10)
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"gorm.io/gorm"
@@ -12,22 +13,19 @@ type PostsRepository interface {
 	DeletePost(post models.Posts) error
 	EditPost(post models.Posts) (models.Posts, error)
 	FindPost(id string) (models.Posts, error)
-	AllPosts() ([]models.Posts, error)
+	AllPosts(viewer models.PostViewer) ([]models.Posts, error)
+	GetOrgPosts(orgId uint, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error)
+	GetPinnedPosts(orgId uint) ([]models.Posts, error)
+	FindViewer(userId uint) (models.PostViewer, error)
 }
 type CommentsRepository interface {
 	CreateComment(Comment models.Comments) (models.Comments, error)
 	DeleteComment(Comment models.Comments) error
-	EditComment(Comment models.Comments) (models.Comments, error)
+	EditComment(Comment models.Comments, previous string) (models.Comments, error)
 	FindComment(id string) (models.Comments, error)
-	AllComments() ([]models.Comments, error)
-}
-
-type LikesRepository interface {
-	CreateLike(Like models.Likes) (models.Likes, error)
-	DeleteLike(Like models.Likes) error
-	FindLike(id string) (models.Likes, error)
-	AllLikes() ([]models.Likes, error)
-	GetLikes(id string) (int64, error)
+	GetThreads(postId uint, afterId uint, limit int) ([]models.Comments, error)
+	GetReplies(threadIds []uint) ([]models.Comments, error)
+	GetEdits(commentId uint) ([]models.CommentEdits, error)
 }
 
 type postsRepository struct {
@@ -50,16 +48,6 @@ func NewCommentsRepository(db *gorm.DB) CommentsRepository {
 	}
 }
 
-type likesRepository struct {
-	DB *gorm.DB
-}
-
-func NewLikesRepository(db *gorm.DB) LikesRepository {
-	return likesRepository{
-		DB: db,
-	}
-}
-
 func (r postsRepository) CreatePost(post models.Posts) (models.Posts, error) {
 
 	err := r.DB.Create(&post).Error
@@ -97,9 +85,10 @@ func (r postsRepository) FindPost(id string) (models.Posts, error) {
 	return post, nil
 }
 
-func (r postsRepository) AllPosts() ([]models.Posts, error) {
+// Lists the posts the viewer can see that moderators neither hold nor hid
+func (r postsRepository) AllPosts(viewer models.PostViewer) ([]models.Posts, error) {
 	var posts []models.Posts
-	result := r.DB.Find(&posts)
+	result := visiblePosts(r.DB.Where("moderation = ''"), viewer).Find(&posts)
 
 	if result.Error != nil {
 		return []models.Posts{}, errors.New("could not retrive post")
@@ -107,6 +96,85 @@ func (r postsRepository) AllPosts() ([]models.Posts, error) {
 	return posts, nil
 }
 
+// Lists up to limit of the organization's shown posts that aren't pinned
+// and the viewer can see, before the post beforeId, latest first
+func (r postsRepository) GetOrgPosts(orgId uint, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	var posts []models.Posts
+
+	query := visiblePosts(r.DB.Where("organization_id = ? AND pinned_at IS NULL AND moderation = ''", orgId), viewer)
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	result := query.Order("id DESC").Limit(limit).Find(&posts)
+
+	if result.Error != nil {
+		return []models.Posts{}, errors.New("could not retrive posts")
+	}
+	return posts, nil
+}
+
+// Lists the organization's shown pinned posts, latest pinned first
+func (r postsRepository) GetPinnedPosts(orgId uint) ([]models.Posts, error) {
+	var posts []models.Posts
+
+	result := r.DB.Where("organization_id = ? AND pinned_at IS NOT NULL AND moderation = ''", orgId).Order("pinned_at DESC").Find(&posts)
+
+	if result.Error != nil {
+		return []models.Posts{}, errors.New("could not retrive posts")
+	}
+	return posts, nil
+}
+
+// Finds what decides which posts the user sees: their handle, their
+// friends and the organizations they belong to
+func (r postsRepository) FindViewer(userId uint) (models.PostViewer, error) {
+	return findViewer(r.DB, userId)
+}
+
+func findViewer(db *gorm.DB, userId uint) (models.PostViewer, error) {
+	var user models.Users
+	if err := db.First(&user, userId).Error; err != nil {
+		return models.PostViewer{}, errors.New("could not retrieve user")
+	}
+
+	friends, err := friendHandles(db, user.Handle)
+	if err != nil {
+		return models.PostViewer{}, err
+	}
+
+	var orgIds []uint
+	if err := db.Model(&models.OrgUsers{}).Where("users_id = ?", userId).Pluck("organization_id", &orgIds).Error; err != nil {
+		return models.PostViewer{}, errors.New("could not retrieve organizations")
+	}
+
+	return models.PostViewer{Handle: user.Handle, FriendHandles: friends, OrganizationIDs: orgIds}, nil
+}
+
+// Narrows the query to the posts the viewer can see, in line with
+// models.PostViewer.CanSee
+func visiblePosts(query *gorm.DB, viewer models.PostViewer) *gorm.DB {
+	conditions := []string{"posts.audience = ?"}
+	args := []any{models.AudiencePublic}
+
+	if viewer.Handle != "" {
+		conditions = append(conditions, "posts.handle = ?")
+		args = append(args, viewer.Handle)
+	}
+
+	if len(viewer.FriendHandles) > 0 {
+		conditions = append(conditions, "(posts.audience = ? AND posts.handle IN ?)")
+		args = append(args, models.AudienceFriends, viewer.FriendHandles)
+	}
+
+	if len(viewer.OrganizationIDs) > 0 {
+		conditions = append(conditions, "(posts.audience IN ? AND posts.audience_organization_id IN ?)")
+		args = append(args, []string{models.AudienceMembers, models.AudienceOrganization}, viewer.OrganizationIDs)
+	}
+
+	return query.Where(strings.Join(conditions, " OR "), args...)
+}
+
 func (r commentsRepository) CreateComment(comment models.Comments) (models.Comments, error) {
 
 	err := r.DB.Create(&comment).Error
@@ -124,10 +192,17 @@ func (r commentsRepository) DeleteComment(comment models.Comments) error {
 	return nil
 }
 
-func (r commentsRepository) EditComment(comment models.Comments) (models.Comments, error) {
+// Saves the edited comment along with what it said before
+func (r commentsRepository) EditComment(comment models.Comments, previous string) (models.Comments, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&models.CommentEdits{CommentsID: comment.ID, CommentDescription: previous}).Error; err != nil {
+			return err
+		}
 
-	result := r.DB.Save(&comment)
-	if result.Error != nil {
+		return tx.Save(&comment).Error
+	})
+
+	if err != nil {
 		return models.Comments{}, errors.New("could not update comment")
 	}
 
@@ -144,60 +219,50 @@ func (r commentsRepository) FindComment(id string) (models.Comments, error) {
 	return comment, nil
 }
 
-func (r commentsRepository) AllComments() ([]models.Comments, error) {
+// Lists up to limit top level comments of the post after the comment
+// afterId, oldest first. Deleted ones are included while their thread still
+// has replies.
+func (r commentsRepository) GetThreads(postId uint, afterId uint, limit int) ([]models.Comments, error) {
 	var comments []models.Comments
-	result := r.DB.Find(&comments)
 
-	if result.Error != nil {
-		return []models.Comments{}, errors.New("could not retrive comment")
-	}
-	return comments, nil
-}
-
-func (r likesRepository) CreateLike(like models.Likes) (models.Likes, error) {
-
-	err := r.DB.Create(&like).Error
+	replies := r.DB.Table("comments AS replies").Select("1").
+		Where("replies.thread_id = comments.id AND replies.deleted_at IS NULL")
 
-	return like, err
-}
-
-func (r likesRepository) DeleteLike(like models.Likes) error {
-
-	result := r.DB.Delete(&like)
-	if result.Error != nil {
-		return errors.New("could not delete like")
+	query := r.DB.Unscoped().
+		Where("posts_id = ? AND parent_id IS NULL", postId).
+		Where("deleted_at IS NULL OR EXISTS (?)", replies)
+	if afterId != 0 {
+		query = query.Where("id > ?", afterId)
 	}
 
-	return nil
-}
-
-func (r likesRepository) FindLike(id string) (models.Likes, error) {
-	var likes models.Likes
-	result := r.DB.Find(&likes, id)
+	result := query.Order("id").Limit(limit).Find(&comments)
 
 	if result.Error != nil {
-		return models.Likes{}, errors.New("could not retrive like")
+		return []models.Comments{}, errors.New("could not retrive comments")
 	}
-	return likes, nil
+	return comments, nil
 }
 
-func (r likesRepository) AllLikes() ([]models.Likes, error) {
-	var likes []models.Likes
-	result := r.DB.Find(&likes)
+// Lists every reply in the threads, deleted ones included, oldest first
+func (r commentsRepository) GetReplies(threadIds []uint) ([]models.Comments, error) {
+	var comments []models.Comments
+
+	result := r.DB.Unscoped().Where("thread_id IN ?", threadIds).Order("id").Find(&comments)
 
 	if result.Error != nil {
-		return []models.Likes{}, errors.New("could not retrive like")
+		return []models.Comments{}, errors.New("could not retrive comments")
 	}
-	return likes, nil
+	return comments, nil
 }
 
-func (r likesRepository) GetLikes(id string) (int64, error) {
-	var likes models.Likes
-	var count int64
-	result := r.DB.Where("PostID=?", id).Find(&likes).Count(&count)
+// Lists what the comment said before each edit, oldest first
+func (r commentsRepository) GetEdits(commentId uint) ([]models.CommentEdits, error) {
+	var edits []models.CommentEdits
+
+	result := r.DB.Where("comments_id = ?", commentId).Order("id").Find(&edits)
 
 	if result.Error != nil {
-		return count, errors.New("could not retrive like")
+		return []models.CommentEdits{}, errors.New("could not retrive edits")
 	}
-	return count, nil
+	return edits, nil
 }
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type PostsRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   PostsRepository
+}
+
+func (suite *PostsRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewPostsRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *PostsRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestPostsRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PostsRepositoryUnitTestSuite))
+}
+
+func (suite *PostsRepositoryUnitTestSuite) TestAllPosts_SignedOut() {
+	defer suite.db.Close()
+
+	// Those signed out only see public posts
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE moderation = '' AND posts.audience = ? AND `posts`.`deleted_at` IS NULL")).
+		WithArgs(models.AudiencePublic).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+
+	posts, err := suite.repo.AllPosts(models.PostViewer{})
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 1)
+}
+
+func (suite *PostsRepositoryUnitTestSuite) TestAllPosts_Viewer() {
+	defer suite.db.Close()
+
+	viewer := models.PostViewer{Handle: "ada", FriendHandles: []string{"grace", "linus"}}
+
+	// Their own posts and their friends' friends-only posts, but no
+	// organization's members-only posts
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE moderation = '' AND (posts.audience = ? OR posts.handle = ? OR (posts.audience = ? AND posts.handle IN (?,?))) AND `posts`.`deleted_at` IS NULL")).
+		WithArgs(models.AudiencePublic, "ada", models.AudienceFriends, "grace", "linus").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	posts, err := suite.repo.AllPosts(viewer)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), posts)
+}
+
+func (suite *PostsRepositoryUnitTestSuite) TestGetOrgPosts() {
+	defer suite.db.Close()
+
+	viewer := models.PostViewer{Handle: "ada", OrganizationIDs: []uint{2, 5}}
+
+	// Members-only and organization posts of the viewer's organizations
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE (organization_id = ? AND pinned_at IS NULL AND moderation = '') AND (posts.audience = ? OR posts.handle = ? OR (posts.audience IN (?,?) AND posts.audience_organization_id IN (?,?))) AND id < ? AND `posts`.`deleted_at` IS NULL ORDER BY id DESC LIMIT 21")).
+		WithArgs(2, models.AudiencePublic, "ada", models.AudienceMembers, models.AudienceOrganization, 2, 5, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(29).AddRow(28))
+
+	posts, err := suite.repo.GetOrgPosts(2, viewer, 30, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 2)
+}
+
+func (suite *PostsRepositoryUnitTestSuite) TestGetOrgPosts_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `posts`")).
+		WillReturnError(suite.err)
+
+	_, err := suite.repo.GetOrgPosts(2, models.PostViewer{}, 0, 21)
+
+	assert.EqualError(suite.T(), err, "could not retrive posts")
+}
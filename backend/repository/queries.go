@@ -0,0 +1,12 @@
+package repository
+
+import "strings"
+
+// Escapes the LIKE wildcards in user input so it is matched literally
+func escapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
+
+// Matches one-off events starting at or after the parameter and recurring
+// series that still have occurrences left by then. Takes the time twice.
+const upcomingEventSQL = "(events.start >= ? OR (events.recurrence <> '' AND (events.recurrence_end IS NULL OR events.recurrence_end >= ?)))"
@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ReactionRepository interface {
+	SetReaction(models.Reactions) (string, error)
+	DeleteReaction(uint, uint) (string, error)
+	GetReactionCounts(uint) ([]models.ReactionCounts, error)
+	GetReactions(uint, string, uint, int) ([]models.Reactions, error)
+}
+
+type reactionRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewReactionRepository(db *gorm.DB) ReactionRepository {
+	return reactionRepository{
+		DB: db,
+	}
+}
+
+// Sets the user's reaction to the post, replacing the one they had, and
+// updates the counts with it. Returns the type of the reaction replaced,
+// empty if there was none.
+func (r reactionRepository) SetReaction(reaction models.Reactions) (string, error) {
+	var previous string
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		existing, err := lockReaction(tx, reaction.PostsID, reaction.UsersID)
+		if err != nil {
+			return err
+		}
+
+		if existing.ID == 0 {
+			if err := tx.Create(&reaction).Error; err != nil {
+				return err
+			}
+
+			if err := countReaction(tx, reaction.PostsID, reaction.Type, 1); err != nil {
+				return err
+			}
+
+			return tx.Model(&models.Posts{}).Where("id = ?", reaction.PostsID).
+				UpdateColumn("reaction_count", gorm.Expr("reaction_count + 1")).Error
+		}
+
+		previous = existing.Type
+		if existing.Type == reaction.Type {
+			return nil
+		}
+
+		if err := tx.Model(&existing).Update("type", reaction.Type).Error; err != nil {
+			return err
+		}
+
+		if err := countReaction(tx, reaction.PostsID, previous, -1); err != nil {
+			return err
+		}
+
+		return countReaction(tx, reaction.PostsID, reaction.Type, 1)
+	})
+
+	if err != nil {
+		return "", errors.New("could not save reaction")
+	}
+
+	return previous, nil
+}
+
+// Removes the user's reaction to the post and updates the counts. Returns
+// the type of the reaction removed, empty if there was none.
+func (r reactionRepository) DeleteReaction(postId uint, userId uint) (string, error) {
+	var removed string
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		existing, err := lockReaction(tx, postId, userId)
+		if err != nil || existing.ID == 0 {
+			return err
+		}
+
+		if err := tx.Unscoped().Delete(&existing).Error; err != nil {
+			return err
+		}
+
+		if err := countReaction(tx, postId, existing.Type, -1); err != nil {
+			return err
+		}
+
+		removed = existing.Type
+
+		return tx.Model(&models.Posts{}).Where("id = ? AND reaction_count > 0", postId).
+			UpdateColumn("reaction_count", gorm.Expr("reaction_count - 1")).Error
+	})
+
+	if err != nil {
+		return "", errors.New("could not remove reaction")
+	}
+
+	return removed, nil
+}
+
+// Lists how many users reacted to the post each way, leaving out the ways
+// nobody did
+func (r reactionRepository) GetReactionCounts(postId uint) ([]models.ReactionCounts, error) {
+	var counts []models.ReactionCounts
+
+	result := r.DB.Where("posts_id = ? AND `count` > 0", postId).Find(&counts)
+
+	if result.Error != nil {
+		return []models.ReactionCounts{}, errors.New("could not retrieve reactions")
+	}
+
+	return counts, nil
+}
+
+// Lists up to limit reactions to the post before the reaction beforeId,
+// latest first, only those of reactionType unless it is empty
+func (r reactionRepository) GetReactions(postId uint, reactionType string, beforeId uint, limit int) ([]models.Reactions, error) {
+	var reactions []models.Reactions
+
+	query := r.DB.Where("posts_id = ?", postId)
+	if reactionType != "" {
+		query = query.Where("type = ?", reactionType)
+	}
+	if beforeId != 0 {
+		query = query.Where("id < ?", beforeId)
+	}
+
+	result := query.Order("id DESC").Limit(limit).Find(&reactions)
+
+	if result.Error != nil {
+		return []models.Reactions{}, errors.New("could not retrieve reactions")
+	}
+
+	return reactions, nil
+}
+
+// Finds the user's reaction to the post, locked until the transaction ends
+func lockReaction(tx *gorm.DB, postId uint, userId uint) (models.Reactions, error) {
+	var reaction models.Reactions
+
+	result := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("posts_id = ? AND users_id = ?", postId, userId).
+		Limit(1).
+		Find(&reaction)
+
+	return reaction, result.Error
+}
+
+// Adds delta, 1 or -1, to the count of the post's reactions of the type
+func countReaction(tx *gorm.DB, postId uint, reactionType string, delta int) error {
+	if delta > 0 {
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "posts_id"}, {Name: "type"}},
+			DoUpdates: clause.Assignments(map[string]any{"count": gorm.Expr("`count` + 1")}),
+		}).Create(&models.ReactionCounts{PostsID: postId, Type: reactionType, Count: 1}).Error
+	}
+
+	return tx.Model(&models.ReactionCounts{}).
+		Where("posts_id = ? AND type = ? AND `count` > 0", postId, reactionType).
+		UpdateColumn("count", gorm.Expr("`count` - 1")).Error
+}
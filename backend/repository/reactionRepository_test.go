@@ -0,0 +1,174 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type ReactionRepositoryUnitTestSuite struct {
+	suite.Suite
+	db       *sql.DB
+	mock     sqlmock.Sqlmock
+	err      error
+	gormDB   *gorm.DB
+	repo     ReactionRepository
+	reaction models.Reactions
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewReactionRepository(suite.gormDB)
+	suite.reaction = models.Reactions{PostsID: 3, UsersID: 4, Handle: "ada", Type: models.ReactionLove}
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestReactionRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(ReactionRepositoryUnitTestSuite))
+}
+
+// Expects the user's reaction to the post to be locked, returning rows
+func (suite *ReactionRepositoryUnitTestSuite) expectLock(rows *sqlmock.Rows) {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `reactions` WHERE (posts_id = ? AND users_id = ?) AND `reactions`.`deleted_at` IS NULL LIMIT 1 FOR UPDATE")).
+		WithArgs(3, 4).
+		WillReturnRows(rows)
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) TestReactionRepository_SetReaction_New() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectLock(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reactions`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"INSERT INTO `reaction_counts` (`posts_id`,`type`,`count`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `count`=`count` + 1")).
+		WithArgs(3, models.ReactionLove, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `posts` SET `reaction_count`=reaction_count + 1 WHERE id = ? AND `posts`.`deleted_at` IS NULL")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	previous, err := suite.repo.SetReaction(suite.reaction)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "", previous)
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) TestReactionRepository_SetReaction_Changed() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectLock(sqlmock.NewRows([]string{"id", "posts_id", "users_id", "type"}).AddRow(7, 3, 4, models.ReactionLike))
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `reactions` SET `type`=?,`updated_at`=?")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `reaction_counts` SET `count`=`count` - 1 WHERE posts_id = ? AND type = ? AND `count` > 0")).
+		WithArgs(3, models.ReactionLike).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reaction_counts`")).
+		WithArgs(3, models.ReactionLove, 1).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	// The post's total stays the same
+	previous, err := suite.repo.SetReaction(suite.reaction)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.ReactionLike, previous)
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) TestReactionRepository_SetReaction_Duplicate() {
+	defer suite.db.Close()
+
+	// Another request by the user got there first
+	suite.mock.ExpectBegin()
+	suite.expectLock(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `reactions`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.SetReaction(suite.reaction)
+
+	assert.EqualError(suite.T(), err, "could not save reaction")
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) TestReactionRepository_DeleteReaction() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectLock(sqlmock.NewRows([]string{"id", "posts_id", "users_id", "type"}).AddRow(7, 3, 4, models.ReactionLike))
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `reactions` WHERE `reactions`.`id` = ?")).
+		WithArgs(7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `reaction_counts` SET `count`=`count` - 1")).
+		WithArgs(3, models.ReactionLike).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"UPDATE `posts` SET `reaction_count`=reaction_count - 1 WHERE (id = ? AND reaction_count > 0) AND `posts`.`deleted_at` IS NULL")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	removed, err := suite.repo.DeleteReaction(3, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.ReactionLike, removed)
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) TestReactionRepository_DeleteReaction_None() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectLock(sqlmock.NewRows([]string{"id"}))
+	suite.mock.ExpectCommit()
+
+	removed, err := suite.repo.DeleteReaction(3, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "", removed)
+}
+
+func (suite *ReactionRepositoryUnitTestSuite) TestReactionRepository_GetReactions() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `reactions` WHERE posts_id = ? AND type = ? AND id < ? AND `reactions`.`deleted_at` IS NULL ORDER BY id DESC LIMIT 21")).
+		WithArgs(3, models.ReactionLike, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "handle"}).AddRow(29, "ada"))
+
+	reactions, err := suite.repo.GetReactions(3, models.ReactionLike, 30, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "ada", reactions[0].Handle)
+}
@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type SearchRepository interface {
+	IndexPost(post models.Posts, tags []string, handles []string) error
+	IndexComment(comment models.Comments, tags []string, handles []string) error
+	TrendingHashtags(since time.Time, limit int) ([]models.TrendingHashtag, error)
+	TaggedPosts(tag string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error)
+	MentioningPosts(handle string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error)
+	SearchPosts(text string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error)
+}
+
+type searchRepository struct {
+	DB *gorm.DB
+}
+
+func NewSearchRepository(db *gorm.DB) SearchRepository {
+	return searchRepository{
+		DB: db,
+	}
+}
+
+// Replaces the hashtags and mentions indexed for what the post says
+func (r searchRepository) IndexPost(post models.Posts, tags []string, handles []string) error {
+	return r.index(post.ID, nil, post.CreatedAt, tags, handles)
+}
+
+// Replaces the hashtags and mentions indexed for what the comment says
+func (r searchRepository) IndexComment(comment models.Comments, tags []string, handles []string) error {
+	commentId := comment.ID
+	return r.index(comment.PostsID, &commentId, comment.CreatedAt, tags, handles)
+}
+
+func (r searchRepository) index(postId uint, commentId *uint, createdAt time.Time, tags []string, handles []string) error {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		subject := tx.Where("posts_id = ?", postId)
+		if commentId != nil {
+			subject = subject.Where("comments_id = ?", *commentId)
+		} else {
+			subject = subject.Where("comments_id IS NULL")
+		}
+
+		if err := subject.Session(&gorm.Session{}).Delete(&models.Hashtags{}).Error; err != nil {
+			return err
+		}
+		if err := subject.Session(&gorm.Session{}).Delete(&models.Mentions{}).Error; err != nil {
+			return err
+		}
+
+		hashtags := []models.Hashtags{}
+		for _, tag := range tags {
+			hashtags = append(hashtags, models.Hashtags{Tag: tag, PostsID: postId, CommentsID: commentId, CreatedAt: createdAt})
+		}
+		if len(hashtags) > 0 {
+			if err := tx.Create(&hashtags).Error; err != nil {
+				return err
+			}
+		}
+
+		mentions := []models.Mentions{}
+		for _, handle := range handles {
+			mentions = append(mentions, models.Mentions{Handle: handle, PostsID: postId, CommentsID: commentId, CreatedAt: createdAt})
+		}
+		if len(mentions) > 0 {
+			return tx.Create(&mentions).Error
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return errors.New("could not index post")
+	}
+
+	return nil
+}
+
+// Lists the hashtags used most since then, in public posts and the comments
+// on them that are shown, most used first
+func (r searchRepository) TrendingHashtags(since time.Time, limit int) ([]models.TrendingHashtag, error) {
+	trending := []models.TrendingHashtag{}
+
+	result := r.DB.Model(&models.Hashtags{}).
+		Select("hashtags.tag, COUNT(*) AS uses").
+		Joins("JOIN posts ON posts.id = hashtags.posts_id AND posts.deleted_at IS NULL").
+		Joins("LEFT JOIN comments ON comments.id = hashtags.comments_id").
+		Where("hashtags.created_at >= ?", since).
+		Where("posts.audience = ? AND posts.moderation = ''", models.AudiencePublic).
+		Where("hashtags.comments_id IS NULL OR (comments.deleted_at IS NULL AND comments.moderation = '')").
+		Group("hashtags.tag").
+		Order("uses DESC, hashtags.tag").
+		Limit(limit).
+		Scan(&trending)
+
+	if result.Error != nil {
+		return []models.TrendingHashtag{}, errors.New("could not retrieve hashtags")
+	}
+
+	return trending, nil
+}
+
+// Lists up to limit of the shown posts using the hashtag that the viewer can
+// see, before the post beforeId, latest first
+func (r searchRepository) TaggedPosts(tag string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	tagged := r.DB.Model(&models.Hashtags{}).Select("posts_id").
+		Where("tag = ? AND comments_id IS NULL", tag)
+
+	return r.postPage(r.DB.Where("posts.id IN (?)", tagged), viewer, beforeId, limit)
+}
+
+// Lists up to limit of the shown posts the viewer can see that mention the
+// handle, themselves or in a shown comment, before the post beforeId, latest
+// first
+func (r searchRepository) MentioningPosts(handle string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	mentioning := r.DB.Model(&models.Mentions{}).Select("mentions.posts_id").
+		Joins("LEFT JOIN comments ON comments.id = mentions.comments_id").
+		Where("mentions.handle = ?", handle).
+		Where("mentions.comments_id IS NULL OR (comments.deleted_at IS NULL AND comments.moderation = '')")
+
+	return r.postPage(r.DB.Where("posts.id IN (?)", mentioning), viewer, beforeId, limit)
+}
+
+// Lists up to limit of the shown posts the viewer can see that match the
+// text, before the post beforeId, latest first
+func (r searchRepository) SearchPosts(text string, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	matching := r.DB.Where("MATCH(posts.post_description) AGAINST (? IN NATURAL LANGUAGE MODE)", text)
+
+	return r.postPage(matching, viewer, beforeId, limit)
+}
+
+func (r searchRepository) postPage(query *gorm.DB, viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+	var posts []models.Posts
+
+	query = visiblePosts(query.Where("posts.moderation = ''"), viewer)
+	if beforeId != 0 {
+		query = query.Where("posts.id < ?", beforeId)
+	}
+
+	result := query.Order("posts.id DESC").Limit(limit).Find(&posts)
+
+	if result.Error != nil {
+		return []models.Posts{}, errors.New("could not retrieve posts")
+	}
+
+	return posts, nil
+}
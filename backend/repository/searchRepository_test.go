@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type SearchRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   SearchRepository
+	now    time.Time
+}
+
+func (suite *SearchRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewSearchRepository(suite.gormDB)
+	suite.now = time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *SearchRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestSearchRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchRepositoryUnitTestSuite))
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestIndexComment() {
+	defer suite.db.Close()
+
+	comment := models.Comments{PostsID: 3}
+	comment.ID = 10
+	comment.CreatedAt = suite.now
+
+	// What the comment said before is forgotten
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `hashtags` WHERE posts_id = ? AND comments_id = ?")).
+		WithArgs(3, 10).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `mentions` WHERE posts_id = ? AND comments_id = ?")).
+		WithArgs(3, 10).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `hashtags` (`tag`,`posts_id`,`comments_id`,`created_at`) VALUES (?,?,?,?),(?,?,?,?)")).
+		WithArgs("cleanup", 3, 10, suite.now, "park", 3, 10, suite.now).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `mentions` (`handle`,`posts_id`,`comments_id`,`created_at`) VALUES (?,?,?,?)")).
+		WithArgs("ada", 3, 10, suite.now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.IndexComment(comment, []string{"cleanup", "park"}, []string{"ada"})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestIndexPost_Nothing() {
+	defer suite.db.Close()
+
+	post := models.Posts{}
+	post.ID = 3
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `hashtags` WHERE posts_id = ? AND comments_id IS NULL")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `mentions` WHERE posts_id = ? AND comments_id IS NULL")).
+		WithArgs(3).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.IndexPost(post, []string{}, []string{})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestIndexPost_Error() {
+	defer suite.db.Close()
+
+	post := models.Posts{}
+	post.ID = 3
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `hashtags`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	err := suite.repo.IndexPost(post, []string{"cleanup"}, []string{})
+
+	assert.EqualError(suite.T(), err, "could not index post")
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestTrendingHashtags() {
+	defer suite.db.Close()
+
+	since := suite.now.Add(-24 * time.Hour)
+
+	// Only public posts, and comments on them, that are shown
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT hashtags.tag, COUNT(*) AS uses FROM `hashtags` JOIN posts ON posts.id = hashtags.posts_id AND posts.deleted_at IS NULL LEFT JOIN comments ON comments.id = hashtags.comments_id WHERE hashtags.created_at >= ? AND (posts.audience = ? AND posts.moderation = '') AND (hashtags.comments_id IS NULL OR (comments.deleted_at IS NULL AND comments.moderation = '')) GROUP BY `hashtags`.`tag` ORDER BY uses DESC, hashtags.tag LIMIT 10")).
+		WithArgs(since, models.AudiencePublic).
+		WillReturnRows(sqlmock.NewRows([]string{"tag", "uses"}).AddRow("cleanup", 4).AddRow("park", 2))
+
+	trending, err := suite.repo.TrendingHashtags(since, 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.TrendingHashtag{{Tag: "cleanup", Uses: 4}, {Tag: "park", Uses: 2}}, trending)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestTaggedPosts_SignedOut() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE posts.id IN (SELECT `posts_id` FROM `hashtags` WHERE tag = ? AND comments_id IS NULL) AND posts.moderation = '' AND posts.audience = ? AND posts.id < ? AND `posts`.`deleted_at` IS NULL ORDER BY posts.id DESC LIMIT 21")).
+		WithArgs("cleanup", models.AudiencePublic, 30).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(29))
+
+	posts, err := suite.repo.TaggedPosts("cleanup", models.PostViewer{}, 30, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 1)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestMentioningPosts() {
+	defer suite.db.Close()
+
+	// Mentions in comments count while the comment is shown
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE posts.id IN (SELECT mentions.posts_id FROM `mentions` LEFT JOIN comments ON comments.id = mentions.comments_id WHERE mentions.handle = ? AND (mentions.comments_id IS NULL OR (comments.deleted_at IS NULL AND comments.moderation = ''))) AND posts.moderation = '' AND posts.audience = ?")).
+		WithArgs("ada", models.AudiencePublic).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	posts, err := suite.repo.MentioningPosts("ada", models.PostViewer{}, 0, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), posts)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestTaggedPosts_Friend() {
+	defer suite.db.Close()
+
+	viewer := models.PostViewer{Handle: "ada", FriendHandles: []string{"grace"}}
+
+	// Friends-only posts only of the viewer's friends
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE posts.id IN (SELECT `posts_id` FROM `hashtags` WHERE tag = ? AND comments_id IS NULL) AND posts.moderation = '' AND (posts.audience = ? OR posts.handle = ? OR (posts.audience = ? AND posts.handle IN (?))) AND `posts`.`deleted_at` IS NULL ORDER BY posts.id DESC LIMIT 21")).
+		WithArgs("cleanup", models.AudiencePublic, "ada", models.AudienceFriends, "grace").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(12))
+
+	posts, err := suite.repo.TaggedPosts("cleanup", viewer, 0, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 1)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestSearchPosts_Viewer() {
+	defer suite.db.Close()
+
+	viewer := models.PostViewer{Handle: "ada", FriendHandles: []string{"grace"}, OrganizationIDs: []uint{2}}
+
+	// Private posts only match for those who can see them
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `posts` WHERE MATCH(posts.post_description) AGAINST (? IN NATURAL LANGUAGE MODE) AND posts.moderation = '' AND (posts.audience = ? OR posts.handle = ? OR (posts.audience = ? AND posts.handle IN (?)) OR (posts.audience IN (?,?) AND posts.audience_organization_id IN (?))) AND `posts`.`deleted_at` IS NULL ORDER BY posts.id DESC LIMIT 21")).
+		WithArgs("park cleanup", models.AudiencePublic, "ada", models.AudienceFriends, "grace", models.AudienceMembers, models.AudienceOrganization, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+
+	posts, err := suite.repo.SearchPosts("park cleanup", viewer, 0, 21)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 1)
+}
+
+func (suite *SearchRepositoryUnitTestSuite) TestSearchPosts_Error() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `posts`")).
+		WillReturnError(suite.err)
+
+	_, err := suite.repo.SearchPosts("park", models.PostViewer{}, 0, 21)
+
+	assert.EqualError(suite.T(), err, "could not retrieve posts")
+}
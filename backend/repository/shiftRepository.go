@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type ShiftRepository interface {
+	CreateShift(models.EventShifts) (models.EventShifts, error)
+	GetShifts(uint) ([]models.EventShifts, error)
+	GetShiftsByIds([]uint) ([]models.EventShifts, error)
+	GetShiftById(uint) (models.EventShifts, error)
+	UpdateShift(models.EventShifts) (models.EventShifts, error)
+	DeleteShift(models.EventShifts) error
+	CountShiftSignups(uint, time.Time) (int64, error)
+	HasSignups(uint) (bool, error)
+}
+
+type shiftRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewShiftRepository(db *gorm.DB) ShiftRepository {
+	return shiftRepository{
+		DB: db,
+	}
+}
+
+// Creates a shift along with its required skills
+func (r shiftRepository) CreateShift(shift models.EventShifts) (models.EventShifts, error) {
+	result := r.DB.Create(&shift)
+
+	if result.Error != nil {
+		return models.EventShifts{}, errors.New("creation failed")
+	}
+
+	return shift, nil
+}
+
+// Lists the shifts of an event, earliest first
+func (r shiftRepository) GetShifts(eventId uint) ([]models.EventShifts, error) {
+	var shifts []models.EventShifts
+
+	result := r.DB.Preload("Skills").Where("event_id = ?", eventId).Order("start, id").Find(&shifts)
+
+	if result.Error != nil {
+		return []models.EventShifts{}, errors.New("get failed")
+	}
+
+	return shifts, nil
+}
+
+func (r shiftRepository) GetShiftsByIds(ids []uint) ([]models.EventShifts, error) {
+	var shifts []models.EventShifts
+
+	if len(ids) == 0 {
+		return []models.EventShifts{}, nil
+	}
+
+	result := r.DB.Where("id IN ?", ids).Find(&shifts)
+
+	if result.Error != nil {
+		return []models.EventShifts{}, errors.New("get failed")
+	}
+
+	return shifts, nil
+}
+
+func (r shiftRepository) GetShiftById(id uint) (models.EventShifts, error) {
+	var shift models.EventShifts
+
+	result := r.DB.Preload("Skills").First(&shift, id)
+
+	if result.Error != nil {
+		return models.EventShifts{}, errors.New("get failed")
+	}
+
+	return shift, nil
+}
+
+// Saves the shift and replaces its required skills
+func (r shiftRepository) UpdateShift(shift models.EventShifts) (models.EventShifts, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Omit("Skills").Save(&shift).Error; err != nil {
+			return err
+		}
+
+		return tx.Model(&shift).Association("Skills").Replace(shift.Skills)
+	})
+
+	if err != nil {
+		return models.EventShifts{}, errors.New("update failed")
+	}
+
+	return shift, nil
+}
+
+func (r shiftRepository) DeleteShift(shift models.EventShifts) error {
+	result := r.DB.Delete(&shift)
+
+	if result.Error != nil {
+		return errors.New("deletion failed")
+	}
+
+	return nil
+}
+
+// Counts the volunteers signed up to a shift at one occurrence
+func (r shiftRepository) CountShiftSignups(shiftId uint, occurrence time.Time) (int64, error) {
+	var count int64
+
+	result := r.DB.Model(&models.EventSignups{}).
+		Where("shift_id = ? AND occurrence_date = ?", shiftId, occurrence).
+		Count(&count)
+
+	if result.Error != nil {
+		return 0, errors.New("could not count sign-ups")
+	}
+
+	return count, nil
+}
+
+// Whether anyone signed up to the shift at any occurrence
+func (r shiftRepository) HasSignups(shiftId uint) (bool, error) {
+	var count int64
+
+	result := r.DB.Model(&models.EventSignups{}).
+		Where("shift_id = ?", shiftId).
+		Limit(1).
+		Count(&count)
+
+	if result.Error != nil {
+		return false, errors.New("could not count sign-ups")
+	}
+
+	return count > 0, nil
+}
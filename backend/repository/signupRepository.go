@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"errors"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+var ErrShiftFull = errors.New("shift is full")
+
+type SignupRepository interface {
+	CreateSignup(models.EventSignups) (models.EventSignups, error)
+	FindSignup(uint, uint, time.Time, uint) (models.EventSignups, error)
+	DeleteSignup(uint, uint, time.Time, uint) error
+	GetSignups(uint, time.Time) ([]models.EventSignups, error)
+	GetUserSignups(uint, time.Time) ([]models.EventSignups, error)
+	GetSignupsBetween(time.Time, time.Time) ([]models.EventSignups, error)
+	FindSignupByToken(string) (models.EventSignups, error)
+	SetCheckInToken(models.EventSignups, string) (models.EventSignups, error)
+}
+
+type signupRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewSignupRepository(db *gorm.DB) SignupRepository {
+	return signupRepository{
+		DB: db,
+	}
+}
+
+// Signs the user up to an occurrence of an event. Shift sign-ups are
+// counted and made while the shift is locked, so that volunteers signing
+// up at once cannot take it over capacity.
+func (r signupRepository) CreateSignup(signup models.EventSignups) (models.EventSignups, error) {
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if signup.ShiftID != 0 {
+			if err := checkCapacity(tx, signup.ShiftID, signup.OccurrenceDate); err != nil {
+				return err
+			}
+		}
+
+		return tx.Create(&signup).Error
+	})
+
+	if errors.Is(err, ErrShiftFull) {
+		return models.EventSignups{}, err
+	}
+
+	if err != nil {
+		return models.EventSignups{}, errors.New("could not sign up")
+	}
+
+	return signup, nil
+}
+
+// Finds the user's sign-up to an occurrence of an event, or of a shift
+func (r signupRepository) FindSignup(eventId uint, userId uint, occurrence time.Time, shiftId uint) (models.EventSignups, error) {
+	var signup models.EventSignups
+
+	err := r.DB.Where("event_id = ? AND users_id = ? AND occurrence_date = ? AND shift_id = ?",
+		eventId, userId, occurrence, shiftId).
+		First(&signup).Error
+
+	return signup, err
+}
+
+// Hard deletes the sign-up so that the unique index allows signing up again
+func (r signupRepository) DeleteSignup(eventId uint, userId uint, occurrence time.Time, shiftId uint) error {
+	result := r.DB.Unscoped().
+		Where("event_id = ? AND users_id = ? AND occurrence_date = ? AND shift_id = ?",
+			eventId, userId, occurrence, shiftId).
+		Delete(&models.EventSignups{})
+
+	if result.Error != nil {
+		return errors.New("could not withdraw sign-up")
+	}
+
+	if result.RowsAffected == 0 {
+		return errors.New("not signed up")
+	}
+
+	return nil
+}
+
+// Lists the sign-ups to an event along with their user, only those of one
+// occurrence unless it is zero
+func (r signupRepository) GetSignups(eventId uint, occurrence time.Time) ([]models.EventSignups, error) {
+	var signups []models.EventSignups
+
+	query := r.DB.Preload("Users").Where("event_id = ?", eventId)
+	if !occurrence.IsZero() {
+		query = query.Where("occurrence_date = ?", occurrence)
+	}
+
+	result := query.Order("occurrence_date, id").Find(&signups)
+
+	if result.Error != nil {
+		return []models.EventSignups{}, errors.New("could not get sign-ups")
+	}
+
+	return signups, nil
+}
+
+// Lists the user's sign-ups to occurrences from the given time on, along
+// with their event
+func (r signupRepository) GetUserSignups(userId uint, from time.Time) ([]models.EventSignups, error) {
+	var signups []models.EventSignups
+
+	result := r.DB.Preload("Event").
+		Where("users_id = ? AND occurrence_date >= ?", userId, from).
+		Order("occurrence_date, id").
+		Find(&signups)
+
+	if result.Error != nil {
+		return []models.EventSignups{}, errors.New("could not get sign-ups")
+	}
+
+	return signups, nil
+}
+
+// Lists the sign-ups to occurrences originally starting from from until
+// to, along with their event
+func (r signupRepository) GetSignupsBetween(from time.Time, to time.Time) ([]models.EventSignups, error) {
+	var signups []models.EventSignups
+
+	result := r.DB.Preload("Event").
+		Where("occurrence_date >= ? AND occurrence_date < ?", from, to).
+		Order("occurrence_date, id").
+		Find(&signups)
+
+	if result.Error != nil {
+		return []models.EventSignups{}, errors.New("could not get sign-ups")
+	}
+
+	return signups, nil
+}
+
+// Finds the sign-up with the check-in token, along with its user
+func (r signupRepository) FindSignupByToken(token string) (models.EventSignups, error) {
+	var signup models.EventSignups
+
+	err := r.DB.Preload("Users").Where("check_in_token = ?", token).First(&signup).Error
+
+	return signup, err
+}
+
+// Sets the token the volunteer checks in to the sign-up with
+func (r signupRepository) SetCheckInToken(signup models.EventSignups, token string) (models.EventSignups, error) {
+	result := r.DB.Model(&signup).Update("check_in_token", token)
+
+	if result.Error != nil {
+		return models.EventSignups{}, errors.New("could not create check-in code")
+	}
+
+	signup.CheckInToken = token
+
+	return signup, nil
+}
+
+// Locks the shift until the transaction ends and fails if its sign-ups at
+// the occurrence already fill it. Shifts without a capacity never fill.
+func checkCapacity(tx *gorm.DB, shiftId uint, occurrence time.Time) error {
+	var shift models.EventShifts
+
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&shift, shiftId).Error; err != nil {
+		return err
+	}
+
+	if shift.Capacity == 0 {
+		return nil
+	}
+
+	var count int64
+	if err := tx.Model(&models.EventSignups{}).
+		Where("shift_id = ? AND occurrence_date = ?", shiftId, occurrence).
+		Count(&count).Error; err != nil {
+		return err
+	}
+
+	if count >= int64(shift.Capacity) {
+		return ErrShiftFull
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type SignupRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   SignupRepository
+	signup models.EventSignups
+}
+
+func (suite *SignupRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewSignupRepository(suite.gormDB)
+	suite.signup = models.EventSignups{EventID: 2, UsersID: 4, OccurrenceDate: time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC), ShiftID: 7}
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *SignupRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestSignupRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(SignupRepositoryUnitTestSuite))
+}
+
+// Expects shift 7 to be locked and its sign-ups counted, returning count
+func (suite *SignupRepositoryUnitTestSuite) expectCount(capacity int, count int) {
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `event_shifts` WHERE `event_shifts`.`id` = ? AND `event_shifts`.`deleted_at` IS NULL ORDER BY `event_shifts`.`id` LIMIT 1 FOR UPDATE")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "capacity"}).AddRow(7, capacity))
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT count(*) FROM `event_signups` WHERE (shift_id = ? AND occurrence_date = ?) AND `event_signups`.`deleted_at` IS NULL")).
+		WithArgs(7, suite.signup.OccurrenceDate).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+}
+
+func (suite *SignupRepositoryUnitTestSuite) TestSignupRepository_CreateSignup_Shift() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.expectCount(3, 2)
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `event_signups`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	signup, err := suite.repo.CreateSignup(suite.signup)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(7), signup.ShiftID)
+}
+
+func (suite *SignupRepositoryUnitTestSuite) TestSignupRepository_CreateSignup_ShiftFull() {
+	defer suite.db.Close()
+
+	// The last place went to someone else first
+	suite.mock.ExpectBegin()
+	suite.expectCount(3, 3)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.CreateSignup(suite.signup)
+
+	assert.Equal(suite.T(), ErrShiftFull, err)
+}
+
+func (suite *SignupRepositoryUnitTestSuite) TestSignupRepository_DeleteSignup() {
+	defer suite.db.Close()
+
+	// Hard deleted, so the volunteer no longer counts as signed up anywhere
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta(
+		"DELETE FROM `event_signups` WHERE event_id = ? AND users_id = ? AND occurrence_date = ? AND shift_id = ?")).
+		WithArgs(2, 4, suite.signup.OccurrenceDate, 7).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectCommit()
+
+	err := suite.repo.DeleteSignup(2, 4, suite.signup.OccurrenceDate, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SignupRepositoryUnitTestSuite) TestSignupRepository_CreateSignup_Unlimited() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `event_shifts`")).
+		WithArgs(7).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "capacity"}).AddRow(7, 0))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `event_signups`")).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	_, err := suite.repo.CreateSignup(suite.signup)
+
+	assert.Nil(suite.T(), err)
+}
@@ -0,0 +1,238 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type TagRepository interface {
+	CreateTag(models.Tags) (models.Tags, error)
+	GetTags(string) ([]models.Tags, error)
+	GetTagById(string) (models.Tags, error)
+	GetTagsByIds([]uint) ([]models.Tags, error)
+	UpdateTag(models.Tags) (models.Tags, error)
+	DeleteTag(models.Tags) error
+	SearchTags(string, string, int) ([]models.Tags, error)
+	FindOrCreateTag(string, string) (models.Tags, error)
+	GetOwnerTags(models.Model) ([]models.Tags, error)
+	ReplaceOwnerTags(models.Model, []models.Tags) error
+	AppendOwnerTags(models.Model, []models.Tags) error
+	AllUsers() ([]models.Users, error)
+	AllOrganizations() ([]models.Organization, error)
+	AllEvents() ([]models.Event, error)
+	MigrationApplied(string) (bool, error)
+	RecordMigration(string) error
+}
+
+type tagRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return tagRepository{
+		DB: db,
+	}
+}
+
+func (r tagRepository) CreateTag(tag models.Tags) (models.Tags, error) {
+	result := r.DB.Create(&tag)
+
+	if result.Error != nil {
+		return models.Tags{}, errors.New("could not create tag")
+	}
+
+	return tag, nil
+}
+
+// Lists every tag, optionally restricted to one category
+func (r tagRepository) GetTags(category string) ([]models.Tags, error) {
+	var tags []models.Tags
+
+	query := r.DB.Order("name")
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	result := query.Find(&tags)
+
+	if result.Error != nil {
+		return []models.Tags{}, errors.New("could not retrieve tags")
+	}
+
+	return tags, nil
+}
+
+func (r tagRepository) GetTagById(id string) (models.Tags, error) {
+	var tag models.Tags
+
+	result := r.DB.First(&tag, id)
+
+	if result.Error != nil {
+		return models.Tags{}, errors.New("could not retrieve tag")
+	}
+
+	return tag, nil
+}
+
+func (r tagRepository) GetTagsByIds(ids []uint) ([]models.Tags, error) {
+	var tags []models.Tags
+
+	if len(ids) == 0 {
+		return tags, nil
+	}
+
+	result := r.DB.Where("id IN ?", ids).Find(&tags)
+
+	if result.Error != nil {
+		return []models.Tags{}, errors.New("could not retrieve tags")
+	}
+
+	return tags, nil
+}
+
+func (r tagRepository) UpdateTag(tag models.Tags) (models.Tags, error) {
+	result := r.DB.Save(&tag)
+
+	if result.Error != nil {
+		return models.Tags{}, errors.New("could not update tag")
+	}
+
+	return tag, nil
+}
+
+func (r tagRepository) DeleteTag(tag models.Tags) error {
+	result := r.DB.Delete(&tag)
+
+	if result.Error != nil {
+		return errors.New("could not delete tag")
+	}
+
+	return nil
+}
+
+// Tags whose name starts with the query, optionally within one category
+func (r tagRepository) SearchTags(query string, category string, limit int) ([]models.Tags, error) {
+	var tags []models.Tags
+
+	search := r.DB.Where("name LIKE ?", escapeLike(query)+"%")
+	if category != "" {
+		search = search.Where("category = ?", category)
+	}
+
+	result := search.Order("name").Limit(limit).Find(&tags)
+
+	if result.Error != nil {
+		return []models.Tags{}, errors.New("could not search tags")
+	}
+
+	return tags, nil
+}
+
+// Returns the tag with this name in the category, creating it if needed
+func (r tagRepository) FindOrCreateTag(name string, category string) (models.Tags, error) {
+	tag := models.Tags{Name: name, Category: category}
+
+	result := r.DB.Where("name = ? AND category = ?", name, category).FirstOrCreate(&tag)
+
+	if result.Error != nil {
+		return models.Tags{}, errors.New("could not create tag")
+	}
+
+	return tag, nil
+}
+
+// Tags linked to a user, organization or event
+func (r tagRepository) GetOwnerTags(owner models.Model) ([]models.Tags, error) {
+	var tags []models.Tags
+
+	err := r.DB.Model(owner).Association("Tags").Find(&tags)
+
+	if err != nil {
+		return []models.Tags{}, errors.New("could not retrieve tags")
+	}
+
+	return tags, nil
+}
+
+// Replaces every tag linked to a user, organization or event
+func (r tagRepository) ReplaceOwnerTags(owner models.Model, tags []models.Tags) error {
+	err := r.DB.Model(owner).Association("Tags").Replace(tags)
+
+	if err != nil {
+		return errors.New("could not update tags")
+	}
+
+	return nil
+}
+
+// Links extra tags to a user, organization or event, keeping existing ones
+func (r tagRepository) AppendOwnerTags(owner models.Model, tags []models.Tags) error {
+	err := r.DB.Model(owner).Association("Tags").Append(tags)
+
+	if err != nil {
+		return errors.New("could not update tags")
+	}
+
+	return nil
+}
+
+func (r tagRepository) AllUsers() ([]models.Users, error) {
+	var users []models.Users
+
+	result := r.DB.Find(&users)
+
+	if result.Error != nil {
+		return []models.Users{}, errors.New("could not retrieve users")
+	}
+
+	return users, nil
+}
+
+func (r tagRepository) AllOrganizations() ([]models.Organization, error) {
+	var orgs []models.Organization
+
+	result := r.DB.Find(&orgs)
+
+	if result.Error != nil {
+		return []models.Organization{}, errors.New("could not retrieve organizations")
+	}
+
+	return orgs, nil
+}
+
+func (r tagRepository) AllEvents() ([]models.Event, error) {
+	var events []models.Event
+
+	result := r.DB.Find(&events)
+
+	if result.Error != nil {
+		return []models.Event{}, errors.New("could not retrieve events")
+	}
+
+	return events, nil
+}
+
+func (r tagRepository) MigrationApplied(name string) (bool, error) {
+	var count int64
+
+	result := r.DB.Model(&models.SchemaMigrations{}).Where("name = ?", name).Count(&count)
+
+	if result.Error != nil {
+		return false, errors.New("could not check migrations")
+	}
+
+	return count > 0, nil
+}
+
+func (r tagRepository) RecordMigration(name string) error {
+	result := r.DB.Create(&models.SchemaMigrations{Name: name})
+
+	if result.Error != nil {
+		return errors.New("could not record migration")
+	}
+
+	return nil
+}
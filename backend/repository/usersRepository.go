@@ -12,6 +12,9 @@ type UsersRepository interface {
 	OneUser(id string, user models.Users) (models.Users, error)
 	UpdateUser(user models.Users) (models.Users, error)
 	DeleteUser(user models.Users) (models.Users, error)
+	FindUserByCalendarToken(token string) (models.Users, error)
+	SetCalendarToken(user models.Users, token string) (models.Users, error)
+	FindUserByHandle(handle string) (models.Users, error)
 }
 
 type usersRepository struct {
@@ -47,7 +50,7 @@ func (u usersRepository) OneUser(id string, user models.Users) (models.Users, er
 func (u usersRepository) UpdateUser(user models.Users) (models.Users, error) {
 	log.Println("[UsersRepository] Update User...")
 
-	err := u.DB.Update("Update", &user).Error
+	err := u.DB.Save(&user).Error
 
 	return user, err
 }
@@ -60,3 +63,33 @@ func (u usersRepository) DeleteUser(user models.Users) (models.Users, error) {
 
 	return user, err
 }
+
+// Find the user whose calendar feed has the token
+func (u usersRepository) FindUserByCalendarToken(token string) (models.Users, error) {
+	log.Println("[UsersRepository] Find user by calendar token...")
+
+	var user models.Users
+	err := u.DB.Where("calendar_token = ?", token).First(&user).Error
+
+	return user, err
+}
+
+// Replace the token of the user's calendar feed
+func (u usersRepository) SetCalendarToken(user models.Users, token string) (models.Users, error) {
+	log.Println("[UsersRepository] Set calendar token...")
+
+	err := u.DB.Model(&user).Update("calendar_token", token).Error
+	user.CalendarToken = token
+
+	return user, err
+}
+
+// Find the user with the handle
+func (u usersRepository) FindUserByHandle(handle string) (models.Users, error) {
+	log.Println("[UsersRepository] Find user by handle...")
+
+	var user models.Users
+	err := u.DB.Where("handle = ?", handle).First(&user).Error
+
+	return user, err
+}
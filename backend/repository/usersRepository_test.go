@@ -26,7 +26,7 @@ func TestUsersRepository_CreateUser(t *testing.T) {
 	// choose insert and mock the args
 	// will return result has just random
 	mock.ExpectExec("INSERT").WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(),
-		sqlmock.AnyArg(), "", "", "", "", "", "", "", 0, sqlmock.AnyArg()).
+		sqlmock.AnyArg(), "", "", "", "", "", "", "", 0, false, sqlmock.AnyArg(), "", nil).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 	mock.ExpectCommit()
 
@@ -0,0 +1,201 @@
+package repository
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"gorm.io/gorm"
+)
+
+type WaiverRepository interface {
+	CreateWaiver(models.Waivers) (models.Waivers, error)
+	GetWaiverById(uint) (models.Waivers, error)
+	GetOrganizationWaivers(uint) ([]models.Waivers, error)
+	GetEventWaivers(uint, uint) ([]models.Waivers, error)
+	UpdateWaiver(models.Waivers) (models.Waivers, error)
+	DeleteWaiver(models.Waivers) error
+	GetWaiverVersions(uint) ([]models.WaiverVersions, error)
+	CreateAcceptance(models.WaiverAcceptances) (models.WaiverAcceptances, error)
+	GetAcceptances(uint, []uint) ([]models.WaiverAcceptances, error)
+	CreateBackgroundCheck(models.BackgroundChecks) (models.BackgroundChecks, error)
+	FindBackgroundCheck(uint, uint) (models.BackgroundChecks, error)
+}
+
+type waiverRepository struct {
+	DB *gorm.DB
+}
+
+// Instantiated in router.go
+func NewWaiverRepository(db *gorm.DB) WaiverRepository {
+	return waiverRepository{
+		DB: db,
+	}
+}
+
+// Creates a waiver along with its first version
+func (r waiverRepository) CreateWaiver(waiver models.Waivers) (models.Waivers, error) {
+	waiver.Version = 1
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&waiver).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.WaiverVersions{
+			WaiverID: waiver.ID,
+			Version:  waiver.Version,
+			Title:    waiver.Title,
+			Body:     waiver.Body,
+		}).Error
+	})
+
+	if err != nil {
+		return models.Waivers{}, errors.New("creation failed")
+	}
+
+	return waiver, nil
+}
+
+func (r waiverRepository) GetWaiverById(id uint) (models.Waivers, error) {
+	var waiver models.Waivers
+
+	result := r.DB.First(&waiver, id)
+
+	if result.Error != nil {
+		return models.Waivers{}, errors.New("get failed")
+	}
+
+	return waiver, nil
+}
+
+// Lists the waivers of an organization, for all its events or one
+func (r waiverRepository) GetOrganizationWaivers(orgId uint) ([]models.Waivers, error) {
+	var waivers []models.Waivers
+
+	result := r.DB.Where("organization_id = ?", orgId).Order("id").Find(&waivers)
+
+	if result.Error != nil {
+		return []models.Waivers{}, errors.New("get failed")
+	}
+
+	return waivers, nil
+}
+
+// Lists the waivers that apply to an event: those of its organization for
+// all events and those for the event itself
+func (r waiverRepository) GetEventWaivers(orgId uint, eventId uint) ([]models.Waivers, error) {
+	var waivers []models.Waivers
+
+	result := r.DB.
+		Where("organization_id = ? AND (event_id IS NULL OR event_id = ?)", orgId, eventId).
+		Order("id").
+		Find(&waivers)
+
+	if result.Error != nil {
+		return []models.Waivers{}, errors.New("get failed")
+	}
+
+	return waivers, nil
+}
+
+// Saves the waiver as its next version, keeping the previous text
+func (r waiverRepository) UpdateWaiver(waiver models.Waivers) (models.Waivers, error) {
+	waiver.Version++
+
+	err := r.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&waiver).Error; err != nil {
+			return err
+		}
+
+		return tx.Create(&models.WaiverVersions{
+			WaiverID: waiver.ID,
+			Version:  waiver.Version,
+			Title:    waiver.Title,
+			Body:     waiver.Body,
+		}).Error
+	})
+
+	if err != nil {
+		return models.Waivers{}, errors.New("update failed")
+	}
+
+	return waiver, nil
+}
+
+func (r waiverRepository) DeleteWaiver(waiver models.Waivers) error {
+	result := r.DB.Delete(&waiver)
+
+	if result.Error != nil {
+		return errors.New("deletion failed")
+	}
+
+	return nil
+}
+
+// Lists the versions of a waiver, oldest first
+func (r waiverRepository) GetWaiverVersions(waiverId uint) ([]models.WaiverVersions, error) {
+	var versions []models.WaiverVersions
+
+	result := r.DB.Where("waiver_id = ?", waiverId).Order("version").Find(&versions)
+
+	if result.Error != nil {
+		return []models.WaiverVersions{}, errors.New("get failed")
+	}
+
+	return versions, nil
+}
+
+func (r waiverRepository) CreateAcceptance(acceptance models.WaiverAcceptances) (models.WaiverAcceptances, error) {
+	result := r.DB.Create(&acceptance)
+
+	if result.Error != nil {
+		return models.WaiverAcceptances{}, errors.New("creation failed")
+	}
+
+	return acceptance, nil
+}
+
+// Lists the acceptances of the user for any of the waivers
+func (r waiverRepository) GetAcceptances(userId uint, waiverIds []uint) ([]models.WaiverAcceptances, error) {
+	var acceptances []models.WaiverAcceptances
+
+	if len(waiverIds) == 0 {
+		return []models.WaiverAcceptances{}, nil
+	}
+
+	result := r.DB.Where("users_id = ? AND waiver_id IN ?", userId, waiverIds).
+		Order("waiver_id, version").
+		Find(&acceptances)
+
+	if result.Error != nil {
+		return []models.WaiverAcceptances{}, errors.New("get failed")
+	}
+
+	return acceptances, nil
+}
+
+func (r waiverRepository) CreateBackgroundCheck(check models.BackgroundChecks) (models.BackgroundChecks, error) {
+	result := r.DB.Create(&check)
+
+	if result.Error != nil {
+		return models.BackgroundChecks{}, errors.New("creation failed")
+	}
+
+	return check, nil
+}
+
+// Finds the latest background check of the user cleared by the
+// organization
+func (r waiverRepository) FindBackgroundCheck(userId uint, orgId uint) (models.BackgroundChecks, error) {
+	var check models.BackgroundChecks
+
+	result := r.DB.Where("users_id = ? AND organization_id = ?", userId, orgId).
+		Order("cleared_at DESC").
+		First(&check)
+
+	if result.Error != nil {
+		return models.BackgroundChecks{}, errors.New("background check not found")
+	}
+
+	return check, nil
+}
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"database/sql"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+)
+
+type WaiverRepositoryUnitTestSuite struct {
+	suite.Suite
+	db     *sql.DB
+	mock   sqlmock.Sqlmock
+	err    error
+	gormDB *gorm.DB
+	repo   WaiverRepository
+	waiver models.Waivers
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) SetupTest() {
+	suite.db, suite.mock, suite.err = sqlmock.New()
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.gormDB, suite.err = gorm.Open(mysql.New(mysql.Config{
+		Conn:                      suite.db,
+		DriverName:                "mysql",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{})
+	if suite.err != nil {
+		suite.T().Fatalf("an error '%s' was not expected when opening a stub database connection", suite.err)
+	}
+
+	suite.repo = NewWaiverRepository(suite.gormDB)
+	suite.err = fmt.Errorf("error")
+
+	suite.waiver = models.Waivers{OrganizationID: 3, Title: "Liability", Body: "I take part at my own risk."}
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) AfterTest(_, _ string) {
+	if suite.err = suite.mock.ExpectationsWereMet(); suite.err != nil {
+		suite.T().Errorf("there were unfulfilled expectations: %s", suite.err)
+	}
+}
+
+func TestWaiverRepositoryUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(WaiverRepositoryUnitTestSuite))
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) TestWaiverRepository_CreateWaiver() {
+	defer suite.db.Close()
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `waivers`")).
+		WillReturnResult(sqlmock.NewResult(6, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `waiver_versions`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(6), uint(1), suite.waiver.Title, suite.waiver.Body).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.CreateWaiver(suite.waiver)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(6), res.ID)
+	assert.Equal(suite.T(), uint(1), res.Version)
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) TestWaiverRepository_UpdateWaiver_NextVersion() {
+	defer suite.db.Close()
+
+	waiver := suite.waiver
+	waiver.ID = 6
+	waiver.Version = 1
+	waiver.Body = "Updated"
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `waivers`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `waiver_versions`")).
+		WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), nil, uint(6), uint(2), waiver.Title, "Updated").
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	suite.mock.ExpectCommit()
+
+	res, err := suite.repo.UpdateWaiver(waiver)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), res.Version)
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) TestWaiverRepository_UpdateWaiver_Fail() {
+	defer suite.db.Close()
+
+	waiver := suite.waiver
+	waiver.ID = 6
+	waiver.Version = 1
+
+	suite.mock.ExpectBegin()
+	suite.mock.ExpectExec(regexp.QuoteMeta("UPDATE `waivers`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	suite.mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `waiver_versions`")).
+		WillReturnError(suite.err)
+	suite.mock.ExpectRollback()
+
+	_, err := suite.repo.UpdateWaiver(waiver)
+
+	assert.EqualError(suite.T(), err, "update failed")
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) TestWaiverRepository_GetEventWaivers() {
+	defer suite.db.Close()
+
+	rows := sqlmock.NewRows([]string{"id", "organization_id", "event_id", "title", "version"}).
+		AddRow(6, 3, nil, "Liability", 2)
+
+	suite.mock.ExpectQuery(regexp.QuoteMeta(
+		"SELECT * FROM `waivers` WHERE (organization_id = ? AND (event_id IS NULL OR event_id = ?))")).
+		WithArgs(uint(3), uint(1)).
+		WillReturnRows(rows)
+
+	res, err := suite.repo.GetEventWaivers(3, 1)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+	assert.Equal(suite.T(), uint(2), res[0].Version)
+}
+
+func (suite *WaiverRepositoryUnitTestSuite) TestWaiverRepository_GetAcceptances_NoWaivers() {
+	defer suite.db.Close()
+
+	res, err := suite.repo.GetAcceptances(4, []uint{})
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), res)
+}
@@ -1,9 +1,19 @@
 package server
 
 import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/contentfilter"
 	"github.com/VolunteerOne/volunteer-one-app/backend/controllers"
 	"github.com/VolunteerOne/volunteer-one-app/backend/database"
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
 	"github.com/VolunteerOne/volunteer-one-app/backend/middleware"
+	"github.com/VolunteerOne/volunteer-one-app/backend/push"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 	"github.com/VolunteerOne/volunteer-one-app/backend/service"
 	"github.com/gin-gonic/gin"
@@ -26,21 +36,70 @@ func NewRouter() *gin.Engine {
 	eventRepository := repository.NewEventRepository(database.GetDatabase())
 	postsRepository := repository.NewPostsRepository(database.GetDatabase())
 	commentsRepository := repository.NewCommentsRepository(database.GetDatabase())
-	likesRepository := repository.NewLikesRepository(database.GetDatabase())
+	reactionRepository := repository.NewReactionRepository(database.GetDatabase())
+	followRepository := repository.NewFollowRepository(database.GetDatabase())
+	feedRepository := repository.NewFeedRepository(database.GetDatabase())
+	tagRepository := repository.NewTagRepository(database.GetDatabase())
+	signupRepository := repository.NewSignupRepository(database.GetDatabase())
+	shiftRepository := repository.NewShiftRepository(database.GetDatabase())
+	attendanceRepository := repository.NewAttendanceRepository(database.GetDatabase())
+	waiverRepository := repository.NewWaiverRepository(database.GetDatabase())
+	guardianConsentRepository := repository.NewGuardianConsentRepository(database.GetDatabase())
+	notificationRepository := repository.NewNotificationRepository(database.GetDatabase())
+	jobRepository := repository.NewJobRepository(database.GetDatabase())
+	deviceRepository := repository.NewDeviceRepository(database.GetDatabase())
+	moderationRepository := repository.NewModerationRepository(database.GetDatabase())
+	searchRepository := repository.NewSearchRepository(database.GetDatabase())
+	messageRepository := repository.NewMessageRepository(database.GetDatabase())
+	discussionRepository := repository.NewDiscussionRepository(database.GetDatabase())
 
 	// *********************************************************
 	// INITIALIZE SERVICES HERE
 	// *********************************************************
 
+	addressGeocoder := geocoder.FromEnvironment()
+	emailMailer := mailer.FromEnvironment()
+	realtimeHub := realtime.NewHub(realtime.NewLocalBroker())
+	pushDispatcher := push.FromEnvironment()
+	// Ranked feeds, kept for 10 minutes while users page through them
+	feedCache := feedcache.NewMemoryCache(10*time.Minute, 10000)
+	// Checks posts and comments before they are saved
+	contentFilter := contentfilter.FromEnvironment()
+
+	// Other services notify users through it
+	pushService := service.NewPushService(deviceRepository, loginRepository, jobRepository, pushDispatcher)
+	notificationService := service.NewNotificationService(notificationRepository, usersRepository, emailMailer, realtimeHub, pushService)
+
 	loginService := service.NewLoginService(loginRepository)
 	usersService := service.NewUsersService(usersRepository)
-	friendService := service.NewFriendService(friendRepository)
-	organizationService := service.NewOrganizationService(organizationRepository)
-	orgUsersService := service.NewOrgUsersService(orgUsersRepository)
-	eventService := service.NewEventService(eventRepository)
-	postsService := service.NewPostsService(postsRepository)
-	commentsService := service.NewCommentsService(commentsRepository)
-	likesService := service.NewLikesService(likesRepository)
+	friendService := service.NewFriendService(friendRepository, usersRepository, notificationService, feedCache)
+	organizationService := service.NewOrganizationService(organizationRepository, orgUsersRepository, addressGeocoder)
+	orgUsersService := service.NewOrgUsersService(orgUsersRepository, organizationRepository, notificationService)
+	eventService := service.NewEventService(eventRepository, orgUsersRepository, signupRepository, addressGeocoder, notificationService)
+	postsService := service.NewPostsService(postsRepository, usersRepository, orgUsersRepository, organizationRepository, followRepository, eventRepository, notificationService, feedCache, moderationRepository, contentFilter, searchRepository)
+	commentsService := service.NewCommentsService(commentsRepository, postsRepository, usersRepository, orgUsersRepository, notificationService, realtimeHub, moderationRepository, contentFilter, searchRepository)
+	reactionService := service.NewReactionService(reactionRepository, postsRepository, usersRepository, notificationService, realtimeHub)
+	followService := service.NewFollowService(followRepository, feedCache)
+	feedService := service.NewFeedService(feedRepository, feedCache)
+	tagService := service.NewTagService(tagRepository, eventRepository, orgUsersRepository)
+	searchService := service.NewSearchService(searchRepository, postsRepository)
+	messageService := service.NewMessageService(messageRepository, usersRepository, orgUsersRepository, organizationRepository, notificationService, realtimeHub)
+	discussionService := service.NewDiscussionService(discussionRepository, eventRepository, orgUsersRepository, usersRepository, notificationService)
+	signupService := service.NewSignupService(signupRepository, eventRepository, shiftRepository, tagRepository, usersRepository, waiverRepository, guardianConsentRepository, emailMailer, notificationService, realtimeHub, orgUsersRepository)
+	shiftService := service.NewShiftService(shiftRepository, eventRepository, tagRepository, orgUsersRepository)
+	calendarService := service.NewCalendarService(eventRepository, signupRepository, shiftRepository, usersRepository)
+	attendanceService := service.NewAttendanceService(attendanceRepository, signupRepository, eventRepository, shiftRepository, orgUsersRepository, realtimeHub)
+	eventStatusService := service.NewEventStatusService(eventRepository, orgUsersRepository, signupRepository, shiftRepository, attendanceRepository, emailMailer, notificationService, feedCache)
+	waiverService := service.NewWaiverService(waiverRepository, guardianConsentRepository, eventRepository, orgUsersRepository, usersRepository)
+	streamService := service.NewStreamService(realtimeHub, postsRepository, eventRepository, orgUsersRepository)
+	guardianConsentService := service.NewGuardianConsentService(guardianConsentRepository, usersRepository, eventRepository, emailMailer, os.Getenv("APP_URL"))
+	moderationService := service.NewModerationService(moderationRepository, postsRepository, commentsRepository, usersRepository, organizationRepository, eventRepository, orgUsersRepository, loginRepository, notificationService, feedCache)
+	schedulerService := service.NewSchedulerService(jobRepository, signupRepository, eventRepository, shiftRepository, feedRepository, notificationService, pushService)
+
+	// Sends reminders and digests in the background
+	if os.Getenv("SCHEDULER_DISABLED") == "" {
+		go schedulerService.Start(context.Background())
+	}
 
 
 	// *********************************************************
@@ -52,10 +111,30 @@ func NewRouter() *gin.Engine {
 	friendController := controllers.NewFriendController(friendService)
 	organizationController := controllers.NewOrganizationController(organizationService)
 	orgUsersController := controllers.NewOrgUsersController(orgUsersService)
-	eventController := controllers.NewEventController(eventService)
+	eventController := controllers.NewEventController(eventService, eventStatusService)
 	postsController := controllers.NewPostsController(postsService)
 	commentsController := controllers.NewCommentsController(commentsService)
-	likesController := controllers.NewLikesController(likesService)
+	reactionController := controllers.NewReactionController(reactionService)
+	followController := controllers.NewFollowController(followService)
+	feedController := controllers.NewFeedController(feedService)
+	tagController := controllers.NewTagController(tagService)
+	searchController := controllers.NewSearchController(searchService)
+	messageController := controllers.NewMessageController(messageService)
+	discussionController := controllers.NewDiscussionController(discussionService)
+	signupController := controllers.NewSignupController(signupService)
+	shiftController := controllers.NewShiftController(shiftService)
+	calendarController := controllers.NewCalendarController(calendarService)
+	attendanceController := controllers.NewAttendanceController(attendanceService)
+	eventStatusController := controllers.NewEventStatusController(eventStatusService)
+	waiverController := controllers.NewWaiverController(waiverService)
+	guardianConsentController := controllers.NewGuardianConsentController(guardianConsentService)
+	notificationController := controllers.NewNotificationController(notificationService)
+	deviceController := controllers.NewDeviceController(pushService)
+	streamController := controllers.NewStreamController(streamService)
+	moderationController := controllers.NewModerationController(moderationService)
+
+	// Platform administrators only, must come after middleware.BasicAuth
+	adminAuth := middleware.AdminAuth(usersRepository)
 
 	userGroup := router.Group("user")
 
@@ -63,7 +142,19 @@ func NewRouter() *gin.Engine {
 	userGroup.POST("/", usersController.Create)
 	userGroup.GET("/:id", middleware.BasicAuth, usersController.One)
 	userGroup.DELETE("/:id", usersController.Delete)
-	userGroup.PUT("/:id", usersController.Update)
+	userGroup.PUT("/:id", middleware.BasicAuth, usersController.Update)
+	userGroup.PUT("/:id/birthdate", middleware.BasicAuth, adminAuth, usersController.SetBirthdate)
+	userGroup.GET("/:id/following", followController.Following)
+	userGroup.GET("/:id/feed", middleware.BasicAuth, feedController.UserFeed)
+	userGroup.GET("/:id/tags", tagController.UserTags)
+	userGroup.PUT("/:id/tags", middleware.BasicAuth, tagController.SetUserTags)
+	userGroup.GET("/:id/calendar", middleware.BasicAuth, calendarController.CalendarURL)
+	userGroup.POST("/:id/calendar", middleware.BasicAuth, calendarController.ResetCalendarURL)
+	userGroup.GET("/:id/consents", middleware.BasicAuth, guardianConsentController.Consents)
+	userGroup.POST("/:id/consents", middleware.BasicAuth, guardianConsentController.RequestConsent)
+	userGroup.POST("/:id/report", middleware.BasicAuth, moderationController.ReportUser)
+	userGroup.PUT("/:id/block", middleware.BasicAuth, messageController.Block)
+	userGroup.DELETE("/:id/block", middleware.BasicAuth, messageController.Unblock)
 
 	loginGroup := router.Group("login")
 
@@ -79,25 +170,115 @@ func NewRouter() *gin.Engine {
 	loginGroup.POST("/refresh", loginController.RefreshToken)
 
 	organizationGroup := router.Group("organization")
-	organizationGroup.POST("/", organizationController.Create)
+	organizationGroup.POST("/", middleware.BasicAuth, organizationController.Create)
 	organizationGroup.GET("/", organizationController.All)
 	organizationGroup.GET("/:id", organizationController.One)
 	organizationGroup.DELETE("/:id", organizationController.Delete)
 	organizationGroup.PUT("/:id", organizationController.Update)
+	organizationGroup.POST("/:id/follow", middleware.BasicAuth, followController.Follow)
+	organizationGroup.DELETE("/:id/follow", middleware.BasicAuth, followController.Unfollow)
+	organizationGroup.GET("/:id/followers", followController.Followers)
+	organizationGroup.GET("/:id/posts", middleware.OptionalAuth, postsController.OrganizationPosts)
+	organizationGroup.GET("/:id/tags", tagController.OrganizationTags)
+	organizationGroup.PUT("/:id/tags", middleware.BasicAuth, tagController.SetOrganizationTags)
+	organizationGroup.GET("/:id/waivers", waiverController.OrganizationWaivers)
+	organizationGroup.POST("/:id/waivers", middleware.BasicAuth, waiverController.Create)
+	organizationGroup.PUT("/:id/backgroundChecks/:userId", middleware.BasicAuth, waiverController.RecordBackgroundCheck)
+	organizationGroup.POST("/:id/report", middleware.BasicAuth, moderationController.ReportOrganization)
 
 	eventGroup := router.Group("event")
-	eventGroup.POST("/", eventController.Create)
-	eventGroup.GET("/", eventController.All)
-	eventGroup.GET("/:id", eventController.One)
-	eventGroup.DELETE("/:id", eventController.Delete)
-	eventGroup.PUT("/:id", eventController.Update)
+	eventGroup.POST("/", middleware.BasicAuth, eventController.Create)
+	eventGroup.GET("/", middleware.OptionalAuth, eventController.All)
+	eventGroup.GET("/search", middleware.OptionalAuth, eventController.Search)
+	eventGroup.GET("/nearby", middleware.OptionalAuth, eventController.Nearby)
+	eventGroup.GET("/occurrences", middleware.OptionalAuth, eventController.Occurrences)
+	eventGroup.GET("/:id", middleware.OptionalAuth, eventController.One)
+	eventGroup.DELETE("/:id", middleware.BasicAuth, eventController.Delete)
+	eventGroup.PUT("/:id", middleware.BasicAuth, eventController.Update)
+	eventGroup.GET("/:id/tags", middleware.OptionalAuth, tagController.EventTags)
+	eventGroup.PUT("/:id/tags", middleware.BasicAuth, tagController.SetEventTags)
+	eventGroup.GET("/:id/occurrences", middleware.OptionalAuth, eventController.EventOccurrences)
+	eventGroup.PUT("/:id/occurrences", middleware.BasicAuth, eventController.UpdateOccurrence)
+	eventGroup.POST("/:id/exceptions", middleware.BasicAuth, eventController.CancelOccurrence)
+	eventGroup.DELETE("/:id/exceptions", middleware.BasicAuth, eventController.RestoreOccurrence)
+	eventGroup.GET("/:id/signups", middleware.BasicAuth, signupController.Signups)
+	eventGroup.POST("/:id/signups", middleware.BasicAuth, signupController.SignUp)
+	eventGroup.DELETE("/:id/signups", middleware.BasicAuth, signupController.Withdraw)
+	eventGroup.GET("/:id/shifts", middleware.OptionalAuth, shiftController.All)
+	eventGroup.POST("/:id/shifts", middleware.BasicAuth, shiftController.Create)
+	eventGroup.PUT("/:id/shifts/:shiftId", middleware.BasicAuth, shiftController.Update)
+	eventGroup.DELETE("/:id/shifts/:shiftId", middleware.BasicAuth, shiftController.Delete)
+	eventGroup.GET("/:id/ics", calendarController.EventCalendar)
+	eventGroup.GET("/:id/checkin", middleware.BasicAuth, attendanceController.CheckInCode)
+	eventGroup.POST("/:id/checkin", middleware.BasicAuth, attendanceController.CheckIn)
+	eventGroup.GET("/:id/attendance", middleware.BasicAuth, attendanceController.Roster)
+	eventGroup.GET("/:id/hours", middleware.BasicAuth, attendanceController.Hours)
+	eventGroup.PUT("/:id/hours/:hoursId", middleware.BasicAuth, attendanceController.VerifyHours)
+	eventGroup.GET("/:id/status", middleware.BasicAuth, eventStatusController.StatusHistory)
+	eventGroup.PUT("/:id/status", middleware.BasicAuth, eventStatusController.ChangeStatus)
+	eventGroup.GET("/:id/requirements", middleware.BasicAuth, waiverController.Requirements)
+	eventGroup.POST("/:id/report", middleware.BasicAuth, moderationController.ReportEvent)
+	eventGroup.GET("/:id/discussion", middleware.BasicAuth, discussionController.Messages)
+	eventGroup.POST("/:id/discussion", middleware.BasicAuth, discussionController.PostMessage)
+	eventGroup.DELETE("/:id/discussion/:messageId", middleware.BasicAuth, discussionController.DeleteMessage)
+	eventGroup.PUT("/:id/discussion/:messageId/pin", middleware.BasicAuth, discussionController.PinMessage)
+	eventGroup.DELETE("/:id/discussion/:messageId/pin", middleware.BasicAuth, discussionController.UnpinMessage)
+
+	waiversGroup := router.Group("waivers")
+	waiversGroup.GET("/:id", waiverController.One)
+	waiversGroup.PUT("/:id", middleware.BasicAuth, waiverController.Update)
+	waiversGroup.DELETE("/:id", middleware.BasicAuth, waiverController.Delete)
+	waiversGroup.GET("/:id/versions", middleware.BasicAuth, waiverController.Versions)
+	waiversGroup.POST("/:id/accept", middleware.BasicAuth, waiverController.Accept)
+
+	// Subscribable calendar feeds, the token is the only credential
+	router.GET("/calendar/:token", calendarController.UserCalendar)
+
+	// Guardians respond to consent requests through the link they were
+	// emailed, the token is the only credential
+	router.GET("/consent/:token", guardianConsentController.ConsentRequest)
+	router.POST("/consent/:token", guardianConsentController.RespondToConsent)
+
+	notificationsGroup := router.Group("notifications", middleware.BasicAuth)
+	notificationsGroup.GET("/", notificationController.Inbox)
+	notificationsGroup.GET("/unread", notificationController.UnreadCount)
+	notificationsGroup.PUT("/read", notificationController.MarkRead)
+	notificationsGroup.GET("/preferences", notificationController.Preferences)
+	notificationsGroup.PUT("/preferences", notificationController.SetPreference)
+	notificationsGroup.DELETE("/:id", notificationController.Delete)
+
+	conversationsGroup := router.Group("conversations", middleware.BasicAuth)
+	conversationsGroup.GET("/", messageController.Conversations)
+	conversationsGroup.POST("/", messageController.StartConversation)
+	conversationsGroup.GET("/unread", messageController.Unread)
+	conversationsGroup.GET("/:id/messages", messageController.Messages)
+	conversationsGroup.POST("/:id/messages", messageController.SendMessage)
+	conversationsGroup.PUT("/:id/read", messageController.MarkRead)
+
+	devicesGroup := router.Group("devices", middleware.BasicAuth)
+	devicesGroup.POST("/", deviceController.Register)
+	devicesGroup.GET("/", deviceController.All)
+	devicesGroup.DELETE("/:id", deviceController.Unregister)
+
+	// Server-Sent Events, browsers can't set headers so they open the stream
+	// with a ticket from /stream/ticket
+	router.GET("/stream", middleware.OptionalAuth, streamController.Stream)
+	router.POST("/stream/ticket", middleware.BasicAuth, streamController.Ticket)
+
+	tagsGroup := router.Group("tags")
+	tagsGroup.GET("/", tagController.All)
+	tagsGroup.GET("/autocomplete", tagController.Autocomplete)
+	tagsGroup.GET("/:id", tagController.One)
+	tagsGroup.POST("/", middleware.BasicAuth, adminAuth, tagController.Create)
+	tagsGroup.PUT("/:id", middleware.BasicAuth, adminAuth, tagController.Update)
+	tagsGroup.DELETE("/:id", middleware.BasicAuth, adminAuth, tagController.Delete)
 
 	orgUsersGroup := router.Group("orgUsers")
-	orgUsersGroup.POST("/", orgUsersController.CreateOrgUser)
+	orgUsersGroup.POST("/", middleware.BasicAuth, orgUsersController.CreateOrgUser)
 	orgUsersGroup.GET("/", orgUsersController.ListAllOrgUsers)
 	orgUsersGroup.GET("/:userId", orgUsersController.FindOrgUser)
-	orgUsersGroup.PUT("/:userId", orgUsersController.UpdateOrgUser)
-	orgUsersGroup.DELETE("/:userId", orgUsersController.DeleteOrgUser)
+	orgUsersGroup.PUT("/:userId", middleware.BasicAuth, orgUsersController.UpdateOrgUser)
+	orgUsersGroup.DELETE("/:userId", middleware.BasicAuth, orgUsersController.DeleteOrgUser)
 
 	friendGroup := router.Group("friend")
 	friendGroup.POST("/", friendController.Create)
@@ -107,24 +288,38 @@ func NewRouter() *gin.Engine {
 	friendGroup.PUT("/:id", friendController.Accept)
 
 	postsGroup := router.Group("posts")
-	postsGroup.POST("/", postsController.CreatePost)
-	postsGroup.GET("/", postsController.AllPosts)
-	postsGroup.GET("/:id", postsController.FindPost)
-	postsGroup.DELETE("/:id", postsController.DeletePost)
-	postsGroup.PUT("/:id", postsController.EditPost)
+	postsGroup.POST("/", middleware.BasicAuth, postsController.CreatePost)
+	postsGroup.GET("/", middleware.OptionalAuth, postsController.AllPosts)
+	postsGroup.GET("/search", middleware.OptionalAuth, searchController.SearchPosts)
+	postsGroup.GET("/:id", middleware.OptionalAuth, postsController.FindPost)
+	postsGroup.DELETE("/:id", middleware.BasicAuth, postsController.DeletePost)
+	postsGroup.PUT("/:id", middleware.BasicAuth, postsController.EditPost)
+	postsGroup.PUT("/:id/pin", middleware.BasicAuth, postsController.PinPost)
+	postsGroup.DELETE("/:id/pin", middleware.BasicAuth, postsController.UnpinPost)
+	postsGroup.GET("/:id/comments", middleware.OptionalAuth, commentsController.PostComments)
+	postsGroup.GET("/:id/reactions", middleware.OptionalAuth, reactionController.Reactions)
+	postsGroup.GET("/:id/reactions/users", middleware.OptionalAuth, reactionController.Reactors)
+	postsGroup.PUT("/:id/reactions", middleware.BasicAuth, reactionController.React)
+	postsGroup.DELETE("/:id/reactions", middleware.BasicAuth, reactionController.Unreact)
+	postsGroup.POST("/:id/report", middleware.BasicAuth, moderationController.ReportPost)
 
 	commentsGroup := router.Group("comments")
-	commentsGroup.POST("/", commentsController.CreateComment)
-	commentsGroup.GET("/", commentsController.AllComments)
-	commentsGroup.GET("/:id", commentsController.FindComment)
-	commentsGroup.DELETE("/:id", commentsController.DeleteComment)
-	commentsGroup.PUT("/:id", commentsController.EditComment)
-
-	likesGroup := router.Group("likes")
-	likesGroup.POST("/", likesController.CreateLike)
-	likesGroup.GET("/", likesController.AllLikes)
-	likesGroup.GET("/:id", likesController.FindLike)
-	likesGroup.DELETE("/:id", likesController.DeleteLike)
+	commentsGroup.POST("/", middleware.BasicAuth, commentsController.CreateComment)
+	commentsGroup.GET("/:id", middleware.OptionalAuth, commentsController.FindComment)
+	commentsGroup.GET("/:id/history", middleware.OptionalAuth, commentsController.CommentHistory)
+	commentsGroup.DELETE("/:id", middleware.BasicAuth, commentsController.DeleteComment)
+	commentsGroup.PUT("/:id", middleware.BasicAuth, commentsController.EditComment)
+	commentsGroup.POST("/:id/report", middleware.BasicAuth, moderationController.ReportComment)
+
+	hashtagsGroup := router.Group("hashtags")
+	hashtagsGroup.GET("/trending", searchController.TrendingHashtags)
+	hashtagsGroup.GET("/:tag/posts", middleware.OptionalAuth, searchController.HashtagPosts)
+
+	moderationGroup := router.Group("moderation", middleware.BasicAuth, adminAuth)
+	moderationGroup.GET("/cases", moderationController.Cases)
+	moderationGroup.GET("/cases/:id", moderationController.Case)
+	moderationGroup.POST("/cases/:id/actions", moderationController.Act)
+
 	// objectGroup := router.Group("object")
 	// {
 	// 	object := new(controllers.ObjectController)
@@ -0,0 +1,305 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// How long before an occurrence, or shift, starts volunteers can check in
+const checkInOpens = 2 * time.Hour
+
+// Prefix of the text encoded in check-in QR codes, so scanners can tell
+// them apart from other codes
+const checkInPrefix = "volunteerone:checkin:"
+
+type AttendanceService interface {
+	CheckInCode(uint, uint, time.Time, uint) (models.CheckInCode, error)
+	CheckIn(uint, uint, string, bool) (models.EventAttendance, error)
+	Roster(uint, uint, time.Time) (models.AttendanceRoster, error)
+	Hours(uint, uint) ([]models.VolunteerHours, error)
+	VerifyHours(uint, uint, uint, bool, *time.Time, *time.Time) (models.VolunteerHours, error)
+}
+
+type attendanceService struct {
+	attendanceRepository repository.AttendanceRepository
+	signupRepository     repository.SignupRepository
+	eventRepository      repository.EventRepository
+	shiftRepository      repository.ShiftRepository
+	orgUsersRepository   repository.OrgUsersRepository
+	hub                  realtime.Hub
+}
+
+// Instantiated in router.go
+func NewAttendanceService(a repository.AttendanceRepository, s repository.SignupRepository, e repository.EventRepository, sh repository.ShiftRepository, o repository.OrgUsersRepository, h realtime.Hub) AttendanceService {
+	return attendanceService{
+		attendanceRepository: a,
+		signupRepository:     s,
+		eventRepository:      e,
+		shiftRepository:      sh,
+		orgUsersRepository:   o,
+		hub:                  h,
+	}
+}
+
+// Returns the code the user shows to check in to their sign-up, creating
+// its token the first time
+func (s attendanceService) CheckInCode(eventId uint, userId uint, occurrence time.Time, shiftId uint) (models.CheckInCode, error) {
+	log.Println("[AttendanceService] Check-in code...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.CheckInCode{}, err
+	}
+
+	seriesId, date := seriesOccurrence(event, occurrence)
+
+	signup, err := s.signupRepository.FindSignup(seriesId, userId, date, shiftId)
+	if err != nil {
+		return models.CheckInCode{}, errors.New("not signed up")
+	}
+
+	if signup.CheckInToken == "" {
+		token, err := newToken()
+		if err != nil {
+			return models.CheckInCode{}, err
+		}
+
+		if signup, err = s.signupRepository.SetCheckInToken(signup, token); err != nil {
+			return models.CheckInCode{}, err
+		}
+	}
+
+	return models.CheckInCode{
+		Token: signup.CheckInToken,
+		QR:    checkInPrefix + signup.CheckInToken,
+	}, nil
+}
+
+// Checks in the volunteer whose code the manager scanned at the event in
+// eventId. Codes are accepted from two hours before their occurrence, or
+// shift, starts until it ends, once. startHours also starts tracking the
+// volunteer's hours.
+func (s attendanceService) CheckIn(eventId uint, managerId uint, token string, startHours bool) (models.EventAttendance, error) {
+	log.Println("[AttendanceService] Check in...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.EventAttendance{}, err
+	}
+
+	if err := requireManager(s.orgUsersRepository, managerId, event.OrganizationID); err != nil {
+		return models.EventAttendance{}, err
+	}
+
+	if event.Status == models.EventCancelled || event.Status == models.EventCompleted {
+		return models.EventAttendance{}, errors.New("event is " + event.Status)
+	}
+
+	token = strings.TrimPrefix(token, checkInPrefix)
+	if token == "" {
+		return models.EventAttendance{}, errors.New("unknown check-in code")
+	}
+
+	signup, err := s.signupRepository.FindSignupByToken(token)
+	if err != nil {
+		return models.EventAttendance{}, errors.New("unknown check-in code")
+	}
+
+	// An edited occurrence only accepts codes for itself
+	seriesId, date := seriesOccurrence(event, signup.OccurrenceDate)
+	if signup.EventID != seriesId || !signup.OccurrenceDate.Equal(date) {
+		return models.EventAttendance{}, errors.New("check-in code is for another event")
+	}
+
+	series, start, end, err := s.occurrenceWindow(event, signup)
+	if err != nil {
+		return models.EventAttendance{}, err
+	}
+
+	now := time.Now()
+	if now.Before(start.Add(-checkInOpens)) {
+		return models.EventAttendance{}, errors.New("check-in opens 2 hours before the start")
+	}
+	if !now.Before(end) {
+		return models.EventAttendance{}, errors.New("event is over")
+	}
+
+	if previous, err := s.attendanceRepository.FindAttendance(series.ID, signup.UsersID, date, signup.ShiftID); err == nil {
+		return models.EventAttendance{}, errors.New(signup.Users.FirstName + " already checked in at " +
+			previous.CheckedInAt.In(series.Location()).Format("3:04 PM"))
+	}
+
+	attendance, err := s.attendanceRepository.CreateAttendance(models.EventAttendance{
+		EventID:        series.ID,
+		UsersID:        signup.UsersID,
+		OccurrenceDate: date,
+		ShiftID:        signup.ShiftID,
+		CheckedInAt:    now,
+		CheckedInBy:    managerId,
+	}, startHours)
+	if err != nil {
+		return attendance, err
+	}
+
+	s.hub.Publish(realtime.EventTopic(series.ID), "checkin", attendance)
+
+	return attendance, nil
+}
+
+// When the occurrence, or shift, signed up to takes place
+func (s attendanceService) occurrenceWindow(event models.Event, signup models.EventSignups) (models.Event, time.Time, time.Time, error) {
+	series, _, start, err := resolveOccurrence(s.eventRepository, event, signup.OccurrenceDate)
+	if err != nil {
+		return models.Event{}, time.Time{}, time.Time{}, err
+	}
+
+	end := start.Add(occurrenceEvent(event, series).Duration())
+
+	if signup.ShiftID != 0 {
+		shift, err := s.shiftRepository.GetShiftById(signup.ShiftID)
+		if err != nil {
+			return models.Event{}, time.Time{}, time.Time{}, err
+		}
+
+		start, end = shiftWindow(shift, series, start)
+	}
+
+	return series, start, end, nil
+}
+
+// Lists who signed up to an occurrence of the event and who of them has
+// checked in so far. Recurring events need the occurrence.
+func (s attendanceService) Roster(eventId uint, managerId uint, occurrence time.Time) (models.AttendanceRoster, error) {
+	log.Println("[AttendanceService] Roster...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.AttendanceRoster{}, err
+	}
+
+	if err := requireManager(s.orgUsersRepository, managerId, event.OrganizationID); err != nil {
+		return models.AttendanceRoster{}, err
+	}
+
+	seriesId, date := seriesOccurrence(event, occurrence)
+	if date.IsZero() {
+		return models.AttendanceRoster{}, errors.New("occurrence is required for a recurring event")
+	}
+
+	signups, err := s.signupRepository.GetSignups(seriesId, date)
+	if err != nil {
+		return models.AttendanceRoster{}, err
+	}
+
+	attendance, err := s.attendanceRepository.GetAttendance(seriesId, date)
+	if err != nil {
+		return models.AttendanceRoster{}, err
+	}
+
+	type attendee struct {
+		userId  uint
+		shiftId uint
+	}
+
+	checkedIn := map[attendee]models.EventAttendance{}
+	for _, a := range attendance {
+		checkedIn[attendee{a.UsersID, a.ShiftID}] = a
+	}
+
+	roster := models.AttendanceRoster{
+		EventID:        seriesId,
+		OccurrenceDate: date,
+		SignedUp:       len(signups),
+		Entries:        []models.AttendanceEntry{},
+	}
+
+	for _, signup := range signups {
+		entry := models.AttendanceEntry{Signup: signup}
+
+		if a, ok := checkedIn[attendee{signup.UsersID, signup.ShiftID}]; ok {
+			at := a.CheckedInAt
+			entry.CheckedInAt = &at
+			entry.CheckedInBy = a.CheckedInBy
+			roster.CheckedIn++
+		}
+
+		roster.Entries = append(roster.Entries, entry)
+	}
+
+	return roster, nil
+}
+
+// Lists the hours volunteered at any occurrence of the event
+func (s attendanceService) Hours(eventId uint, managerId uint) ([]models.VolunteerHours, error) {
+	log.Println("[AttendanceService] Hours...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return []models.VolunteerHours{}, err
+	}
+
+	if err := requireManager(s.orgUsersRepository, managerId, event.OrganizationID); err != nil {
+		return []models.VolunteerHours{}, err
+	}
+
+	seriesId, _ := seriesOccurrence(event, time.Time{})
+
+	return s.attendanceRepository.GetHours(seriesId, "")
+}
+
+// Verifies or rejects hours waiting for verification, once their event is
+// completed. The manager may correct when the volunteer started or ended.
+func (s attendanceService) VerifyHours(eventId uint, managerId uint, hoursId uint, approve bool, start *time.Time, end *time.Time) (models.VolunteerHours, error) {
+	log.Println("[AttendanceService] Verify hours...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.VolunteerHours{}, err
+	}
+
+	if err := requireManager(s.orgUsersRepository, managerId, event.OrganizationID); err != nil {
+		return models.VolunteerHours{}, err
+	}
+
+	if event.Status != models.EventCompleted {
+		return models.VolunteerHours{}, errors.New("hours can be verified once the event is completed")
+	}
+
+	seriesId, _ := seriesOccurrence(event, time.Time{})
+
+	hours, err := s.attendanceRepository.FindHours(hoursId)
+	if err != nil || hours.EventID != seriesId {
+		return models.VolunteerHours{}, errors.New("hours not found")
+	}
+
+	if hours.Status != models.HoursPending {
+		return models.VolunteerHours{}, errors.New("hours are already " + hours.Status)
+	}
+
+	if start != nil {
+		hours.Start = *start
+	}
+	if end != nil {
+		hours.End = end
+	}
+	if hours.End == nil || !hours.End.After(hours.Start) {
+		return models.VolunteerHours{}, errors.New("end must be after start")
+	}
+
+	now := time.Now()
+	hours.Status = models.HoursRejected
+	if approve {
+		hours.Status = models.HoursVerified
+	}
+	hours.VerifiedBy = managerId
+	hours.VerifiedAt = &now
+
+	return s.attendanceRepository.UpdateHours(hours)
+}
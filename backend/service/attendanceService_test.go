@@ -0,0 +1,351 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AttendanceServiceUnitTestSuite struct {
+	suite.Suite
+	mockAttendanceRepo *mocks.AttendanceRepository
+	mockSignupRepo     *mocks.SignupRepository
+	mockEventRepo      *mocks.EventRepository
+	mockShiftRepo      *mocks.ShiftRepository
+	mockOrgUsersRepo   *mocks.OrgUsersRepository
+	mockHub            *mocks.Hub
+	service            AttendanceService
+	event              models.Event
+	signup             models.EventSignups
+	err                error
+}
+
+func (suite *AttendanceServiceUnitTestSuite) SetupTest() {
+	suite.mockAttendanceRepo = new(mocks.AttendanceRepository)
+	suite.mockSignupRepo = new(mocks.SignupRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockShiftRepo = new(mocks.ShiftRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.mockHub = new(mocks.Hub)
+	suite.service = NewAttendanceService(suite.mockAttendanceRepo, suite.mockSignupRepo, suite.mockEventRepo,
+		suite.mockShiftRepo, suite.mockOrgUsersRepo, suite.mockHub)
+
+	// A one-off event of organization 3 starting in an hour
+	suite.event = models.Event{}
+	suite.event.ID = 1
+	suite.event.OrganizationID = 3
+	suite.event.Start = time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	suite.event.End = suite.event.Start.Add(2 * time.Hour)
+	suite.event.TimeZone = "UTC"
+
+	suite.signup = models.EventSignups{
+		EventID:        1,
+		UsersID:        4,
+		OccurrenceDate: suite.event.Start,
+		CheckInToken:   "secret",
+	}
+	suite.signup.Users.FirstName = "Sam"
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockAttendanceRepo.AssertExpectations(suite.T())
+	suite.mockSignupRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockShiftRepo.AssertExpectations(suite.T())
+	suite.mockHub.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestAttendanceServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(AttendanceServiceUnitTestSuite))
+}
+
+func (suite *AttendanceServiceUnitTestSuite) manager(role uint) {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(2), uint(3)).Return(models.OrgUsers{Role: role}, nil)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckInCode_CreatesToken() {
+	signup := suite.signup
+	signup.CheckInToken = ""
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockSignupRepo.On("FindSignup", uint(1), uint(4), suite.event.Start, uint(0)).Return(signup, nil)
+	suite.mockSignupRepo.On("SetCheckInToken", signup, mock.MatchedBy(func(token string) bool {
+		return len(token) == 64
+	})).Return(func(signup models.EventSignups, token string) models.EventSignups {
+		signup.CheckInToken = token
+		return signup
+	}, nil)
+
+	code, err := suite.service.CheckInCode(1, 4, time.Time{}, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), code.Token, 64)
+	assert.Equal(suite.T(), checkInPrefix+code.Token, code.QR)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckInCode_Existing() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockSignupRepo.On("FindSignup", uint(1), uint(4), suite.event.Start, uint(0)).Return(suite.signup, nil)
+
+	code, err := suite.service.CheckInCode(1, 4, time.Time{}, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "secret", code.Token)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckInCode_NotSignedUp() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockSignupRepo.On("FindSignup", uint(1), uint(4), suite.event.Start, uint(0)).
+		Return(models.EventSignups{}, suite.err)
+
+	_, err := suite.service.CheckInCode(1, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "not signed up")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockSignupRepo.On("FindSignupByToken", "secret").Return(suite.signup, nil)
+	suite.mockAttendanceRepo.On("FindAttendance", uint(1), uint(4), suite.event.Start, uint(0)).
+		Return(models.EventAttendance{}, suite.err)
+	suite.mockAttendanceRepo.On("CreateAttendance", mock.MatchedBy(func(a models.EventAttendance) bool {
+		return a.EventID == 1 && a.UsersID == 4 && a.CheckedInBy == 2 && !a.CheckedInAt.IsZero()
+	}), true).Return(func(a models.EventAttendance, _ bool) models.EventAttendance {
+		return a
+	}, nil)
+	suite.mockHub.On("Publish", "event:1", "checkin", mock.MatchedBy(func(a models.EventAttendance) bool {
+		return a.UsersID == 4
+	})).Once()
+
+	att, err := suite.service.CheckIn(1, 2, checkInPrefix+"secret", true)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(4), att.UsersID)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_NotManager() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleMember)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_UnknownCode() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+	suite.mockSignupRepo.On("FindSignupByToken", "guess").Return(models.EventSignups{}, suite.err)
+
+	_, err := suite.service.CheckIn(1, 2, "guess", false)
+
+	assert.EqualError(suite.T(), err, "unknown check-in code")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_OtherEvent() {
+	signup := suite.signup
+	signup.EventID = 9
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+	suite.mockSignupRepo.On("FindSignupByToken", "secret").Return(signup, nil)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.EqualError(suite.T(), err, "check-in code is for another event")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_TooEarly() {
+	suite.event.Start = suite.event.Start.Add(3 * time.Hour)
+	suite.event.End = suite.event.Start.Add(2 * time.Hour)
+	suite.signup.OccurrenceDate = suite.event.Start
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+	suite.mockSignupRepo.On("FindSignupByToken", "secret").Return(suite.signup, nil)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.EqualError(suite.T(), err, "check-in opens 2 hours before the start")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_Over() {
+	suite.event.Start = suite.event.Start.Add(-4 * time.Hour)
+	suite.event.End = suite.event.Start.Add(2 * time.Hour)
+	suite.signup.OccurrenceDate = suite.event.Start
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+	suite.mockSignupRepo.On("FindSignupByToken", "secret").Return(suite.signup, nil)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.EqualError(suite.T(), err, "event is over")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_ShiftWindow() {
+	// The shift starts 4 hours into the event, too early to check in to it
+	var shift models.EventShifts
+	shift.ID = 8
+	shift.EventID = 1
+	shift.Start = suite.event.Start.Add(4 * time.Hour)
+	shift.End = suite.event.Start.Add(5 * time.Hour)
+	suite.event.End = shift.End
+	suite.signup.ShiftID = 8
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+	suite.mockSignupRepo.On("FindSignupByToken", "secret").Return(suite.signup, nil)
+	suite.mockShiftRepo.On("GetShiftById", uint(8)).Return(shift, nil)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.EqualError(suite.T(), err, "check-in opens 2 hours before the start")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_Duplicate() {
+	earlier := time.Date(2034, 4, 1, 15, 4, 0, 0, time.UTC)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+	suite.mockSignupRepo.On("FindSignupByToken", "secret").Return(suite.signup, nil)
+	suite.mockAttendanceRepo.On("FindAttendance", uint(1), uint(4), suite.event.Start, uint(0)).
+		Return(models.EventAttendance{CheckedInAt: earlier}, nil)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.EqualError(suite.T(), err, "Sam already checked in at 3:04 PM")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_Roster() {
+	checkedIn := time.Now()
+	other := models.EventSignups{EventID: 1, UsersID: 6, OccurrenceDate: suite.event.Start}
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockSignupRepo.On("GetSignups", uint(1), suite.event.Start).
+		Return([]models.EventSignups{suite.signup, other}, nil)
+	suite.mockAttendanceRepo.On("GetAttendance", uint(1), suite.event.Start).
+		Return([]models.EventAttendance{{EventID: 1, UsersID: 4, CheckedInAt: checkedIn, CheckedInBy: 2}}, nil)
+
+	roster, err := suite.service.Roster(1, 2, time.Time{})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, roster.SignedUp)
+	assert.Equal(suite.T(), 1, roster.CheckedIn)
+	assert.Equal(suite.T(), &checkedIn, roster.Entries[0].CheckedInAt)
+	assert.Nil(suite.T(), roster.Entries[1].CheckedInAt)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_Roster_NeedsOccurrence() {
+	suite.event.Recurrence = "FREQ=WEEKLY;COUNT=4"
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+
+	_, err := suite.service.Roster(1, 2, time.Time{})
+
+	assert.EqualError(suite.T(), err, "occurrence is required for a recurring event")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_CheckIn_Cancelled() {
+	suite.event.Status = models.EventCancelled
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+
+	_, err := suite.service.CheckIn(1, 2, "secret", false)
+
+	assert.EqualError(suite.T(), err, "event is cancelled")
+}
+
+// Hours of volunteer 4 waiting for verification
+func (suite *AttendanceServiceUnitTestSuite) pendingHours() models.VolunteerHours {
+	end := suite.event.End
+	hours := models.VolunteerHours{UsersID: 4, EventID: 1, OccurrenceDate: suite.event.Start,
+		Start: suite.event.Start, End: &end, Status: models.HoursPending}
+	hours.ID = 7
+	return hours
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_VerifyHours() {
+	suite.event.Status = models.EventCompleted
+	start := suite.event.Start.Add(30 * time.Minute)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockAttendanceRepo.On("FindHours", uint(7)).Return(suite.pendingHours(), nil)
+	suite.mockAttendanceRepo.On("UpdateHours", mock.MatchedBy(func(h models.VolunteerHours) bool {
+		return h.Status == models.HoursVerified && h.VerifiedBy == 2 && h.VerifiedAt != nil && h.Start.Equal(start)
+	})).Return(func(h models.VolunteerHours) models.VolunteerHours {
+		return h
+	}, nil)
+
+	res, err := suite.service.VerifyHours(1, 2, 7, true, &start, nil)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.HoursVerified, res.Status)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_VerifyHours_Reject() {
+	suite.event.Status = models.EventCompleted
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockAttendanceRepo.On("FindHours", uint(7)).Return(suite.pendingHours(), nil)
+	suite.mockAttendanceRepo.On("UpdateHours", mock.MatchedBy(func(h models.VolunteerHours) bool {
+		return h.Status == models.HoursRejected
+	})).Return(models.VolunteerHours{Status: models.HoursRejected}, nil)
+
+	res, err := suite.service.VerifyHours(1, 2, 7, false, nil, nil)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.HoursRejected, res.Status)
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_VerifyHours_NotCompleted() {
+	suite.event.Status = models.EventPublished
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+
+	_, err := suite.service.VerifyHours(1, 2, 7, true, nil, nil)
+
+	assert.EqualError(suite.T(), err, "hours can be verified once the event is completed")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_VerifyHours_AlreadyVerified() {
+	suite.event.Status = models.EventCompleted
+	hours := suite.pendingHours()
+	hours.Status = models.HoursVerified
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockAttendanceRepo.On("FindHours", uint(7)).Return(hours, nil)
+
+	_, err := suite.service.VerifyHours(1, 2, 7, true, nil, nil)
+
+	assert.EqualError(suite.T(), err, "hours are already verified")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_VerifyHours_OtherEvent() {
+	suite.event.Status = models.EventCompleted
+	hours := suite.pendingHours()
+	hours.EventID = 9
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockAttendanceRepo.On("FindHours", uint(7)).Return(hours, nil)
+
+	_, err := suite.service.VerifyHours(1, 2, 7, true, nil, nil)
+
+	assert.EqualError(suite.T(), err, "hours not found")
+}
+
+func (suite *AttendanceServiceUnitTestSuite) TestAttendanceService_VerifyHours_EndBeforeStart() {
+	suite.event.Status = models.EventCompleted
+	end := suite.event.Start.Add(-time.Minute)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.mockAttendanceRepo.On("FindHours", uint(7)).Return(suite.pendingHours(), nil)
+
+	_, err := suite.service.VerifyHours(1, 2, 7, true, nil, &end)
+
+	assert.EqualError(suite.T(), err, "end must be after start")
+}
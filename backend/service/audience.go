@@ -0,0 +1,41 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// Who the user is when looking at posts. 0 is someone signed out, who only
+// sees public posts.
+func findViewer(r repository.PostsRepository, viewerId uint) (models.PostViewer, error) {
+	if viewerId == 0 {
+		return models.PostViewer{}, nil
+	}
+
+	return r.FindViewer(viewerId)
+}
+
+// Whether the user may see the post. Failing to find out counts as no.
+func canSeePost(r repository.PostsRepository, post models.Posts, viewerId uint) bool {
+	if post.Audience == models.AudiencePublic || post.Audience == "" {
+		return true
+	}
+
+	viewer, err := findViewer(r, viewerId)
+
+	return err == nil && viewer.CanSee(post)
+}
+
+// Finds the post if the user may see it and moderators neither hold nor hid
+// it. Posts they may not see are not found, so they don't learn of them.
+func visiblePost(r repository.PostsRepository, postId uint, viewerId uint) (models.Posts, error) {
+	post, err := r.FindPost(strconv.FormatUint(uint64(postId), 10))
+	if err != nil || post.ID == 0 || post.Moderation != "" || !canSeePost(r, post, viewerId) {
+		return models.Posts{}, errors.New("post not found")
+	}
+
+	return post, nil
+}
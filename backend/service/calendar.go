@@ -0,0 +1,105 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/ical"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+)
+
+// UIDs stay the same however often a calendar is exported, so clients
+// update their copy instead of adding another
+func eventUID(eventId uint) string {
+	return fmt.Sprintf("event-%d@volunteerone", eventId)
+}
+
+func signupUID(signup models.EventSignups) string {
+	return fmt.Sprintf("signup-%d-%d-%d-%d@volunteerone",
+		signup.EventID, signup.UsersID, signup.OccurrenceDate.Unix(), signup.ShiftID)
+}
+
+// The calendar entry of a one-off event, or of an occurrence of a series
+// when event is its edited copy. Cancelled events stay in calendars as
+// cancelled.
+func calendarEvent(event models.Event) ical.Event {
+	uid := eventUID(event.ID)
+	if event.SeriesID != nil {
+		uid = eventUID(*event.SeriesID)
+	}
+
+	status := ical.Confirmed
+	if event.Status == models.EventCancelled {
+		status = ical.Cancelled
+	}
+
+	return ical.Event{
+		UID:          uid,
+		Created:      event.CreatedAt,
+		Modified:     event.UpdatedAt,
+		Status:       status,
+		Summary:      event.Name,
+		Description:  event.Description,
+		Address:      event.Address,
+		Latitude:     event.Latitude,
+		Longitude:    event.Longitude,
+		Start:        event.Start,
+		End:          event.End,
+		TimeZone:     event.Location(),
+		RecurrenceID: event.OccurrenceDate,
+	}
+}
+
+// The calendar entries of a series: the series itself, repeating without
+// its cancelled occurrences, followed by its edited occurrences
+func seriesCalendar(series models.Event, overrides []models.Event, exceptions []models.EventExceptions) []ical.Event {
+	master := calendarEvent(series)
+	master.RRule = series.Recurrence
+	if series.RecurrenceEnd != nil {
+		master.RecurEnd = series.RecurrenceEnd.Add(series.Duration())
+	}
+	for _, exception := range exceptions {
+		master.ExDates = append(master.ExDates, exception.OccurrenceDate)
+	}
+
+	events := []ical.Event{master}
+	for _, override := range overrides {
+		events = append(events, calendarEvent(override))
+	}
+
+	return events
+}
+
+// The calendar entry of a sign-up to the occurrence of series starting at
+// start. event is the occurrence: the series or its edited copy. Shift
+// sign-ups last as long as the shift.
+func signupEvent(signup models.EventSignups, series models.Event, event models.Event, start time.Time, shift *models.EventShifts) ical.Event {
+	entry := calendarEvent(event)
+	entry.UID = signupUID(signup)
+	entry.RecurrenceID = nil
+	entry.Start = start
+	entry.End = start.Add(event.Duration())
+
+	if shift != nil {
+		entry.Summary = event.Name + ": " + shift.Role
+		entry.Start, entry.End = shiftWindow(*shift, series, start)
+		if shift.Description != "" {
+			entry.Description = shift.Description + "\n\n" + event.Description
+		}
+	}
+
+	return entry
+}
+
+// Attaches entry to an email as an invite, or its cancellation, so mail
+// clients offer to add it to or remove it from the calendar
+func inviteAttachment(name string, method string, entry ical.Event) mailer.Attachment {
+	cal := ical.Calendar{Method: method, Events: []ical.Event{entry}}
+
+	return mailer.Attachment{
+		Name:        name,
+		ContentType: "text/calendar; charset=utf-8; method=" + method,
+		Data:        []byte(cal.String()),
+	}
+}
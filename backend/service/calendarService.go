@@ -0,0 +1,149 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/ical"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// How far back the calendar feed of a user goes
+const feedHistory = 90 * 24 * time.Hour
+
+type CalendarService interface {
+	EventCalendar(string) (string, error)
+	UserCalendar(string) (string, error)
+	CalendarToken(uint, bool) (string, error)
+}
+
+type calendarService struct {
+	eventRepository  repository.EventRepository
+	signupRepository repository.SignupRepository
+	shiftRepository  repository.ShiftRepository
+	usersRepository  repository.UsersRepository
+}
+
+// Instantiated in router.go
+func NewCalendarService(e repository.EventRepository, s repository.SignupRepository, sh repository.ShiftRepository, u repository.UsersRepository) CalendarService {
+	return calendarService{
+		eventRepository:  e,
+		signupRepository: s,
+		shiftRepository:  sh,
+		usersRepository:  u,
+	}
+}
+
+// Returns the iCalendar of an event. A recurring event repeats with its
+// cancelled occurrences excluded and its edited ones included; asking for
+// an edited occurrence returns its whole series.
+func (s calendarService) EventCalendar(id string) (string, error) {
+	log.Println("[CalendarService] Event calendar...")
+
+	event, err := s.eventRepository.GetEventById(id)
+	if err != nil {
+		return "", err
+	}
+
+	// Drafts are not shared outside their organization
+	if event.Status == models.EventDraft {
+		return "", errors.New("get failed")
+	}
+
+	if event.SeriesID != nil {
+		if event, err = s.eventRepository.GetEventById(strconv.FormatUint(uint64(*event.SeriesID), 10)); err != nil {
+			return "", err
+		}
+	}
+
+	cal := ical.Calendar{Method: ical.Publish, Name: event.Name}
+
+	if _, recurring, _ := eventRule(event); !recurring {
+		cal.Events = []ical.Event{calendarEvent(event)}
+		return cal.String(), nil
+	}
+
+	overrides, err := s.eventRepository.GetOverrides([]uint{event.ID})
+	if err != nil {
+		return "", err
+	}
+
+	exceptions, err := s.eventRepository.GetExceptions([]uint{event.ID})
+	if err != nil {
+		return "", err
+	}
+
+	cal.Events = seriesCalendar(event, overrides, exceptions)
+
+	return cal.String(), nil
+}
+
+// Returns the calendar feed of the user with the token: every occurrence
+// or shift they signed up for. Cancelled occurrences stay in the feed as
+// cancelled so subscribed calendars show it.
+func (s calendarService) UserCalendar(token string) (string, error) {
+	log.Println("[CalendarService] User calendar...")
+
+	if token == "" {
+		return "", errors.New("calendar not found")
+	}
+
+	user, err := s.usersRepository.FindUserByCalendarToken(token)
+	if err != nil {
+		return "", errors.New("calendar not found")
+	}
+
+	signups, err := s.signupRepository.GetUserSignups(user.ID, time.Now().Add(-feedHistory))
+	if err != nil {
+		return "", err
+	}
+
+	occurrences, err := resolveSignups(s.eventRepository, s.shiftRepository, signups)
+	if err != nil {
+		return "", err
+	}
+
+	cal := ical.Calendar{Method: ical.Publish, Name: "VolunteerOne"}
+
+	for _, occurrence := range occurrences {
+		entry := occurrence.Entry()
+		if occurrence.Cancelled {
+			entry.Status = ical.Cancelled
+		}
+
+		cal.Events = append(cal.Events, entry)
+	}
+
+	return cal.String(), nil
+}
+
+// Returns the token of the user's calendar feed, creating one if they have
+// none yet or reset is set. Resetting stops the old feed URL from working.
+func (s calendarService) CalendarToken(userId uint, reset bool) (string, error) {
+	log.Println("[CalendarService] Calendar token...")
+
+	var user models.Users
+	user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), user)
+	if err != nil {
+		return "", err
+	}
+
+	if user.CalendarToken != "" && !reset {
+		return user.CalendarToken, nil
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	user, err = s.usersRepository.SetCalendarToken(user, token)
+	if err != nil {
+		return "", errors.New("could not create calendar token")
+	}
+
+	return user.CalendarToken, nil
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CalendarServiceUnitTestSuite struct {
+	suite.Suite
+	mockEventRepo  *mocks.EventRepository
+	mockSignupRepo *mocks.SignupRepository
+	mockShiftRepo  *mocks.ShiftRepository
+	mockUsersRepo  *mocks.UsersRepository
+	service        CalendarService
+	series         models.Event
+	chicago        *time.Location
+	err            error
+}
+
+func (suite *CalendarServiceUnitTestSuite) SetupTest() {
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockSignupRepo = new(mocks.SignupRepository)
+	suite.mockShiftRepo = new(mocks.ShiftRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.service = NewCalendarService(suite.mockEventRepo, suite.mockSignupRepo, suite.mockShiftRepo, suite.mockUsersRepo)
+
+	// Weekly on Saturdays from 9 to 11 in Chicago, four times
+	suite.chicago, _ = time.LoadLocation("America/Chicago")
+	suite.series = models.Event{}
+	suite.series.ID = 1
+	suite.series.Name = "Food bank"
+	suite.series.Start = suite.saturday(0)
+	suite.series.End = suite.series.Start.Add(2 * time.Hour)
+	suite.series.TimeZone = "America/Chicago"
+	suite.series.Recurrence = "FREQ=WEEKLY;COUNT=4"
+	last := suite.saturday(3)
+	suite.series.RecurrenceEnd = &last
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *CalendarServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockSignupRepo.AssertExpectations(suite.T())
+	suite.mockShiftRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestCalendarServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(CalendarServiceUnitTestSuite))
+}
+
+// The start of the series' occurrence in the given week, at 9 local time
+func (suite *CalendarServiceUnitTestSuite) saturday(week int) time.Time {
+	return time.Date(2034, 3, 4, 9, 0, 0, 0, suite.chicago).AddDate(0, 0, 7*week).UTC()
+}
+
+// The edited copy of the second occurrence, an hour later
+func (suite *CalendarServiceUnitTestSuite) edited() models.Event {
+	occurrence := suite.saturday(1)
+	var edited models.Event
+	edited.ID = 5
+	edited.Name = "Food bank (late)"
+	edited.SeriesID = &suite.series.ID
+	edited.OccurrenceDate = &occurrence
+	edited.Start = occurrence.Add(time.Hour)
+	edited.End = edited.Start.Add(2 * time.Hour)
+	edited.TimeZone = "America/Chicago"
+	return edited
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_EventCalendar_OneOff() {
+	var event models.Event
+	event.ID = 2
+	event.Name = "Park cleanup"
+	event.Start = time.Date(2034, 4, 1, 14, 0, 0, 0, time.UTC)
+	event.End = event.Start.Add(time.Hour)
+	event.TimeZone = "UTC"
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+
+	cal, err := suite.service.EventCalendar("2")
+
+	assert.Nil(suite.T(), err)
+	assert.Contains(suite.T(), cal, "UID:event-2@volunteerone\r\n")
+	assert.Contains(suite.T(), cal, "DTSTART:20340401T140000Z\r\n")
+	assert.NotContains(suite.T(), cal, "RRULE")
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_EventCalendar_Series() {
+	cancelled := suite.saturday(2)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{suite.edited()}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).
+		Return([]models.EventExceptions{{EventID: 1, OccurrenceDate: cancelled}}, nil)
+
+	cal, err := suite.service.EventCalendar("1")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, strings.Count(cal, "UID:event-1@volunteerone\r\n"))
+	assert.Contains(suite.T(), cal, "DTSTART;TZID=America/Chicago:20340304T090000\r\n")
+	assert.Contains(suite.T(), cal, "RRULE:FREQ=WEEKLY;COUNT=4\r\n")
+	// The third Saturday is after the switch to daylight saving time, still 9am
+	assert.Contains(suite.T(), cal, "EXDATE;TZID=America/Chicago:20340318T090000\r\n")
+	assert.Contains(suite.T(), cal, "RECURRENCE-ID;TZID=America/Chicago:20340311T090000\r\n")
+	assert.Contains(suite.T(), cal, "DTSTART;TZID=America/Chicago:20340311T100000\r\n")
+	assert.Contains(suite.T(), cal, "BEGIN:VTIMEZONE\r\nTZID:America/Chicago\r\n")
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_EventCalendar_EditedOccurrence() {
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.edited(), nil)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{suite.edited()}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	cal, err := suite.service.EventCalendar("5")
+
+	assert.Nil(suite.T(), err)
+	assert.Contains(suite.T(), cal, "RRULE:FREQ=WEEKLY;COUNT=4\r\n")
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_EventCalendar_Cancelled() {
+	// Subscribed calendars drop the series instead of keeping it as confirmed
+	suite.series.Status = models.EventCancelled
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	cal, err := suite.service.EventCalendar("1")
+
+	assert.Nil(suite.T(), err)
+	assert.Contains(suite.T(), cal, "STATUS:CANCELLED\r\n")
+	assert.NotContains(suite.T(), cal, "STATUS:CONFIRMED")
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_EventCalendar_Fail() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(models.Event{}, suite.err)
+
+	_, err := suite.service.EventCalendar("1")
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_UserCalendar() {
+	var user models.Users
+	user.ID = 4
+
+	second, third, fourth := suite.saturday(1), suite.saturday(2), suite.saturday(3)
+
+	var shift models.EventShifts
+	shift.ID = 8
+	shift.EventID = 1
+	shift.Role = "Sorting"
+	shift.Start = suite.series.Start.Add(time.Hour)
+	shift.End = suite.series.Start.Add(2 * time.Hour)
+
+	signups := []models.EventSignups{
+		{EventID: 1, UsersID: 4, OccurrenceDate: second, Event: suite.series},
+		{EventID: 1, UsersID: 4, OccurrenceDate: third, Event: suite.series},
+		{EventID: 1, UsersID: 4, OccurrenceDate: fourth, ShiftID: 8, Event: suite.series},
+	}
+
+	suite.mockUsersRepo.On("FindUserByCalendarToken", "secret").Return(user, nil)
+	suite.mockSignupRepo.On("GetUserSignups", uint(4), mock.Anything).Return(signups, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{suite.edited()}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).
+		Return([]models.EventExceptions{{EventID: 1, OccurrenceDate: third}}, nil)
+	suite.mockShiftRepo.On("GetShiftsByIds", []uint{8}).Return([]models.EventShifts{shift}, nil)
+
+	cal, err := suite.service.UserCalendar("secret")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 3, strings.Count(cal, "BEGIN:VEVENT"))
+	assert.NotContains(suite.T(), cal, "RRULE")
+	// Edited occurrence
+	assert.Contains(suite.T(), cal, fmt.Sprintf("UID:signup-1-4-%d-0@volunteerone\r\n", second.Unix()))
+	assert.Contains(suite.T(), cal, "SUMMARY:Food bank (late)\r\n")
+	assert.Contains(suite.T(), cal, "DTSTART;TZID=America/Chicago:20340311T100000\r\n")
+	// Cancelled occurrence
+	assert.Equal(suite.T(), 1, strings.Count(cal, "STATUS:CANCELLED\r\n"))
+	// Shift, from 10 to 11
+	assert.Contains(suite.T(), cal, "SUMMARY:Food bank: Sorting\r\n")
+	assert.Contains(suite.T(), cal, "DTSTART;TZID=America/Chicago:20340325T100000\r\n")
+	assert.Contains(suite.T(), cal, "DTEND;TZID=America/Chicago:20340325T110000\r\n")
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_UserCalendar_CancelledSeries() {
+	var user models.Users
+	user.ID = 4
+
+	// Every occurrence of a cancelled series is cancelled, edited ones included
+	suite.series.Status = models.EventCancelled
+	signups := []models.EventSignups{
+		{EventID: 1, UsersID: 4, OccurrenceDate: suite.saturday(0), Event: suite.series},
+		{EventID: 1, UsersID: 4, OccurrenceDate: suite.saturday(1), Event: suite.series},
+	}
+
+	suite.mockUsersRepo.On("FindUserByCalendarToken", "secret").Return(user, nil)
+	suite.mockSignupRepo.On("GetUserSignups", uint(4), mock.Anything).Return(signups, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{suite.edited()}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	cal, err := suite.service.UserCalendar("secret")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 2, strings.Count(cal, "STATUS:CANCELLED\r\n"))
+	assert.NotContains(suite.T(), cal, "STATUS:CONFIRMED")
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_UserCalendar_UnknownToken() {
+	suite.mockUsersRepo.On("FindUserByCalendarToken", "guess").Return(models.Users{}, suite.err)
+
+	_, err := suite.service.UserCalendar("guess")
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_UserCalendar_EmptyToken() {
+	_, err := suite.service.UserCalendar("")
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_CalendarToken_Existing() {
+	var user models.Users
+	user.ID = 4
+	user.CalendarToken = "secret"
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(user, nil)
+
+	token, err := suite.service.CalendarToken(4, false)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "secret", token)
+}
+
+func (suite *CalendarServiceUnitTestSuite) TestCalendarService_CalendarToken_Reset() {
+	var user models.Users
+	user.ID = 4
+	user.CalendarToken = "secret"
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(user, nil)
+	suite.mockUsersRepo.On("SetCalendarToken", user, mock.MatchedBy(func(token string) bool {
+		return len(token) == 64 && token != "secret"
+	})).Return(func(user models.Users, token string) models.Users {
+		user.CalendarToken = token
+		return user
+	}, nil)
+
+	token, err := suite.service.CalendarToken(4, true)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), token, 64)
+}
@@ -0,0 +1,401 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type CommentsServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo        *mocks.CommentsRepository
+	mockPostsRepo   *mocks.PostsRepository
+	mockUsersRepo   *mocks.UsersRepository
+	mockOrgUserRepo *mocks.OrgUsersRepository
+	notifications   *mocks.NotificationService
+	mockHub         *mocks.Hub
+	mockModRepo     *mocks.ModerationRepository
+	mockSearchRepo  *mocks.SearchRepository
+	service         CommentsService
+	post            models.Posts
+	err             error
+}
+
+func (suite *CommentsServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.CommentsRepository)
+	suite.mockPostsRepo = new(mocks.PostsRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockOrgUserRepo = new(mocks.OrgUsersRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.mockHub = new(mocks.Hub)
+	suite.mockModRepo = new(mocks.ModerationRepository)
+	suite.mockSearchRepo = new(mocks.SearchRepository)
+	suite.service = NewCommentsService(suite.mockRepo, suite.mockPostsRepo, suite.mockUsersRepo,
+		suite.mockOrgUserRepo, suite.notifications, suite.mockHub,
+		suite.mockModRepo, testContentFilter, suite.mockSearchRepo)
+
+	suite.post = models.Posts{Handle: "grace"}
+	suite.post.ID = 3
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *CommentsServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockModRepo.AssertExpectations(suite.T())
+	suite.mockSearchRepo.AssertExpectations(suite.T())
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPostsRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockOrgUserRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.mockHub.AssertExpectations(suite.T())
+}
+
+func TestCommentsServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(CommentsServiceUnitTestSuite))
+}
+
+func commenter(id uint, handle string) models.Users {
+	u := models.Users{Handle: handle}
+	u.ID = id
+
+	return u
+}
+
+func threadComment(id uint, handle string, parentId *uint, threadId *uint) models.Comments {
+	c := models.Comments{PostsID: 3, Handle: handle, CommentDescription: "hi", ParentID: parentId, ThreadID: threadId}
+	c.ID = id
+
+	return c
+}
+
+func uintRef(id uint) *uint {
+	return &id
+}
+
+// Expects the user to look up the post by its ID
+func (suite *CommentsServiceUnitTestSuite) expectPost() {
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil).Once()
+}
+
+// Expects the comment to be indexed with the hashtags and mentions
+func (suite *CommentsServiceUnitTestSuite) expectIndex(tags []string, handles []string) {
+	suite.mockSearchRepo.On("IndexComment", mock.Anything, tags, handles).Return(nil).Once()
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_Reply() {
+	parent := threadComment(10, "ada", nil, nil)
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockRepo.On("FindComment", "10").Return(parent, nil)
+
+	// Threaded under the top level comment replied to
+	suite.mockRepo.On("CreateComment", mock.MatchedBy(func(c models.Comments) bool {
+		return c.Handle == "linus" && *c.ThreadID == 10 && *c.ParentID == 10
+	})).Return(func(c models.Comments) models.Comments {
+		c.ID = 11
+		return c
+	}, nil)
+	suite.expectIndex([]string{}, []string{"ada", "linus", "grace"})
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "comment", mock.Anything)
+
+	// Ada is told about the reply only once, though also mentioned. Linus
+	// isn't told about his own mention.
+	suite.mockUsersRepo.On("FindUserByHandle", "ada").Return(commenter(5, "ada"), nil)
+	suite.mockUsersRepo.On("FindUserByHandle", "linus").Return(commenter(4, "linus"), nil)
+	suite.mockUsersRepo.On("FindUserByHandle", "grace").Return(commenter(6, "grace"), nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 5 && n.Type == models.NotifyReply && n.ActorID == 4
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 6 && n.Type == models.NotifyMention && n.SubjectID == 3
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	res, err := suite.service.CreateComment(4, models.Comments{
+		PostsID:            3,
+		ParentID:           uintRef(10),
+		CommentDescription: " thanks @ada, @linus and @grace! ",
+	})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(11), res.ID)
+	assert.Equal(suite.T(), "thanks @ada, @linus and @grace!", res.CommentDescription)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_NestedReply() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockRepo.On("FindComment", "11").Return(threadComment(11, "linus", uintRef(10), uintRef(10)), nil)
+
+	// Stays in the thread of the top level comment
+	suite.mockRepo.On("CreateComment", mock.MatchedBy(func(c models.Comments) bool {
+		return *c.ThreadID == 10 && *c.ParentID == 11
+	})).Return(models.Comments{PostsID: 3, Handle: "linus", ParentID: uintRef(11)}, nil)
+	suite.expectIndex([]string{}, []string{})
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "comment", mock.Anything)
+
+	// Replying to himself, so only the post author hears about it
+	suite.mockUsersRepo.On("FindUserByHandle", "linus").Return(commenter(4, "linus"), nil)
+	suite.mockUsersRepo.On("FindUserByHandle", "grace").Return(commenter(6, "grace"), nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 6 && n.Type == models.NotifyComment
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, ParentID: uintRef(11), CommentDescription: "also"})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_ParentOnOtherPost() {
+	parent := threadComment(10, "ada", nil, nil)
+	parent.PostsID = 9
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockRepo.On("FindComment", "10").Return(parent, nil)
+
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, ParentID: uintRef(10), CommentDescription: "hi"})
+
+	assert.EqualError(suite.T(), err, "the comment replied to is not on this post")
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_Empty() {
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, CommentDescription: "  "})
+
+	assert.EqualError(suite.T(), err, "comment cannot be empty")
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_EditComment() {
+	original := threadComment(10, "linus", nil, nil)
+	original.CommentDescription = "thanks @ada"
+
+	suite.mockRepo.On("FindComment", "10").Return(original, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.mockRepo.On("EditComment", mock.MatchedBy(func(c models.Comments) bool {
+		return c.CommentDescription == "thanks @Ada and @grace" && c.EditedAt != nil
+	}), "thanks @ada").Return(func(c models.Comments, _ string) models.Comments {
+		return c
+	}, nil)
+	suite.expectIndex([]string{}, []string{"ada", "grace"})
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "comment_edited", mock.Anything)
+	suite.expectPost()
+
+	// Ada was already mentioned
+	suite.mockUsersRepo.On("FindUserByHandle", "grace").Return(commenter(6, "grace"), nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 6 && n.Type == models.NotifyMention
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	res, err := suite.service.EditComment(4, 10, "thanks @Ada and @grace")
+
+	assert.Nil(suite.T(), err)
+	assert.WithinDuration(suite.T(), time.Now(), *res.EditedAt, time.Minute)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_EditComment_Held() {
+	suite.mockRepo.On("FindComment", "10").Return(threadComment(10, "linus", nil, nil), nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.mockRepo.On("EditComment", mock.MatchedBy(func(c models.Comments) bool {
+		return c.Moderation == models.ModerationHeld
+	}), mock.Anything).Return(func(c models.Comments, _ string) models.Comments {
+		return c
+	}, nil)
+	suite.expectIndex([]string{}, []string{"ada"})
+	suite.mockModRepo.On("HoldContent", models.SubjectComment, uint(10), `contains "spoiler"`).Return(models.ModerationCases{}, nil)
+
+	// Taken off the post for everyone watching it, nobody is notified
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "comment_deleted", map[string]uint{"ID": 10})
+
+	res, err := suite.service.EditComment(4, 10, "spoiler: @ada wins")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.ModerationHeld, res.Moderation)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_Rejected() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, CommentDescription: "FREE MONEY!"})
+
+	assert.EqualError(suite.T(), err, `this comment isn't allowed, it contains "free money"`)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_HiddenPost() {
+	suite.post.Moderation = models.ModerationHidden
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, CommentDescription: "nice"})
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_FriendsOnlyMention() {
+	suite.post.Audience = models.AudienceFriends
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "linus", FriendHandles: []string{"grace"}}, nil)
+	suite.mockRepo.On("CreateComment", mock.Anything).Return(func(c models.Comments) models.Comments {
+		c.ID = 11
+		return c
+	}, nil)
+	suite.expectIndex([]string{}, []string{"ada"})
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "comment", mock.Anything)
+
+	// Ada isn't grace's friend, so isn't told about a post she can't see
+	suite.mockUsersRepo.On("FindUserByHandle", "ada").Return(commenter(5, "ada"), nil)
+	suite.mockPostsRepo.On("FindViewer", uint(5)).Return(models.PostViewer{Handle: "ada"}, nil)
+	suite.mockUsersRepo.On("FindUserByHandle", "grace").Return(commenter(6, "grace"), nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 6 && n.Type == models.NotifyComment
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, CommentDescription: "see this @ada"})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CreateComment_MembersOnlyPost() {
+	suite.post.Audience = models.AudienceMembers
+	suite.post.AudienceOrganizationID = uintRef(2)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "linus"}, nil)
+
+	_, err := suite.service.CreateComment(4, models.Comments{PostsID: 3, CommentDescription: "nice"})
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_EditComment_NotAuthor() {
+	suite.mockRepo.On("FindComment", "10").Return(threadComment(10, "ada", nil, nil), nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+
+	_, err := suite.service.EditComment(4, 10, "rewritten")
+
+	assert.Equal(suite.T(), ErrNotAuthor, err)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_DeleteComment_OrgManager() {
+	suite.post.OrganizationID = uintRef(2)
+
+	suite.mockRepo.On("FindComment", "10").Return(threadComment(10, "ada", nil, nil), nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockOrgUserRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+	suite.mockRepo.On("DeleteComment", mock.Anything).Return(nil)
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "comment_deleted", map[string]uint{"ID": 10})
+
+	err := suite.service.DeleteComment(4, 10)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_DeleteComment_OrgMember() {
+	suite.post.OrganizationID = uintRef(2)
+
+	suite.mockRepo.On("FindComment", "10").Return(threadComment(10, "ada", nil, nil), nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(commenter(4, "linus"), nil)
+	suite.expectPost()
+	suite.mockOrgUserRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	err := suite.service.DeleteComment(4, 10)
+
+	assert.Equal(suite.T(), ErrNotAuthor, err)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_PostComments() {
+	deleted := threadComment(10, "ada", nil, nil)
+	deleted.DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true}
+	gone := threadComment(13, "ada", uintRef(12), uintRef(10))
+	gone.DeletedAt = deleted.DeletedAt
+
+	suite.expectPost()
+	suite.mockRepo.On("GetThreads", uint(3), uint(0), 3).Return([]models.Comments{
+		deleted,
+		threadComment(20, "grace", nil, nil),
+		threadComment(30, "grace", nil, nil),
+	}, nil)
+	suite.mockRepo.On("GetReplies", []uint{10, 20}).Return([]models.Comments{
+		threadComment(11, "linus", uintRef(10), uintRef(10)),
+		threadComment(12, "grace", uintRef(11), uintRef(10)),
+		gone,
+	}, nil)
+
+	page, err := suite.service.PostComments(3, "", 2, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Comments, 2)
+
+	// The deleted comment stays for its replies, blanked out
+	thread := page.Comments[0]
+	assert.Equal(suite.T(), "", thread.Handle)
+	assert.Equal(suite.T(), "", thread.CommentDescription)
+	assert.Equal(suite.T(), uint(11), thread.Replies[0].ID)
+	assert.Equal(suite.T(), uint(12), thread.Replies[0].Replies[0].ID)
+	assert.Empty(suite.T(), thread.Replies[0].Replies[0].Replies)
+	assert.Empty(suite.T(), page.Comments[1].Replies)
+
+	// The next page carries on after the last thread
+	suite.expectPost()
+	suite.mockRepo.On("GetThreads", uint(3), uint(20), 3).Return([]models.Comments{}, nil)
+
+	next, err := suite.service.PostComments(3, page.NextCursor, 2, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), next.Comments)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_CommentHistory() {
+	suite.mockRepo.On("FindComment", "10").Return(threadComment(10, "ada", nil, nil), nil)
+	suite.expectPost()
+	suite.mockRepo.On("GetEdits", uint(10)).Return([]models.CommentEdits{{CommentsID: 10, CommentDescription: "hello"}}, nil)
+
+	edits, err := suite.service.CommentHistory(10, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), edits, 1)
+}
+
+func TestHashtags(t *testing.T) {
+	tags := hashtags("#Cleanup at the #park, #cleanup again! Not issue#4, &#39; or ##twice. #Día_2 #1")
+
+	assert.Equal(t, []string{"cleanup", "park", "día_2"}, tags)
+}
+
+func TestMentionedHandles(t *testing.T) {
+	handles := mentionedHandles("@ada, email me at grace@example.com or ask @Linus and @linus (@ada_l)")
+
+	assert.Equal(t, []string{"ada", "Linus", "ada_l"}, handles)
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_FindComment_FriendsOnlyPost() {
+	suite.post.Audience = models.AudienceFriends
+	suite.mockRepo.On("FindComment", "10").Return(threadComment(10, "ada", nil, nil), nil)
+	suite.expectPost()
+
+	// Signed out users can't read comments on it either
+	_, err := suite.service.FindComment("10", 0)
+
+	assert.EqualError(suite.T(), err, "comment not found")
+}
+
+func (suite *CommentsServiceUnitTestSuite) TestCommentsService_PostComments_FriendsOnlyPost() {
+	suite.post.Audience = models.AudienceFriends
+	suite.expectPost()
+	suite.mockPostsRepo.On("FindViewer", uint(5)).Return(models.PostViewer{Handle: "ada"}, nil)
+
+	_, err := suite.service.PostComments(3, "", 10, 5)
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+)
+
+// Pagination cursors are opaque to clients: the position is marshalled to
+// JSON and base64 encoded.
+func encodeCursor(position any) string {
+	raw, _ := json.Marshal(position)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Decodes a cursor made by encodeCursor into position. An empty cursor
+// leaves position untouched (the start of the list).
+func decodeCursor(cursor string, position any) error {
+	if cursor == "" {
+		return nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return errors.New("invalid cursor")
+	}
+
+	if err := json.Unmarshal(raw, position); err != nil {
+		return errors.New("invalid cursor")
+	}
+
+	return nil
+}
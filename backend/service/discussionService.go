@@ -0,0 +1,243 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+var ErrNotParticipant = errors.New("only volunteers signed up for this event and its organization's staff can join its discussion")
+
+// How many messages an event's discussion can have pinned at once
+const maxPinnedMessages = 5
+
+type DiscussionService interface {
+	Messages(userId uint, eventId uint, cursor string, limit int) (models.DiscussionPage, error)
+	PostMessage(userId uint, eventId uint, body string) (models.DiscussionMessages, error)
+	DeleteMessage(userId uint, id uint) error
+	PinMessage(userId uint, id uint, pinned bool) (models.DiscussionMessages, error)
+}
+
+type discussionService struct {
+	discussionRepository repository.DiscussionRepository
+	eventRepository      repository.EventRepository
+	orgUsersRepository   repository.OrgUsersRepository
+	usersRepository      repository.UsersRepository
+	notifications        NotificationService
+}
+
+// Instantiated in router.go
+func NewDiscussionService(r repository.DiscussionRepository, e repository.EventRepository, o repository.OrgUsersRepository, u repository.UsersRepository, n NotificationService) DiscussionService {
+	return discussionService{
+		discussionRepository: r,
+		eventRepository:      e,
+		orgUsersRepository:   o,
+		usersRepository:      u,
+		notifications:        n,
+	}
+}
+
+// Lists a page of the event's discussion, latest first, with its pinned
+// messages on the first page
+func (s discussionService) Messages(userId uint, eventId uint, cursor string, limit int) (models.DiscussionPage, error) {
+	var position messagePosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.DiscussionPage{}, err
+	}
+
+	seriesId, _, _, err := s.participant(userId, eventId)
+	if err != nil {
+		return models.DiscussionPage{}, err
+	}
+
+	page := models.DiscussionPage{}
+
+	if position.ID == 0 {
+		if page.Pinned, err = s.discussionRepository.GetPinnedMessages(seriesId); err != nil {
+			return models.DiscussionPage{}, err
+		}
+	}
+
+	// One extra tells whether there is another page
+	messages, err := s.discussionRepository.GetMessages(seriesId, position.ID, limit+1)
+	if err != nil {
+		return models.DiscussionPage{}, err
+	}
+
+	page.Messages = messages
+	if len(messages) > limit {
+		page.Messages = messages[:limit]
+		page.NextCursor = encodeCursor(messagePosition{ID: page.Messages[limit-1].ID})
+	}
+
+	return page, nil
+}
+
+// Posts the message in the event's discussion and notifies everyone else
+// taking part in it
+func (s discussionService) PostMessage(userId uint, eventId uint, body string) (models.DiscussionMessages, error) {
+	log.Println("[DiscussionService] Post message...")
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return models.DiscussionMessages{}, errors.New("message cannot be empty")
+	}
+	if len([]rune(body)) > maxMessageLength {
+		return models.DiscussionMessages{}, fmt.Errorf("messages can be at most %d characters", maxMessageLength)
+	}
+
+	seriesId, event, _, err := s.participant(userId, eventId)
+	if err != nil {
+		return models.DiscussionMessages{}, err
+	}
+
+	author, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.DiscussionMessages{}, err
+	}
+
+	if author.Suspended(time.Now()) {
+		return models.DiscussionMessages{}, ErrSuspended
+	}
+
+	created, err := s.discussionRepository.CreateMessage(models.DiscussionMessages{
+		EventID: seriesId,
+		UsersID: userId,
+		Handle:  author.Handle,
+		Body:    body,
+	})
+	if err != nil {
+		return models.DiscussionMessages{}, err
+	}
+
+	s.notify(created, event)
+
+	return created, nil
+}
+
+// Deletes the message, for its author or the organization's staff
+func (s discussionService) DeleteMessage(userId uint, id uint) error {
+	message, err := s.discussionRepository.FindMessage(id)
+	if err != nil {
+		return err
+	}
+
+	_, _, staff, err := s.participant(userId, message.EventID)
+	if err != nil {
+		return err
+	}
+
+	if message.UsersID != userId && !staff {
+		return ErrNotAuthor
+	}
+
+	return s.discussionRepository.DeleteMessage(message)
+}
+
+// Pins the message to the top of the discussion, or unpins it. Only the
+// organization's staff can.
+func (s discussionService) PinMessage(userId uint, id uint, pinned bool) (models.DiscussionMessages, error) {
+	message, err := s.discussionRepository.FindMessage(id)
+	if err != nil {
+		return models.DiscussionMessages{}, err
+	}
+
+	_, _, staff, err := s.participant(userId, message.EventID)
+	if err != nil {
+		return models.DiscussionMessages{}, err
+	}
+
+	if !staff {
+		return models.DiscussionMessages{}, ErrNotStaff
+	}
+
+	if (message.PinnedAt != nil) == pinned {
+		return message, nil
+	}
+
+	if pinned {
+		current, err := s.discussionRepository.GetPinnedMessages(message.EventID)
+		if err != nil {
+			return models.DiscussionMessages{}, err
+		}
+
+		if len(current) >= maxPinnedMessages {
+			return models.DiscussionMessages{}, fmt.Errorf("a discussion can pin at most %d messages", maxPinnedMessages)
+		}
+
+		now := time.Now()
+		message.PinnedAt = &now
+	} else {
+		message.PinnedAt = nil
+	}
+
+	return s.discussionRepository.EditMessage(message)
+}
+
+// Finds the series of the event whose discussion the user takes part in,
+// and whether they do as staff of its organization. Volunteers take part
+// for as long as they are signed up for any occurrence or shift of it.
+func (s discussionService) participant(userId uint, eventId uint) (uint, models.Event, bool, error) {
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return 0, models.Event{}, false, errors.New("event not found")
+	}
+
+	// Edited occurrences share the discussion of their series
+	seriesId, _ := seriesOccurrence(event, time.Time{})
+
+	if isStaff(s.orgUsersRepository, userId, event.OrganizationID) {
+		return seriesId, event, true, nil
+	}
+
+	volunteer, err := s.discussionRepository.IsVolunteer(seriesId, userId)
+	if err != nil {
+		return 0, models.Event{}, false, err
+	}
+	if !volunteer {
+		return 0, models.Event{}, false, ErrNotParticipant
+	}
+
+	return seriesId, event, false, nil
+}
+
+// Notifies the event's volunteers and the organization's staff, but the
+// author, of the message
+func (s discussionService) notify(message models.DiscussionMessages, event models.Event) {
+	volunteers, err := s.discussionRepository.Volunteers(message.EventID)
+	if err != nil {
+		log.Println("[DiscussionService] Could not find volunteers:", err)
+	}
+
+	staff, err := s.orgUsersRepository.GetOrgMembers(event.OrganizationID)
+	if err != nil {
+		log.Println("[DiscussionService] Could not find staff:", err)
+	}
+
+	recipients := volunteers
+	for _, member := range staff {
+		recipients = append(recipients, member.UsersID)
+	}
+
+	for _, recipient := range uniqueIds(recipients) {
+		if recipient == message.UsersID {
+			continue
+		}
+
+		s.notifications.Notify(models.Notifications{
+			UsersID:     recipient,
+			Type:        models.NotifyDiscussion,
+			Title:       "@" + message.Handle + " posted in the discussion of " + event.Name,
+			Body:        message.Body,
+			SubjectType: models.SubjectEvent,
+			SubjectID:   message.EventID,
+			ActorID:     message.UsersID,
+		}, nil)
+	}
+}
@@ -0,0 +1,269 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type DiscussionServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.DiscussionRepository
+	mockEventRepo    *mocks.EventRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	mockUsersRepo    *mocks.UsersRepository
+	notifications    *mocks.NotificationService
+	service          DiscussionService
+	event            models.Event
+	ada              models.Users
+	message          models.DiscussionMessages
+	err              error
+}
+
+func (suite *DiscussionServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.DiscussionRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.service = NewDiscussionService(suite.mockRepo, suite.mockEventRepo, suite.mockOrgUsersRepo, suite.mockUsersRepo, suite.notifications)
+
+	suite.event = models.Event{OrganizationID: 2, Name: "Park Cleanup"}
+	suite.event.ID = 5
+	suite.ada = models.Users{Handle: "ada"}
+	suite.ada.ID = 4
+	suite.message = models.DiscussionMessages{EventID: 5, UsersID: 4, Handle: "ada", Body: "Anyone driving from downtown?"}
+	suite.message.ID = 20
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *DiscussionServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+}
+
+func TestDiscussionServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(DiscussionServiceUnitTestSuite))
+}
+
+// Expects the user to take part in the discussion of event 5 as a volunteer
+func (suite *DiscussionServiceUnitTestSuite) expectVolunteer(userId uint) {
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.event, nil).Once()
+	suite.mockOrgUsersRepo.On("FindOrgUser", userId, uint(2)).Return(models.OrgUsers{}, suite.err).Once()
+	suite.mockRepo.On("IsVolunteer", uint(5), userId).Return(true, nil).Once()
+}
+
+// Expects the user to take part in the discussion of event 5 as staff
+func (suite *DiscussionServiceUnitTestSuite) expectStaff(userId uint) {
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.event, nil).Once()
+	suite.mockOrgUsersRepo.On("FindOrgUser", userId, uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil).Once()
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_Messages() {
+	messages := []models.DiscussionMessages{{}, {}, {}}
+	for i := range messages {
+		messages[i].ID = uint(30 - i)
+	}
+
+	// Pinned messages come with the first page only
+	suite.expectVolunteer(4)
+	suite.mockRepo.On("GetPinnedMessages", uint(5)).Return([]models.DiscussionMessages{suite.message}, nil).Once()
+	suite.mockRepo.On("GetMessages", uint(5), uint(0), 3).Return(messages, nil).Once()
+
+	page, err := suite.service.Messages(4, 5, "", 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Pinned, 1)
+	assert.Len(suite.T(), page.Messages, 2)
+
+	suite.expectVolunteer(4)
+	suite.mockRepo.On("GetMessages", uint(5), uint(29), 3).Return(messages[2:], nil).Once()
+
+	next, err := suite.service.Messages(4, 5, page.NextCursor, 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), next.Pinned)
+	assert.Len(suite.T(), next.Messages, 1)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_Messages_Occurrence() {
+	seriesId := uint(5)
+	occurrenceDate := time.Date(2034, 4, 8, 9, 0, 0, 0, time.UTC)
+	occurrence := models.Event{OrganizationID: 2, SeriesID: &seriesId, OccurrenceDate: &occurrenceDate}
+	occurrence.ID = 9
+
+	// Edited occurrences share the discussion of their series
+	suite.mockEventRepo.On("GetEventById", "9").Return(occurrence, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+	suite.mockRepo.On("IsVolunteer", uint(5), uint(4)).Return(true, nil)
+	suite.mockRepo.On("GetPinnedMessages", uint(5)).Return([]models.DiscussionMessages{}, nil)
+	suite.mockRepo.On("GetMessages", uint(5), uint(0), 51).Return([]models.DiscussionMessages{}, nil)
+
+	_, err := suite.service.Messages(4, 9, "", 50)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_Messages_NotSignedUp() {
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+	suite.mockRepo.On("IsVolunteer", uint(5), uint(4)).Return(false, nil)
+
+	_, err := suite.service.Messages(4, 5, "", 50)
+
+	assert.Equal(suite.T(), ErrNotParticipant, err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_Withdrawn() {
+	suite.expectVolunteer(4)
+	suite.mockRepo.On("GetPinnedMessages", uint(5)).Return([]models.DiscussionMessages{}, nil)
+	suite.mockRepo.On("GetMessages", uint(5), uint(0), 51).Return([]models.DiscussionMessages{}, nil)
+
+	_, err := suite.service.Messages(4, 5, "", 50)
+
+	assert.Nil(suite.T(), err)
+
+	// Once they withdraw from their last sign-up, they can neither read nor post
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.event, nil).Twice()
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err).Twice()
+	suite.mockRepo.On("IsVolunteer", uint(5), uint(4)).Return(false, nil).Twice()
+
+	_, err = suite.service.Messages(4, 5, "", 50)
+
+	assert.Equal(suite.T(), ErrNotParticipant, err)
+
+	_, err = suite.service.PostMessage(4, 5, "Still coming?")
+
+	assert.Equal(suite.T(), ErrNotParticipant, err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_PostMessage() {
+	suite.expectVolunteer(4)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockRepo.On("CreateMessage", models.DiscussionMessages{EventID: 5, UsersID: 4, Handle: "ada", Body: "Anyone driving from downtown?"}).
+		Return(suite.message, nil)
+
+	// Everyone else taking part is notified once
+	suite.mockRepo.On("Volunteers", uint(5)).Return([]uint{4, 6, 7}, nil)
+	suite.mockOrgUsersRepo.On("GetOrgMembers", uint(2)).Return([]models.OrgUsers{{UsersID: 7}, {UsersID: 8}}, nil)
+	for _, userId := range []uint{6, 7, 8} {
+		recipient := userId
+		suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+			return n.UsersID == recipient && n.Type == models.NotifyDiscussion && n.ActorID == 4 &&
+				n.Title == "@ada posted in the discussion of Park Cleanup" &&
+				n.SubjectType == models.SubjectEvent && n.SubjectID == 5
+		}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	}
+
+	message, err := suite.service.PostMessage(4, 5, " Anyone driving from downtown? ")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(20), message.ID)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_PostMessage_Empty() {
+	_, err := suite.service.PostMessage(4, 5, "  ")
+
+	assert.EqualError(suite.T(), err, "message cannot be empty")
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_PostMessage_Suspended() {
+	until := time.Now().Add(time.Hour)
+	suite.ada.SuspendedUntil = &until
+
+	suite.expectVolunteer(4)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+
+	_, err := suite.service.PostMessage(4, 5, "Hello")
+
+	assert.Equal(suite.T(), ErrSuspended, err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_DeleteMessage_Author() {
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectVolunteer(4)
+	suite.mockRepo.On("DeleteMessage", suite.message).Return(nil)
+
+	err := suite.service.DeleteMessage(4, 20)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_DeleteMessage_OtherVolunteer() {
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectVolunteer(6)
+
+	err := suite.service.DeleteMessage(6, 20)
+
+	assert.Equal(suite.T(), ErrNotAuthor, err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_DeleteMessage_Staff() {
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectStaff(8)
+	suite.mockRepo.On("DeleteMessage", suite.message).Return(nil)
+
+	err := suite.service.DeleteMessage(8, 20)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_PinMessage() {
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectStaff(8)
+	suite.mockRepo.On("GetPinnedMessages", uint(5)).Return([]models.DiscussionMessages{}, nil)
+	suite.mockRepo.On("EditMessage", mock.MatchedBy(func(m models.DiscussionMessages) bool {
+		return m.ID == 20 && m.PinnedAt != nil
+	})).Return(func(m models.DiscussionMessages) models.DiscussionMessages { return m }, nil)
+
+	message, err := suite.service.PinMessage(8, 20, true)
+
+	assert.Nil(suite.T(), err)
+	assert.NotNil(suite.T(), message.PinnedAt)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_PinMessage_Full() {
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectStaff(8)
+	suite.mockRepo.On("GetPinnedMessages", uint(5)).Return(make([]models.DiscussionMessages, maxPinnedMessages), nil)
+
+	_, err := suite.service.PinMessage(8, 20, true)
+
+	assert.EqualError(suite.T(), err, "a discussion can pin at most 5 messages")
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_PinMessage_Volunteer() {
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectVolunteer(4)
+
+	_, err := suite.service.PinMessage(4, 20, true)
+
+	assert.Equal(suite.T(), ErrNotStaff, err)
+}
+
+func (suite *DiscussionServiceUnitTestSuite) TestDiscussionService_UnpinMessage() {
+	pinnedAt := time.Now()
+	suite.message.PinnedAt = &pinnedAt
+
+	suite.mockRepo.On("FindMessage", uint(20)).Return(suite.message, nil)
+	suite.expectStaff(8)
+	suite.mockRepo.On("EditMessage", mock.MatchedBy(func(m models.DiscussionMessages) bool {
+		return m.ID == 20 && m.PinnedAt == nil
+	})).Return(func(m models.DiscussionMessages) models.DiscussionMessages { return m }, nil)
+
+	message, err := suite.service.PinMessage(8, 20, false)
+
+	assert.Nil(suite.T(), err)
+	assert.Nil(suite.T(), message.PinnedAt)
+}
@@ -1,30 +1,68 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 )
 
 type EventService interface {
-	CreateEvent(models.Event) (models.Event, error)
-	GetEvents() ([]models.Event, error)
+	CreateEvent(models.Event, uint) (models.Event, error)
+	GetEvents([]string, uint, uint) ([]models.Event, error)
 	GetEventById(string) (models.Event, error)
-	UpdateEvent(models.Event) (models.Event, error)
-	DeleteEvent(models.Event) error
+	CheckVisible(models.Event, uint) error
+	UpdateEvent(models.Event, uint) (models.Event, error)
+	SearchEvents(models.EventSearchQuery, string, uint) (models.EventSearchResult, error)
+	NearbyEvents(float64, float64, float64, int, []string, uint, uint) ([]models.NearbyEvent, error)
+	GetOccurrences(time.Time, time.Time, uint, int, []string, uint) ([]models.EventOccurrence, error)
+	GetEventOccurrences(models.Event, time.Time, time.Time) ([]models.EventOccurrence, error)
+	UpdateOccurrence(models.Event, time.Time, string, models.Event, uint) (models.Event, error)
+	CancelOccurrence(models.Event, time.Time, uint) (models.EventExceptions, error)
+	RestoreOccurrence(models.Event, time.Time, uint) error
 }
 
+// Longest range of occurrences that can be listed at once
+const maxOccurrenceRange = 366 * 24 * time.Hour
+
 type eventService struct {
-	eventRepository repository.EventRepository
+	eventRepository    repository.EventRepository
+	orgUsersRepository repository.OrgUsersRepository
+	signupRepository   repository.SignupRepository
+	geocoder           geocoder.Geocoder
+	notifications      NotificationService
 }
 
 // CreateEvent implements EventService
-func (s eventService) CreateEvent(event models.Event) (models.Event, error) {
-	return s.eventRepository.CreateEvent(event);
-}
+//
+// Only managers of the organization can create its events. New events are
+// drafts until a manager publishes them.
+func (s eventService) CreateEvent(event models.Event, userId uint) (models.Event, error) {
+	if err := requireManager(s.orgUsersRepository, userId, event.OrganizationID); err != nil {
+		return models.Event{}, err
+	}
+
+	event.Status = models.EventDraft
+
+	if err := prepareSchedule(&event); err != nil {
+		return models.Event{}, err
+	}
+
+	if event.Start.Before(time.Now()) {
+		return models.Event{}, errors.New("event cannot start in the past")
+	}
+
+	if err := prepareRecurrence(&event); err != nil {
+		return models.Event{}, err
+	}
 
-// DeleteEvent implements EventService
-func (s eventService) DeleteEvent(event models.Event) error {
-	return s.eventRepository.DeleteEvent(event);
+	event.Latitude, event.Longitude = geocodeAddress(s.geocoder, event.Address)
+	return s.eventRepository.CreateEvent(event);
 }
 
 // GetEventById implements EventService
@@ -33,17 +71,533 @@ func (s eventService) GetEventById(id string) (models.Event, error) {
 }
 
 // GetEvents implements EventService
-func (s eventService) GetEvents() ([]models.Event, error) {
-	return s.eventRepository.GetEvents();
+func (s eventService) GetEvents(statuses []string, organizationId uint, viewerId uint) ([]models.Event, error) {
+	statuses, err := s.statusFilter(statuses, organizationId, viewerId)
+	if err != nil {
+		return []models.Event{}, err
+	}
+
+	return s.eventRepository.GetEvents(statuses, organizationId);
+}
+
+// CheckVisible implements EventService
+func (s eventService) CheckVisible(event models.Event, viewerId uint) error {
+	return checkVisible(s.orgUsersRepository, event, viewerId)
+}
+
+// Drafts are only visible to the staff of their organization, to anyone
+// else they do not exist
+func checkVisible(r repository.OrgUsersRepository, event models.Event, viewerId uint) error {
+	if event.Status == models.EventDraft && !isStaff(r, viewerId, event.OrganizationID) {
+		return errors.New("get failed")
+	}
+
+	return nil
+}
+
+// Returns the statuses to list events in, published by default. Drafts
+// can only be listed for one organization, by its staff.
+func (s eventService) statusFilter(statuses []string, organizationId uint, viewerId uint) ([]string, error) {
+	if len(statuses) == 0 {
+		return []string{models.EventPublished}, nil
+	}
+
+	for _, status := range statuses {
+		switch status {
+		case models.EventDraft:
+			if organizationId == 0 {
+				return nil, errors.New("drafts can only be listed for one organization")
+			}
+
+			if !isStaff(s.orgUsersRepository, viewerId, organizationId) {
+				return nil, ErrNotStaff
+			}
+		case models.EventPublished, models.EventCancelled, models.EventCompleted:
+		default:
+			return nil, errors.New("status must be draft, published, cancelled or completed")
+		}
+	}
+
+	return statuses, nil
+}
+
+// Fails unless the event can still be edited: cancelled and completed
+// events are kept as they were
+func checkEditable(event models.Event) error {
+	if event.Status == models.EventCancelled || event.Status == models.EventCompleted {
+		return errors.New(event.Status + " events cannot be edited")
+	}
+
+	return nil
 }
 
 // UpdateEvent implements EventService
-func (s eventService) UpdateEvent(event models.Event) (models.Event, error) {
-	return s.eventRepository.UpdateEvent(event);
+//
+// Only managers of the event's organization can edit it, and it stays in
+// that organization
+func (s eventService) UpdateEvent(event models.Event, userId uint) (models.Event, error) {
+	if err := prepareSchedule(&event); err != nil {
+		return models.Event{}, err
+	}
+
+	if err := prepareRecurrence(&event); err != nil {
+		return models.Event{}, err
+	}
+
+	event.Latitude, event.Longitude = geocodeAddress(s.geocoder, event.Address)
+
+	current, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(event.ID), 10))
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if err := requireManager(s.orgUsersRepository, userId, current.OrganizationID); err != nil {
+		return models.Event{}, err
+	}
+
+	if err := checkEditable(current); err != nil {
+		return models.Event{}, err
+	}
+
+	// Statuses only change through their own transitions
+	event.Status = current.Status
+	event.OrganizationID = current.OrganizationID
+
+	seriesId, occurrence := seriesOccurrence(current, time.Time{})
+	users := s.signedUpUsers(current, seriesId, func(date time.Time) bool {
+		return current.SeriesID == nil || date.Equal(occurrence)
+	})
+
+	// Moving an event or series moves its sign-ups along with it
+	delta := event.Start.Sub(current.Start)
+	if delta != 0 && event.SeriesID == nil {
+		updated, err := s.eventRepository.UpdateSeries(event, current.Start, delta)
+		if err == nil {
+			s.notifyChanged(users, updated)
+		}
+		return updated, err
+	}
+
+	updated, err := s.eventRepository.UpdateEvent(event);
+	if err == nil {
+		s.notifyChanged(users, updated)
+	}
+	return updated, err
+}
+
+// SearchEvents implements EventService
+func (s eventService) SearchEvents(query models.EventSearchQuery, cursor string, viewerId uint) (models.EventSearchResult, error) {
+	if err := decodeCursor(cursor, &query.Cursor); err != nil {
+		return models.EventSearchResult{}, err
+	}
+
+	statuses, err := s.statusFilter(query.Statuses, query.OrganizationID, viewerId)
+	if err != nil {
+		return models.EventSearchResult{}, err
+	}
+	query.Statuses = statuses
+
+	if query.Sort == "" {
+		query.Sort = models.EventSortDate
+	}
+	if query.Sort != models.EventSortDate && query.Sort != models.EventSortRelevance {
+		return models.EventSearchResult{}, errors.New("sort must be date or relevance")
+	}
+
+	if !query.From.IsZero() && !query.To.IsZero() && query.To.Before(query.From) {
+		return models.EventSearchResult{}, errors.New("to must not be before from")
+	}
+
+	// Fetch one extra event so we know if there is a next page
+	limit := query.Limit
+	query.Limit = limit + 1
+
+	events, total, err := s.eventRepository.SearchEvents(query)
+	if err != nil {
+		return models.EventSearchResult{}, err
+	}
+
+	result := models.EventSearchResult{
+		Events: events,
+		Total:  total,
+	}
+
+	if len(events) > limit {
+		result.Events = events[:limit]
+		last := result.Events[limit-1]
+
+		if query.Sort == models.EventSortRelevance && query.Text != "" {
+			result.NextCursor = encodeCursor(models.EventSearchCursor{Offset: query.Cursor.Offset + limit})
+		} else {
+			result.NextCursor = encodeCursor(models.EventSearchCursor{Start: last.Start, ID: last.ID})
+		}
+	}
+
+	return result, nil
+}
+
+// NearbyEvents implements EventService
+//
+// Like GetEvents, drafts can only be listed for one organization, by its
+// staff
+func (s eventService) NearbyEvents(lat float64, lng float64, radiusKm float64, limit int, statuses []string, organizationId uint, viewerId uint) ([]models.NearbyEvent, error) {
+	if lat < -90 || lat > 90 || lng < -180 || lng > 180 {
+		return []models.NearbyEvent{}, errors.New("lat must be within [-90, 90] and lng within [-180, 180]")
+	}
+
+	if radiusKm <= 0 || radiusKm > maxRadiusKm {
+		return []models.NearbyEvent{}, fmt.Errorf("radius_km must be between 0 and %v", maxRadiusKm)
+	}
+
+	statuses, err := s.statusFilter(statuses, organizationId, viewerId)
+	if err != nil {
+		return []models.NearbyEvent{}, err
+	}
+
+	return s.eventRepository.NearbyEvents(lat, lng, radiusKm, time.Now(), limit, statuses, organizationId)
+}
+
+// GetOccurrences implements EventService
+func (s eventService) GetOccurrences(from time.Time, to time.Time, organizationId uint, limit int, statuses []string, viewerId uint) ([]models.EventOccurrence, error) {
+	if to.Before(from) {
+		return []models.EventOccurrence{}, errors.New("to must not be before from")
+	}
+
+	if to.Sub(from) > maxOccurrenceRange {
+		return []models.EventOccurrence{}, errors.New("occurrences can be listed for at most a year at a time")
+	}
+
+	statuses, err := s.statusFilter(statuses, organizationId, viewerId)
+	if err != nil {
+		return []models.EventOccurrence{}, err
+	}
+
+	events, err := s.eventRepository.GetEventsBetween(from, to, organizationId, statuses)
+	if err != nil {
+		return []models.EventOccurrence{}, err
+	}
+
+	seriesIds := []uint{}
+	for _, event := range events {
+		if event.Recurrence != "" {
+			seriesIds = append(seriesIds, event.ID)
+		}
+	}
+
+	overrides, err := s.eventRepository.GetOverrides(seriesIds)
+	if err != nil {
+		return []models.EventOccurrence{}, err
+	}
+
+	exceptions, err := s.eventRepository.GetExceptions(seriesIds)
+	if err != nil {
+		return []models.EventOccurrence{}, err
+	}
+
+	occurrences := expandOccurrences(events, overrides, exceptions, from, to)
+	if len(occurrences) > limit {
+		occurrences = occurrences[:limit]
+	}
+
+	return occurrences, nil
+}
+
+// GetEventOccurrences implements EventService
+func (s eventService) GetEventOccurrences(event models.Event, from time.Time, to time.Time) ([]models.EventOccurrence, error) {
+	if to.Before(from) {
+		return []models.EventOccurrence{}, errors.New("to must not be before from")
+	}
+
+	if to.Sub(from) > maxOccurrenceRange {
+		return []models.EventOccurrence{}, errors.New("occurrences can be listed for at most a year at a time")
+	}
+
+	if _, recurring, _ := eventRule(event); !recurring {
+		return expandOccurrences([]models.Event{event}, nil, nil, from, to), nil
+	}
+
+	overrides, err := s.eventRepository.GetOverrides([]uint{event.ID})
+	if err != nil {
+		return []models.EventOccurrence{}, err
+	}
+
+	exceptions, err := s.eventRepository.GetExceptions([]uint{event.ID})
+	if err != nil {
+		return []models.EventOccurrence{}, err
+	}
+
+	events := append([]models.Event{event}, overrides...)
+
+	return expandOccurrences(events, overrides, exceptions, from, to), nil
+}
+
+// UpdateOccurrence implements EventService
+//
+// Applies changes to one occurrence of a recurring series, to it and the
+// occurrences after it, or to the whole series. The difference between
+// the new date in changes and the occurrence is applied to every affected
+// occurrence. Editing the following occurrences splits the series in two.
+// Only managers of the series' organization can.
+func (s eventService) UpdateOccurrence(series models.Event, occurrence time.Time, scope string, changes models.Event, userId uint) (models.Event, error) {
+	if err := requireManager(s.orgUsersRepository, userId, series.OrganizationID); err != nil {
+		return models.Event{}, err
+	}
+
+	users := s.signedUpUsers(series, series.ID, func(date time.Time) bool {
+		switch scope {
+		case models.EditThisOccurrence:
+			return date.Equal(occurrence)
+		case models.EditFollowing:
+			return !date.Before(occurrence)
+		}
+		return true
+	})
+
+	updated, err := s.updateOccurrence(series, occurrence, scope, changes)
+	if err == nil {
+		s.notifyChanged(users, updated)
+	}
+
+	return updated, err
+}
+
+func (s eventService) updateOccurrence(series models.Event, occurrence time.Time, scope string, changes models.Event) (models.Event, error) {
+	if err := checkEditable(series); err != nil {
+		return models.Event{}, err
+	}
+
+	if err := checkOccurrence(s.eventRepository, series, occurrence); err != nil {
+		return models.Event{}, err
+	}
+
+	rule, _, _ := eventRule(series)
+
+	if changes.Start.IsZero() {
+		changes.Start = occurrence
+	}
+	delta := changes.Start.Sub(occurrence)
+
+	// Occurrences keep the length of the series unless given a new end
+	duration := series.Duration()
+	if !changes.End.IsZero() {
+		duration = changes.End.Sub(changes.Start)
+	}
+	changes.End = changes.Start.Add(duration)
+
+	if changes.TimeZone == "" {
+		changes.TimeZone = series.TimeZone
+	}
+
+	changes.OrganizationID = series.OrganizationID
+	changes.Status = series.Status
+	changes.MinimumAge = series.MinimumAge
+	changes.BackgroundCheck = series.BackgroundCheck
+	changes.Latitude, changes.Longitude = geocodeAddress(s.geocoder, changes.Address)
+
+	if scope == models.EditFollowing && occurrence.Equal(series.Start) {
+		scope = models.EditWholeSeries
+	}
+
+	switch scope {
+	case models.EditThisOccurrence:
+		if changes.Recurrence != "" {
+			return models.Event{}, errors.New("a single occurrence cannot repeat")
+		}
+
+		overrides, err := s.eventRepository.GetOverrides([]uint{series.ID})
+		if err != nil {
+			return models.Event{}, err
+		}
+
+		changes.SeriesID = &series.ID
+		changes.OccurrenceDate = &occurrence
+		changes.RecurrenceEnd = nil
+
+		if err := prepareSchedule(&changes); err != nil {
+			return models.Event{}, err
+		}
+
+		for _, override := range overrides {
+			if override.OccurrenceDate != nil && override.OccurrenceDate.Equal(occurrence) {
+				changes.Model = override.Model
+				return s.eventRepository.UpdateEvent(changes)
+			}
+		}
+
+		return s.eventRepository.CreateEvent(changes)
+	case models.EditFollowing:
+		if changes.Recurrence == "" {
+			remaining := rule
+			if rule.Count > 0 {
+				remaining.Count = rule.Count - rule.CountBefore(localStart(series), occurrence)
+			}
+			changes.Recurrence = remaining.String()
+		}
+
+		if err := prepareSchedule(&changes); err != nil {
+			return models.Event{}, err
+		}
+
+		if err := prepareRecurrence(&changes); err != nil {
+			return models.Event{}, err
+		}
+
+		// End the current series just before the edited occurrence
+		ended := rule
+		ended.Count = 0
+		ended.Until = occurrence.Add(-time.Second)
+		series.Recurrence = ended.String()
+
+		if err := prepareRecurrence(&series); err != nil {
+			return models.Event{}, err
+		}
+
+		return s.eventRepository.SplitSeries(series, changes, occurrence, delta)
+	case models.EditWholeSeries:
+		if changes.Recurrence == "" {
+			changes.Recurrence = series.Recurrence
+		}
+
+		start := series.Start
+		changes.Model = series.Model
+		changes.Start = start.Add(delta)
+		changes.End = changes.Start.Add(duration)
+
+		if err := prepareSchedule(&changes); err != nil {
+			return models.Event{}, err
+		}
+
+		if err := prepareRecurrence(&changes); err != nil {
+			return models.Event{}, err
+		}
+
+		return s.eventRepository.UpdateSeries(changes, start, delta)
+	}
+
+	return models.Event{}, errors.New("scope must be this, following or all")
+}
+
+// CancelOccurrence implements EventService
+//
+// Only managers of the series' organization can cancel an occurrence
+func (s eventService) CancelOccurrence(series models.Event, occurrence time.Time, userId uint) (models.EventExceptions, error) {
+	if err := requireManager(s.orgUsersRepository, userId, series.OrganizationID); err != nil {
+		return models.EventExceptions{}, err
+	}
+
+	if err := checkEditable(series); err != nil {
+		return models.EventExceptions{}, err
+	}
+
+	if err := checkOccurrence(s.eventRepository, series, occurrence); err != nil {
+		return models.EventExceptions{}, err
+	}
+
+	overrides, err := s.eventRepository.GetOverrides([]uint{series.ID})
+	if err != nil {
+		return models.EventExceptions{}, err
+	}
+
+	// An edited copy of the occurrence goes with it
+	for _, override := range overrides {
+		if override.OccurrenceDate != nil && override.OccurrenceDate.Equal(occurrence) {
+			if err := s.eventRepository.DeleteEvent(override); err != nil {
+				return models.EventExceptions{}, err
+			}
+		}
+	}
+
+	users := s.signedUpUsers(series, series.ID, func(date time.Time) bool {
+		return date.Equal(occurrence)
+	})
+
+	exception, err := s.eventRepository.CreateException(models.EventExceptions{
+		EventID:        series.ID,
+		OccurrenceDate: occurrence,
+	})
+	if err != nil {
+		return exception, err
+	}
+
+	for _, userId := range users {
+		s.notifications.Notify(models.Notifications{
+			UsersID:     userId,
+			Type:        models.NotifyEventCancelled,
+			Title:       "Cancelled: " + series.Name,
+			Body:        series.Name + " on " + occurrence.In(series.Location()).Format("Monday, January 2 2006 at 3:04 PM MST") + " has been cancelled by the organizer.",
+			SubjectType: models.SubjectEvent,
+			SubjectID:   series.ID,
+		}, nil)
+	}
+
+	return exception, nil
+}
+
+// The users signed up to upcoming occurrences of the series with id
+// seriesId that affected accepts, by original start, each once. Only
+// published events have anyone to tell. Failing to find them is only
+// logged, the change goes ahead either way.
+func (s eventService) signedUpUsers(event models.Event, seriesId uint, affected func(time.Time) bool) []uint {
+	if event.Status != models.EventPublished {
+		return nil
+	}
+
+	signups, err := s.signupRepository.GetSignups(seriesId, time.Time{})
+	if err != nil {
+		log.Println("[EventService] Could not find sign-ups to notify:", err)
+		return nil
+	}
+
+	now := time.Now()
+	seen := map[uint]bool{}
+	users := []uint{}
+
+	for _, signup := range signups {
+		if signup.OccurrenceDate.Before(now) || !affected(signup.OccurrenceDate) || seen[signup.UsersID] {
+			continue
+		}
+		seen[signup.UsersID] = true
+		users = append(users, signup.UsersID)
+	}
+
+	return users
+}
+
+// Tells the users the event they signed up to changed
+func (s eventService) notifyChanged(users []uint, event models.Event) {
+	for _, userId := range users {
+		s.notifications.Notify(models.Notifications{
+			UsersID:     userId,
+			Type:        models.NotifyEventChanged,
+			Title:       event.Name + " has changed",
+			Body:        "The organizer changed the details of " + event.Name + ". It now starts " + event.Start.In(event.Location()).Format("Monday, January 2 2006 at 3:04 PM MST") + ".",
+			SubjectType: models.SubjectEvent,
+			SubjectID:   event.ID,
+		}, nil)
+	}
+}
+
+// RestoreOccurrence implements EventService
+//
+// Only managers of the series' organization can restore an occurrence
+func (s eventService) RestoreOccurrence(series models.Event, occurrence time.Time, userId uint) error {
+	if err := requireManager(s.orgUsersRepository, userId, series.OrganizationID); err != nil {
+		return err
+	}
+
+	if err := checkEditable(series); err != nil {
+		return err
+	}
+
+	return s.eventRepository.DeleteException(series.ID, occurrence)
 }
 
-func NewEventService(r repository.EventRepository) EventService {
+func NewEventService(r repository.EventRepository, o repository.OrgUsersRepository, sr repository.SignupRepository, g geocoder.Geocoder, n NotificationService) EventService {
 	return eventService{
-		eventRepository: r,
+		eventRepository:    r,
+		orgUsersRepository: o,
+		signupRepository:   sr,
+		geocoder:           g,
+		notifications:      n,
 	}
 }
@@ -0,0 +1,711 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.EventRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	mockSignupRepo   *mocks.SignupRepository
+	notifications    *mocks.NotificationService
+	service          EventService
+	date             time.Time
+	err              error
+}
+
+func (suite *EventServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.EventRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.mockSignupRepo = new(mocks.SignupRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.service = NewEventService(suite.mockRepo, suite.mockOrgUsersRepo, suite.mockSignupRepo, geocoder.NewStaticGeocoder(map[string]geocoder.Location{
+		"1 Main St, Springfield": {Latitude: 39.8, Longitude: -89.6},
+	}), suite.notifications)
+
+	suite.date = time.Date(2023, 5, 1, 9, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *EventServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+	suite.mockSignupRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+}
+
+func TestEventServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(EventServiceUnitTestSuite))
+}
+
+// Expects user 7 to be checked for managing the event's organization
+func (suite *EventServiceUnitTestSuite) expectManager() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), mock.Anything).Return(models.OrgUsers{Role: models.RoleManager}, nil).Once()
+}
+
+func (suite *EventServiceUnitTestSuite) events(count int) []models.Event {
+	events := []models.Event{}
+	for i := 1; i <= count; i++ {
+		var event models.Event
+		event.ID = uint(i)
+		event.Start = suite.date.Add(time.Duration(i) * time.Hour)
+		events = append(events, event)
+	}
+	return events
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_SearchEvents_InvalidSort() {
+	_, err := suite.service.SearchEvents(models.EventSearchQuery{Sort: "name", Limit: 2}, "", 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_SearchEvents_InvalidRange() {
+	query := models.EventSearchQuery{From: suite.date, To: suite.date.Add(-time.Hour), Limit: 2}
+	_, err := suite.service.SearchEvents(query, "", 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_SearchEvents_DateCursor() {
+	expected := models.EventSearchQuery{Statuses: []string{models.EventPublished}, Sort: models.EventSortDate, Limit: 3}
+	suite.mockRepo.On("SearchEvents", expected).Return(suite.events(3), int64(7), nil)
+
+	res, err := suite.service.SearchEvents(models.EventSearchQuery{Limit: 2}, "", 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res.Events, 2)
+	assert.Equal(suite.T(), int64(7), res.Total)
+
+	var cursor models.EventSearchCursor
+	assert.Nil(suite.T(), decodeCursor(res.NextCursor, &cursor))
+	assert.Equal(suite.T(), uint(2), cursor.ID)
+	assert.True(suite.T(), cursor.Start.Equal(res.Events[1].Start))
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_SearchEvents_RelevanceCursor() {
+	cursor := encodeCursor(models.EventSearchCursor{Offset: 2})
+	expected := models.EventSearchQuery{
+		Text:     "food",
+		Statuses: []string{models.EventPublished},
+		Sort:     models.EventSortRelevance,
+		Limit:    3,
+		Cursor:   models.EventSearchCursor{Offset: 2},
+	}
+	suite.mockRepo.On("SearchEvents", expected).Return(suite.events(3), int64(9), nil)
+
+	res, err := suite.service.SearchEvents(models.EventSearchQuery{
+		Text:  "food",
+		Sort:  models.EventSortRelevance,
+		Limit: 2,
+	}, cursor, 0)
+
+	assert.Nil(suite.T(), err)
+
+	var next models.EventSearchCursor
+	assert.Nil(suite.T(), decodeCursor(res.NextCursor, &next))
+	assert.Equal(suite.T(), 4, next.Offset)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_SearchEvents_LastPage() {
+	expected := models.EventSearchQuery{Statuses: []string{models.EventPublished}, Sort: models.EventSortDate, Limit: 3}
+	suite.mockRepo.On("SearchEvents", expected).Return(suite.events(1), int64(1), nil)
+
+	res, err := suite.service.SearchEvents(models.EventSearchQuery{Limit: 2}, "", 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "", res.NextCursor)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_SearchEvents_Fail() {
+	expected := models.EventSearchQuery{Statuses: []string{models.EventPublished}, Sort: models.EventSortDate, Limit: 3}
+	suite.mockRepo.On("SearchEvents", expected).Return([]models.Event{}, int64(0), suite.err)
+
+	_, err := suite.service.SearchEvents(models.EventSearchQuery{Limit: 2}, "", 0)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_Geocodes() {
+	suite.expectManager()
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.Latitude != nil && *event.Latitude == 39.8 &&
+			event.Longitude != nil && *event.Longitude == -89.6
+	})).Return(models.Event{}, nil)
+
+	event := suite.series("")
+	event.Address = " 1 main st,  Springfield"
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_UnknownAddress() {
+	suite.expectManager()
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.Latitude == nil && event.Longitude == nil
+	})).Return(models.Event{}, nil)
+
+	event := suite.series("")
+	event.Address = "Nowhere"
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_StoresUTC() {
+	suite.expectManager()
+	chicago, _ := time.LoadLocation("America/Chicago")
+	start := time.Date(2034, 7, 1, 9, 0, 0, 0, chicago)
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.Start == start.UTC() && event.End == start.Add(time.Hour).UTC() &&
+			event.TimeZone == "America/Chicago"
+	})).Return(models.Event{}, nil)
+
+	_, err := suite.service.CreateEvent(models.Event{
+		Start:    start,
+		End:      start.Add(time.Hour),
+		TimeZone: "America/Chicago",
+	}, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_DefaultTimeZone() {
+	suite.expectManager()
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.TimeZone == "UTC"
+	})).Return(models.Event{}, nil)
+
+	_, err := suite.service.CreateEvent(suite.series(""), 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_UnknownTimeZone() {
+	suite.expectManager()
+	event := suite.series("")
+	event.TimeZone = "Mars/Olympus_Mons"
+
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_EndBeforeStart() {
+	suite.expectManager()
+	event := suite.series("")
+	event.End = event.Start.Add(-time.Minute)
+
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_MissingEnd() {
+	suite.expectManager()
+	event := suite.series("")
+	event.End = time.Time{}
+
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_InPast() {
+	suite.expectManager()
+	start := time.Now().Add(-time.Hour)
+
+	_, err := suite.service.CreateEvent(models.Event{Start: start, End: start.Add(2 * time.Hour)}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateEvent_EndBeforeStart() {
+	event := suite.series("")
+	event.End = event.Start
+
+	_, err := suite.service.UpdateEvent(event, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_NearbyEvents_InvalidCoordinates() {
+	_, err := suite.service.NearbyEvents(91, 0, 10, 20, nil, 0, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_NearbyEvents_InvalidRadius() {
+	_, err := suite.service.NearbyEvents(39.8, -89.6, 0, 20, nil, 0, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_NearbyEvents() {
+	nearby := []models.NearbyEvent{{Event: suite.events(1)[0], DistanceKm: 1.5}}
+	suite.mockRepo.On("NearbyEvents", 39.8, -89.6, 10.0, mock.Anything, 20, []string{models.EventPublished}, uint(0)).Return(nearby, nil)
+
+	res, err := suite.service.NearbyEvents(39.8, -89.6, 10, 20, nil, 0, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), nearby, res)
+}
+
+// Weekly on Saturdays from 9 to 11 starting April 1st 2034
+func (suite *EventServiceUnitTestSuite) series(rule string) models.Event {
+	var event models.Event
+	event.ID = 1
+	event.OrganizationID = 2
+	event.Start = time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	event.End = event.Start.Add(2 * time.Hour)
+	event.Recurrence = rule
+	return event
+}
+
+func (suite *EventServiceUnitTestSuite) saturday(week int) time.Time {
+	return time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC).AddDate(0, 0, 7*week)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_InvalidRecurrence() {
+	suite.expectManager()
+	_, err := suite.service.CreateEvent(suite.series("FREQ=HOURLY"), 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_RecurrenceEnd() {
+	suite.expectManager()
+	event := suite.series("freq=weekly;count=3")
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.Recurrence == "FREQ=WEEKLY;COUNT=3" &&
+			event.RecurrenceEnd != nil && event.RecurrenceEnd.Equal(suite.saturday(2))
+	})).Return(event, nil)
+
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateEvent_MovesSignups() {
+	suite.expectManager()
+	current := suite.series("FREQ=WEEKLY")
+	moved := current
+	moved.Start = current.Start.Add(time.Hour)
+
+	suite.mockRepo.On("GetEventById", "1").Return(current, nil)
+	suite.mockRepo.On("UpdateSeries", mock.Anything, current.Start, time.Hour).Return(moved, nil)
+
+	_, err := suite.service.UpdateEvent(moved, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetOccurrences() {
+	series := suite.series("FREQ=WEEKLY")
+	seriesId := series.ID
+
+	// The second Saturday moved to the Sunday, the third cancelled
+	var edited models.Event
+	edited.ID = 5
+	edited.SeriesID = &seriesId
+	occurrence := suite.saturday(1)
+	edited.OccurrenceDate = &occurrence
+	edited.Start = occurrence.AddDate(0, 0, 1)
+
+	var oneOff models.Event
+	oneOff.ID = 3
+	oneOff.Start = suite.saturday(0).Add(time.Hour)
+
+	from, to := suite.saturday(0), suite.saturday(3)
+	suite.mockRepo.On("GetEventsBetween", from, to, uint(0), []string{models.EventPublished}).Return([]models.Event{series, oneOff, edited}, nil)
+	suite.mockRepo.On("GetOverrides", []uint{1}).Return([]models.Event{edited}, nil)
+	suite.mockRepo.On("GetExceptions", []uint{1}).
+		Return([]models.EventExceptions{{EventID: 1, OccurrenceDate: suite.saturday(2)}}, nil)
+
+	res, err := suite.service.GetOccurrences(from, to, 0, 10, nil, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 4)
+	assert.Equal(suite.T(), suite.saturday(0), res[0].Start)
+	assert.Equal(suite.T(), uint(3), res[1].Event.ID)
+	assert.Equal(suite.T(), uint(5), res[2].Event.ID)
+	assert.Equal(suite.T(), uint(1), res[2].SeriesID)
+	assert.Equal(suite.T(), suite.saturday(1), res[2].OccurrenceDate)
+	assert.Equal(suite.T(), suite.saturday(3), res[3].Start)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetOccurrences_RangeTooLong() {
+	_, err := suite.service.GetOccurrences(suite.date, suite.date.AddDate(2, 0, 0), 0, 10, nil, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEventOccurrences_KeepsLocalTime() {
+	// Weekly at 9 in Chicago across the start of daylight saving time on
+	// March 12th 2034
+	chicago, _ := time.LoadLocation("America/Chicago")
+	event := suite.series("FREQ=WEEKLY")
+	event.Start = time.Date(2034, 3, 4, 9, 0, 0, 0, chicago).UTC()
+	event.End = event.Start.Add(2 * time.Hour)
+	event.TimeZone = "America/Chicago"
+
+	suite.mockRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	res, err := suite.service.GetEventOccurrences(event, event.Start, event.Start.AddDate(0, 0, 14))
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 3)
+	assert.Equal(suite.T(), time.Date(2034, 3, 4, 15, 0, 0, 0, time.UTC), res[0].Start)
+	assert.Equal(suite.T(), time.Date(2034, 3, 11, 15, 0, 0, 0, time.UTC), res[1].Start)
+	assert.Equal(suite.T(), time.Date(2034, 3, 18, 14, 0, 0, 0, time.UTC), res[2].Start)
+	assert.Equal(suite.T(), time.Date(2034, 3, 18, 16, 0, 0, 0, time.UTC), res[2].End)
+	assert.Equal(suite.T(), 9, res[2].In(chicago).Start.Hour())
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_NotAnOccurrence() {
+	suite.expectManager()
+	_, err := suite.service.UpdateOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1).Add(time.Hour),
+		models.EditThisOccurrence, models.Event{}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_Cancelled() {
+	suite.expectManager()
+	suite.mockRepo.On("GetExceptions", []uint{1}).
+		Return([]models.EventExceptions{{EventID: 1, OccurrenceDate: suite.saturday(1)}}, nil)
+
+	_, err := suite.service.UpdateOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1),
+		models.EditThisOccurrence, models.Event{}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_This() {
+	suite.expectManager()
+	series := suite.series("FREQ=WEEKLY")
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return *event.SeriesID == 1 && event.OccurrenceDate.Equal(suite.saturday(1)) &&
+			event.Start.Equal(suite.saturday(1)) && event.Name == "Late shift" &&
+			event.OrganizationID == 2 && event.Recurrence == ""
+	})).Return(models.Event{}, nil)
+
+	_, err := suite.service.UpdateOccurrence(series, suite.saturday(1), models.EditThisOccurrence,
+		models.Event{Name: "Late shift"}, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_Following() {
+	suite.expectManager()
+	series := suite.series("FREQ=WEEKLY;COUNT=5")
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockRepo.On("SplitSeries",
+		mock.MatchedBy(func(event models.Event) bool {
+			return event.Recurrence == "FREQ=WEEKLY;UNTIL=20340415T085959Z" &&
+				event.RecurrenceEnd.Equal(suite.saturday(1))
+		}),
+		mock.MatchedBy(func(event models.Event) bool {
+			return event.Recurrence == "FREQ=WEEKLY;COUNT=3" &&
+				event.Start.Equal(suite.saturday(2).Add(time.Hour))
+		}),
+		suite.saturday(2), time.Hour).Return(models.Event{}, nil)
+
+	_, err := suite.service.UpdateOccurrence(series, suite.saturday(2), models.EditFollowing,
+		models.Event{Start: suite.saturday(2).Add(time.Hour)}, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_WholeSeries() {
+	suite.expectManager()
+	series := suite.series("FREQ=WEEKLY")
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockRepo.On("UpdateSeries",
+		mock.MatchedBy(func(event models.Event) bool {
+			return event.ID == 1 && event.Recurrence == "FREQ=WEEKLY" &&
+				event.Start.Equal(suite.saturday(0).Add(-time.Hour))
+		}),
+		suite.saturday(0), -time.Hour).Return(models.Event{}, nil)
+
+	_, err := suite.service.UpdateOccurrence(series, suite.saturday(3), models.EditWholeSeries,
+		models.Event{Start: suite.saturday(3).Add(-time.Hour)}, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_InvalidScope() {
+	suite.expectManager()
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	_, err := suite.service.UpdateOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1), "some", models.Event{}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_NotManager() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), mock.Anything).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	_, err := suite.service.UpdateOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1),
+		models.EditThisOccurrence, models.Event{Name: "Late shift"}, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateEvent", mock.Anything)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CancelOccurrence_NotManager() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), mock.Anything).Return(models.OrgUsers{}, fmt.Errorf("error"))
+
+	_, err := suite.service.CancelOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1), 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateException", mock.Anything)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_RestoreOccurrence() {
+	suite.expectManager()
+	suite.mockRepo.On("DeleteException", uint(1), suite.saturday(1)).Return(nil)
+
+	err := suite.service.RestoreOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1), 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_RestoreOccurrence_NotManager() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), mock.Anything).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	err := suite.service.RestoreOccurrence(suite.series("FREQ=WEEKLY"), suite.saturday(1), 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "DeleteException", mock.Anything, mock.Anything)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CancelOccurrence_DeletesEditedCopy() {
+	suite.expectManager()
+	series := suite.series("FREQ=WEEKLY")
+	occurrence := suite.saturday(1)
+
+	var edited models.Event
+	edited.ID = 5
+	edited.SeriesID = &series.ID
+	edited.OccurrenceDate = &occurrence
+
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockRepo.On("GetOverrides", []uint{1}).Return([]models.Event{edited}, nil)
+	suite.mockRepo.On("DeleteEvent", edited).Return(nil)
+	suite.mockRepo.On("CreateException", models.EventExceptions{EventID: 1, OccurrenceDate: occurrence}).
+		Return(models.EventExceptions{}, nil)
+
+	_, err := suite.service.CancelOccurrence(series, occurrence, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateOccurrence_NotifiesSignedUp() {
+	suite.expectManager()
+	series := suite.series("FREQ=WEEKLY")
+	series.Status = models.EventPublished
+	past := time.Date(2020, 4, 4, 9, 0, 0, 0, time.UTC)
+
+	// Only the upcoming sign-ups to the edited occurrence, once per user
+	suite.mockSignupRepo.On("GetSignups", uint(1), time.Time{}).Return([]models.EventSignups{
+		{UsersID: 4, OccurrenceDate: suite.saturday(1)},
+		{UsersID: 4, OccurrenceDate: suite.saturday(1), ShiftID: 2},
+		{UsersID: 5, OccurrenceDate: suite.saturday(2)},
+		{UsersID: 6, OccurrenceDate: past},
+	}, nil)
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockRepo.On("CreateEvent", mock.Anything).Return(models.Event{Name: "Late shift", Start: suite.saturday(1)}, nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Type == models.NotifyEventChanged && n.Title == "Late shift has changed"
+	}), (*mailer.Message)(nil)).Once()
+
+	_, err := suite.service.UpdateOccurrence(series, suite.saturday(1), models.EditThisOccurrence,
+		models.Event{Name: "Late shift"}, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CancelOccurrence_NotifiesSignedUp() {
+	suite.expectManager()
+	series := suite.series("FREQ=WEEKLY")
+	series.Status = models.EventPublished
+	series.Name = "Park cleanup"
+	occurrence := suite.saturday(1)
+
+	suite.mockSignupRepo.On("GetSignups", uint(1), time.Time{}).Return([]models.EventSignups{
+		{UsersID: 4, OccurrenceDate: occurrence},
+		{UsersID: 5, OccurrenceDate: suite.saturday(2)},
+	}, nil)
+	suite.mockRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockRepo.On("CreateException", mock.Anything).Return(models.EventExceptions{}, nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Type == models.NotifyEventCancelled && n.SubjectID == 1
+	}), (*mailer.Message)(nil)).Once()
+
+	_, err := suite.service.CancelOccurrence(series, occurrence, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_Draft() {
+	suite.expectManager()
+	suite.mockRepo.On("CreateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.Status == models.EventDraft
+	})).Return(models.Event{}, nil)
+
+	event := suite.series("")
+	event.Status = models.EventPublished
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateEvent_Cancelled() {
+	suite.expectManager()
+	current := suite.series("")
+	current.Status = models.EventCancelled
+	suite.mockRepo.On("GetEventById", "1").Return(current, nil)
+
+	_, err := suite.service.UpdateEvent(suite.series(""), 7)
+
+	assert.EqualError(suite.T(), err, "cancelled events cannot be edited")
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CreateEvent_NotManager() {
+	event := suite.series("")
+	event.OrganizationID = 2
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	_, err := suite.service.CreateEvent(event, 7)
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateEvent_KeepsOrganization() {
+	current := suite.series("")
+	current.OrganizationID = 2
+	moved := current
+	moved.OrganizationID = 9
+
+	// Managers of the event's organization can't move it to another one
+	suite.mockRepo.On("GetEventById", "1").Return(current, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+	suite.mockRepo.On("UpdateEvent", mock.MatchedBy(func(event models.Event) bool {
+		return event.OrganizationID == 2
+	})).Return(current, nil)
+
+	_, err := suite.service.UpdateEvent(moved, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_UpdateEvent_NotManager() {
+	current := suite.series("")
+	current.OrganizationID = 2
+	suite.mockRepo.On("GetEventById", "1").Return(current, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.UpdateEvent(current, 7)
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEvents_PublishedByDefault() {
+	suite.mockRepo.On("GetEvents", []string{models.EventPublished}, uint(0)).Return([]models.Event{}, nil)
+
+	_, err := suite.service.GetEvents(nil, 0, 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEvents_DraftsForStaff() {
+	statuses := []string{models.EventDraft, models.EventPublished}
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+	suite.mockRepo.On("GetEvents", statuses, uint(2)).Return([]models.Event{}, nil)
+
+	_, err := suite.service.GetEvents(statuses, 2, 4)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEvents_DraftsNotStaff() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.GetEvents([]string{models.EventDraft}, 2, 4)
+
+	assert.Equal(suite.T(), ErrNotStaff, err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEvents_DraftsAnonymous() {
+	_, err := suite.service.GetEvents([]string{models.EventDraft}, 2, 0)
+
+	assert.Equal(suite.T(), ErrNotStaff, err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEvents_DraftsNeedOrganization() {
+	_, err := suite.service.GetEvents([]string{models.EventDraft}, 0, 4)
+
+	assert.EqualError(suite.T(), err, "drafts can only be listed for one organization")
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_GetEvents_UnknownStatus() {
+	_, err := suite.service.GetEvents([]string{"archived"}, 0, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_NearbyEvents_NoDrafts() {
+	_, err := suite.service.NearbyEvents(39.8, -89.6, 10, 20, []string{models.EventDraft}, 0, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_NearbyEvents_StaffDrafts() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+	suite.mockRepo.On("NearbyEvents", 39.8, -89.6, 10.0, mock.Anything, 20, []string{models.EventDraft}, uint(2)).Return([]models.NearbyEvent{}, nil)
+
+	_, err := suite.service.NearbyEvents(39.8, -89.6, 10, 20, []string{models.EventDraft}, 2, 4)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_NearbyEvents_DraftsNotStaff() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(5), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.NearbyEvents(39.8, -89.6, 10, 20, []string{models.EventDraft}, 2, 5)
+
+	assert.ErrorIs(suite.T(), err, ErrNotStaff)
+}
+
+func (suite *EventServiceUnitTestSuite) TestEventService_CheckVisible() {
+	draft := suite.series("")
+	draft.Status = models.EventDraft
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(5), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	assert.Nil(suite.T(), suite.service.CheckVisible(draft, 4))
+	assert.NotNil(suite.T(), suite.service.CheckVisible(draft, 5))
+	assert.NotNil(suite.T(), suite.service.CheckVisible(draft, 0))
+
+	published := suite.series("")
+	published.Status = models.EventPublished
+	assert.Nil(suite.T(), suite.service.CheckVisible(published, 0))
+}
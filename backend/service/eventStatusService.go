@@ -0,0 +1,310 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/ical"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// The statuses each status can change to. Cancelled and completed events
+// stay that way.
+var eventTransitions = map[string][]string{
+	models.EventDraft:     {models.EventPublished, models.EventCancelled},
+	models.EventPublished: {models.EventCancelled, models.EventCompleted},
+}
+
+type EventStatusService interface {
+	ChangeStatus(uint, uint, string, string) (models.Event, error)
+	StatusHistory(uint, uint) ([]models.EventStatusChanges, error)
+}
+
+type eventStatusService struct {
+	eventRepository      repository.EventRepository
+	orgUsersRepository   repository.OrgUsersRepository
+	signupRepository     repository.SignupRepository
+	shiftRepository      repository.ShiftRepository
+	attendanceRepository repository.AttendanceRepository
+	mailer               mailer.Mailer
+	notifications        NotificationService
+	feedCache            feedcache.Cache
+}
+
+// Instantiated in router.go
+func NewEventStatusService(e repository.EventRepository, o repository.OrgUsersRepository, s repository.SignupRepository, sh repository.ShiftRepository, a repository.AttendanceRepository, m mailer.Mailer, n NotificationService, c feedcache.Cache) EventStatusService {
+	return eventStatusService{
+		eventRepository:      e,
+		orgUsersRepository:   o,
+		signupRepository:     s,
+		shiftRepository:      sh,
+		attendanceRepository: a,
+		mailer:               m,
+		notifications:        n,
+		feedCache:            c,
+	}
+}
+
+// Moves the event, a one-off event or a whole series, to status. Only
+// managers of its organization can. Cancelling notifies the volunteers
+// signed up to its upcoming occurrences, with reason. Completing closes
+// the hours still being tracked so managers can verify them.
+func (s eventStatusService) ChangeStatus(eventId uint, userId uint, status string, reason string) (models.Event, error) {
+	log.Println("[EventStatusService] Change status...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	if event.SeriesID != nil {
+		return models.Event{}, errors.New("change the status of the series instead")
+	}
+
+	if err := requireManager(s.orgUsersRepository, userId, event.OrganizationID); err != nil {
+		return models.Event{}, err
+	}
+
+	switch status {
+	case models.EventDraft, models.EventPublished, models.EventCancelled, models.EventCompleted:
+	default:
+		return models.Event{}, errors.New("status must be draft, published, cancelled or completed")
+	}
+
+	allowed := false
+	for _, to := range eventTransitions[event.Status] {
+		allowed = allowed || to == status
+	}
+
+	if !allowed {
+		return models.Event{}, errors.New(event.Status + " events cannot become " + status)
+	}
+
+	if status == models.EventCompleted {
+		if time.Now().Before(event.Start) {
+			return models.Event{}, errors.New("an event cannot be completed before it starts")
+		}
+
+		if err := s.closeHours(event); err != nil {
+			return models.Event{}, err
+		}
+	}
+
+	previous := event.Status
+
+	event, err = s.eventRepository.SetStatus(event, models.EventStatusChanges{
+		EventID: event.ID,
+		From:    previous,
+		To:      status,
+		UsersID: userId,
+		Reason:  reason,
+	})
+	if err != nil {
+		return models.Event{}, err
+	}
+
+	// Only published events show up in feeds. Feeds that have the event
+	// only for its tags catch up once they expire.
+	if status == models.EventPublished || previous == models.EventPublished {
+		s.feedCache.Invalidate(feedcache.Sources{OrganizationIDs: []uint{event.OrganizationID}})
+	}
+
+	// Drafts have no one signed up to tell
+	if status == models.EventCancelled && previous == models.EventPublished {
+		s.notifyCancelled(event, reason)
+	}
+
+	return event, nil
+}
+
+// Lists the status changes of the event, oldest first, to its
+// organization's staff
+func (s eventStatusService) StatusHistory(eventId uint, userId uint) ([]models.EventStatusChanges, error) {
+	log.Println("[EventStatusService] Status history...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return []models.EventStatusChanges{}, err
+	}
+
+	if !isStaff(s.orgUsersRepository, userId, event.OrganizationID) {
+		return []models.EventStatusChanges{}, ErrNotStaff
+	}
+
+	seriesId, _ := seriesOccurrence(event, time.Time{})
+
+	return s.eventRepository.GetStatusChanges(seriesId)
+}
+
+// Ends the hours still tracked at the series when their occurrence, or
+// shift, ended, or now if that is earlier, and leaves them for a manager
+// to verify
+func (s eventStatusService) closeHours(series models.Event) error {
+	hours, err := s.attendanceRepository.GetHours(series.ID, models.HoursTracking)
+	if err != nil || len(hours) == 0 {
+		return err
+	}
+
+	shiftIds := []uint{}
+	for _, h := range hours {
+		if h.ShiftID != 0 {
+			shiftIds = append(shiftIds, h.ShiftID)
+		}
+	}
+
+	edited, shifts, err := s.occurrenceDetails(series, shiftIds)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, h := range hours {
+		event, start := series, h.OccurrenceDate
+		if override, ok := edited[keyOf(series.ID, h.OccurrenceDate)]; ok {
+			event, start = override, override.Start
+		}
+
+		end := start.Add(event.Duration())
+		if shift, ok := shifts[h.ShiftID]; ok {
+			_, end = shiftWindow(shift, series, start)
+		}
+
+		if end.After(now) {
+			end = now
+		}
+		if end.Before(h.Start) {
+			end = h.Start
+		}
+
+		h.End = &end
+		h.Status = models.HoursPending
+
+		if _, err := s.attendanceRepository.UpdateHours(h); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Notifies every volunteer signed up to an upcoming occurrence of the
+// cancelled series once, with an emailed cancellation for each of their
+// sign-ups. Failing to send is only logged, the event is cancelled either
+// way.
+func (s eventStatusService) notifyCancelled(series models.Event, reason string) {
+	signups, err := s.signupRepository.GetSignups(series.ID, time.Time{})
+	if err != nil {
+		log.Println("[EventStatusService] Could not find sign-ups to notify:", err)
+		return
+	}
+
+	shiftIds := []uint{}
+	for _, signup := range signups {
+		if signup.ShiftID != 0 {
+			shiftIds = append(shiftIds, signup.ShiftID)
+		}
+	}
+
+	edited, shifts, err := s.occurrenceDetails(series, shiftIds)
+	if err != nil {
+		log.Println("[EventStatusService] Could not find sign-ups to notify:", err)
+		return
+	}
+
+	now := time.Now()
+	order := []uint{}
+	messages := map[uint]*mailer.Message{}
+
+	for _, signup := range signups {
+		event, start := series, signup.OccurrenceDate
+		if override, ok := edited[keyOf(series.ID, signup.OccurrenceDate)]; ok {
+			event, start = override, override.Start
+		}
+
+		if start.Before(now) || signup.Users.Email == "" {
+			continue
+		}
+
+		var shift *models.EventShifts
+		if found, ok := shifts[signup.ShiftID]; ok {
+			shift = &found
+		}
+
+		entry := signupEvent(signup, series, event, start, shift)
+		entry.Organizer = s.mailer.From()
+		entry.Attendees = []string{signup.Users.Email}
+		entry.Status = ical.Cancelled
+		entry.Sequence = 1
+
+		message, ok := messages[signup.UsersID]
+		if !ok {
+			message = &mailer.Message{
+				To:      signup.Users.Email,
+				Subject: "Cancelled: " + series.Name,
+				Body:    series.Name + " has been cancelled by the organizer. You were signed up for:\n",
+			}
+			messages[signup.UsersID] = message
+			order = append(order, signup.UsersID)
+		}
+
+		when := entry.Start.In(event.Location()).Format("Monday, January 2 2006 at 3:04 PM MST")
+		message.Body += "\n- " + entry.Summary + " on " + when
+		message.Attachments = append(message.Attachments, inviteAttachment(
+			"cancel-"+strconv.Itoa(len(message.Attachments)+1)+".ics", ical.Cancel, entry))
+	}
+
+	for _, userId := range order {
+		message := messages[userId]
+		if reason != "" {
+			message.Body += "\n\nReason: " + reason
+		}
+
+		s.notifications.Notify(models.Notifications{
+			UsersID:     userId,
+			Type:        models.NotifyEventCancelled,
+			Title:       message.Subject,
+			Body:        reason,
+			SubjectType: models.SubjectEvent,
+			SubjectID:   series.ID,
+		}, message)
+	}
+}
+
+// The edited occurrences of series by occurrence, and the shifts with the
+// given ids by id
+func (s eventStatusService) occurrenceDetails(series models.Event, shiftIds []uint) (map[occurrenceKey]models.Event, map[uint]models.EventShifts, error) {
+	edited := map[occurrenceKey]models.Event{}
+	shifts := map[uint]models.EventShifts{}
+
+	if series.Recurrence != "" {
+		overrides, err := s.eventRepository.GetOverrides([]uint{series.ID})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, override := range overrides {
+			if override.SeriesID != nil && override.OccurrenceDate != nil {
+				edited[keyOf(*override.SeriesID, *override.OccurrenceDate)] = override
+			}
+		}
+	}
+
+	if len(shiftIds) > 0 {
+		found, err := s.shiftRepository.GetShiftsByIds(uniqueIds(shiftIds))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		for _, shift := range found {
+			shifts[shift.ID] = shift
+		}
+	}
+
+	return edited, shifts, nil
+}
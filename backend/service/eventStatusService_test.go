@@ -0,0 +1,251 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EventStatusServiceUnitTestSuite struct {
+	suite.Suite
+	mockEventRepo      *mocks.EventRepository
+	mockOrgUsersRepo   *mocks.OrgUsersRepository
+	mockSignupRepo     *mocks.SignupRepository
+	mockShiftRepo      *mocks.ShiftRepository
+	mockAttendanceRepo *mocks.AttendanceRepository
+	mockMailer         *mocks.Mailer
+	notifications      *mocks.NotificationService
+	feedCache          *mocks.Cache
+	service            EventStatusService
+	event              models.Event
+	err                error
+}
+
+func (suite *EventStatusServiceUnitTestSuite) SetupTest() {
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.mockSignupRepo = new(mocks.SignupRepository)
+	suite.mockShiftRepo = new(mocks.ShiftRepository)
+	suite.mockAttendanceRepo = new(mocks.AttendanceRepository)
+	suite.mockMailer = new(mocks.Mailer)
+	suite.notifications = new(mocks.NotificationService)
+	suite.feedCache = new(mocks.Cache)
+	suite.service = NewEventStatusService(suite.mockEventRepo, suite.mockOrgUsersRepo, suite.mockSignupRepo,
+		suite.mockShiftRepo, suite.mockAttendanceRepo, suite.mockMailer, suite.notifications, suite.feedCache)
+
+	// A published one-off event of organization 3, tomorrow from 9 to 11
+	suite.event = models.Event{}
+	suite.event.ID = 1
+	suite.event.Name = "Park cleanup"
+	suite.event.OrganizationID = 3
+	suite.event.Status = models.EventPublished
+	suite.event.Start = time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 1)
+	suite.event.End = suite.event.Start.Add(2 * time.Hour)
+	suite.event.TimeZone = "UTC"
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *EventStatusServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+	suite.mockSignupRepo.AssertExpectations(suite.T())
+	suite.mockShiftRepo.AssertExpectations(suite.T())
+	suite.mockAttendanceRepo.AssertExpectations(suite.T())
+	suite.mockMailer.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.feedCache.AssertExpectations(suite.T())
+}
+
+func TestEventStatusServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(EventStatusServiceUnitTestSuite))
+}
+
+func (suite *EventStatusServiceUnitTestSuite) manager(role uint) {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(2), uint(3)).Return(models.OrgUsers{Role: role}, nil)
+}
+
+// Expects the event to move from its status to status
+func (suite *EventStatusServiceUnitTestSuite) expectChange(status string, reason string) {
+	suite.mockEventRepo.On("SetStatus", suite.event, models.EventStatusChanges{
+		EventID: 1,
+		From:    suite.event.Status,
+		To:      status,
+		UsersID: 2,
+		Reason:  reason,
+	}).Return(func(event models.Event, change models.EventStatusChanges) models.Event {
+		event.Status = change.To
+		return event
+	}, nil)
+}
+
+// Expects the feeds following organization 3 to be marked stale
+func (suite *EventStatusServiceUnitTestSuite) expectFeedInvalidated() {
+	suite.feedCache.On("Invalidate", feedcache.Sources{OrganizationIDs: []uint{3}}).Once()
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_Publish() {
+	suite.event.Status = models.EventDraft
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.expectChange(models.EventPublished, "")
+	suite.expectFeedInvalidated()
+
+	event, err := suite.service.ChangeStatus(1, 2, models.EventPublished, "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.EventPublished, event.Status)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_Publish_NotManager() {
+	suite.event.Status = models.EventDraft
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleMember)
+
+	_, err := suite.service.ChangeStatus(1, 2, models.EventPublished, "")
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_InvalidTransition() {
+	suite.event.Status = models.EventCancelled
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+
+	_, err := suite.service.ChangeStatus(1, 2, models.EventPublished, "")
+
+	assert.EqualError(suite.T(), err, "cancelled events cannot become published")
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_UnknownStatus() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleOwner)
+
+	_, err := suite.service.ChangeStatus(1, 2, "archived", "")
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_EditedOccurrence() {
+	seriesId := uint(9)
+	suite.event.SeriesID = &seriesId
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+
+	_, err := suite.service.ChangeStatus(1, 2, models.EventCancelled, "")
+
+	assert.EqualError(suite.T(), err, "change the status of the series instead")
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_Cancel_NotifiesVolunteers() {
+	past := suite.event.Start.AddDate(0, 0, -7)
+	signups := []models.EventSignups{
+		{EventID: 1, UsersID: 4, OccurrenceDate: suite.event.Start},
+		{EventID: 1, UsersID: 5, OccurrenceDate: past},
+	}
+	signups[0].Users.Email = "ada@example.com"
+	signups[1].Users.Email = "bob@example.com"
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectFeedInvalidated()
+	suite.manager(models.RoleManager)
+	suite.expectChange(models.EventCancelled, "Storm warning")
+	suite.mockSignupRepo.On("GetSignups", uint(1), time.Time{}).Return(signups, nil)
+	suite.mockMailer.On("From").Return("events@volunteerone.org")
+	suite.notifications.On("Notify", models.Notifications{
+		UsersID:     4,
+		Type:        models.NotifyEventCancelled,
+		Title:       "Cancelled: Park cleanup",
+		Body:        "Storm warning",
+		SubjectType: models.SubjectEvent,
+		SubjectID:   1,
+	}, mock.MatchedBy(func(message *mailer.Message) bool {
+		if message.To != "ada@example.com" || len(message.Attachments) != 1 {
+			return false
+		}
+
+		cal := string(message.Attachments[0].Data)
+
+		return message.Subject == "Cancelled: Park cleanup" &&
+			strings.HasSuffix(message.Body, "Reason: Storm warning") &&
+			strings.Contains(cal, "METHOD:CANCEL\r\n") &&
+			strings.Contains(cal, "STATUS:CANCELLED\r\n")
+	})).Once()
+
+	event, err := suite.service.ChangeStatus(1, 2, models.EventCancelled, "Storm warning")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.EventCancelled, event.Status)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_CancelDraft_NoOneToNotify() {
+	suite.event.Status = models.EventDraft
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+	suite.expectChange(models.EventCancelled, "")
+
+	_, err := suite.service.ChangeStatus(1, 2, models.EventCancelled, "")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_Complete_BeforeStart() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.manager(models.RoleManager)
+
+	_, err := suite.service.ChangeStatus(1, 2, models.EventCompleted, "")
+
+	assert.EqualError(suite.T(), err, "an event cannot be completed before it starts")
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_Complete_ClosesHours() {
+	// Started three hours ago and ended an hour ago
+	suite.event.Start = time.Now().UTC().Add(-3 * time.Hour).Truncate(time.Second)
+	suite.event.End = suite.event.Start.Add(2 * time.Hour)
+	checkedIn := suite.event.Start.Add(10 * time.Minute)
+	hours := models.VolunteerHours{UsersID: 4, EventID: 1, OccurrenceDate: suite.event.Start, Start: checkedIn,
+		Status: models.HoursTracking}
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectFeedInvalidated()
+	suite.manager(models.RoleManager)
+	suite.mockAttendanceRepo.On("GetHours", uint(1), models.HoursTracking).Return([]models.VolunteerHours{hours}, nil)
+	suite.mockAttendanceRepo.On("UpdateHours", mock.MatchedBy(func(h models.VolunteerHours) bool {
+		return h.Status == models.HoursPending && h.End != nil && h.End.Equal(suite.event.End)
+	})).Return(hours, nil)
+	suite.expectChange(models.EventCompleted, "")
+
+	event, err := suite.service.ChangeStatus(1, 2, models.EventCompleted, "")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.EventCompleted, event.Status)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_StatusHistory() {
+	changes := []models.EventStatusChanges{{EventID: 1, From: models.EventDraft, To: models.EventPublished}}
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(2), uint(3)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+	suite.mockEventRepo.On("GetStatusChanges", uint(1)).Return(changes, nil)
+
+	res, err := suite.service.StatusHistory(1, 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), changes, res)
+}
+
+func (suite *EventStatusServiceUnitTestSuite) TestEventStatusService_StatusHistory_NotStaff() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(2), uint(3)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.StatusHistory(1, 2)
+
+	assert.Equal(suite.T(), ErrNotStaff, err)
+}
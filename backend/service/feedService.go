@@ -0,0 +1,399 @@
+package service
+
+import (
+	"log"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+const (
+	// Posts older than this are left out of the ranked feed
+	feedWindow = 14 * 24 * time.Hour
+	// At most this many posts and events from each source are ranked
+	feedCandidates = 200
+	// An item's score halves every half life after it was created
+	feedHalfLife = 36 * time.Hour
+	// Events starting this soon rank higher
+	feedSoon = 7 * 24 * time.Hour
+)
+
+// How much each signal adds to an item's score before it decays
+const (
+	engagementWeight   = 0.5
+	interestWeight     = 0.75
+	announcementWeight = 1
+	soonWeight         = 0.5
+)
+
+type FeedService interface {
+	GetFeed(uint, string, int) (models.FeedPage, error)
+	RankedFeed(uint, string, int) (models.FeedPage, error)
+}
+
+type feedService struct {
+	feedRepository repository.FeedRepository
+	cache          feedcache.Cache
+}
+
+// Instantiated in router.go
+func NewFeedService(r repository.FeedRepository, c feedcache.Cache) FeedService {
+	return feedService{
+		feedRepository: r,
+		cache:          c,
+	}
+}
+
+// Where pages of a ranked feed continue from: how far into which ranking
+type rankedPosition struct {
+	Version string `json:"version"`
+	Offset  int    `json:"offset"`
+}
+
+// Builds one page of the user's feed: upcoming events from the
+// organizations they follow merged with posts from their friends and
+// those organizations, newest first.
+func (f feedService) GetFeed(userId uint, cursor string, limit int) (models.FeedPage, error) {
+	log.Println("[FeedService] Get feed...")
+
+	var after models.FeedCursor
+	if err := decodeCursor(cursor, &after); err != nil {
+		return models.FeedPage{}, err
+	}
+
+	viewer, err := f.feedRepository.FindViewer(userId)
+	if err != nil {
+		return models.FeedPage{}, err
+	}
+
+	orgIds, err := f.feedRepository.FollowedOrganizationIds(userId)
+	if err != nil {
+		return models.FeedPage{}, err
+	}
+
+	handles := viewer.FriendHandles
+
+	// Fetch one extra item from each source so we know if there is a next page
+	items := []models.FeedItem{}
+
+	if len(orgIds) > 0 {
+		events, err := f.feedRepository.UpcomingEvents(orgIds, time.Now(), after, limit+1)
+		if err != nil {
+			return models.FeedPage{}, err
+		}
+
+		for i := range events {
+			items = append(items, models.FeedItem{
+				Type:      models.FeedItemEvent,
+				Timestamp: events[i].CreatedAt,
+				Event:     &events[i],
+			})
+		}
+	}
+
+	if len(handles) > 0 || len(orgIds) > 0 {
+		posts, err := f.feedRepository.LatestPosts(handles, orgIds, viewer, after, limit+1)
+		if err != nil {
+			return models.FeedPage{}, err
+		}
+
+		for i := range posts {
+			items = append(items, models.FeedItem{
+				Type:      models.FeedItemPost,
+				Timestamp: posts[i].CreatedAt,
+				Post:      &posts[i],
+			})
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return feedItemBefore(items[i], items[j])
+	})
+
+	page := models.FeedPage{Items: items}
+
+	if len(items) > limit {
+		page.Items = items[:limit]
+		page.NextCursor = encodeCursor(feedItemCursor(page.Items[limit-1]))
+	}
+
+	return page, nil
+}
+
+// Builds one page of the user's ranked feed: posts of their friends and of
+// the organizations they follow, with the organizations' upcoming events and
+// events matching their interests. Rankings are cached, so later pages come
+// from the same ranking as the first.
+func (f feedService) RankedFeed(userId uint, cursor string, limit int) (models.FeedPage, error) {
+	log.Println("[FeedService] Ranked feed...")
+
+	var position rankedPosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.FeedPage{}, err
+	}
+
+	snapshot, ok := f.cache.Get(userId)
+
+	// Pages after the first keep to their ranking even once new content
+	// arrived. If it expired, they continue as far into a new one.
+	reuse := ok && (position.Version == snapshot.Version || (position.Version == "" && !snapshot.Stale))
+
+	if !reuse {
+		var err error
+		snapshot, err = f.rank(userId)
+		if err != nil {
+			return models.FeedPage{}, err
+		}
+
+		f.cache.Put(userId, snapshot)
+	}
+
+	page := models.FeedPage{Items: []models.FeedItem{}}
+
+	if position.Offset < 0 || position.Offset >= len(snapshot.Items) {
+		return page, nil
+	}
+
+	end := position.Offset + limit
+	if end >= len(snapshot.Items) {
+		end = len(snapshot.Items)
+	} else {
+		page.NextCursor = encodeCursor(rankedPosition{Version: snapshot.Version, Offset: end})
+	}
+
+	page.Items = snapshot.Items[position.Offset:end]
+
+	return page, nil
+}
+
+// Gathers what belongs in the user's feed and ranks it
+func (f feedService) rank(userId uint) (feedcache.Snapshot, error) {
+	now := time.Now()
+
+	viewer, err := f.feedRepository.FindViewer(userId)
+	if err != nil {
+		return feedcache.Snapshot{}, err
+	}
+
+	orgIds, err := f.feedRepository.FollowedOrganizationIds(userId)
+	if err != nil {
+		return feedcache.Snapshot{}, err
+	}
+
+	handles := viewer.FriendHandles
+
+	tagIds, err := f.feedRepository.InterestTagIds(userId)
+	if err != nil {
+		return feedcache.Snapshot{}, err
+	}
+
+	posts := []models.Posts{}
+	seen := map[uint]bool{}
+
+	if len(handles) > 0 {
+		friendPosts, err := f.feedRepository.PostsByHandles(handles, viewer, models.FeedCursor{}, feedCandidates)
+		if err != nil {
+			return feedcache.Snapshot{}, err
+		}
+
+		for _, post := range friendPosts {
+			if now.Sub(post.CreatedAt) <= feedWindow {
+				seen[post.ID] = true
+				posts = append(posts, post)
+			}
+		}
+	}
+
+	events := []models.Event{}
+
+	if len(orgIds) > 0 {
+		orgPosts, err := f.feedRepository.OrganizationPosts(orgIds, viewer, now.Add(-feedWindow), feedCandidates)
+		if err != nil {
+			return feedcache.Snapshot{}, err
+		}
+
+		// Friends also post as organizations they manage
+		for _, post := range orgPosts {
+			if !seen[post.ID] {
+				posts = append(posts, post)
+			}
+		}
+	}
+
+	if len(orgIds) > 0 || len(tagIds) > 0 {
+		events, err = f.feedRepository.RelevantEvents(orgIds, tagIds, now, feedCandidates)
+		if err != nil {
+			return feedcache.Snapshot{}, err
+		}
+	}
+
+	items, err := f.score(posts, events, tagIds, now)
+	if err != nil {
+		return feedcache.Snapshot{}, err
+	}
+
+	version, err := newToken()
+	if err != nil {
+		return feedcache.Snapshot{}, err
+	}
+
+	return feedcache.Snapshot{
+		Version: version,
+		Items:   items,
+		Sources: feedcache.Sources{Handles: handles, OrganizationIDs: orgIds, TagIDs: tagIds},
+		Built:   now,
+	}, nil
+}
+
+// Scores the posts and events on how recent they are, how much others
+// engaged with them and how well they match the interests in tagIds, best
+// first
+func (f feedService) score(posts []models.Posts, events []models.Event, tagIds []uint, now time.Time) ([]models.FeedItem, error) {
+	interests := map[uint]bool{}
+	for _, tagId := range tagIds {
+		interests[tagId] = true
+	}
+
+	overlap := func(tags []uint) int {
+		count := 0
+		for _, tagId := range tags {
+			if interests[tagId] {
+				count++
+			}
+		}
+		return count
+	}
+
+	items := []models.FeedItem{}
+
+	if len(posts) > 0 {
+		postIds := []uint{}
+		postOrgIds := []uint{}
+		for _, post := range posts {
+			postIds = append(postIds, post.ID)
+			if post.OrganizationID != nil {
+				postOrgIds = append(postOrgIds, *post.OrganizationID)
+			}
+		}
+
+		comments, err := f.feedRepository.CommentCounts(postIds)
+		if err != nil {
+			return nil, err
+		}
+
+		orgTags := map[uint][]uint{}
+		if len(postOrgIds) > 0 && len(interests) > 0 {
+			orgTags, err = f.feedRepository.OrganizationTagIds(postOrgIds)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i := range posts {
+			post := &posts[i]
+
+			boost := 0.0
+			if post.OrganizationID != nil {
+				boost += interestWeight * float64(overlap(orgTags[*post.OrganizationID]))
+			}
+			if post.Type == models.PostTypeAnnouncement {
+				boost += announcementWeight
+			}
+
+			items = append(items, models.FeedItem{
+				Type:      models.FeedItemPost,
+				Timestamp: post.CreatedAt,
+				Post:      post,
+				Score:     feedScore(now.Sub(post.CreatedAt), int64(post.ReactionCount)+comments[post.ID], boost),
+			})
+		}
+	}
+
+	if len(events) > 0 {
+		eventIds := []uint{}
+		for _, event := range events {
+			eventIds = append(eventIds, event.ID)
+		}
+
+		signups, err := f.feedRepository.SignupCounts(eventIds)
+		if err != nil {
+			return nil, err
+		}
+
+		eventTags := map[uint][]uint{}
+		if len(interests) > 0 {
+			eventTags, err = f.feedRepository.EventTagIds(eventIds)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i := range events {
+			event := &events[i]
+
+			boost := interestWeight * float64(overlap(eventTags[event.ID]))
+			if event.Start.After(now) && event.Start.Sub(now) <= feedSoon {
+				boost += soonWeight
+			}
+
+			items = append(items, models.FeedItem{
+				Type:      models.FeedItemEvent,
+				Timestamp: event.CreatedAt,
+				Event:     event,
+				Score:     feedScore(now.Sub(event.CreatedAt), signups[event.ID], boost),
+			})
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].Score != items[j].Score {
+			return items[i].Score > items[j].Score
+		}
+		return feedItemBefore(items[i], items[j])
+	})
+
+	return items, nil
+}
+
+// Decays an item's worth by its age. Engagement counts logarithmically, so
+// a popular item doesn't stay on top for good.
+func feedScore(age time.Duration, engagement int64, boost float64) float64 {
+	if age < 0 {
+		age = 0
+	}
+
+	recency := math.Pow(0.5, age.Hours()/feedHalfLife.Hours())
+
+	return recency * (1 + engagementWeight*math.Log1p(float64(engagement)) + boost)
+}
+
+func feedItemId(item models.FeedItem) uint {
+	if item.Event != nil {
+		return item.Event.ID
+	}
+	return item.Post.ID
+}
+
+func feedItemCursor(item models.FeedItem) models.FeedCursor {
+	return models.FeedCursor{
+		Timestamp: item.Timestamp,
+		Type:      item.Type,
+		ID:        feedItemId(item),
+	}
+}
+
+// Reports whether a comes before b: newest first, then by type, then
+// highest id first. Must agree with the ordering used by the repository.
+func feedItemBefore(a models.FeedItem, b models.FeedItem) bool {
+	if !a.Timestamp.Equal(b.Timestamp) {
+		return a.Timestamp.After(b.Timestamp)
+	}
+	if a.Type != b.Type {
+		return a.Type < b.Type
+	}
+	return feedItemId(a) > feedItemId(b)
+}
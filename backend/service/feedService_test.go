@@ -0,0 +1,312 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FeedServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo *mocks.FeedRepository
+	cache    feedcache.Cache
+	service  FeedService
+	user     models.Users
+	now      time.Time
+	err      error
+}
+
+func (suite *FeedServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.FeedRepository)
+	suite.cache = feedcache.NewMemoryCache(time.Minute, 10)
+	suite.service = NewFeedService(suite.mockRepo, suite.cache)
+
+	suite.user.ID = 1
+	suite.user.Handle = "me"
+	suite.now = time.Date(2023, 4, 1, 12, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *FeedServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+}
+
+func TestFeedServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(FeedServiceUnitTestSuite))
+}
+
+func (suite *FeedServiceUnitTestSuite) event(id uint, created time.Time) models.Event {
+	var event models.Event
+	event.ID = id
+	event.CreatedAt = created
+	return event
+}
+
+// The user as a viewer of posts, with the friends in handles
+func (suite *FeedServiceUnitTestSuite) viewer(handles []string) models.PostViewer {
+	return models.PostViewer{Handle: suite.user.Handle, FriendHandles: handles}
+}
+
+func (suite *FeedServiceUnitTestSuite) post(id uint, created time.Time) models.Posts {
+	var post models.Posts
+	post.ID = id
+	post.CreatedAt = created
+	return post
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_GetFeed_InvalidCursor() {
+	_, err := suite.service.GetFeed(suite.user.ID, "not a cursor!", 10)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_GetFeed_FindViewerFail() {
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(models.PostViewer{}, suite.err)
+
+	_, err := suite.service.GetFeed(suite.user.ID, "", 10)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_GetFeed_NothingFollowed() {
+	suite.mockRepo.On("FollowedOrganizationIds", suite.user.ID).Return([]uint{}, nil)
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(suite.viewer([]string{}), nil)
+
+	page, err := suite.service.GetFeed(suite.user.ID, "", 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), page.Items)
+	assert.Equal(suite.T(), "", page.NextCursor)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_GetFeed_MergesNewestFirst() {
+	events := []models.Event{
+		suite.event(1, suite.now.Add(-1*time.Hour)),
+		suite.event(2, suite.now.Add(-3*time.Hour)),
+	}
+	// A friend's post and one of the followed organization's
+	posts := []models.Posts{
+		suite.post(7, suite.now),
+		suite.post(8, suite.now.Add(-2*time.Hour)),
+	}
+
+	suite.mockRepo.On("FollowedOrganizationIds", suite.user.ID).Return([]uint{3}, nil)
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(suite.viewer([]string{"friend"}), nil)
+	suite.mockRepo.On("UpcomingEvents", []uint{3}, mock.Anything, models.FeedCursor{}, 4).Return(events, nil)
+	suite.mockRepo.On("LatestPosts", []string{"friend"}, []uint{3}, suite.viewer([]string{"friend"}), models.FeedCursor{}, 4).Return(posts, nil)
+
+	page, err := suite.service.GetFeed(suite.user.ID, "", 3)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 3)
+	assert.Equal(suite.T(), uint(7), page.Items[0].Post.ID)
+	assert.Equal(suite.T(), uint(1), page.Items[1].Event.ID)
+	assert.Equal(suite.T(), uint(8), page.Items[2].Post.ID)
+
+	// The next page starts after the last item returned
+	var cursor models.FeedCursor
+	err = decodeCursor(page.NextCursor, &cursor)
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), cursor.Timestamp.Equal(suite.now.Add(-2*time.Hour)))
+	assert.Equal(suite.T(), models.FeedItemPost, cursor.Type)
+	assert.Equal(suite.T(), uint(8), cursor.ID)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_GetFeed_LastPage() {
+	suite.mockRepo.On("FollowedOrganizationIds", suite.user.ID).Return([]uint{}, nil)
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(suite.viewer([]string{"friend"}), nil)
+	suite.mockRepo.On("LatestPosts", []string{"friend"}, []uint{}, suite.viewer([]string{"friend"}), models.FeedCursor{}, 11).
+		Return([]models.Posts{suite.post(1, suite.now)}, nil)
+
+	page, err := suite.service.GetFeed(suite.user.ID, "", 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 1)
+	assert.Equal(suite.T(), "", page.NextCursor)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_OrganizationPosts_BothOrders() {
+	now := time.Now()
+	orgId := uint(3)
+	post := suite.post(7, now.Add(-time.Hour))
+	post.OrganizationID = &orgId
+
+	// Following an organization brings its posts into the feed either way
+	suite.mockRepo.On("FollowedOrganizationIds", suite.user.ID).Return([]uint{orgId}, nil).Once()
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(suite.viewer([]string{}), nil).Once()
+	suite.mockRepo.On("UpcomingEvents", []uint{orgId}, mock.Anything, models.FeedCursor{}, 11).Return([]models.Event{}, nil).Once()
+	suite.mockRepo.On("LatestPosts", []string{}, []uint{orgId}, suite.viewer([]string{}), models.FeedCursor{}, 11).
+		Return([]models.Posts{post}, nil).Once()
+
+	page, err := suite.service.GetFeed(suite.user.ID, "", 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 1)
+	assert.Equal(suite.T(), uint(7), page.Items[0].Post.ID)
+
+	suite.expectSources([]string{}, []uint{orgId}, []uint{})
+	suite.mockRepo.On("OrganizationPosts", []uint{orgId}, suite.viewer([]string{}), mock.Anything, feedCandidates).
+		Return([]models.Posts{post}, nil).Once()
+	suite.mockRepo.On("RelevantEvents", []uint{orgId}, []uint{}, mock.Anything, feedCandidates).
+		Return([]models.Event{}, nil).Once()
+	suite.mockRepo.On("CommentCounts", []uint{7}).Return(map[uint]int64{}, nil).Once()
+
+	page, err = suite.service.RankedFeed(suite.user.ID, "", 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 1)
+	assert.Equal(suite.T(), uint(7), page.Items[0].Post.ID)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_GetFeed_EventsFail() {
+	suite.mockRepo.On("FollowedOrganizationIds", suite.user.ID).Return([]uint{3}, nil)
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(suite.viewer([]string{}), nil)
+	suite.mockRepo.On("UpcomingEvents", []uint{3}, mock.Anything, models.FeedCursor{}, 11).
+		Return([]models.Event{}, suite.err)
+
+	_, err := suite.service.GetFeed(suite.user.ID, "", 10)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_Cursor_RoundTrip() {
+	cursor := models.FeedCursor{
+		Timestamp: suite.now,
+		Type:      models.FeedItemEvent,
+		ID:        42,
+	}
+
+	var decoded models.FeedCursor
+	err := decodeCursor(encodeCursor(cursor), &decoded)
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), cursor.Timestamp.Equal(decoded.Timestamp))
+	assert.Equal(suite.T(), cursor.Type, decoded.Type)
+	assert.Equal(suite.T(), cursor.ID, decoded.ID)
+}
+
+// Expects the user's friends, followed organizations and interests to be
+// looked up once
+func (suite *FeedServiceUnitTestSuite) expectSources(handles []string, orgIds []uint, tagIds []uint) {
+	suite.mockRepo.On("FollowedOrganizationIds", suite.user.ID).Return(orgIds, nil).Once()
+	suite.mockRepo.On("FindViewer", suite.user.ID).Return(suite.viewer(handles), nil).Once()
+	suite.mockRepo.On("InterestTagIds", suite.user.ID).Return(tagIds, nil).Once()
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_RankedFeed_NothingFollowed() {
+	suite.expectSources([]string{}, []uint{}, []uint{})
+
+	page, err := suite.service.RankedFeed(suite.user.ID, "", 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), page.Items)
+	assert.Equal(suite.T(), "", page.NextCursor)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_RankedFeed_Ranks() {
+	now := time.Now()
+	orgId := uint(3)
+
+	// A day old but popular, just posted, and one from before the window
+	popular := suite.post(7, now.Add(-24*time.Hour))
+	popular.ReactionCount = 40
+	recent := suite.post(8, now.Add(-time.Hour))
+	old := suite.post(9, now.Add(-30*24*time.Hour))
+	// The organization's announcement from two days ago
+	announcement := suite.post(10, now.Add(-48*time.Hour))
+	announcement.OrganizationID = &orgId
+	announcement.Type = models.PostTypeAnnouncement
+	// Created three days ago, starting tomorrow, on something the user cares about
+	event := suite.event(1, now.Add(-72*time.Hour))
+	event.Start = now.Add(24 * time.Hour)
+
+	suite.expectSources([]string{"friend"}, []uint{orgId}, []uint{5})
+	suite.mockRepo.On("PostsByHandles", []string{"friend"}, suite.viewer([]string{"friend"}), models.FeedCursor{}, feedCandidates).
+		Return([]models.Posts{recent, popular, old}, nil).Once()
+	// The friend's announcement comes up twice but is ranked once
+	suite.mockRepo.On("OrganizationPosts", []uint{orgId}, suite.viewer([]string{"friend"}), mock.Anything, feedCandidates).
+		Return([]models.Posts{announcement, recent}, nil).Once()
+	suite.mockRepo.On("RelevantEvents", []uint{orgId}, []uint{5}, mock.Anything, feedCandidates).
+		Return([]models.Event{event}, nil).Once()
+	suite.mockRepo.On("CommentCounts", []uint{8, 7, 10}).Return(map[uint]int64{7: 10}, nil).Once()
+	suite.mockRepo.On("OrganizationTagIds", []uint{3}).Return(map[uint][]uint{}, nil).Once()
+	suite.mockRepo.On("SignupCounts", []uint{1}).Return(map[uint]int64{1: 3}, nil).Once()
+	suite.mockRepo.On("EventTagIds", []uint{1}).Return(map[uint][]uint{1: {5, 6}}, nil).Once()
+
+	page, err := suite.service.RankedFeed(suite.user.ID, "", 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 2)
+	assert.Equal(suite.T(), uint(7), page.Items[0].Post.ID)
+	assert.Equal(suite.T(), uint(8), page.Items[1].Post.ID)
+	assert.Greater(suite.T(), page.Items[0].Score, page.Items[1].Score)
+	assert.NotEqual(suite.T(), "", page.NextCursor)
+
+	// The next page comes from the same ranking, without ranking again
+	page, err = suite.service.RankedFeed(suite.user.ID, page.NextCursor, 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Items, 2)
+	assert.Equal(suite.T(), uint(10), page.Items[0].Post.ID)
+	assert.Equal(suite.T(), uint(1), page.Items[1].Event.ID)
+	assert.Equal(suite.T(), "", page.NextCursor)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_RankedFeed_Invalidated() {
+	now := time.Now()
+	first := []models.Posts{suite.post(1, now.Add(-time.Hour)), suite.post(2, now.Add(-2*time.Hour))}
+	second := append([]models.Posts{suite.post(3, now)}, first...)
+
+	suite.expectSources([]string{"friend"}, []uint{}, []uint{})
+	suite.mockRepo.On("PostsByHandles", []string{"friend"}, suite.viewer([]string{"friend"}), models.FeedCursor{}, feedCandidates).Return(first, nil).Once()
+	suite.mockRepo.On("CommentCounts", []uint{1, 2}).Return(map[uint]int64{}, nil).Once()
+
+	page, err := suite.service.RankedFeed(suite.user.ID, "", 1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), page.Items[0].Post.ID)
+
+	// The friend posts again
+	suite.cache.Invalidate(feedcache.Sources{Handles: []string{"friend"}})
+
+	// Paging on keeps to the ranking the first page came from
+	next, err := suite.service.RankedFeed(suite.user.ID, page.NextCursor, 1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), next.Items[0].Post.ID)
+
+	// A new first page has the new post
+	suite.expectSources([]string{"friend"}, []uint{}, []uint{})
+	suite.mockRepo.On("PostsByHandles", []string{"friend"}, suite.viewer([]string{"friend"}), models.FeedCursor{}, feedCandidates).Return(second, nil).Once()
+	suite.mockRepo.On("CommentCounts", []uint{3, 1, 2}).Return(map[uint]int64{}, nil).Once()
+
+	page, err = suite.service.RankedFeed(suite.user.ID, "", 1)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(3), page.Items[0].Post.ID)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_RankedFeed_InvalidCursor() {
+	_, err := suite.service.RankedFeed(suite.user.ID, "not a cursor!", 10)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *FeedServiceUnitTestSuite) TestFeedService_FeedScore() {
+	// Halves every half life
+	assert.InDelta(suite.T(), 1, feedScore(0, 0, 0), 0.001)
+	assert.InDelta(suite.T(), 0.5, feedScore(feedHalfLife, 0, 0), 0.001)
+	assert.InDelta(suite.T(), 0.25, feedScore(2*feedHalfLife, 0, 0), 0.001)
+
+	// Engagement counts less the more there is
+	once := feedScore(0, 1, 0) - feedScore(0, 0, 0)
+	tenth := feedScore(0, 10, 0) - feedScore(0, 9, 0)
+	assert.Greater(suite.T(), once, tenth)
+
+	// Posts from the future don't outrank new ones
+	assert.InDelta(suite.T(), 1, feedScore(-time.Hour, 0, 0), 0.001)
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"errors"
+	"log"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+type FollowService interface {
+	FollowOrganization(uint, uint) (models.OrgFollowers, error)
+	UnfollowOrganization(uint, uint) error
+	CountFollowers(uint) (int64, error)
+	GetFollowers(uint) ([]models.PublicUser, error)
+	GetFollowing(uint) ([]models.OrgFollowers, error)
+}
+
+type followService struct {
+	followRepository repository.FollowRepository
+	feedCache        feedcache.Cache
+}
+
+// Instantiated in router.go
+func NewFollowService(r repository.FollowRepository, c feedcache.Cache) FollowService {
+	return followService{
+		followRepository: r,
+		feedCache:        c,
+	}
+}
+
+func (f followService) FollowOrganization(userId uint, orgId uint) (models.OrgFollowers, error) {
+	log.Println("[FollowService] Follow organization...")
+
+	if _, err := f.followRepository.FindFollow(userId, orgId); err == nil {
+		return models.OrgFollowers{}, errors.New("already following organization")
+	}
+
+	follow, err := f.followRepository.FollowOrganization(models.OrgFollowers{
+		UsersID:        userId,
+		OrganizationID: orgId,
+	})
+	if err != nil {
+		return models.OrgFollowers{}, err
+	}
+
+	f.feedCache.Forget(userId)
+
+	return follow, nil
+}
+
+func (f followService) UnfollowOrganization(userId uint, orgId uint) error {
+	log.Println("[FollowService] Unfollow organization...")
+
+	if err := f.followRepository.UnfollowOrganization(userId, orgId); err != nil {
+		return err
+	}
+
+	f.feedCache.Forget(userId)
+
+	return nil
+}
+
+func (f followService) CountFollowers(orgId uint) (int64, error) {
+	return f.followRepository.CountFollowers(orgId)
+}
+
+func (f followService) GetFollowers(orgId uint) ([]models.PublicUser, error) {
+	log.Println("[FollowService] Get followers...")
+	return f.followRepository.GetFollowers(orgId)
+}
+
+func (f followService) GetFollowing(userId uint) ([]models.OrgFollowers, error) {
+	log.Println("[FollowService] Get following...")
+	return f.followRepository.GetFollowing(userId)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"log"
 
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 )
@@ -18,28 +19,96 @@ type FriendService interface {
 }
 
 type friendService struct {
-	friendRepository repository.FriendRepository
+	friendRepository    repository.FriendRepository
+	usersRepository     repository.UsersRepository
+	notificationService NotificationService
+	feedCache           feedcache.Cache
 }
 
-func NewFriendService(r repository.FriendRepository) FriendService {
+func NewFriendService(r repository.FriendRepository, u repository.UsersRepository, n NotificationService, c feedcache.Cache) FriendService {
 	return friendService{
-		friendRepository: r,
+		friendRepository:    r,
+		usersRepository:     u,
+		notificationService: n,
+		feedCache:           c,
 	}
 }
 
 func (f friendService) CreateFriend(friend models.Friend) (models.Friend, error) {
 	log.Println("[FriendService] Create friend request...")
-	return f.friendRepository.CreateFriend(friend)
+
+	created, err := f.friendRepository.CreateFriend(friend)
+	if err != nil {
+		return created, err
+	}
+
+	f.notify(created, created.FriendOneHandle, created.FriendTwoHandle, models.NotifyFriendRequest, " sent you a friend request")
+
+	return created, nil
 }
 
 func (f friendService) AcceptFriend(friend models.Friend) (models.Friend, error) {
 	log.Println("[FriendService] Accept friend...")
-	return f.friendRepository.AcceptFriend(friend)
+
+	accepted, err := f.friendRepository.AcceptFriend(friend)
+	if err != nil {
+		return accepted, err
+	}
+
+	f.forgetFeeds(accepted)
+	f.notify(accepted, accepted.FriendTwoHandle, accepted.FriendOneHandle, models.NotifyFriendAccepted, " accepted your friend request")
+
+	return accepted, nil
+}
+
+// Drops both friends' feeds, now that they see each other's posts or no
+// longer do
+func (f friendService) forgetFeeds(friend models.Friend) {
+	for _, handle := range []string{friend.FriendOneHandle, friend.FriendTwoHandle} {
+		user, err := f.usersRepository.FindUserByHandle(handle)
+		if err != nil {
+			log.Println("[FriendService] Could not find user to forget feed of:", err)
+			continue
+		}
+
+		f.feedCache.Forget(user.ID)
+	}
+}
+
+// Tells the user with handle to what the user with handle from did
+func (f friendService) notify(friend models.Friend, from string, to string, notificationType string, action string) {
+	actor, err := f.usersRepository.FindUserByHandle(from)
+	if err != nil {
+		log.Println("[FriendService] Could not find user to notify about:", err)
+		return
+	}
+
+	user, err := f.usersRepository.FindUserByHandle(to)
+	if err != nil {
+		log.Println("[FriendService] Could not find user to notify:", err)
+		return
+	}
+
+	f.notificationService.Notify(models.Notifications{
+		UsersID:     user.ID,
+		Type:        notificationType,
+		Title:       "@" + actor.Handle + action,
+		SubjectType: models.SubjectFriend,
+		SubjectID:   friend.ID,
+		ActorID:     actor.ID,
+	}, nil)
 }
 
 func (f friendService) RejectFriend(friend models.Friend) error {
 	log.Println("[FriendService] Reject friend...")
-	return f.friendRepository.RejectFriend(friend)
+
+	if err := f.friendRepository.RejectFriend(friend); err != nil {
+		return err
+	}
+
+	f.forgetFeeds(friend)
+
+	return nil
 }
 
 func (f friendService) OneFriend(id string) (models.Friend, error) {
@@ -3,6 +3,7 @@ package service
 import (
 	"bytes"
 	"fmt"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
 	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/gin-gonic/gin"
@@ -19,6 +20,9 @@ type FriendsServiceUnitTestSuite struct {
 	c                  *gin.Context
 	w                  *httptest.ResponseRecorder
 	mockRepo           *mocks.FriendRepository
+	mockUsersRepo      *mocks.UsersRepository
+	notifications      *mocks.NotificationService
+	feedCache          *mocks.Cache
 	service            FriendService
 	err                error
 	paramID            string
@@ -30,7 +34,10 @@ func (suite *FriendsServiceUnitTestSuite) SetupTest() {
 	suite.c, _ = gin.CreateTestContext(suite.w)
 
 	suite.mockRepo = new(mocks.FriendRepository)
-	suite.service = NewFriendService(suite.mockRepo)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.feedCache = new(mocks.Cache)
+	suite.service = NewFriendService(suite.mockRepo, suite.mockUsersRepo, suite.notifications, suite.feedCache)
 
 	suite.arrayFriendsObject = append(suite.arrayFriendsObject, suite.friendsObject)
 
@@ -42,6 +49,9 @@ func (suite *FriendsServiceUnitTestSuite) SetupTest() {
 // Ran after every test finishes
 func (suite *FriendsServiceUnitTestSuite) AfterTest(_, _ string) {
 	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.feedCache.AssertExpectations(suite.T())
 }
 
 // Run all the tests in the FriendsServiceUnitTestSuite
@@ -49,8 +59,19 @@ func TestFriendsUnitTestSuite(t *testing.T) {
 	suite.Run(t, new(FriendsServiceUnitTestSuite))
 }
 
+// Expects the users with handles ada and bob to be looked up
+func (suite *FriendsServiceUnitTestSuite) expectUsers() {
+	var ada, bob models.Users
+	ada.ID, ada.Handle = 1, "ada"
+	bob.ID, bob.Handle = 2, "bob"
+
+	suite.mockUsersRepo.On("FindUserByHandle", "ada").Return(ada, nil)
+	suite.mockUsersRepo.On("FindUserByHandle", "bob").Return(bob, nil)
+}
+
 func (suite *FriendsServiceUnitTestSuite) TestFriendService_CreateFriend() {
 	suite.mockRepo.On("CreateFriend", suite.friendsObject).Return(suite.friendsObject, nil)
+	suite.mockUsersRepo.On("FindUserByHandle", "").Return(models.Users{}, suite.err)
 	res, err := suite.service.CreateFriend(suite.friendsObject)
 
 	assert.Equal(suite.T(), res.FriendOneHandle, "")
@@ -59,23 +80,71 @@ func (suite *FriendsServiceUnitTestSuite) TestFriendService_CreateFriend() {
 	assert.Nil(suite.T(), err)
 }
 
-func (suite *FriendsServiceUnitTestSuite) TestFriendService_AcceptFriend() {
-	suite.mockRepo.On("AcceptFriend", suite.friendsObject).Return(suite.friendsObject, nil)
-	res, err := suite.service.AcceptFriend(suite.friendsObject)
+func (suite *FriendsServiceUnitTestSuite) TestFriendService_CreateFriend_NotifiesRecipient() {
+	request := models.Friend{FriendOneHandle: "ada", FriendTwoHandle: "bob"}
+	request.ID = 3
+
+	suite.mockRepo.On("CreateFriend", request).Return(request, nil)
+	suite.expectUsers()
+	suite.notifications.On("Notify", models.Notifications{
+		UsersID:     2,
+		Type:        models.NotifyFriendRequest,
+		Title:       "@ada sent you a friend request",
+		SubjectType: models.SubjectFriend,
+		SubjectID:   3,
+		ActorID:     1,
+	}, (*mailer.Message)(nil)).Once()
+
+	_, err := suite.service.CreateFriend(request)
 
-	assert.Equal(suite.T(), res.FriendOneHandle, "")
-	assert.Equal(suite.T(), res.FriendTwoHandle, "")
-	assert.Equal(suite.T(), res.RelationshipBit, "")
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *FriendsServiceUnitTestSuite) TestFriendService_AcceptFriend() {
+	request := models.Friend{FriendOneHandle: "ada", FriendTwoHandle: "bob", RelationshipBit: "friends"}
+	request.ID = 3
+
+	suite.mockRepo.On("AcceptFriend", request).Return(request, nil)
+	suite.expectUsers()
+	// Both now see each other's posts
+	suite.feedCache.On("Forget", uint(1)).Once()
+	suite.feedCache.On("Forget", uint(2)).Once()
+	suite.notifications.On("Notify", models.Notifications{
+		UsersID:     1,
+		Type:        models.NotifyFriendAccepted,
+		Title:       "@bob accepted your friend request",
+		SubjectType: models.SubjectFriend,
+		SubjectID:   3,
+		ActorID:     2,
+	}, (*mailer.Message)(nil)).Once()
+
+	res, err := suite.service.AcceptFriend(request)
+
+	assert.Equal(suite.T(), res.RelationshipBit, "friends")
 	assert.Nil(suite.T(), err)
 }
 
 func (suite *FriendsServiceUnitTestSuite) TestFriendService_RejectFriend() {
-	suite.mockRepo.On("RejectFriend", suite.friendsObject).Return(nil)
-	err := suite.service.RejectFriend(suite.friendsObject)
+	request := models.Friend{FriendOneHandle: "ada", FriendTwoHandle: "bob"}
+
+	suite.mockRepo.On("RejectFriend", request).Return(nil)
+	suite.expectUsers()
+	suite.feedCache.On("Forget", uint(1)).Once()
+	suite.feedCache.On("Forget", uint(2)).Once()
+
+	err := suite.service.RejectFriend(request)
 
 	assert.Nil(suite.T(), err)
 }
 
+func (suite *FriendsServiceUnitTestSuite) TestFriendService_RejectFriend_Fail() {
+	suite.mockRepo.On("RejectFriend", suite.friendsObject).Return(suite.err)
+
+	err := suite.service.RejectFriend(suite.friendsObject)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
 func (suite *FriendsServiceUnitTestSuite) TestFriendService_OneFriend() {
 	suite.mockRepo.On("OneFriend", suite.paramID).Return(suite.friendsObject, nil)
 	res, err := suite.service.OneFriend(suite.paramID)
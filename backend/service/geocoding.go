@@ -0,0 +1,28 @@
+package service
+
+import (
+	"log"
+	"strings"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
+)
+
+// Largest radius accepted by the events near me search
+const maxRadiusKm = 500
+
+// Looks up the coordinates of an address. Returns nils when the address is
+// empty or cannot be located; callers fall back to other coordinates, so a
+// failed lookup must not fail the request.
+func geocodeAddress(g geocoder.Geocoder, address string) (*float64, *float64) {
+	if strings.TrimSpace(address) == "" {
+		return nil, nil
+	}
+
+	location, err := g.Geocode(address)
+	if err != nil {
+		log.Printf("Could not geocode address %q: %v", address, err)
+		return nil, nil
+	}
+
+	return &location.Latitude, &location.Longitude
+}
@@ -0,0 +1,244 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"net/mail"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// How long guardians have to respond to a consent request
+const consentLinkLifetime = 14 * 24 * time.Hour
+
+type GuardianConsentService interface {
+	RequestConsent(uint, string, string, *uint) (models.GuardianConsents, error)
+	Consents(uint) ([]models.GuardianConsents, error)
+	ConsentRequest(string) (models.ConsentRequest, error)
+	RespondToConsent(string, bool, string, string) (models.ConsentRequest, error)
+}
+
+type guardianConsentService struct {
+	consentRepository repository.GuardianConsentRepository
+	usersRepository   repository.UsersRepository
+	eventRepository   repository.EventRepository
+	mailer            mailer.Mailer
+	// Where the app is served, guardians' links point there
+	appURL string
+}
+
+// Instantiated in router.go
+func NewGuardianConsentService(c repository.GuardianConsentRepository, u repository.UsersRepository, e repository.EventRepository, m mailer.Mailer, appURL string) GuardianConsentService {
+	if appURL == "" {
+		appURL = "http://localhost:8080"
+	}
+
+	return guardianConsentService{
+		consentRepository: c,
+		usersRepository:   u,
+		eventRepository:   e,
+		mailer:            m,
+		appURL:            strings.TrimSuffix(appURL, "/"),
+	}
+}
+
+// Asks the guardian of a minor to consent to them volunteering at the
+// event, or at any event without one. The guardian is emailed a link to
+// respond with.
+func (s guardianConsentService) RequestConsent(userId uint, guardianName string, guardianEmail string, eventId *uint) (models.GuardianConsents, error) {
+	log.Println("[GuardianConsentService] Request consent...")
+
+	user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.GuardianConsents{}, err
+	}
+
+	minor, err := user.IsMinor(time.Now())
+	if err != nil {
+		return models.GuardianConsents{}, errors.New("add your birthdate to your profile first")
+	}
+	if !minor {
+		return models.GuardianConsents{}, errors.New("only volunteers under 18 need a guardian's consent")
+	}
+
+	guardianName = strings.TrimSpace(guardianName)
+	if guardianName == "" {
+		return models.GuardianConsents{}, errors.New("guardian name is required")
+	}
+
+	address, err := mail.ParseAddress(guardianEmail)
+	if err != nil {
+		return models.GuardianConsents{}, errors.New("guardian email is invalid")
+	}
+	if strings.EqualFold(address.Address, user.Email) {
+		return models.GuardianConsents{}, errors.New("guardian email must not be your own")
+	}
+
+	eventName := ""
+	if eventId != nil {
+		event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(*eventId), 10))
+		if err != nil || event.Status == models.EventDraft {
+			return models.GuardianConsents{}, errors.New("event not found")
+		}
+
+		// Consent to an edited occurrence covers its whole series
+		seriesId, _ := seriesOccurrence(event, time.Time{})
+		eventId = &seriesId
+		eventName = event.Name
+	}
+
+	token, err := newToken()
+	if err != nil {
+		return models.GuardianConsents{}, err
+	}
+
+	consent, err := s.consentRepository.CreateConsent(models.GuardianConsents{
+		UsersID:       userId,
+		GuardianName:  guardianName,
+		GuardianEmail: address.Address,
+		EventID:       eventId,
+		Token:         token,
+		ExpiresAt:     time.Now().Add(consentLinkLifetime),
+	})
+	if err != nil {
+		return models.GuardianConsents{}, err
+	}
+
+	s.sendRequest(consent, user, eventName)
+
+	return consent, nil
+}
+
+// Emails the guardian the link to respond with. Failing to send is only
+// logged, the minor can ask again.
+func (s guardianConsentService) sendRequest(consent models.GuardianConsents, user models.Users, eventName string) {
+	volunteer := strings.TrimSpace(user.FirstName + " " + user.LastName)
+
+	scope := "any event on VolunteerOne"
+	if eventName != "" {
+		scope = eventName
+	}
+
+	err := s.mailer.Send(mailer.Message{
+		To:      consent.GuardianEmail,
+		Subject: "Consent for " + volunteer + " to volunteer",
+		Body: "Hello " + consent.GuardianName + ",\n\n" +
+			volunteer + " would like to volunteer at " + scope + " and named you as their guardian. " +
+			"Volunteers under 18 need a guardian's consent to sign up.\n\n" +
+			"To approve or decline, open:\n" + s.appURL + "/consent/" + consent.Token + "\n\n" +
+			"The link expires on " + consent.ExpiresAt.UTC().Format("January 2, 2006") + ". " +
+			"If you do not know " + volunteer + ", you can ignore this email.",
+	})
+	if err != nil {
+		log.Println("[GuardianConsentService] Could not email consent request:", err)
+	}
+}
+
+// Lists the consents the user asked for
+func (s guardianConsentService) Consents(userId uint) ([]models.GuardianConsents, error) {
+	log.Println("[GuardianConsentService] Consents...")
+
+	return s.consentRepository.GetConsents(userId)
+}
+
+// What the guardian with the token is asked to consent to
+func (s guardianConsentService) ConsentRequest(token string) (models.ConsentRequest, error) {
+	log.Println("[GuardianConsentService] Consent request...")
+
+	consent, err := s.findConsent(token)
+	if err != nil {
+		return models.ConsentRequest{}, err
+	}
+
+	return s.consentRequest(consent)
+}
+
+// Approves or declines a pending consent, or revokes an approved one when
+// approve is false. ip and userAgent are kept for audit.
+func (s guardianConsentService) RespondToConsent(token string, approve bool, ip string, userAgent string) (models.ConsentRequest, error) {
+	log.Println("[GuardianConsentService] Respond to consent...")
+
+	consent, err := s.findConsent(token)
+	if err != nil {
+		return models.ConsentRequest{}, err
+	}
+
+	status := ""
+	switch {
+	case consent.Status == models.ConsentPending && approve:
+		status = models.ConsentApproved
+	case consent.Status == models.ConsentPending:
+		status = models.ConsentDeclined
+	case consent.Status == models.ConsentApproved && !approve:
+		status = models.ConsentRevoked
+	default:
+		return models.ConsentRequest{}, errors.New("consent is already " + consent.Status)
+	}
+
+	if consent.Status == models.ConsentPending && time.Now().After(consent.ExpiresAt) {
+		return models.ConsentRequest{}, errors.New("consent link has expired")
+	}
+
+	now := time.Now()
+	consent.RespondedAt = &now
+
+	consent, err = s.consentRepository.SetConsentStatus(consent, models.GuardianConsentChanges{
+		ConsentID: consent.ID,
+		From:      consent.Status,
+		To:        status,
+		IP:        ip,
+		UserAgent: userAgent,
+	})
+	if err != nil {
+		return models.ConsentRequest{}, err
+	}
+
+	return s.consentRequest(consent)
+}
+
+func (s guardianConsentService) findConsent(token string) (models.GuardianConsents, error) {
+	if token == "" {
+		return models.GuardianConsents{}, errors.New("consent not found")
+	}
+
+	return s.consentRepository.FindConsentByToken(token)
+}
+
+// The consent as shown to the guardian, without anything else about the
+// minor than their name
+func (s guardianConsentService) consentRequest(consent models.GuardianConsents) (models.ConsentRequest, error) {
+	user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(consent.UsersID), 10), models.Users{})
+	if err != nil {
+		return models.ConsentRequest{}, err
+	}
+
+	req := models.ConsentRequest{
+		ID:           consent.ID,
+		Volunteer:    strings.TrimSpace(user.FirstName + " " + user.LastName),
+		GuardianName: consent.GuardianName,
+		EventID:      consent.EventID,
+		Status:       consent.Status,
+		ExpiresAt:    consent.ExpiresAt,
+	}
+
+	if consent.EventID != nil {
+		event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(*consent.EventID), 10))
+		if err != nil {
+			return models.ConsentRequest{}, err
+		}
+		req.EventName = event.Name
+	}
+
+	return req, nil
+}
+
+// Whether a guardian consented to the user volunteering at the series
+func hasGuardianConsent(c repository.GuardianConsentRepository, userId uint, seriesId uint) bool {
+	_, err := c.FindApprovedConsent(userId, seriesId)
+	return err == nil
+}
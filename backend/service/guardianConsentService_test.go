@@ -0,0 +1,196 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type GuardianConsentServiceUnitTestSuite struct {
+	suite.Suite
+	mockConsentRepo *mocks.GuardianConsentRepository
+	mockUsersRepo   *mocks.UsersRepository
+	mockEventRepo   *mocks.EventRepository
+	mockMailer      *mocks.Mailer
+	service         GuardianConsentService
+	minor           models.Users
+	consent         models.GuardianConsents
+	err             error
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) SetupTest() {
+	suite.mockConsentRepo = new(mocks.GuardianConsentRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockMailer = new(mocks.Mailer)
+	suite.service = NewGuardianConsentService(suite.mockConsentRepo, suite.mockUsersRepo, suite.mockEventRepo,
+		suite.mockMailer, "https://volunteerone.org/")
+
+	// 15 years old
+	suite.minor = models.Users{
+		Email:     "sam@example.com",
+		FirstName: "Sam",
+		LastName:  "Lee",
+		Birthdate: time.Now().AddDate(-15, 0, 0).Format("01/02/2006"),
+	}
+	suite.minor.ID = 4
+
+	suite.consent = models.GuardianConsents{
+		UsersID:       4,
+		GuardianName:  "Alex Lee",
+		GuardianEmail: "alex@example.com",
+		Status:        models.ConsentPending,
+		Token:         "secret",
+		ExpiresAt:     time.Now().Add(time.Hour),
+	}
+	suite.consent.ID = 9
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockConsentRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockMailer.AssertExpectations(suite.T())
+}
+
+func TestGuardianConsentServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(GuardianConsentServiceUnitTestSuite))
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RequestConsent_Event() {
+	// Consent to an edited occurrence covers its series
+	occurrence := time.Now().AddDate(0, 0, 7)
+	var edited models.Event
+	edited.ID = 5
+	edited.Name = "Park cleanup"
+	edited.Status = models.EventPublished
+	seriesId := uint(1)
+	edited.SeriesID = &seriesId
+	edited.OccurrenceDate = &occurrence
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.minor, nil)
+	suite.mockEventRepo.On("GetEventById", "5").Return(edited, nil)
+	suite.mockConsentRepo.On("CreateConsent", mock.MatchedBy(func(c models.GuardianConsents) bool {
+		return c.UsersID == 4 && c.GuardianEmail == "alex@example.com" && *c.EventID == 1 &&
+			len(c.Token) == 64 && c.ExpiresAt.After(time.Now().Add(13*24*time.Hour))
+	})).Return(func(c models.GuardianConsents) models.GuardianConsents {
+		return c
+	}, nil)
+	suite.mockMailer.On("Send", mock.MatchedBy(func(m mailer.Message) bool {
+		return m.To == "alex@example.com" && m.Subject == "Consent for Sam Lee to volunteer" &&
+			strings.Contains(m.Body, "at Park cleanup") &&
+			strings.Contains(m.Body, "https://volunteerone.org/consent/")
+	})).Return(nil)
+
+	eventId := uint(5)
+	consent, err := suite.service.RequestConsent(4, "Alex Lee", "Alex Lee <alex@example.com>", &eventId)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(1), *consent.EventID)
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RequestConsent_Adult() {
+	adult := suite.minor
+	adult.Birthdate = "1990-05-01"
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(adult, nil)
+
+	_, err := suite.service.RequestConsent(4, "Alex Lee", "alex@example.com", nil)
+
+	assert.EqualError(suite.T(), err, "only volunteers under 18 need a guardian's consent")
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RequestConsent_OwnEmail() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.minor, nil)
+
+	_, err := suite.service.RequestConsent(4, "Alex Lee", "SAM@example.com", nil)
+
+	assert.EqualError(suite.T(), err, "guardian email must not be your own")
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_ConsentRequest() {
+	suite.mockConsentRepo.On("FindConsentByToken", "secret").Return(suite.consent, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.minor, nil)
+
+	req, err := suite.service.ConsentRequest("secret")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "Sam Lee", req.Volunteer)
+	assert.Equal(suite.T(), models.ConsentPending, req.Status)
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RespondToConsent_Approve() {
+	suite.mockConsentRepo.On("FindConsentByToken", "secret").Return(suite.consent, nil)
+	suite.mockConsentRepo.On("SetConsentStatus", mock.MatchedBy(func(c models.GuardianConsents) bool {
+		return c.ID == 9 && c.RespondedAt != nil
+	}), models.GuardianConsentChanges{
+		ConsentID: 9,
+		From:      models.ConsentPending,
+		To:        models.ConsentApproved,
+		IP:        "203.0.113.7",
+		UserAgent: "Mozilla/5.0",
+	}).Return(func(c models.GuardianConsents, change models.GuardianConsentChanges) models.GuardianConsents {
+		c.Status = change.To
+		return c
+	}, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.minor, nil)
+
+	req, err := suite.service.RespondToConsent("secret", true, "203.0.113.7", "Mozilla/5.0")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.ConsentApproved, req.Status)
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RespondToConsent_Revoke() {
+	approved := suite.consent
+	approved.Status = models.ConsentApproved
+	// Approved consents can be revoked after the link would have expired
+	approved.ExpiresAt = time.Now().Add(-time.Hour)
+
+	suite.mockConsentRepo.On("FindConsentByToken", "secret").Return(approved, nil)
+	suite.mockConsentRepo.On("SetConsentStatus", mock.Anything, mock.MatchedBy(func(change models.GuardianConsentChanges) bool {
+		return change.From == models.ConsentApproved && change.To == models.ConsentRevoked
+	})).Return(approved, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.minor, nil)
+
+	_, err := suite.service.RespondToConsent("secret", false, "203.0.113.7", "Mozilla/5.0")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RespondToConsent_Expired() {
+	expired := suite.consent
+	expired.ExpiresAt = time.Now().Add(-time.Hour)
+	suite.mockConsentRepo.On("FindConsentByToken", "secret").Return(expired, nil)
+
+	_, err := suite.service.RespondToConsent("secret", true, "203.0.113.7", "Mozilla/5.0")
+
+	assert.EqualError(suite.T(), err, "consent link has expired")
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RespondToConsent_AlreadyDeclined() {
+	declined := suite.consent
+	declined.Status = models.ConsentDeclined
+	suite.mockConsentRepo.On("FindConsentByToken", "secret").Return(declined, nil)
+
+	_, err := suite.service.RespondToConsent("secret", true, "203.0.113.7", "Mozilla/5.0")
+
+	assert.EqualError(suite.T(), err, "consent is already declined")
+}
+
+func (suite *GuardianConsentServiceUnitTestSuite) TestGuardianConsentService_RespondToConsent_UnknownToken() {
+	suite.mockConsentRepo.On("FindConsentByToken", "guess").Return(models.GuardianConsents{}, suite.err)
+
+	_, err := suite.service.RespondToConsent("guess", true, "203.0.113.7", "Mozilla/5.0")
+
+	assert.NotNil(suite.T(), err)
+}
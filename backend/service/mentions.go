@@ -0,0 +1,73 @@
+package service
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Most users notified of being mentioned in one text
+const maxMentions = 10
+
+var mentionPattern = regexp.MustCompile(`(?:^|[^\w@])@(\w+)`)
+
+// The handles mentioned as @handle in text, each once, in order
+func mentionedHandles(text string) []string {
+	handles := []string{}
+	seen := map[string]bool{}
+
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		handle := strings.ToLower(match[1])
+		if seen[handle] {
+			continue
+		}
+		seen[handle] = true
+
+		handles = append(handles, match[1])
+		if len(handles) == maxMentions {
+			break
+		}
+	}
+
+	return handles
+}
+
+// Most hashtags indexed for one text, and how long each can be
+const (
+	maxHashtags      = 10
+	maxHashtagLength = 64
+)
+
+// A # not within a word or an HTML entity, then a letter and more letters,
+// digits or underscores
+var hashtagPattern = regexp.MustCompile(`(?:^|[^\pL\pN_#&])#(\pL[\pL\pN_]*)`)
+
+// The hashtags in text, lowercase and without the #, each once, in order
+func hashtags(text string) []string {
+	tags := []string{}
+	seen := map[string]bool{}
+
+	for _, match := range hashtagPattern.FindAllStringSubmatch(text, -1) {
+		tag := strings.ToLower(match[1])
+		if seen[tag] || len(tag) > maxHashtagLength {
+			continue
+		}
+		seen[tag] = true
+
+		tags = append(tags, tag)
+		if len(tags) == maxHashtags {
+			break
+		}
+	}
+
+	return tags
+}
+
+// The handles mentioned in text, lowercase, as they are indexed
+func indexedHandles(text string) []string {
+	handles := mentionedHandles(text)
+	for i, handle := range handles {
+		handles[i] = strings.ToLower(handle)
+	}
+
+	return handles
+}
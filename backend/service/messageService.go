@@ -0,0 +1,417 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+var ErrCannotMessage = errors.New("you can't message this user")
+
+// How long a message can be, in characters
+const maxMessageLength = 2000
+
+type MessageService interface {
+	StartConversation(userId uint, otherUserId uint, orgId uint) (models.Conversations, error)
+	Conversations(userId uint, orgId uint, cursor string, limit int) (models.ConversationPage, error)
+	Messages(userId uint, conversationId uint, cursor string, limit int) (models.MessagePage, error)
+	SendMessage(userId uint, conversationId uint, body string) (models.Messages, error)
+	MarkRead(userId uint, conversationId uint) error
+	Unread(userId uint) (models.UnreadMessages, error)
+	Block(userId uint, otherUserId uint) error
+	Unblock(userId uint, otherUserId uint) error
+}
+
+type messageService struct {
+	messageRepository      repository.MessageRepository
+	usersRepository        repository.UsersRepository
+	orgUsersRepository     repository.OrgUsersRepository
+	organizationRepository repository.OrganizationRepository
+	notifications          NotificationService
+	hub                    realtime.Hub
+}
+
+// Instantiated in router.go
+func NewMessageService(r repository.MessageRepository, u repository.UsersRepository, o repository.OrgUsersRepository, g repository.OrganizationRepository, n NotificationService, h realtime.Hub) MessageService {
+	return messageService{
+		messageRepository:      r,
+		usersRepository:        u,
+		orgUsersRepository:     o,
+		organizationRepository: g,
+		notifications:          n,
+		hub:                    h,
+	}
+}
+
+// Told to the writers of messages once they are read
+type readReceipt struct {
+	ConversationsID uint
+	ReaderID        uint
+	ReadAt          time.Time
+}
+
+// Where message pages continue from
+type messagePosition struct {
+	ID uint `json:"id"`
+}
+
+// Starts a conversation of the user with another user, who must be their
+// friend and neither blocked the other, or with the inbox of an
+// organization. Finds the one they already have if there is one.
+func (s messageService) StartConversation(userId uint, otherUserId uint, orgId uint) (models.Conversations, error) {
+	log.Println("[MessageService] Start conversation...")
+
+	if (otherUserId == 0) == (orgId == 0) {
+		return models.Conversations{}, errors.New("give either a user or an organization to message")
+	}
+
+	user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.Conversations{}, err
+	}
+
+	if user.Suspended(time.Now()) {
+		return models.Conversations{}, ErrSuspended
+	}
+
+	conversation := models.Conversations{UserOneID: userId, LastMessageAt: time.Now()}
+
+	if otherUserId != 0 {
+		if otherUserId == userId {
+			return models.Conversations{}, errors.New("you can't message yourself")
+		}
+
+		other, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(otherUserId), 10), models.Users{})
+		if err != nil {
+			return models.Conversations{}, errors.New("user not found")
+		}
+
+		if err := s.canMessage(user, other); err != nil {
+			return models.Conversations{}, err
+		}
+
+		// The same conversation whoever starts it
+		one, two := userId, otherUserId
+		if one > two {
+			one, two = two, one
+		}
+		conversation.UserOneID = one
+		conversation.UserTwoID = &two
+		conversation.Key = fmt.Sprintf("users:%d:%d", one, two)
+	} else {
+		if _, err := s.organizationRepository.GetOrganizationById(strconv.FormatUint(uint64(orgId), 10)); err != nil {
+			return models.Conversations{}, errors.New("organization not found")
+		}
+
+		if requireManager(s.orgUsersRepository, userId, orgId) == nil {
+			return models.Conversations{}, errors.New("you can't message an organization you manage")
+		}
+
+		conversation.OrganizationID = &orgId
+		conversation.Key = fmt.Sprintf("organization:%d:%d", orgId, userId)
+	}
+
+	return s.messageRepository.StartConversation(conversation)
+}
+
+// Lists a page of the user's conversations, or of the inbox of the
+// organization when orgId is set and they manage it, latest message first
+func (s messageService) Conversations(userId uint, orgId uint, cursor string, limit int) (models.ConversationPage, error) {
+	var position models.ConversationCursor
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.ConversationPage{}, err
+	}
+
+	var conversations []models.Conversations
+	var err error
+
+	// One extra tells whether there is another page
+	if orgId != 0 {
+		if err := requireManager(s.orgUsersRepository, userId, orgId); err != nil {
+			return models.ConversationPage{}, err
+		}
+
+		conversations, err = s.messageRepository.OrganizationConversations(orgId, position, limit+1)
+	} else {
+		conversations, err = s.messageRepository.UserConversations(userId, position, limit+1)
+	}
+	if err != nil {
+		return models.ConversationPage{}, err
+	}
+
+	page := models.ConversationPage{Conversations: conversations}
+	if len(conversations) > limit {
+		page.Conversations = conversations[:limit]
+		last := page.Conversations[limit-1]
+		page.NextCursor = encodeCursor(models.ConversationCursor{LastMessageAt: last.LastMessageAt, ID: last.ID})
+	}
+
+	return page, nil
+}
+
+// Lists a page of the conversation's messages, latest first
+func (s messageService) Messages(userId uint, conversationId uint, cursor string, limit int) (models.MessagePage, error) {
+	var position messagePosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.MessagePage{}, err
+	}
+
+	if _, _, err := s.participant(userId, conversationId); err != nil {
+		return models.MessagePage{}, err
+	}
+
+	// One extra tells whether there is another page
+	messages, err := s.messageRepository.GetMessages(conversationId, position.ID, limit+1)
+	if err != nil {
+		return models.MessagePage{}, err
+	}
+
+	page := models.MessagePage{Messages: messages}
+	if len(messages) > limit {
+		page.Messages = messages[:limit]
+		page.NextCursor = encodeCursor(messagePosition{ID: page.Messages[limit-1].ID})
+	}
+
+	return page, nil
+}
+
+// Sends the message in the conversation, as the organization when the user
+// manages it. Users only keep messaging each other while they are friends
+// and neither blocked the other; managers can't answer users who blocked
+// them. The recipients get it in real time while connected, as a
+// notification otherwise.
+func (s messageService) SendMessage(userId uint, conversationId uint, body string) (models.Messages, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return models.Messages{}, errors.New("message cannot be empty")
+	}
+	if len([]rune(body)) > maxMessageLength {
+		return models.Messages{}, fmt.Errorf("messages can be at most %d characters", maxMessageLength)
+	}
+
+	conversation, asOrganization, err := s.participant(userId, conversationId)
+	if err != nil {
+		return models.Messages{}, err
+	}
+
+	sender, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.Messages{}, err
+	}
+
+	if sender.Suspended(time.Now()) {
+		return models.Messages{}, ErrSuspended
+	}
+
+	message := models.Messages{ConversationsID: conversation.ID, SenderID: userId, Body: body}
+
+	switch {
+	case asOrganization:
+		blocked, err := s.messageRepository.Blocked(userId, conversation.UserOneID)
+		if err != nil || blocked {
+			return models.Messages{}, ErrCannotMessage
+		}
+
+		message.SenderOrganizationID = conversation.OrganizationID
+		message.RecipientID = &conversation.UserOneID
+	case conversation.OrganizationID != nil:
+		message.RecipientOrganizationID = conversation.OrganizationID
+	default:
+		otherId := conversation.UserOneID
+		if otherId == userId {
+			otherId = *conversation.UserTwoID
+		}
+
+		other, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(otherId), 10), models.Users{})
+		if err != nil {
+			return models.Messages{}, errors.New("user not found")
+		}
+
+		if err := s.canMessage(sender, other); err != nil {
+			return models.Messages{}, err
+		}
+
+		message.RecipientID = &otherId
+	}
+
+	created, err := s.messageRepository.CreateMessage(message)
+	if err != nil {
+		return models.Messages{}, err
+	}
+
+	s.deliver(created, conversation, sender)
+
+	return created, nil
+}
+
+// Marks the messages the user, or the organization they answer for, got in
+// the conversation read. Their writers are told in real time.
+func (s messageService) MarkRead(userId uint, conversationId uint) error {
+	conversation, asOrganization, err := s.participant(userId, conversationId)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	var read int64
+	if asOrganization {
+		read, err = s.messageRepository.MarkOrganizationRead(conversation.ID, *conversation.OrganizationID, now)
+	} else {
+		read, err = s.messageRepository.MarkRead(conversation.ID, userId, now)
+	}
+	if err != nil || read == 0 {
+		return err
+	}
+
+	var writers []uint
+	switch {
+	case asOrganization:
+		writers = []uint{conversation.UserOneID}
+	case conversation.OrganizationID != nil:
+		writers = s.managerIds(*conversation.OrganizationID, userId)
+	case conversation.UserOneID == userId:
+		writers = []uint{*conversation.UserTwoID}
+	default:
+		writers = []uint{conversation.UserOneID}
+	}
+
+	receipt := readReceipt{ConversationsID: conversation.ID, ReaderID: userId, ReadAt: now}
+	for _, writer := range writers {
+		s.hub.Publish(realtime.UserTopic(writer), "messages_read", receipt)
+	}
+
+	return nil
+}
+
+// Counts the messages the user, and each organization they manage, did not
+// read yet
+func (s messageService) Unread(userId uint) (models.UnreadMessages, error) {
+	return s.messageRepository.UnreadCounts(userId)
+}
+
+// Stops the other user and the user from messaging each other
+func (s messageService) Block(userId uint, otherUserId uint) error {
+	if otherUserId == userId {
+		return errors.New("you can't block yourself")
+	}
+
+	if _, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(otherUserId), 10), models.Users{}); err != nil {
+		return errors.New("user not found")
+	}
+
+	return s.messageRepository.Block(userId, otherUserId)
+}
+
+func (s messageService) Unblock(userId uint, otherUserId uint) error {
+	return s.messageRepository.Unblock(userId, otherUserId)
+}
+
+// Finds the conversation if the user takes part in it, and whether they do
+// as a manager of its organization
+func (s messageService) participant(userId uint, conversationId uint) (models.Conversations, bool, error) {
+	conversation, err := s.messageRepository.FindConversation(conversationId)
+	if err != nil {
+		return models.Conversations{}, false, errors.New("conversation not found")
+	}
+
+	if conversation.HasUser(userId) {
+		return conversation, false, nil
+	}
+
+	if conversation.OrganizationID != nil && requireManager(s.orgUsersRepository, userId, *conversation.OrganizationID) == nil {
+		return conversation, true, nil
+	}
+
+	return models.Conversations{}, false, errors.New("conversation not found")
+}
+
+// Fails unless the users are friends and neither blocked the other
+func (s messageService) canMessage(user models.Users, other models.Users) error {
+	blocked, err := s.messageRepository.Blocked(user.ID, other.ID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return ErrCannotMessage
+	}
+
+	friends, err := s.messageRepository.AreFriends(user.Handle, other.Handle)
+	if err != nil {
+		return err
+	}
+	if !friends {
+		return errors.New("you can only message your friends")
+	}
+
+	return nil
+}
+
+// Ids of the organization's owners and managers, but the user's
+func (s messageService) managerIds(orgId uint, exceptId uint) []uint {
+	members, err := s.orgUsersRepository.GetOrgMembers(orgId)
+	if err != nil {
+		log.Println("[MessageService] Could not find managers:", err)
+	}
+
+	ids := []uint{}
+	for _, member := range members {
+		if member.Role <= models.RoleManager && member.UsersID != exceptId {
+			ids = append(ids, member.UsersID)
+		}
+	}
+
+	return ids
+}
+
+// Hands the message to its recipients: in real time to those connected,
+// as a notification to the others
+func (s messageService) deliver(message models.Messages, conversation models.Conversations, sender models.Users) {
+	var organization models.Organization
+	if conversation.OrganizationID != nil {
+		var err error
+		organization, err = s.organizationRepository.GetOrganizationById(strconv.FormatUint(uint64(*conversation.OrganizationID), 10))
+		if err != nil {
+			log.Println("[MessageService] Could not find organization:", err)
+		}
+	}
+
+	var recipients []uint
+	var title string
+	switch {
+	case message.RecipientOrganizationID != nil:
+		recipients = s.managerIds(*message.RecipientOrganizationID, sender.ID)
+		title = "@" + sender.Handle + " messaged " + organization.Name
+	case message.SenderOrganizationID != nil:
+		recipients = []uint{*message.RecipientID}
+		title = organization.Name + " sent you a message"
+	default:
+		recipients = []uint{*message.RecipientID}
+		title = "@" + sender.Handle + " sent you a message"
+	}
+
+	for _, recipient := range recipients {
+		topic := realtime.UserTopic(recipient)
+		if s.hub.Subscribed(topic) {
+			s.hub.Publish(topic, "message", message)
+			continue
+		}
+
+		s.notifications.Notify(models.Notifications{
+			UsersID:     recipient,
+			Type:        models.NotifyMessage,
+			Title:       title,
+			Body:        message.Body,
+			SubjectType: models.SubjectConversation,
+			SubjectID:   conversation.ID,
+			ActorID:     sender.ID,
+		}, nil)
+	}
+}
@@ -0,0 +1,419 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type MessageServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.MessageRepository
+	mockUsersRepo    *mocks.UsersRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	mockOrgRepo      *mocks.OrganizationRepository
+	notifications    *mocks.NotificationService
+	mockHub          *mocks.Hub
+	service          MessageService
+	ada              models.Users
+	grace            models.Users
+	direct           models.Conversations
+	inbox            models.Conversations
+	err              error
+}
+
+func (suite *MessageServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.MessageRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.mockOrgRepo = new(mocks.OrganizationRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.mockHub = new(mocks.Hub)
+	suite.service = NewMessageService(suite.mockRepo, suite.mockUsersRepo, suite.mockOrgUsersRepo, suite.mockOrgRepo, suite.notifications, suite.mockHub)
+
+	suite.ada = models.Users{Handle: "ada"}
+	suite.ada.ID = 4
+	suite.grace = models.Users{Handle: "grace"}
+	suite.grace.ID = 6
+
+	// Between ada and grace
+	graceId := uint(6)
+	suite.direct = models.Conversations{UserOneID: 4, UserTwoID: &graceId}
+	suite.direct.ID = 11
+
+	// Between ada and the inbox of organization 2
+	orgId := uint(2)
+	suite.inbox = models.Conversations{UserOneID: 4, OrganizationID: &orgId}
+	suite.inbox.ID = 12
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *MessageServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+	suite.mockOrgRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.mockHub.AssertExpectations(suite.T())
+}
+
+func TestMessageServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(MessageServiceUnitTestSuite))
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation() {
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockRepo.On("Blocked", uint(6), uint(4)).Return(false, nil)
+	suite.mockRepo.On("AreFriends", "grace", "ada").Return(true, nil)
+
+	// The same conversation whoever starts it
+	suite.mockRepo.On("StartConversation", mock.MatchedBy(func(c models.Conversations) bool {
+		return c.Key == "users:4:6" && c.UserOneID == 4 && *c.UserTwoID == 6 && c.OrganizationID == nil
+	})).Return(suite.direct, nil)
+
+	conversation, err := suite.service.StartConversation(6, 4, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(11), conversation.ID)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation_NotFriends() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockRepo.On("Blocked", uint(4), uint(6)).Return(false, nil)
+	suite.mockRepo.On("AreFriends", "ada", "grace").Return(false, nil)
+
+	_, err := suite.service.StartConversation(4, 6, 0)
+
+	assert.EqualError(suite.T(), err, "you can only message your friends")
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation_Blocked() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockRepo.On("Blocked", uint(4), uint(6)).Return(true, nil)
+
+	_, err := suite.service.StartConversation(4, 6, 0)
+
+	assert.Equal(suite.T(), ErrCannotMessage, err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation_Invalid() {
+	_, err := suite.service.StartConversation(4, 0, 0)
+	assert.EqualError(suite.T(), err, "give either a user or an organization to message")
+
+	_, err = suite.service.StartConversation(4, 6, 2)
+	assert.EqualError(suite.T(), err, "give either a user or an organization to message")
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+
+	_, err = suite.service.StartConversation(4, 4, 0)
+	assert.EqualError(suite.T(), err, "you can't message yourself")
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation_Suspended() {
+	until := time.Now().Add(time.Hour)
+	suite.ada.SuspendedUntil = &until
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+
+	_, err := suite.service.StartConversation(4, 6, 0)
+
+	assert.Equal(suite.T(), ErrSuspended, err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation_Organization() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(models.Organization{Name: "Parks"}, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	// Anyone can write to an organization, friends or not
+	suite.mockRepo.On("StartConversation", mock.MatchedBy(func(c models.Conversations) bool {
+		return c.Key == "organization:2:4" && c.UserOneID == 4 && c.UserTwoID == nil && *c.OrganizationID == 2
+	})).Return(suite.inbox, nil)
+
+	conversation, err := suite.service.StartConversation(4, 0, 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(12), conversation.ID)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_StartConversation_OwnOrganization() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(models.Organization{Name: "Parks"}, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+
+	_, err := suite.service.StartConversation(4, 0, 2)
+
+	assert.EqualError(suite.T(), err, "you can't message an organization you manage")
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Conversations() {
+	first := models.Conversations{LastMessageAt: time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)}
+	first.ID = 11
+	second := models.Conversations{LastMessageAt: time.Date(2034, 3, 30, 9, 0, 0, 0, time.UTC)}
+	second.ID = 12
+
+	suite.mockRepo.On("UserConversations", uint(4), models.ConversationCursor{}, 2).
+		Return([]models.Conversations{first, second}, nil).Once()
+
+	page, err := suite.service.Conversations(4, 0, "", 1)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Conversations, 1)
+
+	// The next page starts after the last conversation shown
+	suite.mockRepo.On("UserConversations", uint(4), models.ConversationCursor{LastMessageAt: first.LastMessageAt, ID: 11}, 2).
+		Return([]models.Conversations{second}, nil).Once()
+
+	next, err := suite.service.Conversations(4, 0, page.NextCursor, 1)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(12), next.Conversations[0].ID)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Conversations_Organization() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	// Members who don't manage it can't read the inbox
+	_, err := suite.service.Conversations(4, 2, "", 20)
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Messages() {
+	messages := []models.Messages{{}, {}, {}}
+	for i := range messages {
+		messages[i].ID = uint(30 - i)
+	}
+
+	suite.mockRepo.On("FindConversation", uint(11)).Return(suite.direct, nil).Twice()
+	suite.mockRepo.On("GetMessages", uint(11), uint(0), 3).Return(messages, nil).Once()
+
+	page, err := suite.service.Messages(6, 11, "", 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Messages, 2)
+
+	suite.mockRepo.On("GetMessages", uint(11), uint(29), 3).Return(messages[2:], nil).Once()
+
+	next, err := suite.service.Messages(6, 11, page.NextCursor, 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), next.Messages, 1)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Messages_NotParticipant() {
+	suite.mockRepo.On("FindConversation", uint(12)).Return(suite.inbox, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(6), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.Messages(6, 12, "", 20)
+
+	assert.EqualError(suite.T(), err, "conversation not found")
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_Realtime() {
+	recipient := uint(6)
+	sent := models.Messages{ConversationsID: 11, SenderID: 4, RecipientID: &recipient, Body: "See you Saturday"}
+	sent.ID = 30
+
+	suite.mockRepo.On("FindConversation", uint(11)).Return(suite.direct, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockRepo.On("Blocked", uint(4), uint(6)).Return(false, nil)
+	suite.mockRepo.On("AreFriends", "ada", "grace").Return(true, nil)
+	suite.mockRepo.On("CreateMessage", models.Messages{ConversationsID: 11, SenderID: 4, RecipientID: &recipient, Body: "See you Saturday"}).
+		Return(sent, nil)
+
+	// Connected recipients get it right away, without a notification
+	suite.mockHub.On("Subscribed", realtime.UserTopic(6)).Return(true)
+	suite.mockHub.On("Publish", realtime.UserTopic(6), "message", sent).Once()
+
+	message, err := suite.service.SendMessage(4, 11, " See you Saturday ")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(30), message.ID)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_Notification() {
+	suite.mockRepo.On("FindConversation", uint(11)).Return(suite.direct, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockRepo.On("Blocked", uint(4), uint(6)).Return(false, nil)
+	suite.mockRepo.On("AreFriends", "ada", "grace").Return(true, nil)
+	suite.mockRepo.On("CreateMessage", mock.Anything).Return(func(m models.Messages) models.Messages { return m }, nil)
+
+	suite.mockHub.On("Subscribed", realtime.UserTopic(6)).Return(false)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 6 && n.Type == models.NotifyMessage && n.ActorID == 4 &&
+			n.Title == "@ada sent you a message" && n.Body == "See you Saturday" &&
+			n.SubjectType == models.SubjectConversation && n.SubjectID == 11
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	_, err := suite.service.SendMessage(4, 11, "See you Saturday")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_Unfriended() {
+	suite.mockRepo.On("FindConversation", uint(11)).Return(suite.direct, nil)
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockRepo.On("Blocked", uint(6), uint(4)).Return(false, nil)
+	suite.mockRepo.On("AreFriends", "grace", "ada").Return(false, nil)
+
+	_, err := suite.service.SendMessage(6, 11, "Hello")
+
+	assert.EqualError(suite.T(), err, "you can only message your friends")
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_Body() {
+	_, err := suite.service.SendMessage(4, 11, "  ")
+	assert.EqualError(suite.T(), err, "message cannot be empty")
+
+	long := make([]rune, maxMessageLength+1)
+	for i := range long {
+		long[i] = 'é'
+	}
+
+	_, err = suite.service.SendMessage(4, 11, string(long))
+	assert.EqualError(suite.T(), err, "messages can be at most 2000 characters")
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_ToOrganization() {
+	suite.mockRepo.On("FindConversation", uint(12)).Return(suite.inbox, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.ada, nil)
+	suite.mockRepo.On("CreateMessage", mock.MatchedBy(func(m models.Messages) bool {
+		return m.RecipientID == nil && *m.RecipientOrganizationID == 2 && m.SenderOrganizationID == nil
+	})).Return(func(m models.Messages) models.Messages { return m }, nil)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(models.Organization{Name: "Parks"}, nil)
+
+	// Every manager of the organization is told, members aren't
+	suite.mockOrgUsersRepo.On("GetOrgMembers", uint(2)).Return([]models.OrgUsers{
+		{UsersID: 7, Role: models.RoleOwner},
+		{UsersID: 8, Role: models.RoleManager},
+		{UsersID: 9, Role: models.RoleMember},
+	}, nil)
+	suite.mockHub.On("Subscribed", realtime.UserTopic(7)).Return(true)
+	suite.mockHub.On("Publish", realtime.UserTopic(7), "message", mock.Anything).Once()
+	suite.mockHub.On("Subscribed", realtime.UserTopic(8)).Return(false)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 8 && n.Title == "@ada messaged Parks"
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	_, err := suite.service.SendMessage(4, 12, "Is parking available?")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_AsOrganization() {
+	manager := models.Users{Handle: "linus"}
+	manager.ID = 7
+
+	suite.mockRepo.On("FindConversation", uint(12)).Return(suite.inbox, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+	suite.mockUsersRepo.On("OneUser", "7", models.Users{}).Return(manager, nil)
+	suite.mockRepo.On("Blocked", uint(7), uint(4)).Return(false, nil)
+
+	// Managers answer for the organization
+	suite.mockRepo.On("CreateMessage", mock.MatchedBy(func(m models.Messages) bool {
+		return m.SenderID == 7 && *m.SenderOrganizationID == 2 && *m.RecipientID == 4 && m.RecipientOrganizationID == nil
+	})).Return(func(m models.Messages) models.Messages { return m }, nil)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(models.Organization{Name: "Parks"}, nil)
+	suite.mockHub.On("Subscribed", realtime.UserTopic(4)).Return(false)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Title == "Parks sent you a message"
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	_, err := suite.service.SendMessage(7, 12, "Yes, behind the gym")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_SendMessage_AsOrganization_Blocked() {
+	manager := models.Users{Handle: "linus"}
+	manager.ID = 7
+
+	suite.mockRepo.On("FindConversation", uint(12)).Return(suite.inbox, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleOwner}, nil)
+	suite.mockUsersRepo.On("OneUser", "7", models.Users{}).Return(manager, nil)
+	suite.mockRepo.On("Blocked", uint(7), uint(4)).Return(true, nil)
+
+	_, err := suite.service.SendMessage(7, 12, "Hello")
+
+	assert.Equal(suite.T(), ErrCannotMessage, err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_MarkRead() {
+	suite.mockRepo.On("FindConversation", uint(11)).Return(suite.direct, nil)
+	suite.mockRepo.On("MarkRead", uint(11), uint(6), mock.AnythingOfType("time.Time")).Return(int64(2), nil)
+
+	// The writer learns their messages were read
+	suite.mockHub.On("Publish", realtime.UserTopic(4), "messages_read", mock.MatchedBy(func(r readReceipt) bool {
+		return r.ConversationsID == 11 && r.ReaderID == 6
+	})).Once()
+
+	err := suite.service.MarkRead(6, 11)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_MarkRead_Nothing() {
+	suite.mockRepo.On("FindConversation", uint(11)).Return(suite.direct, nil)
+	suite.mockRepo.On("MarkRead", uint(11), uint(4), mock.AnythingOfType("time.Time")).Return(int64(0), nil)
+
+	err := suite.service.MarkRead(4, 11)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_MarkRead_Organization() {
+	suite.mockRepo.On("FindConversation", uint(12)).Return(suite.inbox, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+	suite.mockRepo.On("MarkOrganizationRead", uint(12), uint(2), mock.AnythingOfType("time.Time")).Return(int64(1), nil)
+	suite.mockHub.On("Publish", realtime.UserTopic(4), "messages_read", mock.Anything).Once()
+
+	err := suite.service.MarkRead(7, 12)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Unread() {
+	unread := models.UnreadMessages{Personal: 3, Organizations: map[uint]int64{2: 1}}
+	suite.mockRepo.On("UnreadCounts", uint(4)).Return(unread, nil)
+
+	counts, err := suite.service.Unread(4)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), unread, counts)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Block() {
+	suite.mockUsersRepo.On("OneUser", "6", models.Users{}).Return(suite.grace, nil)
+	suite.mockRepo.On("Block", uint(4), uint(6)).Return(nil)
+
+	err := suite.service.Block(4, 6)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *MessageServiceUnitTestSuite) TestMessageService_Block_Invalid() {
+	err := suite.service.Block(4, 4)
+	assert.EqualError(suite.T(), err, "you can't block yourself")
+
+	suite.mockUsersRepo.On("OneUser", "9", models.Users{}).Return(models.Users{}, suite.err)
+
+	err = suite.service.Block(4, 9)
+	assert.EqualError(suite.T(), err, "user not found")
+}
@@ -0,0 +1,406 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/contentfilter"
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+	"github.com/google/uuid"
+)
+
+const (
+	// Posts and comments are held for review once this many users
+	// reported them
+	reportsToHold = 5
+	// The longest a user can be suspended for, in days
+	maxSuspensionDays = 365
+)
+
+type ModerationService interface {
+	Report(userId uint, subjectType string, subjectId uint, reason string, details string) (models.Reports, error)
+	Cases(status string, cursor string, limit int) (models.ModerationCasePage, error)
+	Case(id uint) (models.ModerationCases, error)
+	Act(moderatorId uint, caseId uint, action string, note string, days int) (models.ModerationCases, error)
+}
+
+type moderationService struct {
+	moderationRepository   repository.ModerationRepository
+	postsRepository        repository.PostsRepository
+	commentsRepository     repository.CommentsRepository
+	usersRepository        repository.UsersRepository
+	organizationRepository repository.OrganizationRepository
+	eventRepository        repository.EventRepository
+	orgUsersRepository     repository.OrgUsersRepository
+	loginRepository        repository.LoginRepository
+	notifications          NotificationService
+	feedCache              feedcache.Cache
+}
+
+// Instantiated in router.go
+func NewModerationService(m repository.ModerationRepository, p repository.PostsRepository, c repository.CommentsRepository, u repository.UsersRepository, g repository.OrganizationRepository, e repository.EventRepository, o repository.OrgUsersRepository, l repository.LoginRepository, n NotificationService, f feedcache.Cache) ModerationService {
+	return moderationService{
+		moderationRepository:   m,
+		postsRepository:        p,
+		commentsRepository:     c,
+		usersRepository:        u,
+		organizationRepository: g,
+		eventRepository:        e,
+		orgUsersRepository:     o,
+		loginRepository:        l,
+		notifications:          n,
+		feedCache:              f,
+	}
+}
+
+// What a case is about, as moderators see it
+type moderationSubject struct {
+	value any
+	// Who is warned or suspended over it
+	userIds []uint
+	// Set for posts and comments
+	post    *models.Posts
+	comment *models.Comments
+}
+
+// The post's or comment's moderation, empty for other subjects
+func (s moderationSubject) moderation() string {
+	switch {
+	case s.post != nil:
+		return s.post.Moderation
+	case s.comment != nil:
+		return s.comment.Moderation
+	}
+
+	return ""
+}
+
+// Reports the post, comment, user, organization or event to the moderators.
+// Posts and comments many users reported are held until a moderator looked
+// at them.
+func (s moderationService) Report(userId uint, subjectType string, subjectId uint, reason string, details string) (models.Reports, error) {
+	log.Println("[ModerationService] Report...")
+
+	details = strings.TrimSpace(details)
+
+	if !models.IsReportReason(reason) {
+		return models.Reports{}, errors.New("reason must be one of " + strings.Join(models.ReportReasons, ", "))
+	}
+
+	if reason == models.ReportOther && details == "" {
+		return models.Reports{}, errors.New("tell us what is wrong in details")
+	}
+
+	if len(details) > 1000 {
+		return models.Reports{}, errors.New("details must be at most 1000 characters")
+	}
+
+	subject, err := s.subject(subjectType, subjectId)
+	if err != nil {
+		return models.Reports{}, err
+	}
+
+	// Owners can report their own organizations, e.g. when taken over
+	if subjectType != models.SubjectOrganization && subjectType != models.SubjectEvent {
+		for _, responsible := range subject.userIds {
+			if responsible == userId {
+				return models.Reports{}, errors.New("you cannot report yourself")
+			}
+		}
+	}
+
+	report := models.Reports{ReporterID: userId, Reason: reason, Details: details}
+
+	moderationCase, err := s.moderationRepository.FileReport(subjectType, subjectId, report)
+	if err != nil {
+		return models.Reports{}, err
+	}
+
+	report.ModerationCasesID = moderationCase.ID
+
+	if moderationCase.ReportCount >= reportsToHold && (subject.post != nil || subject.comment != nil) && subject.moderation() == "" {
+		if _, err := s.moderationRepository.HoldContent(subjectType, subjectId, fmt.Sprintf("reported by %d users", moderationCase.ReportCount)); err != nil {
+			log.Println("[ModerationService] Could not hold reported content:", err)
+		} else if subject.post != nil {
+			s.feedCache.Invalidate(postSources(*subject.post))
+		}
+	}
+
+	return report, nil
+}
+
+// Lists a page of the cases with the status, oldest first
+func (s moderationService) Cases(status string, cursor string, limit int) (models.ModerationCasePage, error) {
+	log.Println("[ModerationService] Cases...")
+
+	if status != models.CaseOpen && status != models.CaseClosed {
+		return models.ModerationCasePage{}, errors.New("status must be open or closed")
+	}
+
+	var position casePosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.ModerationCasePage{}, err
+	}
+
+	// One extra tells whether there is another page
+	cases, err := s.moderationRepository.GetCases(status, position.ID, limit+1)
+	if err != nil {
+		return models.ModerationCasePage{}, err
+	}
+
+	page := models.ModerationCasePage{Cases: cases}
+	if len(cases) > limit {
+		page.Cases = cases[:limit]
+		page.NextCursor = encodeCursor(casePosition{ID: page.Cases[limit-1].ID})
+	}
+
+	return page, nil
+}
+
+// Finds the case with its reports, actions and what it is about
+func (s moderationService) Case(id uint) (models.ModerationCases, error) {
+	log.Println("[ModerationService] Case...")
+
+	moderationCase, err := s.moderationRepository.GetCase(id)
+	if err != nil {
+		return models.ModerationCases{}, err
+	}
+
+	// Subjects deleted since are left out
+	if subject, err := s.subject(moderationCase.SubjectType, moderationCase.SubjectID); err == nil {
+		moderationCase.Subject = subject.value
+	}
+
+	return moderationCase, nil
+}
+
+// Acts on the open case and closes it. Whoever the action concerns is
+// told, with the moderator's note.
+func (s moderationService) Act(moderatorId uint, caseId uint, action string, note string, days int) (models.ModerationCases, error) {
+	log.Println("[ModerationService] Act...")
+
+	note = strings.TrimSpace(note)
+
+	if !models.IsModerationAction(action) {
+		return models.ModerationCases{}, errors.New("action must be one of " + strings.Join(models.ModerationActionTypes, ", "))
+	}
+
+	if len(note) > 1000 {
+		return models.ModerationCases{}, errors.New("note must be at most 1000 characters")
+	}
+
+	moderationCase, err := s.moderationRepository.GetCase(caseId)
+	if err != nil {
+		return models.ModerationCases{}, err
+	}
+
+	if moderationCase.Status != models.CaseOpen {
+		return models.ModerationCases{}, errors.New("the case is already closed")
+	}
+
+	record := models.ModerationActions{ModeratorID: moderatorId, Action: action, Note: note}
+
+	subject, err := s.subject(moderationCase.SubjectType, moderationCase.SubjectID)
+	if err != nil {
+		// Whatever was reported is gone already
+		if action != models.ModerateDismiss {
+			return models.ModerationCases{}, err
+		}
+
+		return s.moderationRepository.CloseCase(moderationCase, record)
+	}
+
+	content := subject.post != nil || subject.comment != nil
+	kind := moderationCase.SubjectType
+
+	switch action {
+	case models.ModerateDismiss:
+		if subject.moderation() == models.ModerationHeld {
+			if err := s.moderationRepository.SetModeration(kind, moderationCase.SubjectID, ""); err != nil {
+				return models.ModerationCases{}, err
+			}
+		}
+
+	case models.ModerateHide, models.ModerateRemove:
+		if !content {
+			return models.ModerationCases{}, errors.New("only posts and comments can be hidden or removed")
+		}
+
+		if action == models.ModerateHide {
+			err = s.moderationRepository.SetModeration(kind, moderationCase.SubjectID, models.ModerationHidden)
+		} else if subject.post != nil {
+			err = s.postsRepository.DeletePost(*subject.post)
+		} else {
+			err = s.commentsRepository.DeleteComment(*subject.comment)
+		}
+		if err != nil {
+			return models.ModerationCases{}, err
+		}
+
+		if subject.post != nil {
+			s.feedCache.Invalidate(postSources(*subject.post))
+		}
+
+		verb := map[string]string{models.ModerateHide: "hidden", models.ModerateRemove: "removed"}[action]
+		s.tell(subject.userIds, "Your "+kind+" was "+verb+" by a moderator", note, kind, moderationCase.SubjectID)
+
+	case models.ModerateWarn:
+		s.tell(subject.userIds, "You received a warning from a moderator", note, kind, moderationCase.SubjectID)
+
+	case models.ModerateSuspend:
+		if len(subject.userIds) != 1 || (!content && kind != models.SubjectUser) {
+			return models.ModerationCases{}, errors.New("only authors of posts and comments, and users, can be suspended")
+		}
+
+		if days < 1 || days > maxSuspensionDays {
+			return models.ModerationCases{}, fmt.Errorf("days must be between 1 and %d", maxSuspensionDays)
+		}
+
+		userId := subject.userIds[0]
+		until := time.Now().AddDate(0, 0, days)
+
+		if err := s.moderationRepository.SuspendUser(userId, until); err != nil {
+			return models.ModerationCases{}, err
+		}
+
+		// Signs them out once their access token expires
+		if session, err := s.loginRepository.FindRefreshToken(float64(userId), models.Delegations{}); err == nil {
+			if err := s.loginRepository.DeleteRefreshToken(session); err != nil {
+				log.Println("[ModerationService] Could not sign suspended user out:", err)
+			}
+		}
+
+		record.SuspendedUntil = &until
+		s.tell([]uint{userId}, "Your account is suspended until "+until.Format("January 2, 2006"), note, models.SubjectUser, userId)
+	}
+
+	if action == models.ModerateWarn || action == models.ModerateSuspend {
+		if len(subject.userIds) == 1 {
+			record.UsersID = subject.userIds[0]
+		}
+	}
+
+	return s.moderationRepository.CloseCase(moderationCase, record)
+}
+
+// Finds what a case is about and who is responsible for it: the author of
+// a post or comment, the user, or the owners of an organization or of the
+// organization of an event
+func (s moderationService) subject(subjectType string, subjectId uint) (moderationSubject, error) {
+	id := strconv.FormatUint(uint64(subjectId), 10)
+
+	switch subjectType {
+	case models.SubjectPost:
+		post, err := s.postsRepository.FindPost(id)
+		if err != nil || post.ID == 0 {
+			return moderationSubject{}, errors.New("post not found")
+		}
+
+		return moderationSubject{value: post, userIds: s.authorIds(post.Handle), post: &post}, nil
+
+	case models.SubjectComment:
+		comment, err := s.commentsRepository.FindComment(id)
+		if err != nil || comment.ID == 0 {
+			return moderationSubject{}, errors.New("comment not found")
+		}
+
+		return moderationSubject{value: comment, userIds: s.authorIds(comment.Handle), comment: &comment}, nil
+
+	case models.SubjectUser:
+		user, err := s.usersRepository.OneUser(id, models.Users{})
+		if err != nil {
+			return moderationSubject{}, errors.New("user not found")
+		}
+
+		user.Password = ""
+		user.ResetCode = uuid.Nil
+
+		return moderationSubject{value: user, userIds: []uint{user.ID}}, nil
+
+	case models.SubjectOrganization:
+		organization, err := s.organizationRepository.GetOrganizationById(id)
+		if err != nil {
+			return moderationSubject{}, errors.New("organization not found")
+		}
+
+		return moderationSubject{value: organization, userIds: s.ownerIds(organization.ID)}, nil
+
+	case models.SubjectEvent:
+		event, err := s.eventRepository.GetEventById(id)
+		if err != nil {
+			return moderationSubject{}, errors.New("event not found")
+		}
+
+		return moderationSubject{value: event, userIds: s.ownerIds(event.OrganizationID)}, nil
+	}
+
+	return moderationSubject{}, errors.New("only posts, comments, users, organizations and events can be reported")
+}
+
+// The id of the user with the handle, none if they are gone
+func (s moderationService) authorIds(handle string) []uint {
+	author, err := s.usersRepository.FindUserByHandle(handle)
+	if err != nil {
+		log.Println("[ModerationService] Could not find author:", err)
+		return []uint{}
+	}
+
+	return []uint{author.ID}
+}
+
+// The ids of the organization's owners
+func (s moderationService) ownerIds(orgId uint) []uint {
+	members, err := s.orgUsersRepository.GetOrgMembers(orgId)
+	if err != nil {
+		log.Println("[ModerationService] Could not find owners:", err)
+	}
+
+	owners := []uint{}
+	for _, member := range members {
+		if member.Role == models.RoleOwner {
+			owners = append(owners, member.UsersID)
+		}
+	}
+
+	return owners
+}
+
+// Tells the users what a moderator did, with their note
+func (s moderationService) tell(userIds []uint, title string, note string, subjectType string, subjectId uint) {
+	for _, userId := range userIds {
+		s.notifications.Notify(models.Notifications{
+			UsersID:     userId,
+			Type:        models.NotifyModeration,
+			Title:       title,
+			Body:        note,
+			SubjectType: subjectType,
+			SubjectID:   subjectId,
+		}, nil)
+	}
+}
+
+// Runs the filter over what the user wrote. Returns the moderation to save
+// it with and why, or an error when it isn't allowed at all.
+func screenContent(filter contentfilter.Filter, kind string, text string) (string, string, error) {
+	verdict := filter.Check(text)
+
+	switch verdict.Action {
+	case contentfilter.Reject:
+		return "", "", errors.New("this " + kind + " isn't allowed, it " + verdict.Reason)
+	case contentfilter.Hold:
+		return models.ModerationHeld, verdict.Reason, nil
+	}
+
+	return "", "", nil
+}
+
+// Where pages of the moderation queue continue from
+type casePosition struct {
+	ID uint `json:"id"`
+}
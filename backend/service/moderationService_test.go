@@ -0,0 +1,327 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ModerationServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.ModerationRepository
+	mockPostsRepo    *mocks.PostsRepository
+	mockCommentsRepo *mocks.CommentsRepository
+	mockUsersRepo    *mocks.UsersRepository
+	mockOrgRepo      *mocks.OrganizationRepository
+	mockEventRepo    *mocks.EventRepository
+	mockOrgUserRepo  *mocks.OrgUsersRepository
+	mockLoginRepo    *mocks.LoginRepository
+	notifications    *mocks.NotificationService
+	feedCache        *mocks.Cache
+	service          ModerationService
+	post             models.Posts
+	openCase         models.ModerationCases
+	err              error
+}
+
+func (suite *ModerationServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ModerationRepository)
+	suite.mockPostsRepo = new(mocks.PostsRepository)
+	suite.mockCommentsRepo = new(mocks.CommentsRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockOrgRepo = new(mocks.OrganizationRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockOrgUserRepo = new(mocks.OrgUsersRepository)
+	suite.mockLoginRepo = new(mocks.LoginRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.feedCache = new(mocks.Cache)
+	suite.service = NewModerationService(suite.mockRepo, suite.mockPostsRepo, suite.mockCommentsRepo, suite.mockUsersRepo,
+		suite.mockOrgRepo, suite.mockEventRepo, suite.mockOrgUserRepo, suite.mockLoginRepo, suite.notifications, suite.feedCache)
+
+	// Ada's post
+	suite.post = models.Posts{Handle: "ada", PostDescription: "Buy now"}
+	suite.post.ID = 3
+
+	suite.openCase = models.ModerationCases{SubjectType: models.SubjectPost, SubjectID: 3, Status: models.CaseOpen, ReportCount: 2}
+	suite.openCase.ID = 7
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *ModerationServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPostsRepo.AssertExpectations(suite.T())
+	suite.mockCommentsRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockOrgRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockOrgUserRepo.AssertExpectations(suite.T())
+	suite.mockLoginRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.feedCache.AssertExpectations(suite.T())
+}
+
+func TestModerationServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(ModerationServiceUnitTestSuite))
+}
+
+// Expects the post to be looked up, with Ada as user 4
+func (suite *ModerationServiceUnitTestSuite) expectPost() {
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil).Once()
+	suite.mockUsersRepo.On("FindUserByHandle", "ada").Return(commenter(4, "ada"), nil).Once()
+}
+
+// Expects the open case about the post to be looked up
+func (suite *ModerationServiceUnitTestSuite) expectCase() {
+	suite.mockRepo.On("GetCase", uint(7)).Return(suite.openCase, nil).Once()
+}
+
+// Expects the case to be closed with the action, returning it closed
+func (suite *ModerationServiceUnitTestSuite) expectClosed(matches func(models.ModerationActions) bool) {
+	closed := suite.openCase
+	closed.Status = models.CaseClosed
+	suite.mockRepo.On("CloseCase", suite.openCase, mock.MatchedBy(matches)).Return(closed, nil).Once()
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Report() {
+	suite.expectPost()
+	suite.mockRepo.On("FileReport", models.SubjectPost, uint(3),
+		models.Reports{ReporterID: 5, Reason: models.ReportSpam, Details: "links to a shop"}).Return(suite.openCase, nil)
+
+	res, err := suite.service.Report(5, models.SubjectPost, 3, models.ReportSpam, " links to a shop ")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(7), res.ModerationCasesID)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Report_HoldsAfterManyReports() {
+	suite.openCase.ReportCount = reportsToHold
+
+	suite.expectPost()
+	suite.mockRepo.On("FileReport", models.SubjectPost, uint(3), mock.Anything).Return(suite.openCase, nil)
+	suite.mockRepo.On("HoldContent", models.SubjectPost, uint(3), "reported by 5 users").Return(suite.openCase, nil)
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}}).Once()
+
+	_, err := suite.service.Report(5, models.SubjectPost, 3, models.ReportScam, "")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Report_Yourself() {
+	suite.expectPost()
+
+	_, err := suite.service.Report(4, models.SubjectPost, 3, models.ReportSpam, "")
+
+	assert.EqualError(suite.T(), err, "you cannot report yourself")
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Report_Twice() {
+	suite.expectPost()
+	suite.mockRepo.On("FileReport", models.SubjectPost, uint(3), mock.Anything).Return(models.ModerationCases{}, repository.ErrAlreadyReported)
+
+	_, err := suite.service.Report(5, models.SubjectPost, 3, models.ReportSpam, "")
+
+	assert.Equal(suite.T(), repository.ErrAlreadyReported, err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Report_Invalid() {
+	_, err := suite.service.Report(5, models.SubjectPost, 3, "boring", "")
+	assert.EqualError(suite.T(), err, "reason must be one of spam, harassment, hate, violence, sexual, scam, other")
+
+	// Other reasons need to be explained
+	_, err = suite.service.Report(5, models.SubjectPost, 3, models.ReportOther, " ")
+	assert.EqualError(suite.T(), err, "tell us what is wrong in details")
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Report_Organization() {
+	organization := models.Organization{Name: "Park Friends"}
+	organization.ID = 2
+
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(organization, nil)
+	suite.mockOrgUserRepo.On("GetOrgMembers", uint(2)).Return([]models.OrgUsers{{UsersID: 5, Role: models.RoleOwner}}, nil)
+	suite.mockRepo.On("FileReport", models.SubjectOrganization, uint(2), mock.Anything).Return(models.ModerationCases{ReportCount: 9}, nil)
+
+	// Owners can report their own organization, and organizations are
+	// never held
+	_, err := suite.service.Report(5, models.SubjectOrganization, 2, models.ReportScam, "")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Cases() {
+	cases := []models.ModerationCases{{}, {}, {}}
+	for i := range cases {
+		cases[i].ID = uint(i + 1)
+	}
+
+	suite.mockRepo.On("GetCases", models.CaseOpen, uint(0), 3).Return(cases, nil)
+
+	res, err := suite.service.Cases(models.CaseOpen, "", 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res.Cases, 2)
+	assert.Equal(suite.T(), encodeCursor(casePosition{ID: 2}), res.NextCursor)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Cases_Status() {
+	_, err := suite.service.Cases("pending", "", 20)
+
+	assert.EqualError(suite.T(), err, "status must be open or closed")
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Case() {
+	suite.expectCase()
+	suite.expectPost()
+
+	res, err := suite.service.Case(7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), suite.post, res.Subject)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_Hide() {
+	suite.expectCase()
+	suite.expectPost()
+	suite.mockRepo.On("SetModeration", models.SubjectPost, uint(3), models.ModerationHidden).Return(nil)
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}}).Once()
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Type == models.NotifyModeration && n.Title == "Your post was hidden by a moderator" && n.Body == "No ads"
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	suite.expectClosed(func(a models.ModerationActions) bool {
+		return a.ModeratorID == 1 && a.Action == models.ModerateHide && a.Note == "No ads"
+	})
+
+	res, err := suite.service.Act(1, 7, models.ModerateHide, " No ads ", 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.CaseClosed, res.Status)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_Remove() {
+	suite.expectCase()
+	suite.expectPost()
+	suite.mockPostsRepo.On("DeletePost", suite.post).Return(nil)
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}}).Once()
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Title == "Your post was removed by a moderator"
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	suite.expectClosed(func(a models.ModerationActions) bool {
+		return a.Action == models.ModerateRemove
+	})
+
+	_, err := suite.service.Act(1, 7, models.ModerateRemove, "", 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_Dismiss() {
+	suite.post.Moderation = models.ModerationHeld
+
+	// The held post is shown again
+	suite.expectCase()
+	suite.expectPost()
+	suite.mockRepo.On("SetModeration", models.SubjectPost, uint(3), "").Return(nil)
+	suite.expectClosed(func(a models.ModerationActions) bool {
+		return a.Action == models.ModerateDismiss
+	})
+
+	_, err := suite.service.Act(1, 7, models.ModerateDismiss, "", 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_DismissDeleted() {
+	suite.expectCase()
+	suite.mockPostsRepo.On("FindPost", "3").Return(models.Posts{}, suite.err)
+	suite.expectClosed(func(a models.ModerationActions) bool {
+		return a.Action == models.ModerateDismiss
+	})
+
+	_, err := suite.service.Act(1, 7, models.ModerateDismiss, "", 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_Warn() {
+	suite.expectCase()
+	suite.expectPost()
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Title == "You received a warning from a moderator"
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	suite.expectClosed(func(a models.ModerationActions) bool {
+		return a.Action == models.ModerateWarn && a.UsersID == 4
+	})
+
+	_, err := suite.service.Act(1, 7, models.ModerateWarn, "Keep it civil", 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_Suspend() {
+	session := models.Delegations{RefreshToken: "refresh"}
+
+	suite.expectCase()
+	suite.expectPost()
+	suite.mockRepo.On("SuspendUser", uint(4), mock.MatchedBy(func(until time.Time) bool {
+		expected := time.Now().AddDate(0, 0, 30)
+		return until.After(expected.Add(-time.Minute)) && until.Before(expected.Add(time.Minute))
+	})).Return(nil)
+	suite.mockLoginRepo.On("FindRefreshToken", float64(4), models.Delegations{}).Return(session, nil)
+	suite.mockLoginRepo.On("DeleteRefreshToken", session).Return(nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.SubjectType == models.SubjectUser && n.SubjectID == 4
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	suite.expectClosed(func(a models.ModerationActions) bool {
+		return a.Action == models.ModerateSuspend && a.UsersID == 4 && a.SuspendedUntil != nil
+	})
+
+	_, err := suite.service.Act(1, 7, models.ModerateSuspend, "", 30)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_SuspendDays() {
+	suite.expectCase()
+	suite.expectPost()
+
+	_, err := suite.service.Act(1, 7, models.ModerateSuspend, "", 0)
+
+	assert.EqualError(suite.T(), err, "days must be between 1 and 365")
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_HideEvent() {
+	suite.openCase.SubjectType = models.SubjectEvent
+
+	suite.expectCase()
+	suite.mockEventRepo.On("GetEventById", "3").Return(models.Event{OrganizationID: 2}, nil)
+	suite.mockOrgUserRepo.On("GetOrgMembers", uint(2)).Return([]models.OrgUsers{}, nil)
+
+	_, err := suite.service.Act(1, 7, models.ModerateHide, "", 0)
+
+	assert.EqualError(suite.T(), err, "only posts and comments can be hidden or removed")
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_Closed() {
+	suite.openCase.Status = models.CaseClosed
+	suite.expectCase()
+
+	_, err := suite.service.Act(1, 7, models.ModerateWarn, "", 0)
+
+	assert.EqualError(suite.T(), err, "the case is already closed")
+}
+
+func (suite *ModerationServiceUnitTestSuite) TestModerationService_Act_CaseNotFound() {
+	suite.mockRepo.On("GetCase", uint(7)).Return(models.ModerationCases{}, errors.New("case not found"))
+
+	_, err := suite.service.Act(1, 7, models.ModerateWarn, "", 0)
+
+	assert.EqualError(suite.T(), err, "case not found")
+}
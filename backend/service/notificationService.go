@@ -0,0 +1,214 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// Notifications listed per page unless asked otherwise
+const defaultInboxLimit = 20
+
+type NotificationService interface {
+	Notify(models.Notifications, *mailer.Message)
+	Inbox(uint, string, int, bool) (models.NotificationPage, error)
+	UnreadCount(uint) (int64, error)
+	MarkRead(uint, []uint) (int64, error)
+	DeleteNotification(uint, uint) error
+	Preferences(uint) ([]models.NotificationPreferences, error)
+	SetPreference(models.NotificationPreferences) (models.NotificationPreferences, error)
+}
+
+type notificationService struct {
+	notificationRepository repository.NotificationRepository
+	usersRepository        repository.UsersRepository
+	mailer                 mailer.Mailer
+	hub                    realtime.Hub
+	pushService            PushService
+}
+
+// Instantiated in router.go
+func NewNotificationService(r repository.NotificationRepository, u repository.UsersRepository, m mailer.Mailer, h realtime.Hub, p PushService) NotificationService {
+	return notificationService{
+		notificationRepository: r,
+		usersRepository:        u,
+		mailer:                 m,
+		hub:                    h,
+		pushService:            p,
+	}
+}
+
+// Where inbox pages continue from
+type inboxPosition struct {
+	ID uint `json:"id"`
+}
+
+// Delivers the notification to its user over the channels they chose for
+// its type. Those in the inbox are also sent to the user's open streams.
+// email replaces the email built from the notification, e.g. to attach a
+// calendar. Users are not notified of what they did themselves.
+// Failures are only logged, notifying never fails what caused it.
+func (s notificationService) Notify(notification models.Notifications, email *mailer.Message) {
+	if notification.UsersID == 0 || (notification.ActorID != 0 && notification.ActorID == notification.UsersID) {
+		return
+	}
+
+	preference := s.preference(notification.UsersID, notification.Type)
+
+	if preference.InApp {
+		saved, err := s.notificationRepository.CreateNotification(notification)
+		if err != nil {
+			log.Println("[NotificationService] Could not save notification:", err)
+		} else {
+			s.hub.Publish(realtime.UserTopic(saved.UsersID), "notification", saved)
+			notification = saved
+		}
+	}
+
+	if preference.Push {
+		s.pushService.Push(notification)
+	}
+
+	if preference.Email {
+		s.email(notification, email)
+	}
+}
+
+// The user's preference for the type, or the default one
+func (s notificationService) preference(userId uint, notificationType string) models.NotificationPreferences {
+	preferences, err := s.notificationRepository.GetPreferences(userId)
+	if err != nil {
+		log.Println("[NotificationService] Could not find preferences, using defaults:", err)
+	}
+
+	for _, preference := range preferences {
+		if preference.Type == notificationType {
+			return preference
+		}
+	}
+
+	return models.DefaultNotificationPreference(userId, notificationType)
+}
+
+func (s notificationService) email(notification models.Notifications, email *mailer.Message) {
+	message := mailer.Message{
+		Subject: notification.Title,
+		Body: notification.Body + "\n\n" +
+			"You can choose which notifications are emailed to you in the VolunteerOne app.",
+	}
+	if email != nil {
+		message = *email
+	}
+
+	if message.To == "" {
+		user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(notification.UsersID), 10), models.Users{})
+		if err != nil || user.Email == "" {
+			log.Println("[NotificationService] No email address to notify:", err)
+			return
+		}
+		message.To = user.Email
+	}
+
+	if err := s.mailer.Send(message); err != nil {
+		log.Println("[NotificationService] Could not email notification:", err)
+	}
+}
+
+// Lists the user's notifications, latest first, from cursor on
+func (s notificationService) Inbox(userId uint, cursor string, limit int, unreadOnly bool) (models.NotificationPage, error) {
+	log.Println("[NotificationService] Inbox...")
+
+	var position inboxPosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.NotificationPage{}, err
+	}
+
+	if limit <= 0 {
+		limit = defaultInboxLimit
+	}
+
+	// One more than asked tells whether there is a next page
+	notifications, err := s.notificationRepository.GetNotifications(userId, position.ID, limit+1, unreadOnly)
+	if err != nil {
+		return models.NotificationPage{}, err
+	}
+
+	unread, err := s.notificationRepository.CountUnread(userId)
+	if err != nil {
+		return models.NotificationPage{}, err
+	}
+
+	page := models.NotificationPage{
+		Notifications: notifications,
+		Unread:        unread,
+	}
+
+	if len(notifications) > limit {
+		page.Notifications = notifications[:limit]
+		page.NextCursor = encodeCursor(inboxPosition{ID: notifications[limit-1].ID})
+	}
+
+	return page, nil
+}
+
+func (s notificationService) UnreadCount(userId uint) (int64, error) {
+	log.Println("[NotificationService] Unread count...")
+
+	return s.notificationRepository.CountUnread(userId)
+}
+
+// Marks the user's notifications with the ids, or all of them without
+// ids, as read. Returns how many were unread.
+func (s notificationService) MarkRead(userId uint, ids []uint) (int64, error) {
+	log.Println("[NotificationService] Mark read...")
+
+	return s.notificationRepository.MarkRead(userId, ids, time.Now())
+}
+
+func (s notificationService) DeleteNotification(userId uint, id uint) error {
+	log.Println("[NotificationService] Delete notification...")
+
+	return s.notificationRepository.DeleteNotification(userId, id)
+}
+
+// The user's preference for every type, defaults included
+func (s notificationService) Preferences(userId uint) ([]models.NotificationPreferences, error) {
+	log.Println("[NotificationService] Preferences...")
+
+	saved, err := s.notificationRepository.GetPreferences(userId)
+	if err != nil {
+		return []models.NotificationPreferences{}, err
+	}
+
+	byType := map[string]models.NotificationPreferences{}
+	for _, preference := range saved {
+		byType[preference.Type] = preference
+	}
+
+	preferences := []models.NotificationPreferences{}
+	for _, notificationType := range models.NotificationTypes {
+		preference, ok := byType[notificationType]
+		if !ok {
+			preference = models.DefaultNotificationPreference(userId, notificationType)
+		}
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, nil
+}
+
+func (s notificationService) SetPreference(preference models.NotificationPreferences) (models.NotificationPreferences, error) {
+	log.Println("[NotificationService] Set preference...")
+
+	if !models.IsNotificationType(preference.Type) {
+		return models.NotificationPreferences{}, errors.New("unknown notification type " + preference.Type)
+	}
+
+	return s.notificationRepository.SavePreference(preference)
+}
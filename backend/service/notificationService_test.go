@@ -0,0 +1,170 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.NotificationRepository
+	mockUsersRepo *mocks.UsersRepository
+	mockMailer    *mocks.Mailer
+	mockHub       *mocks.Hub
+	mockPush      *mocks.PushService
+	service       NotificationService
+	notification  models.Notifications
+	err           error
+}
+
+func (suite *NotificationServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.NotificationRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockMailer = new(mocks.Mailer)
+	suite.mockHub = new(mocks.Hub)
+	suite.mockPush = new(mocks.PushService)
+	suite.service = NewNotificationService(suite.mockRepo, suite.mockUsersRepo, suite.mockMailer, suite.mockHub, suite.mockPush)
+
+	suite.notification = models.Notifications{
+		UsersID:     4,
+		Type:        models.NotifyEventChanged,
+		Title:       "Park cleanup has changed",
+		SubjectType: models.SubjectEvent,
+		SubjectID:   1,
+	}
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *NotificationServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockMailer.AssertExpectations(suite.T())
+	suite.mockHub.AssertExpectations(suite.T())
+	suite.mockPush.AssertExpectations(suite.T())
+}
+
+func TestNotificationServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(NotificationServiceUnitTestSuite))
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Notify_Defaults() {
+	suite.mockRepo.On("GetPreferences", uint(4)).Return([]models.NotificationPreferences{}, nil)
+	suite.mockRepo.On("CreateNotification", suite.notification).Return(suite.notification, nil)
+	suite.mockHub.On("Publish", "user:4", "notification", suite.notification).Once()
+	suite.mockPush.On("Push", suite.notification).Return()
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(models.Users{Email: "ada@example.com"}, nil)
+	suite.mockMailer.On("Send", mock.MatchedBy(func(message mailer.Message) bool {
+		return message.To == "ada@example.com" && message.Subject == "Park cleanup has changed"
+	})).Return(nil)
+
+	suite.service.Notify(suite.notification, nil)
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Notify_InAppOnlyByDefault() {
+	suite.notification.Type = models.NotifyReaction
+	saved := suite.notification
+	saved.ID = 8
+
+	// Pushed as saved, so the app can open it
+	suite.mockRepo.On("GetPreferences", uint(4)).Return([]models.NotificationPreferences{}, nil)
+	suite.mockRepo.On("CreateNotification", suite.notification).Return(saved, nil)
+	suite.mockHub.On("Publish", "user:4", "notification", saved).Once()
+	suite.mockPush.On("Push", saved).Return()
+
+	suite.service.Notify(suite.notification, nil)
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Notify_Preference() {
+	// Emailed only, with the message given
+	suite.mockRepo.On("GetPreferences", uint(4)).Return([]models.NotificationPreferences{
+		{UsersID: 4, Type: models.NotifyEventChanged, Email: true},
+	}, nil)
+	suite.mockMailer.On("Send", mailer.Message{To: "ada@example.com", Subject: "Custom"}).Return(nil)
+
+	suite.service.Notify(suite.notification, &mailer.Message{To: "ada@example.com", Subject: "Custom"})
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Notify_Self() {
+	suite.notification.ActorID = 4
+
+	suite.service.Notify(suite.notification, nil)
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Notify_SaveFails() {
+	suite.notification.Type = models.NotifyComment
+
+	suite.mockRepo.On("GetPreferences", uint(4)).Return([]models.NotificationPreferences{}, suite.err)
+	suite.mockRepo.On("CreateNotification", suite.notification).Return(models.Notifications{}, suite.err)
+	suite.mockPush.On("Push", suite.notification).Return()
+
+	suite.service.Notify(suite.notification, nil)
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Inbox_Pages() {
+	notifications := []models.Notifications{{}, {}, {}}
+	notifications[0].ID = 9
+	notifications[1].ID = 7
+	notifications[2].ID = 5
+
+	suite.mockRepo.On("GetNotifications", uint(4), uint(0), 3, false).Return(notifications, nil)
+	suite.mockRepo.On("CountUnread", uint(4)).Return(int64(2), nil)
+
+	page, err := suite.service.Inbox(4, "", 2, false)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Notifications, 2)
+	assert.Equal(suite.T(), int64(2), page.Unread)
+	assert.NotEmpty(suite.T(), page.NextCursor)
+
+	// The next page starts after the last one shown
+	suite.mockRepo.On("GetNotifications", uint(4), uint(7), 3, true).Return(notifications[2:], nil)
+
+	page, err = suite.service.Inbox(4, page.NextCursor, 2, true)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Notifications, 1)
+	assert.Empty(suite.T(), page.NextCursor)
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Inbox_InvalidCursor() {
+	_, err := suite.service.Inbox(4, "nope", 0, false)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_Preferences() {
+	suite.mockRepo.On("GetPreferences", uint(4)).Return([]models.NotificationPreferences{
+		{UsersID: 4, Type: models.NotifyReaction},
+	}, nil)
+
+	res, err := suite.service.Preferences(4)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, len(models.NotificationTypes))
+
+	for _, preference := range res {
+		switch preference.Type {
+		case models.NotifyReaction:
+			assert.False(suite.T(), preference.InApp)
+		case models.NotifyOrgInvite:
+			assert.True(suite.T(), preference.Email)
+		case models.NotifyComment:
+			assert.True(suite.T(), preference.InApp)
+			assert.False(suite.T(), preference.Email)
+		}
+	}
+}
+
+func (suite *NotificationServiceUnitTestSuite) TestNotificationService_SetPreference_UnknownType() {
+	_, err := suite.service.SetPreference(models.NotificationPreferences{UsersID: 4, Type: "newsletter"})
+
+	assert.EqualError(suite.T(), err, "unknown notification type newsletter")
+}
@@ -0,0 +1,349 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/ical"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/recurrence"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// Identifies one occurrence of a series
+type occurrenceKey struct {
+	seriesId uint
+	date     int64
+}
+
+func keyOf(seriesId uint, date time.Time) occurrenceKey {
+	return occurrenceKey{seriesId: seriesId, date: date.UnixNano()}
+}
+
+// Parses the recurrence rule of an event. False for one-off events and
+// edited occurrences.
+func eventRule(event models.Event) (recurrence.Rule, bool, error) {
+	if event.Recurrence == "" || event.SeriesID != nil {
+		return recurrence.Rule{}, false, nil
+	}
+
+	rule, err := recurrence.Parse(event.Recurrence)
+	if err != nil {
+		return recurrence.Rule{}, false, err
+	}
+
+	return rule, true, nil
+}
+
+// Validates the time zone and times of an event that is about to be saved
+// and converts the times to UTC
+func prepareSchedule(event *models.Event) error {
+	if event.TimeZone == "" {
+		event.TimeZone = "UTC"
+	}
+
+	if _, err := time.LoadLocation(event.TimeZone); err != nil || event.TimeZone == "Local" {
+		return errors.New("unknown time zone " + event.TimeZone)
+	}
+
+	if event.Start.IsZero() {
+		return errors.New("start is required")
+	}
+
+	if !event.End.After(event.Start) {
+		return errors.New("end must be after start")
+	}
+
+	event.Start = event.Start.UTC()
+	event.End = event.End.UTC()
+
+	return nil
+}
+
+// The start of an event in its own time zone. Recurrences are expanded
+// from it so they keep their local time across daylight saving changes.
+func localStart(event models.Event) time.Time {
+	return event.Start.In(event.Location())
+}
+
+// Validates and normalizes the recurrence rule of an event that is about
+// to be saved, and sets when the series ends
+func prepareRecurrence(event *models.Event) error {
+	event.RecurrenceEnd = nil
+
+	if event.Recurrence == "" {
+		return nil
+	}
+
+	if event.SeriesID != nil {
+		return errors.New("an edited occurrence cannot repeat")
+	}
+
+	rule, err := recurrence.Parse(event.Recurrence)
+	if err != nil {
+		return err
+	}
+
+	event.Recurrence = rule.String()
+	if last, ok := rule.Last(localStart(*event)); ok {
+		last = last.UTC()
+		event.RecurrenceEnd = &last
+	}
+
+	return nil
+}
+
+// The series and original start that sign-ups to an occurrence of the
+// event attach to. Edited occurrences map back to their series, one-off
+// events are their own single occurrence.
+func seriesOccurrence(event models.Event, occurrence time.Time) (uint, time.Time) {
+	if event.SeriesID != nil && event.OccurrenceDate != nil {
+		return *event.SeriesID, *event.OccurrenceDate
+	}
+
+	if event.Recurrence == "" {
+		return event.ID, event.Start
+	}
+
+	return event.ID, occurrence
+}
+
+// Checks that occurrence is a scheduled, not cancelled, occurrence of the
+// recurring event series
+func checkOccurrence(r repository.EventRepository, series models.Event, occurrence time.Time) error {
+	rule, recurring, err := eventRule(series)
+	if err != nil {
+		return err
+	}
+
+	if !recurring {
+		return errors.New("event is not a recurring series")
+	}
+
+	if !rule.Includes(localStart(series), occurrence) {
+		return errors.New("not an occurrence of this event")
+	}
+
+	exceptions, err := r.GetExceptions([]uint{series.ID})
+	if err != nil {
+		return err
+	}
+
+	for _, exception := range exceptions {
+		if exception.OccurrenceDate.Equal(occurrence) {
+			return errors.New("occurrence is cancelled")
+		}
+	}
+
+	return nil
+}
+
+// Expands events into their occurrences within [from, to], soonest first.
+// Cancelled occurrences are dropped and edited ones replaced by their
+// copy, which is expected among events when it starts within the range.
+func expandOccurrences(events []models.Event, overrides []models.Event, exceptions []models.EventExceptions, from time.Time, to time.Time) []models.EventOccurrence {
+	cancelled := map[occurrenceKey]bool{}
+	for _, exception := range exceptions {
+		cancelled[keyOf(exception.EventID, exception.OccurrenceDate)] = true
+	}
+
+	edited := map[occurrenceKey]bool{}
+	for _, override := range overrides {
+		if override.SeriesID != nil && override.OccurrenceDate != nil {
+			edited[keyOf(*override.SeriesID, *override.OccurrenceDate)] = true
+		}
+	}
+
+	occurrences := []models.EventOccurrence{}
+
+	for _, event := range events {
+		rule, recurring, err := eventRule(event)
+
+		if !recurring || err != nil {
+			if event.Start.Before(from) || event.Start.After(to) {
+				continue
+			}
+
+			seriesId, date := seriesOccurrence(event, event.Start)
+			if !cancelled[keyOf(seriesId, date)] {
+				occurrences = append(occurrences, models.EventOccurrence{
+					Event:          event,
+					SeriesID:       seriesId,
+					OccurrenceDate: date,
+					Start:          event.Start,
+					End:            event.End,
+				})
+			}
+			continue
+		}
+
+		for _, date := range rule.Between(localStart(event), from, to) {
+			date = date.UTC()
+			key := keyOf(event.ID, date)
+			if cancelled[key] || edited[key] {
+				continue
+			}
+
+			occurrences = append(occurrences, models.EventOccurrence{
+				Event:          event,
+				SeriesID:       event.ID,
+				OccurrenceDate: date,
+				Start:          date,
+				End:            date.Add(event.Duration()),
+			})
+		}
+	}
+
+	sort.SliceStable(occurrences, func(i, j int) bool {
+		if !occurrences[i].Start.Equal(occurrences[j].Start) {
+			return occurrences[i].Start.Before(occurrences[j].Start)
+		}
+		return occurrences[i].SeriesID < occurrences[j].SeriesID
+	})
+
+	return occurrences
+}
+
+// Resolves an occurrence of an event to the series it belongs to, its
+// original start and when it actually starts, which differs when it was
+// edited. Event may be a one-off event, a series, in which case occurrence
+// must be one of its occurrences, or an edited occurrence.
+func resolveOccurrence(r repository.EventRepository, event models.Event, occurrence time.Time) (models.Event, time.Time, time.Time, error) {
+	if event.SeriesID != nil && event.OccurrenceDate != nil {
+		series, err := r.GetEventById(strconv.FormatUint(uint64(*event.SeriesID), 10))
+		if err != nil {
+			return models.Event{}, time.Time{}, time.Time{}, err
+		}
+
+		return series, *event.OccurrenceDate, event.Start, nil
+	}
+
+	if event.Recurrence == "" {
+		return event, event.Start, event.Start, nil
+	}
+
+	if err := checkOccurrence(r, event, occurrence); err != nil {
+		return models.Event{}, time.Time{}, time.Time{}, err
+	}
+
+	overrides, err := r.GetOverrides([]uint{event.ID})
+	if err != nil {
+		return models.Event{}, time.Time{}, time.Time{}, err
+	}
+
+	for _, override := range overrides {
+		if override.OccurrenceDate != nil && override.OccurrenceDate.Equal(occurrence) {
+			return event, occurrence, override.Start, nil
+		}
+	}
+
+	return event, occurrence, occurrence, nil
+}
+
+// When a shift takes place at an occurrence of its series starting at start
+func shiftWindow(shift models.EventShifts, series models.Event, start time.Time) (time.Time, time.Time) {
+	return start.Add(shift.Start.Sub(series.Start)), start.Add(shift.End.Sub(series.Start))
+}
+
+// A sign-up with the occurrence, and shift, it is for
+type signupOccurrence struct {
+	Signup models.EventSignups
+	Series models.Event
+	// The occurrence: the series or its edited copy
+	Event     models.Event
+	Start     time.Time
+	Shift     *models.EventShifts
+	Cancelled bool
+}
+
+// The calendar entry of the sign-up, with when it actually starts and ends
+func (o signupOccurrence) Entry() ical.Event {
+	return signupEvent(o.Signup, o.Series, o.Event, o.Start, o.Shift)
+}
+
+// Resolves the occurrences, and shifts, of sign-ups loaded with their
+// Event. Sign-ups whose series or shift no longer exists are left out.
+func resolveSignups(e repository.EventRepository, sh repository.ShiftRepository, signups []models.EventSignups) ([]signupOccurrence, error) {
+	seriesIds := []uint{}
+	shiftIds := []uint{}
+	for _, signup := range signups {
+		seriesIds = append(seriesIds, signup.EventID)
+		if signup.ShiftID != 0 {
+			shiftIds = append(shiftIds, signup.ShiftID)
+		}
+	}
+
+	overrides := []models.Event{}
+	exceptions := []models.EventExceptions{}
+	shifts := []models.EventShifts{}
+	var err error
+
+	if len(seriesIds) > 0 {
+		if overrides, err = e.GetOverrides(uniqueIds(seriesIds)); err != nil {
+			return []signupOccurrence{}, err
+		}
+
+		if exceptions, err = e.GetExceptions(uniqueIds(seriesIds)); err != nil {
+			return []signupOccurrence{}, err
+		}
+	}
+
+	if len(shiftIds) > 0 {
+		if shifts, err = sh.GetShiftsByIds(uniqueIds(shiftIds)); err != nil {
+			return []signupOccurrence{}, err
+		}
+	}
+
+	edited := map[occurrenceKey]models.Event{}
+	for _, override := range overrides {
+		if override.SeriesID != nil && override.OccurrenceDate != nil {
+			edited[keyOf(*override.SeriesID, *override.OccurrenceDate)] = override
+		}
+	}
+
+	cancelled := map[occurrenceKey]bool{}
+	for _, exception := range exceptions {
+		cancelled[keyOf(exception.EventID, exception.OccurrenceDate)] = true
+	}
+
+	byId := map[uint]models.EventShifts{}
+	for _, shift := range shifts {
+		byId[shift.ID] = shift
+	}
+
+	resolved := []signupOccurrence{}
+
+	for _, signup := range signups {
+		key := keyOf(signup.EventID, signup.OccurrenceDate)
+		series := signup.Event
+		if series.ID == 0 {
+			continue
+		}
+
+		occurrence := signupOccurrence{
+			Signup:    signup,
+			Series:    series,
+			Event:     series,
+			Start:     signup.OccurrenceDate,
+			Cancelled: cancelled[key] || series.Status == models.EventCancelled,
+		}
+
+		if override, ok := edited[key]; ok {
+			occurrence.Event, occurrence.Start = override, override.Start
+		}
+
+		if signup.ShiftID != 0 {
+			found, ok := byId[signup.ShiftID]
+			if !ok {
+				continue
+			}
+			occurrence.Shift = &found
+		}
+
+		resolved = append(resolved, occurrence)
+	}
+
+	return resolved, nil
+}
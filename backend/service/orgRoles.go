@@ -0,0 +1,48 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+var ErrNotManager = errors.New("only organization managers can do this")
+
+var ErrNotStaff = errors.New("only organization staff can do this")
+
+// Fails with ErrNotManager unless the user is an owner or manager of the
+// organization
+func requireManager(r repository.OrgUsersRepository, userId uint, orgId uint) error {
+	orgUser, err := r.FindOrgUser(userId, orgId)
+
+	if err != nil || orgUser.Role > models.RoleManager {
+		return ErrNotManager
+	}
+
+	return nil
+}
+
+// Fails with ErrNotManager unless the user is an owner or manager of the
+// organization whose own role is at least role, so managers can't hand out
+// or take away ownership
+func requireRoleOver(r repository.OrgUsersRepository, userId uint, orgId uint, role uint) error {
+	orgUser, err := r.FindOrgUser(userId, orgId)
+
+	if err != nil || orgUser.Role > models.RoleManager || orgUser.Role > role {
+		return ErrNotManager
+	}
+
+	return nil
+}
+
+// Whether the user has any role in the organization
+func isStaff(r repository.OrgUsersRepository, userId uint, orgId uint) bool {
+	if userId == 0 {
+		return false
+	}
+
+	_, err := r.FindOrgUser(userId, orgId)
+
+	return err == nil
+}
@@ -1,31 +1,63 @@
 package service
 
 import (
+	"log"
+	"strconv"
+
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 )
 
 type OrgUsersService interface {
-	CreateOrgUser(models.OrgUsers) (models.OrgUsers, error)
+	CreateOrgUser(models.OrgUsers, uint) (models.OrgUsers, error)
 	ListAllOrgUsers() ([]models.OrgUsers, error)
 	FindOrgUser(uint, uint) (models.OrgUsers, error)
-	UpdateOrgUser(uint, uint, uint) (models.OrgUsers, error)
-	DeleteOrgUser(uint, uint) error
+	UpdateOrgUser(uint, uint, uint, uint) (models.OrgUsers, error)
+	DeleteOrgUser(uint, uint, uint) error
 }
 
 type orgUsersService struct {
-	orgUsersRepository repository.OrgUsersRepository
+	orgUsersRepository     repository.OrgUsersRepository
+	organizationRepository repository.OrganizationRepository
+	notifications          NotificationService
 }
 
 // Instantiated in router.go
-func NewOrgUsersService(r repository.OrgUsersRepository) OrgUsersService {
+func NewOrgUsersService(r repository.OrgUsersRepository, o repository.OrganizationRepository, n NotificationService) OrgUsersService {
 	return orgUsersService{
-		orgUsersRepository: r,
+		orgUsersRepository:     r,
+		organizationRepository: o,
+		notifications:          n,
 	}
 }
 
-func (o orgUsersService) CreateOrgUser(orgUser models.OrgUsers) (models.OrgUsers, error) {
-	return o.orgUsersRepository.CreateOrgUser(orgUser)
+// Adds the user to the organization and lets them know. Only its owners and
+// managers can, and only with a role no higher than their own.
+func (o orgUsersService) CreateOrgUser(orgUser models.OrgUsers, managerId uint) (models.OrgUsers, error) {
+	if err := requireRoleOver(o.orgUsersRepository, managerId, orgUser.OrganizationID, orgUser.Role); err != nil {
+		return models.OrgUsers{}, err
+	}
+
+	created, err := o.orgUsersRepository.CreateOrgUser(orgUser)
+	if err != nil {
+		return created, err
+	}
+
+	organization, err := o.organizationRepository.GetOrganizationById(strconv.FormatUint(uint64(created.OrganizationID), 10))
+	if err != nil {
+		log.Println("[OrgUsersService] Could not find organization to notify about:", err)
+		return created, nil
+	}
+
+	o.notifications.Notify(models.Notifications{
+		UsersID:     created.UsersID,
+		Type:        models.NotifyOrgInvite,
+		Title:       "You were added to " + organization.Name,
+		SubjectType: models.SubjectOrganization,
+		SubjectID:   organization.ID,
+	}, nil)
+
+	return created, nil
 }
 
 func (o orgUsersService) ListAllOrgUsers() ([]models.OrgUsers, error) {
@@ -36,10 +68,38 @@ func (o orgUsersService) FindOrgUser(userId uint, orgId uint) (models.OrgUsers,
 	return o.orgUsersRepository.FindOrgUser(userId, orgId)
 }
 
-func (o orgUsersService) UpdateOrgUser(userId uint, orgId uint, role uint) (models.OrgUsers, error) {
+// Changes the user's role in the organization. Managers can only change
+// the roles of those no higher than them, to a role no higher than theirs.
+func (o orgUsersService) UpdateOrgUser(userId uint, orgId uint, role uint, managerId uint) (models.OrgUsers, error) {
+	orgUser, err := o.orgUsersRepository.FindOrgUser(userId, orgId)
+	if err != nil {
+		return models.OrgUsers{}, err
+	}
+
+	if err := requireRoleOver(o.orgUsersRepository, managerId, orgId, orgUser.Role); err != nil {
+		return models.OrgUsers{}, err
+	}
+
+	if err := requireRoleOver(o.orgUsersRepository, managerId, orgId, role); err != nil {
+		return models.OrgUsers{}, err
+	}
+
 	return o.orgUsersRepository.UpdateOrgUser(userId, orgId, role)
 }
 
-func (o orgUsersService) DeleteOrgUser(userId uint, orgId uint) error {
+// Removes the user from the organization. Users can always leave, managers
+// can only remove those no higher than them.
+func (o orgUsersService) DeleteOrgUser(userId uint, orgId uint, managerId uint) error {
+	if userId != managerId {
+		orgUser, err := o.orgUsersRepository.FindOrgUser(userId, orgId)
+		if err != nil {
+			return err
+		}
+
+		if err := requireRoleOver(o.orgUsersRepository, managerId, orgId, orgUser.Role); err != nil {
+			return err
+		}
+	}
+
 	return o.orgUsersRepository.DeleteOrgUser(userId, orgId)
 }
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrgUsersServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.OrgUsersRepository
+	mockOrgRepo   *mocks.OrganizationRepository
+	notifications *mocks.NotificationService
+	service       OrgUsersService
+	organization  models.Organization
+	err           error
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.OrgUsersRepository)
+	suite.mockOrgRepo = new(mocks.OrganizationRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.service = NewOrgUsersService(suite.mockRepo, suite.mockOrgRepo, suite.notifications)
+
+	suite.organization = models.Organization{Name: "Food Bank"}
+	suite.organization.ID = 2
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockOrgRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+}
+
+func TestOrgUsersServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(OrgUsersServiceUnitTestSuite))
+}
+
+// Expects the user to have role in organization 2
+func (suite *OrgUsersServiceUnitTestSuite) expectRole(userId uint, role uint) {
+	suite.mockRepo.On("FindOrgUser", userId, uint(2)).Return(models.OrgUsers{UsersID: userId, OrganizationID: 2, Role: role}, nil).Once()
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_CreateOrgUser() {
+	orgUser := models.OrgUsers{UsersID: 4, OrganizationID: 2, Role: models.RoleMember}
+
+	suite.expectRole(7, models.RoleManager)
+	suite.mockRepo.On("CreateOrgUser", orgUser).Return(orgUser, nil)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(suite.organization, nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Type == models.NotifyOrgInvite
+	}), (*mailer.Message)(nil)).Once()
+
+	created, err := suite.service.CreateOrgUser(orgUser, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), orgUser, created)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_CreateOrgUser_NotManager() {
+	orgUser := models.OrgUsers{UsersID: 4, OrganizationID: 2, Role: models.RoleMember}
+
+	suite.expectRole(7, models.RoleMember)
+
+	_, err := suite.service.CreateOrgUser(orgUser, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrgUser", mock.Anything)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_CreateOrgUser_Outsider() {
+	orgUser := models.OrgUsers{UsersID: 7, OrganizationID: 2, Role: models.RoleOwner}
+
+	suite.mockRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{}, suite.err).Once()
+
+	_, err := suite.service.CreateOrgUser(orgUser, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrgUser", mock.Anything)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_CreateOrgUser_ManagerAddsOwner() {
+	orgUser := models.OrgUsers{UsersID: 4, OrganizationID: 2, Role: models.RoleOwner}
+
+	suite.expectRole(7, models.RoleManager)
+
+	_, err := suite.service.CreateOrgUser(orgUser, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateOrgUser", mock.Anything)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_UpdateOrgUser() {
+	updated := models.OrgUsers{UsersID: 4, OrganizationID: 2, Role: models.RoleManager}
+
+	suite.expectRole(4, models.RoleMember)
+	suite.expectRole(7, models.RoleManager)
+	suite.expectRole(7, models.RoleManager)
+	suite.mockRepo.On("UpdateOrgUser", uint(4), uint(2), models.RoleManager).Return(updated, nil)
+
+	result, err := suite.service.UpdateOrgUser(4, 2, models.RoleManager, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), updated, result)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_UpdateOrgUser_ManagerDemotesOwner() {
+	suite.expectRole(4, models.RoleOwner)
+	suite.expectRole(7, models.RoleManager)
+
+	_, err := suite.service.UpdateOrgUser(4, 2, models.RoleMember, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrgUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_UpdateOrgUser_ManagerPromotesToOwner() {
+	suite.expectRole(4, models.RoleMember)
+	suite.expectRole(7, models.RoleManager)
+	suite.expectRole(7, models.RoleManager)
+
+	_, err := suite.service.UpdateOrgUser(4, 2, models.RoleOwner, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateOrgUser", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_DeleteOrgUser() {
+	suite.expectRole(4, models.RoleMember)
+	suite.expectRole(7, models.RoleOwner)
+	suite.mockRepo.On("DeleteOrgUser", uint(4), uint(2)).Return(nil)
+
+	err := suite.service.DeleteOrgUser(4, 2, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_DeleteOrgUser_Leaves() {
+	// Members can leave without being managers
+	suite.mockRepo.On("DeleteOrgUser", uint(4), uint(2)).Return(nil)
+
+	err := suite.service.DeleteOrgUser(4, 2, 4)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *OrgUsersServiceUnitTestSuite) TestOrgUsersService_DeleteOrgUser_NotManager() {
+	suite.expectRole(4, models.RoleMember)
+	suite.expectRole(7, models.RoleMember)
+
+	err := suite.service.DeleteOrgUser(4, 2, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "DeleteOrgUser", mock.Anything, mock.Anything)
+}
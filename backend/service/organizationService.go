@@ -1,12 +1,13 @@
 package service
 
 import (
+	"github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 )
 
 type OrganizationService interface {
-	CreateOrganization(models.Organization) (models.Organization, error)
+	CreateOrganization(models.Organization, uint) (models.Organization, error)
 	GetOrganizations() ([]models.Organization, error)
 	GetOrganizationById(string) (models.Organization, error)
 	UpdateOrganization(models.Organization) (models.Organization, error)
@@ -15,11 +16,27 @@ type OrganizationService interface {
 
 type organizationService struct {
 	organizationRepository repository.OrganizationRepository
+	orgUsersRepository     repository.OrgUsersRepository
+	geocoder               geocoder.Geocoder
 }
 
-// CreateOrganization implements OrganizationService
-func (s organizationService) CreateOrganization(org models.Organization) (models.Organization, error) {
-	return s.organizationRepository.CreateOrganization(org)
+// CreateOrganization implements OrganizationService. The user creating the
+// organization becomes its owner.
+func (s organizationService) CreateOrganization(org models.Organization, ownerId uint) (models.Organization, error) {
+	org.Latitude, org.Longitude = geocodeAddress(s.geocoder, org.Address)
+
+	created, err := s.organizationRepository.CreateOrganization(org)
+	if err != nil {
+		return created, err
+	}
+
+	_, err = s.orgUsersRepository.CreateOrgUser(models.OrgUsers{
+		UsersID:        ownerId,
+		OrganizationID: created.ID,
+		Role:           models.RoleOwner,
+	})
+
+	return created, err
 }
 
 // DeleteOrganization implements OrganizationService
@@ -39,11 +56,14 @@ func (s organizationService) GetOrganizations() ([]models.Organization, error) {
 
 // UpdateOrganization implements OrganizationService
 func (s organizationService) UpdateOrganization(org models.Organization) (models.Organization, error) {
+	org.Latitude, org.Longitude = geocodeAddress(s.geocoder, org.Address)
 	return s.organizationRepository.UpdateOrganization(org)
 }
 
-func NewOrganizationService(r repository.OrganizationRepository) OrganizationService {
+func NewOrganizationService(r repository.OrganizationRepository, o repository.OrgUsersRepository, g geocoder.Geocoder) OrganizationService {
 	return organizationService{
 		organizationRepository: r,
+		orgUsersRepository:     o,
+		geocoder:               g,
 	}
 }
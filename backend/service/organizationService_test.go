@@ -0,0 +1,63 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/geocoder"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type OrganizationServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.OrganizationRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	service          OrganizationService
+	organization     models.Organization
+	err              error
+}
+
+func (suite *OrganizationServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.OrganizationRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.service = NewOrganizationService(suite.mockRepo, suite.mockOrgUsersRepo, geocoder.NewStaticGeocoder(map[string]geocoder.Location{}))
+
+	suite.organization = models.Organization{Name: "Food Bank"}
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *OrganizationServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestOrganizationServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(OrganizationServiceUnitTestSuite))
+}
+
+func (suite *OrganizationServiceUnitTestSuite) TestOrganizationService_CreateOrganization() {
+	created := suite.organization
+	created.ID = 2
+
+	suite.mockRepo.On("CreateOrganization", suite.organization).Return(created, nil)
+	suite.mockOrgUsersRepo.On("CreateOrgUser", models.OrgUsers{UsersID: 7, OrganizationID: 2, Role: models.RoleOwner}).Return(models.OrgUsers{}, nil)
+
+	result, err := suite.service.CreateOrganization(suite.organization, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), created, result)
+}
+
+func (suite *OrganizationServiceUnitTestSuite) TestOrganizationService_CreateOrganization_Error() {
+	suite.mockRepo.On("CreateOrganization", suite.organization).Return(models.Organization{}, suite.err)
+
+	_, err := suite.service.CreateOrganization(suite.organization, 7)
+
+	assert.Equal(suite.T(), suite.err, err)
+	suite.mockOrgUsersRepo.AssertNotCalled(suite.T(), "CreateOrgUser", mock.Anything)
+}
@@ -1,120 +1,827 @@
 package service
 
 import (
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/contentfilter"
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
 )
 
+var ErrNotAuthor = errors.New("only the author can do this")
+
+var ErrSuspended = errors.New("your account is suspended")
+
+// How many posts an organization can pin to its page
+const maxPinnedPosts = 3
+
 type PostsService interface {
-	CreatePost(post models.Posts) (models.Posts, error)
-	DeletePost(post models.Posts) error
-	EditPost(post models.Posts) (models.Posts, error)
-	FindPost(id string) (models.Posts, error)
-	AllPosts() ([]models.Posts, error)
+	CreatePost(userId uint, post models.Posts) (models.Posts, error)
+	DeletePost(userId uint, id uint) error
+	EditPost(userId uint, id uint, description string) (models.Posts, error)
+	FindPost(id string, viewerId uint) (models.Posts, error)
+	AllPosts(viewerId uint) ([]models.Posts, error)
+	OrgPosts(orgId uint, cursor string, limit int, viewerId uint) (models.OrgPostPage, error)
+	PinPost(userId uint, id uint, pinned bool) (models.Posts, error)
 }
 
 type postsService struct {
-	postsRepository repository.PostsRepository
+	postsRepository        repository.PostsRepository
+	usersRepository        repository.UsersRepository
+	orgUsersRepository     repository.OrgUsersRepository
+	organizationRepository repository.OrganizationRepository
+	followRepository       repository.FollowRepository
+	eventRepository        repository.EventRepository
+	notifications          NotificationService
+	feedCache              feedcache.Cache
+	moderationRepository   repository.ModerationRepository
+	filter                 contentfilter.Filter
+	searchRepository       repository.SearchRepository
 }
 
-func NewPostsService(r repository.PostsRepository) PostsService {
+func NewPostsService(r repository.PostsRepository, u repository.UsersRepository, o repository.OrgUsersRepository, g repository.OrganizationRepository, f repository.FollowRepository, e repository.EventRepository, n NotificationService, c feedcache.Cache, m repository.ModerationRepository, cf contentfilter.Filter, s repository.SearchRepository) PostsService {
 	return postsService{
-		postsRepository: r,
+		postsRepository:        r,
+		usersRepository:        u,
+		orgUsersRepository:     o,
+		organizationRepository: g,
+		followRepository:       f,
+		eventRepository:        e,
+		notifications:          n,
+		feedCache:              c,
+		moderationRepository:   m,
+		filter:                 cf,
+		searchRepository:       s,
 	}
 }
 
 type CommentsService interface {
-	CreateComment(comment models.Comments) (models.Comments, error)
-	DeleteComment(comment models.Comments) error
-	EditComment(comment models.Comments) (models.Comments, error)
-	FindComment(id string) (models.Comments, error)
-	AllComments() ([]models.Comments, error)
+	CreateComment(userId uint, comment models.Comments) (models.Comments, error)
+	DeleteComment(userId uint, id uint) error
+	EditComment(userId uint, id uint, description string) (models.Comments, error)
+	FindComment(id string, viewerId uint) (models.Comments, error)
+	PostComments(postId uint, cursor string, limit int, viewerId uint) (models.CommentPage, error)
+	CommentHistory(id uint, viewerId uint) ([]models.CommentEdits, error)
 }
 
 type commentsService struct {
-	commentsRepository repository.CommentsRepository
+	commentsRepository   repository.CommentsRepository
+	postsRepository      repository.PostsRepository
+	usersRepository      repository.UsersRepository
+	orgUsersRepository   repository.OrgUsersRepository
+	notifications        NotificationService
+	hub                  realtime.Hub
+	moderationRepository repository.ModerationRepository
+	filter               contentfilter.Filter
+	searchRepository     repository.SearchRepository
 }
 
-func NewCommentsService(r repository.CommentsRepository) CommentsService {
+func NewCommentsService(r repository.CommentsRepository, p repository.PostsRepository, u repository.UsersRepository, o repository.OrgUsersRepository, n NotificationService, h realtime.Hub, m repository.ModerationRepository, cf contentfilter.Filter, s repository.SearchRepository) CommentsService {
 	return commentsService{
-		commentsRepository: r,
+		commentsRepository:   r,
+		postsRepository:      p,
+		usersRepository:      u,
+		orgUsersRepository:   o,
+		notifications:        n,
+		hub:                  h,
+		moderationRepository: m,
+		filter:               cf,
+		searchRepository:     s,
 	}
 }
 
-type LikesService interface {
-	CreateLike(like models.Likes) (models.Likes, error)
-	DeleteLike(like models.Likes) error
-	FindLike(id string) (models.Likes, error)
-	AllLikes() ([]models.Likes, error)
-	GetLikes(id string) (int64, error)
+// Posts as the user, or as the organization in OrganizationID when they
+// manage it, for the Audience. Announcements notify the organization's
+// members and followers who can see them. Posts the content filter holds are
+// only shown once a moderator approved them. Hashtags and mentions are
+// indexed for search.
+func (f postsService) CreatePost(userId uint, post models.Posts) (models.Posts, error) {
+	post.PostDescription = strings.TrimSpace(post.PostDescription)
+	if post.PostDescription == "" {
+		return models.Posts{}, errors.New("post cannot be empty")
+	}
+
+	switch post.Type {
+	case "":
+		post.Type = models.PostTypePost
+	case models.PostTypePost:
+	case models.PostTypeAnnouncement:
+		if post.OrganizationID == nil {
+			return models.Posts{}, errors.New("only organizations post announcements")
+		}
+	default:
+		return models.Posts{}, errors.New("type must be post or announcement")
+	}
+
+	author, err := f.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	if author.Suspended(time.Now()) {
+		return models.Posts{}, ErrSuspended
+	}
+
+	moderation, heldReason, err := screenContent(f.filter, "post", post.PostDescription)
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	var organization models.Organization
+	if post.OrganizationID != nil {
+		if err := requireManager(f.orgUsersRepository, userId, *post.OrganizationID); err != nil {
+			return models.Posts{}, err
+		}
+
+		organization, err = f.organizationRepository.GetOrganizationById(strconv.FormatUint(uint64(*post.OrganizationID), 10))
+		if err != nil {
+			return models.Posts{}, errors.New("organization not found")
+		}
+	}
+
+	if post.EventID != nil {
+		event, err := f.eventRepository.GetEventById(strconv.FormatUint(uint64(*post.EventID), 10))
+		if err != nil {
+			return models.Posts{}, errors.New("event not found")
+		}
+
+		// Organizations only post about their own events
+		if post.OrganizationID != nil && event.OrganizationID != *post.OrganizationID {
+			return models.Posts{}, errors.New("the event belongs to another organization")
+		}
+	}
+
+	if err := f.checkAudience(userId, &post); err != nil {
+		return models.Posts{}, err
+	}
+
+	post.Handle = author.Handle
+	post.PinnedAt = nil
+	post.ReactionCount = 0
+	post.Moderation = moderation
+
+	created, err := f.postsRepository.CreatePost(post)
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	indexPost(f.searchRepository, created)
+
+	if created.Moderation == models.ModerationHeld {
+		if _, err := f.moderationRepository.HoldContent(models.SubjectPost, created.ID, heldReason); err != nil {
+			log.Println("[PostsService] Could not open case for held post:", err)
+		}
+
+		return created, nil
+	}
+
+	f.feedCache.Invalidate(postSources(created))
+
+	if created.Type == models.PostTypeAnnouncement {
+		f.announce(userId, created, organization)
+	}
+
+	return created, nil
 }
 
-type likesService struct {
-	likesRepository repository.LikesRepository
+// Deletes the post, for its author or a manager of its organization
+func (f postsService) DeletePost(userId uint, id uint) error {
+	post, err := f.moderatedPost(userId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := f.postsRepository.DeletePost(post); err != nil {
+		return err
+	}
+
+	f.feedCache.Invalidate(postSources(post))
+
+	return nil
+}
+
+// Changes what the post says, for its author or a manager of its
+// organization
+func (f postsService) EditPost(userId uint, id uint, description string) (models.Posts, error) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return models.Posts{}, errors.New("post cannot be empty")
+	}
+
+	moderation, heldReason, err := screenContent(f.filter, "post", description)
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	post, err := f.moderatedPost(userId, id)
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	post.PostDescription = description
+
+	// Edits never lift a hold, only moderators do
+	held := moderation != "" && post.Moderation == ""
+	if held {
+		post.Moderation = moderation
+	}
+
+	edited, err := f.postsRepository.EditPost(post)
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	indexPost(f.searchRepository, edited)
+
+	if held {
+		if _, err := f.moderationRepository.HoldContent(models.SubjectPost, edited.ID, heldReason); err != nil {
+			log.Println("[PostsService] Could not open case for held post:", err)
+		}
+
+		f.feedCache.Invalidate(postSources(edited))
+	}
+
+	return edited, nil
 }
 
-func NewLikesService(r repository.LikesRepository) LikesService {
-	return likesService{
-		likesRepository: r,
+// Finds the post if the user can see it, unless moderators hold or hid it
+func (f postsService) FindPost(id string, viewerId uint) (models.Posts, error) {
+	post, err := f.postsRepository.FindPost(id)
+	if err != nil || post.ID == 0 || post.Moderation != "" || !canSeePost(f.postsRepository, post, viewerId) {
+		return models.Posts{}, errors.New("post not found")
 	}
+
+	return post, nil
 }
 
-func (f postsService) CreatePost(post models.Posts) (models.Posts, error) {
-	return f.postsRepository.CreatePost(post)
+// Lists the posts the user can see
+func (f postsService) AllPosts(viewerId uint) ([]models.Posts, error) {
+	viewer, err := findViewer(f.postsRepository, viewerId)
+	if err != nil {
+		return []models.Posts{}, err
+	}
+
+	return f.postsRepository.AllPosts(viewer)
 }
 
-func (f postsService) DeletePost(post models.Posts) error {
-	return f.postsRepository.DeletePost(post)
+// Lists a page of the organization's posts the user can see, latest first,
+// with its pinned posts on the first page
+func (f postsService) OrgPosts(orgId uint, cursor string, limit int, viewerId uint) (models.OrgPostPage, error) {
+	var position postPosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.OrgPostPage{}, err
+	}
+
+	viewer, err := findViewer(f.postsRepository, viewerId)
+	if err != nil {
+		return models.OrgPostPage{}, err
+	}
+
+	page := models.OrgPostPage{Pinned: []models.Posts{}}
+
+	if position.ID == 0 {
+		pinned, err := f.postsRepository.GetPinnedPosts(orgId)
+		if err != nil {
+			return models.OrgPostPage{}, err
+		}
+
+		for _, post := range pinned {
+			if viewer.CanSee(post) {
+				page.Pinned = append(page.Pinned, post)
+			}
+		}
+	}
+
+	// One extra tells whether there is another page
+	posts, err := f.postsRepository.GetOrgPosts(orgId, viewer, position.ID, limit+1)
+	if err != nil {
+		return models.OrgPostPage{}, err
+	}
+
+	page.Posts = posts
+	if len(posts) > limit {
+		page.Posts = posts[:limit]
+		page.NextCursor = encodeCursor(postPosition{ID: page.Posts[limit-1].ID})
+	}
+
+	return page, nil
 }
 
-func (f postsService) EditPost(post models.Posts) (models.Posts, error) {
+// Pins the organization's post to the top of its page, or unpins it. Only
+// its managers can.
+func (f postsService) PinPost(userId uint, id uint, pinned bool) (models.Posts, error) {
+	post, err := f.postsRepository.FindPost(strconv.FormatUint(uint64(id), 10))
+	if err != nil || post.ID == 0 {
+		return models.Posts{}, errors.New("post not found")
+	}
+
+	if post.OrganizationID == nil {
+		return models.Posts{}, errors.New("only posts of organizations can be pinned")
+	}
+
+	if err := requireManager(f.orgUsersRepository, userId, *post.OrganizationID); err != nil {
+		return models.Posts{}, err
+	}
+
+	if (post.PinnedAt != nil) == pinned {
+		return post, nil
+	}
+
+	if pinned {
+		current, err := f.postsRepository.GetPinnedPosts(*post.OrganizationID)
+		if err != nil {
+			return models.Posts{}, err
+		}
+
+		if len(current) >= maxPinnedPosts {
+			return models.Posts{}, fmt.Errorf("an organization can pin at most %d posts", maxPinnedPosts)
+		}
+
+		now := time.Now()
+		post.PinnedAt = &now
+	} else {
+		post.PinnedAt = nil
+	}
+
 	return f.postsRepository.EditPost(post)
 }
 
-func (f postsService) FindPost(id string) (models.Posts, error) {
-	return f.postsRepository.FindPost(id)
+// The feeds the post shows up in: its author's friends' and its
+// organization's followers'
+func postSources(post models.Posts) feedcache.Sources {
+	sources := feedcache.Sources{Handles: []string{post.Handle}}
+	if post.OrganizationID != nil {
+		sources.OrganizationIDs = []uint{*post.OrganizationID}
+	}
+
+	return sources
 }
 
-func (f postsService) AllPosts() ([]models.Posts, error) {
-	return f.postsRepository.AllPosts()
+// Where pages of posts continue from
+type postPosition struct {
+	ID uint `json:"id"`
 }
 
-func (f commentsService) CreateComment(comment models.Comments) (models.Comments, error) {
-	return f.commentsRepository.CreateComment(comment)
+// Finds the post if the user may edit or delete it: they wrote it, or
+// manage its organization
+func (f postsService) moderatedPost(userId uint, id uint) (models.Posts, error) {
+	post, err := f.postsRepository.FindPost(strconv.FormatUint(uint64(id), 10))
+	if err != nil || post.ID == 0 {
+		return models.Posts{}, errors.New("post not found")
+	}
+
+	user, err := f.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.Posts{}, err
+	}
+
+	if user.Handle != "" && user.Handle == post.Handle {
+		return post, nil
+	}
+
+	if post.OrganizationID != nil && requireManager(f.orgUsersRepository, userId, *post.OrganizationID) == nil {
+		return post, nil
+	}
+
+	return models.Posts{}, ErrNotAuthor
 }
 
-func (f commentsService) DeleteComment(comment models.Comments) error {
-	return f.commentsRepository.DeleteComment(comment)
+// Checks who the post is for, filling in the organization whose members see
+// it
+func (f postsService) checkAudience(userId uint, post *models.Posts) error {
+	switch post.Audience {
+	case "", models.AudiencePublic:
+		post.Audience = models.AudiencePublic
+		post.AudienceOrganizationID = nil
+	case models.AudienceFriends:
+		if post.OrganizationID != nil {
+			return errors.New("organizations post to their members instead of friends")
+		}
+		post.AudienceOrganizationID = nil
+	case models.AudienceMembers:
+		if post.OrganizationID == nil {
+			return errors.New("only organizations post to their members")
+		}
+		post.AudienceOrganizationID = post.OrganizationID
+	case models.AudienceOrganization:
+		if post.OrganizationID != nil {
+			return errors.New("organizations post to their own members only")
+		}
+		if post.AudienceOrganizationID == nil {
+			return errors.New("audienceOrganizationId is required")
+		}
+		if _, err := f.orgUsersRepository.FindOrgUser(userId, *post.AudienceOrganizationID); err != nil {
+			return errors.New("you can only post to organizations you belong to")
+		}
+	default:
+		return errors.New("audience must be one of " + strings.Join(models.PostAudiences, ", "))
+	}
+
+	return nil
 }
 
-func (f commentsService) EditComment(comment models.Comments) (models.Comments, error) {
-	return f.commentsRepository.EditComment(comment)
+// Tells the organization's members and followers about the announcement,
+// each once, only members when it is for them. Failing to find them is only
+// logged.
+func (f postsService) announce(userId uint, post models.Posts, organization models.Organization) {
+	recipients := []uint{}
+	seen := map[uint]bool{userId: true}
+
+	members, err := f.orgUsersRepository.GetOrgMembers(organization.ID)
+	if err != nil {
+		log.Println("[PostsService] Could not find members to notify:", err)
+	}
+	for _, member := range members {
+		if !seen[member.UsersID] {
+			seen[member.UsersID] = true
+			recipients = append(recipients, member.UsersID)
+		}
+	}
+
+	followers := []models.PublicUser{}
+	if post.Audience == models.AudiencePublic {
+		followers, err = f.followRepository.GetFollowers(organization.ID)
+		if err != nil {
+			log.Println("[PostsService] Could not find followers to notify:", err)
+		}
+	}
+	for _, follower := range followers {
+		if !seen[follower.ID] {
+			seen[follower.ID] = true
+			recipients = append(recipients, follower.ID)
+		}
+	}
+
+	for _, recipient := range recipients {
+		f.notifications.Notify(models.Notifications{
+			UsersID:     recipient,
+			Type:        models.NotifyAnnouncement,
+			Title:       "Announcement from " + organization.Name,
+			Body:        post.PostDescription,
+			SubjectType: models.SubjectPost,
+			SubjectID:   post.ID,
+			ActorID:     userId,
+		}, nil)
+	}
 }
 
-func (f commentsService) FindComment(id string) (models.Comments, error) {
-	return f.commentsRepository.FindComment(id)
+// Comments on the post by the user, replying to ParentID when it is set.
+// Only posts the user can see can be commented on.
+func (f commentsService) CreateComment(userId uint, comment models.Comments) (models.Comments, error) {
+	comment.CommentDescription = strings.TrimSpace(comment.CommentDescription)
+	if comment.CommentDescription == "" {
+		return models.Comments{}, errors.New("comment cannot be empty")
+	}
+
+	author, err := f.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	if author.Suspended(time.Now()) {
+		return models.Comments{}, ErrSuspended
+	}
+
+	moderation, heldReason, err := screenContent(f.filter, "comment", comment.CommentDescription)
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	post, err := visiblePost(f.postsRepository, comment.PostsID, userId)
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	var parent models.Comments
+	if comment.ParentID != nil {
+		parent, err = f.commentsRepository.FindComment(strconv.FormatUint(uint64(*comment.ParentID), 10))
+		if err != nil || parent.ID == 0 || parent.PostsID != post.ID {
+			return models.Comments{}, errors.New("the comment replied to is not on this post")
+		}
+
+		// Replies to replies stay in the thread of the top level comment
+		threadId := parent.ID
+		if parent.ThreadID != nil {
+			threadId = *parent.ThreadID
+		}
+		comment.ThreadID = &threadId
+	} else {
+		comment.ThreadID = nil
+	}
+
+	comment.Handle = author.Handle
+	comment.EditedAt = nil
+	comment.Moderation = moderation
+
+	created, err := f.commentsRepository.CreateComment(comment)
+	if err != nil {
+		return created, err
+	}
+
+	indexComment(f.searchRepository, created)
+
+	// Nobody hears of held comments until a moderator approved them
+	if created.Moderation == models.ModerationHeld {
+		if _, err := f.moderationRepository.HoldContent(models.SubjectComment, created.ID, heldReason); err != nil {
+			log.Println("[CommentsService] Could not open case for held comment:", err)
+		}
+
+		return created, nil
+	}
+
+	f.hub.Publish(realtime.PostTopic(created.PostsID), "comment", created)
+
+	f.notify(userId, created, post, parent)
+
+	return created, nil
 }
 
-func (f commentsService) AllComments() ([]models.Comments, error) {
-	return f.commentsRepository.AllComments()
+// Deletes the comment. Replies to it are kept, with the comment shown as
+// deleted in their thread.
+func (f commentsService) DeleteComment(userId uint, id uint) error {
+	comment, err := f.moderatedComment(userId, id)
+	if err != nil {
+		return err
+	}
+
+	if err := f.commentsRepository.DeleteComment(comment); err != nil {
+		return err
+	}
+
+	f.hub.Publish(realtime.PostTopic(comment.PostsID), "comment_deleted", map[string]uint{"ID": comment.ID})
+
+	return nil
 }
 
-func (f likesService) CreateLike(like models.Likes) (models.Likes, error) {
-	return f.likesRepository.CreateLike(like)
+// Changes what the comment says, keeping what it said before in its history.
+// Only users mentioned for the first time are notified.
+func (f commentsService) EditComment(userId uint, id uint, description string) (models.Comments, error) {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return models.Comments{}, errors.New("comment cannot be empty")
+	}
+
+	moderation, heldReason, err := screenContent(f.filter, "comment", description)
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	comment, err := f.moderatedComment(userId, id)
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	if comment.CommentDescription == description {
+		return comment, nil
+	}
+
+	previous := comment.CommentDescription
+	now := time.Now()
+	comment.CommentDescription = description
+	comment.EditedAt = &now
+
+	// Edits never lift a hold, only moderators do
+	held := moderation != "" && comment.Moderation == ""
+	if held {
+		comment.Moderation = moderation
+	}
+
+	edited, err := f.commentsRepository.EditComment(comment, previous)
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	indexComment(f.searchRepository, edited)
+
+	if held {
+		if _, err := f.moderationRepository.HoldContent(models.SubjectComment, edited.ID, heldReason); err != nil {
+			log.Println("[CommentsService] Could not open case for held comment:", err)
+		}
+
+		f.hub.Publish(realtime.PostTopic(edited.PostsID), "comment_deleted", map[string]uint{"ID": edited.ID})
+
+		return edited, nil
+	}
+
+	if edited.Moderation != "" {
+		return edited, nil
+	}
+
+	f.hub.Publish(realtime.PostTopic(edited.PostsID), "comment_edited", edited)
+
+	post, err := f.postsRepository.FindPost(strconv.FormatUint(uint64(edited.PostsID), 10))
+	if err == nil {
+		f.notifyMentions(userId, edited, post, previous, map[uint]bool{})
+	}
+
+	return edited, nil
 }
 
-func (f likesService) DeleteLike(like models.Likes) error {
-	return f.likesRepository.DeleteLike(like)
+// Finds the comment if the user can see its post, unless moderators hold or
+// hid it
+func (f commentsService) FindComment(id string, viewerId uint) (models.Comments, error) {
+	comment, err := f.commentsRepository.FindComment(id)
+	if err != nil || comment.ID == 0 || comment.Moderation != "" {
+		return models.Comments{}, errors.New("comment not found")
+	}
+
+	if _, err := visiblePost(f.postsRepository, comment.PostsID, viewerId); err != nil {
+		return models.Comments{}, errors.New("comment not found")
+	}
+
+	return comment, nil
 }
 
-func (f likesService) FindLike(id string) (models.Likes, error) {
-	return f.likesRepository.FindLike(id)
+// Lists a page of the top level comments of the post, if the user can see
+// it, oldest first, each with every reply in its thread nested under the
+// comment it replies to
+func (f commentsService) PostComments(postId uint, cursor string, limit int, viewerId uint) (models.CommentPage, error) {
+	var position commentPosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.CommentPage{}, err
+	}
+
+	if _, err := visiblePost(f.postsRepository, postId, viewerId); err != nil {
+		return models.CommentPage{}, err
+	}
+
+	// One extra tells whether there is another page
+	threads, err := f.commentsRepository.GetThreads(postId, position.ID, limit+1)
+	if err != nil {
+		return models.CommentPage{}, err
+	}
+
+	page := models.CommentPage{Comments: []models.Comments{}}
+
+	if len(threads) > limit {
+		threads = threads[:limit]
+		page.NextCursor = encodeCursor(commentPosition{ID: threads[len(threads)-1].ID})
+	}
+
+	if len(threads) == 0 {
+		return page, nil
+	}
+
+	threadIds := []uint{}
+	for _, thread := range threads {
+		threadIds = append(threadIds, thread.ID)
+	}
+
+	replies, err := f.commentsRepository.GetReplies(threadIds)
+	if err != nil {
+		return models.CommentPage{}, err
+	}
+
+	children := map[uint][]models.Comments{}
+	for _, reply := range replies {
+		if reply.ParentID != nil {
+			children[*reply.ParentID] = append(children[*reply.ParentID], reply)
+		}
+	}
+
+	for _, thread := range threads {
+		if nested, ok := nestReplies(thread, children); ok {
+			page.Comments = append(page.Comments, nested)
+		}
+	}
+
+	return page, nil
+}
+
+// Lists what the comment said before each of its edits, oldest first
+func (f commentsService) CommentHistory(id uint, viewerId uint) ([]models.CommentEdits, error) {
+	if _, err := f.FindComment(strconv.FormatUint(uint64(id), 10), viewerId); err != nil {
+		return []models.CommentEdits{}, err
+	}
+
+	return f.commentsRepository.GetEdits(id)
+}
+
+// Where comment pages continue from
+type commentPosition struct {
+	ID uint `json:"id"`
+}
+
+// Nests the replies under the comment. Deleted comments, and those
+// moderators hold or hid, are only kept, blanked out, while they have
+// replies left. Returns whether the comment is kept.
+func nestReplies(comment models.Comments, children map[uint][]models.Comments) (models.Comments, bool) {
+	comment.Replies = []models.Comments{}
+	for _, child := range children[comment.ID] {
+		if nested, ok := nestReplies(child, children); ok {
+			comment.Replies = append(comment.Replies, nested)
+		}
+	}
+
+	if comment.DeletedAt.Valid || comment.Moderation != "" {
+		if len(comment.Replies) == 0 {
+			return comment, false
+		}
+
+		comment.Handle = ""
+		comment.CommentDescription = ""
+	}
+
+	return comment, true
 }
 
-func (f likesService) AllLikes() ([]models.Likes, error) {
-	return f.likesRepository.AllLikes()
+// Finds the comment if the user may edit or delete it: they wrote it, or
+// manage the organization of its post
+func (f commentsService) moderatedComment(userId uint, id uint) (models.Comments, error) {
+	comment, err := f.commentsRepository.FindComment(strconv.FormatUint(uint64(id), 10))
+	if err != nil || comment.ID == 0 {
+		return models.Comments{}, errors.New("comment not found")
+	}
+
+	user, err := f.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.Comments{}, err
+	}
+
+	if user.Handle != "" && user.Handle == comment.Handle {
+		return comment, nil
+	}
+
+	post, err := f.postsRepository.FindPost(strconv.FormatUint(uint64(comment.PostsID), 10))
+	if err == nil && post.OrganizationID != nil && requireManager(f.orgUsersRepository, userId, *post.OrganizationID) == nil {
+		return comment, nil
+	}
+
+	return models.Comments{}, ErrNotAuthor
+}
+
+// Tells the author of the comment replied to, the users mentioned and the
+// author of the post about the new comment, each only once and only if they
+// can see the post
+func (f commentsService) notify(userId uint, comment models.Comments, post models.Posts, parent models.Comments) {
+	notified := map[uint]bool{userId: true}
+
+	base := models.Notifications{
+		Body:        comment.CommentDescription,
+		SubjectType: models.SubjectPost,
+		SubjectID:   post.ID,
+		ActorID:     userId,
+	}
+
+	if parent.ID != 0 {
+		if recipient, err := f.usersRepository.FindUserByHandle(parent.Handle); err == nil && !notified[recipient.ID] && canSeePost(f.postsRepository, post, recipient.ID) {
+			notified[recipient.ID] = true
+
+			notification := base
+			notification.UsersID = recipient.ID
+			notification.Type = models.NotifyReply
+			notification.Title = "@" + comment.Handle + " replied to your comment"
+			f.notifications.Notify(notification, nil)
+		}
+	}
+
+	f.notifyMentions(userId, comment, post, "", notified)
+
+	if recipient, err := f.usersRepository.FindUserByHandle(post.Handle); err == nil && !notified[recipient.ID] {
+		notification := base
+		notification.UsersID = recipient.ID
+		notification.Type = models.NotifyComment
+		notification.Title = "@" + comment.Handle + " commented on your post"
+		f.notifications.Notify(notification, nil)
+	}
 }
 
-func (f likesService) GetLikes(id string) (int64, error) {
-	return f.likesRepository.GetLikes(id)
+// Notifies the users mentioned in the comment but not in previous, what it
+// said before an edit, who can see the post. Adds them to notified.
+func (f commentsService) notifyMentions(userId uint, comment models.Comments, post models.Posts, previous string, notified map[uint]bool) {
+	notified[userId] = true
+
+	before := map[string]bool{}
+	for _, handle := range mentionedHandles(previous) {
+		before[strings.ToLower(handle)] = true
+	}
+
+	for _, handle := range mentionedHandles(comment.CommentDescription) {
+		if before[strings.ToLower(handle)] {
+			continue
+		}
+
+		recipient, err := f.usersRepository.FindUserByHandle(handle)
+		if err != nil || notified[recipient.ID] || !canSeePost(f.postsRepository, post, recipient.ID) {
+			continue
+		}
+		notified[recipient.ID] = true
+
+		f.notifications.Notify(models.Notifications{
+			UsersID:     recipient.ID,
+			Type:        models.NotifyMention,
+			Title:       "@" + comment.Handle + " mentioned you in a comment",
+			Body:        comment.CommentDescription,
+			SubjectType: models.SubjectPost,
+			SubjectID:   post.ID,
+			ActorID:     userId,
+		}, nil)
+	}
 }
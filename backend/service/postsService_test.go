@@ -0,0 +1,443 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/contentfilter"
+	"github.com/VolunteerOne/volunteer-one-app/backend/feedcache"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+// Holds posts and comments that mention spoilers and rejects scams
+var testContentFilter = contentfilter.Chain(
+	contentfilter.NewWordFilter([]string{"spoiler"}, contentfilter.Hold),
+	contentfilter.NewWordFilter([]string{"free money"}, contentfilter.Reject),
+)
+
+type PostsServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo        *mocks.PostsRepository
+	mockUsersRepo   *mocks.UsersRepository
+	mockOrgUserRepo *mocks.OrgUsersRepository
+	mockOrgRepo     *mocks.OrganizationRepository
+	mockFollowRepo  *mocks.FollowRepository
+	mockEventRepo   *mocks.EventRepository
+	notifications   *mocks.NotificationService
+	feedCache       *mocks.Cache
+	mockModRepo     *mocks.ModerationRepository
+	mockSearchRepo  *mocks.SearchRepository
+	service         PostsService
+	author          models.Users
+	orgId           uint
+	post            models.Posts
+	err             error
+}
+
+func (suite *PostsServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.PostsRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockOrgUserRepo = new(mocks.OrgUsersRepository)
+	suite.mockOrgRepo = new(mocks.OrganizationRepository)
+	suite.mockFollowRepo = new(mocks.FollowRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.feedCache = new(mocks.Cache)
+	suite.mockModRepo = new(mocks.ModerationRepository)
+	suite.mockSearchRepo = new(mocks.SearchRepository)
+	suite.service = NewPostsService(suite.mockRepo, suite.mockUsersRepo, suite.mockOrgUserRepo, suite.mockOrgRepo,
+		suite.mockFollowRepo, suite.mockEventRepo, suite.notifications, suite.feedCache,
+		suite.mockModRepo, testContentFilter, suite.mockSearchRepo)
+
+	suite.author = models.Users{Handle: "ada"}
+	suite.author.ID = 4
+	suite.orgId = 2
+
+	// An organization's post, written by another manager
+	suite.post = models.Posts{Handle: "grace", PostDescription: "Thanks everyone!", OrganizationID: &suite.orgId, Type: models.PostTypePost}
+	suite.post.ID = 3
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *PostsServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockModRepo.AssertExpectations(suite.T())
+	suite.mockSearchRepo.AssertExpectations(suite.T())
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockOrgUserRepo.AssertExpectations(suite.T())
+	suite.mockOrgRepo.AssertExpectations(suite.T())
+	suite.mockFollowRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.feedCache.AssertExpectations(suite.T())
+}
+
+func TestPostsServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PostsServiceUnitTestSuite))
+}
+
+// Expects user 4 to have role in the organization
+func (suite *PostsServiceUnitTestSuite) expectRole(role uint) {
+	suite.mockOrgUserRepo.On("FindOrgUser", uint(4), suite.orgId).Return(models.OrgUsers{Role: role}, nil).Once()
+}
+
+// Expects the post to be indexed with the hashtags and mentions
+func (suite *PostsServiceUnitTestSuite) expectIndex(tags []string, handles []string) {
+	suite.mockSearchRepo.On("IndexPost", mock.Anything, tags, handles).Return(nil).Once()
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+
+	// The handle is the signed in user's, whatever was sent
+	suite.mockRepo.On("CreatePost", models.Posts{Handle: "ada", PostDescription: "Hello #Cleanup crew, @Grace", Type: models.PostTypePost, Audience: models.AudiencePublic}).
+		Return(func(p models.Posts) models.Posts {
+			return p
+		}, nil)
+	suite.expectIndex([]string{"cleanup"}, []string{"grace"})
+
+	// Friends' feeds get the post
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}}).Once()
+
+	res, err := suite.service.CreatePost(4, models.Posts{Handle: "grace", PostDescription: " Hello #Cleanup crew, @Grace ", ReactionCount: 10})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "ada", res.Handle)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_Held() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+
+	held := models.Posts{Handle: "ada", PostDescription: "Spoiler: it was fun", Type: models.PostTypePost, Moderation: models.ModerationHeld, Audience: models.AudiencePublic}
+	created := held
+	created.ID = 9
+	suite.mockRepo.On("CreatePost", held).Return(created, nil)
+	suite.expectIndex([]string{}, []string{})
+	suite.mockModRepo.On("HoldContent", models.SubjectPost, uint(9), `contains "spoiler"`).Return(models.ModerationCases{}, nil)
+
+	// Nobody's feed gets it until a moderator approved it
+	res, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Spoiler: it was fun"})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), models.ModerationHeld, res.Moderation)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_Rejected() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Free money for everyone"})
+
+	assert.EqualError(suite.T(), err, `this post isn't allowed, it contains "free money"`)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_Suspended() {
+	until := time.Now().Add(time.Hour)
+	suite.author.SuspendedUntil = &until
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Hello"})
+
+	assert.Equal(suite.T(), ErrSuspended, err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_NotManager() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleMember)
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Hello", OrganizationID: &suite.orgId})
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_PersonalAnnouncement() {
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Hello", Type: models.PostTypeAnnouncement})
+
+	assert.EqualError(suite.T(), err, "only organizations post announcements")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_OtherOrganizationsEvent() {
+	eventId := uint(8)
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleManager)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(models.Organization{Name: "Park Friends"}, nil)
+	suite.mockEventRepo.On("GetEventById", "8").Return(models.Event{OrganizationID: 5}, nil)
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Join us", OrganizationID: &suite.orgId, EventID: &eventId})
+
+	assert.EqualError(suite.T(), err, "the event belongs to another organization")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_Announcement() {
+	eventId := uint(8)
+	organization := models.Organization{Name: "Park Friends"}
+	organization.ID = 2
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleOwner)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(organization, nil)
+	suite.mockEventRepo.On("GetEventById", "8").Return(models.Event{OrganizationID: 2}, nil)
+	suite.expectIndex([]string{}, []string{})
+	suite.mockRepo.On("CreatePost", mock.MatchedBy(func(p models.Posts) bool {
+		return p.Type == models.PostTypeAnnouncement && *p.EventID == 8 && *p.OrganizationID == 2
+	})).Return(func(p models.Posts) models.Posts {
+		p.ID = 3
+		return p
+	}, nil)
+
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}, OrganizationIDs: []uint{2}}).Once()
+
+	// Members who also follow, and the author, aren't told twice
+	suite.mockOrgUserRepo.On("GetOrgMembers", uint(2)).Return([]models.OrgUsers{{UsersID: 4}, {UsersID: 5}}, nil)
+	suite.mockFollowRepo.On("GetFollowers", uint(2)).Return([]models.PublicUser{{ID: 5}, {ID: 6}}, nil)
+	for _, userId := range []uint{5, 6} {
+		id := userId
+		suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+			return n.UsersID == id && n.Type == models.NotifyAnnouncement && n.SubjectID == 3 &&
+				n.Title == "Announcement from Park Friends" && n.Body == "Cleanup moved to Sunday"
+		}), mock.Anything).Return(models.Notifications{}, nil).Once()
+	}
+
+	_, err := suite.service.CreatePost(4, models.Posts{
+		PostDescription: "Cleanup moved to Sunday",
+		OrganizationID:  &suite.orgId,
+		Type:            models.PostTypeAnnouncement,
+		EventID:         &eventId,
+	})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_MembersAnnouncement() {
+	organization := models.Organization{Name: "Park Friends"}
+	organization.ID = 2
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleManager)
+	suite.mockOrgRepo.On("GetOrganizationById", "2").Return(organization, nil)
+	suite.expectIndex([]string{}, []string{})
+	suite.mockRepo.On("CreatePost", mock.MatchedBy(func(p models.Posts) bool {
+		return p.Audience == models.AudienceMembers && *p.AudienceOrganizationID == 2
+	})).Return(func(p models.Posts) models.Posts {
+		p.ID = 3
+		return p
+	}, nil)
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}, OrganizationIDs: []uint{2}}).Once()
+
+	// Followers who aren't members aren't told
+	suite.mockOrgUserRepo.On("GetOrgMembers", uint(2)).Return([]models.OrgUsers{{UsersID: 5}}, nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 5
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	_, err := suite.service.CreatePost(4, models.Posts{
+		PostDescription: "Members meeting on Friday",
+		OrganizationID:  &suite.orgId,
+		Type:            models.PostTypeAnnouncement,
+		Audience:        models.AudienceMembers,
+	})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_ForOrganization() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleMember)
+	suite.expectIndex([]string{}, []string{})
+	suite.mockRepo.On("CreatePost", mock.MatchedBy(func(p models.Posts) bool {
+		return p.Audience == models.AudienceOrganization && *p.AudienceOrganizationID == 2 && p.OrganizationID == nil
+	})).Return(models.Posts{Handle: "ada"}, nil)
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"ada"}}).Once()
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Who's driving?", Audience: models.AudienceOrganization, AudienceOrganizationID: &suite.orgId})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_ForOtherOrganization() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.mockOrgUserRepo.On("FindOrgUser", uint(4), suite.orgId).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Hello", Audience: models.AudienceOrganization, AudienceOrganizationID: &suite.orgId})
+
+	assert.EqualError(suite.T(), err, "you can only post to organizations you belong to")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_CreatePost_BadAudience() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil).Twice()
+
+	_, err := suite.service.CreatePost(4, models.Posts{PostDescription: "Hello", Audience: models.AudienceMembers})
+	assert.EqualError(suite.T(), err, "only organizations post to their members")
+
+	_, err = suite.service.CreatePost(4, models.Posts{PostDescription: "Hello", Audience: "everyone"})
+	assert.EqualError(suite.T(), err, "audience must be one of public, friends, members, organization")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_FindPost_FriendsOnly() {
+	suite.post.OrganizationID = nil
+	suite.post.Audience = models.AudienceFriends
+
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "ada", FriendHandles: []string{"grace"}}, nil).Once()
+	suite.mockRepo.On("FindViewer", uint(5)).Return(models.PostViewer{Handle: "linus"}, nil).Once()
+
+	post, err := suite.service.FindPost("3", 4)
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(3), post.ID)
+
+	// Strangers and signed out users are told there is no such post
+	_, err = suite.service.FindPost("3", 5)
+	assert.EqualError(suite.T(), err, "post not found")
+
+	_, err = suite.service.FindPost("3", 0)
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_FindPost_MembersOnly() {
+	suite.post.Audience = models.AudienceMembers
+	suite.post.AudienceOrganizationID = &suite.orgId
+
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "ada", OrganizationIDs: []uint{5}}, nil)
+
+	_, err := suite.service.FindPost("3", 4)
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_AllPosts() {
+	viewer := models.PostViewer{Handle: "ada", FriendHandles: []string{"grace"}, OrganizationIDs: []uint{2}}
+
+	suite.mockRepo.On("FindViewer", uint(4)).Return(viewer, nil)
+	suite.mockRepo.On("AllPosts", viewer).Return([]models.Posts{suite.post}, nil)
+
+	posts, err := suite.service.AllPosts(4)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), posts, 1)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_AllPosts_SignedOut() {
+	// Only public posts, without looking anyone up
+	suite.mockRepo.On("AllPosts", models.PostViewer{}).Return([]models.Posts{}, nil)
+
+	_, err := suite.service.AllPosts(0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_EditPost_OrgManager() {
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleManager)
+	suite.expectIndex([]string{}, []string{})
+	suite.mockRepo.On("EditPost", mock.MatchedBy(func(p models.Posts) bool {
+		return p.PostDescription == "Thanks, everyone!"
+	})).Return(suite.post, nil)
+
+	_, err := suite.service.EditPost(4, 3, "Thanks, everyone!")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_DeletePost_OrgManager() {
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+	suite.expectRole(models.RoleManager)
+	suite.mockRepo.On("DeletePost", suite.post).Return(nil)
+
+	// Feeds that have it rank again
+	suite.feedCache.On("Invalidate", feedcache.Sources{Handles: []string{"grace"}, OrganizationIDs: []uint{2}}).Once()
+
+	err := suite.service.DeletePost(4, 3)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_DeletePost_NotAuthor() {
+	suite.post.OrganizationID = nil
+
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.author, nil)
+
+	err := suite.service.DeletePost(4, 3)
+
+	assert.Equal(suite.T(), ErrNotAuthor, err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_PinPost() {
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.expectRole(models.RoleManager)
+	suite.mockRepo.On("GetPinnedPosts", suite.orgId).Return([]models.Posts{{}, {}}, nil)
+	suite.mockRepo.On("EditPost", mock.MatchedBy(func(p models.Posts) bool {
+		return p.PinnedAt != nil && time.Since(*p.PinnedAt) < time.Minute
+	})).Return(suite.post, nil)
+
+	_, err := suite.service.PinPost(4, 3, true)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_PinPost_Full() {
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.expectRole(models.RoleManager)
+	suite.mockRepo.On("GetPinnedPosts", suite.orgId).Return([]models.Posts{{}, {}, {}}, nil)
+
+	_, err := suite.service.PinPost(4, 3, true)
+
+	assert.EqualError(suite.T(), err, "an organization can pin at most 3 posts")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_PinPost_Personal() {
+	suite.post.OrganizationID = nil
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+
+	_, err := suite.service.PinPost(4, 3, true)
+
+	assert.EqualError(suite.T(), err, "only posts of organizations can be pinned")
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_UnpinPost_NotManager() {
+	suite.mockRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.expectRole(models.RoleMember)
+
+	_, err := suite.service.PinPost(4, 3, false)
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *PostsServiceUnitTestSuite) TestPostsService_OrgPosts() {
+	posts := []models.Posts{{}, {}, {}}
+	for i := range posts {
+		posts[i].ID = uint(30 - i)
+	}
+
+	// Ada follows the organization but isn't a member
+	viewer := models.PostViewer{Handle: "ada", OrganizationIDs: []uint{5}}
+	membersOnly := models.Posts{Handle: "grace", OrganizationID: &suite.orgId, Audience: models.AudienceMembers, AudienceOrganizationID: &suite.orgId}
+
+	suite.mockRepo.On("FindViewer", uint(4)).Return(viewer, nil).Twice()
+	suite.mockRepo.On("GetPinnedPosts", suite.orgId).Return([]models.Posts{suite.post, membersOnly}, nil).Once()
+	suite.mockRepo.On("GetOrgPosts", suite.orgId, viewer, uint(0), 3).Return(posts, nil).Once()
+
+	page, err := suite.service.OrgPosts(2, "", 2, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.Posts{suite.post}, page.Pinned)
+	assert.Len(suite.T(), page.Posts, 2)
+
+	// Pinned posts are only on the first page
+	suite.mockRepo.On("GetOrgPosts", suite.orgId, viewer, uint(29), 3).Return(posts[2:], nil).Once()
+
+	next, err := suite.service.OrgPosts(2, page.NextCursor, 2, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), next.Pinned)
+	assert.Len(suite.T(), next.Posts, 1)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/push"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+const (
+	// A push is sent this many times in all before giving up on the
+	// devices it didn't reach
+	pushAttempts = 4
+	// Wait before the first retry, quadrupled for each one after
+	pushBackoff = 30 * time.Second
+)
+
+type PushService interface {
+	RegisterDevice(uint, string, string) (models.DeviceTokens, error)
+	Devices(uint) ([]models.DeviceTokens, error)
+	UnregisterDevice(uint, uint) error
+	Push(models.Notifications)
+	Retry(models.PushJob) error
+}
+
+type pushService struct {
+	deviceRepository repository.DeviceRepository
+	loginRepository  repository.LoginRepository
+	jobRepository    repository.JobRepository
+	dispatcher       push.Dispatcher
+}
+
+// Instantiated in router.go
+func NewPushService(d repository.DeviceRepository, l repository.LoginRepository, j repository.JobRepository, p push.Dispatcher) PushService {
+	return pushService{
+		deviceRepository: d,
+		loginRepository:  l,
+		jobRepository:    j,
+		dispatcher:       p,
+	}
+}
+
+// Registers the device's token for the user's current session
+func (s pushService) RegisterDevice(userId uint, platform string, token string) (models.DeviceTokens, error) {
+	log.Println("[PushService] Register device...")
+
+	platform = strings.ToLower(strings.TrimSpace(platform))
+	token = strings.TrimSpace(token)
+
+	if !push.IsPlatform(platform) {
+		return models.DeviceTokens{}, errors.New("platform must be one of " + strings.Join(push.Platforms, ", "))
+	}
+
+	if token == "" || len(token) > 255 {
+		return models.DeviceTokens{}, errors.New("token must be between 1 and 255 characters")
+	}
+
+	session, err := s.loginRepository.FindRefreshToken(float64(userId), models.Delegations{})
+	if err != nil {
+		return models.DeviceTokens{}, errors.New("sign in again to register this device")
+	}
+
+	return s.deviceRepository.SaveDevice(models.DeviceTokens{
+		UsersID:       userId,
+		DelegationsID: session.ID,
+		Platform:      platform,
+		Token:         token,
+	})
+}
+
+func (s pushService) Devices(userId uint) ([]models.DeviceTokens, error) {
+	log.Println("[PushService] Devices...")
+
+	return s.deviceRepository.GetDevices(userId)
+}
+
+func (s pushService) UnregisterDevice(userId uint, id uint) error {
+	log.Println("[PushService] Unregister device...")
+
+	return s.deviceRepository.DeleteDevice(userId, id)
+}
+
+// Sends the notification to each of the user's signed in devices
+func (s pushService) Push(notification models.Notifications) {
+	devices, err := s.deviceRepository.GetDevices(notification.UsersID)
+	if err != nil {
+		log.Println("[PushService] Could not find devices:", err)
+		return
+	}
+
+	if len(devices) == 0 {
+		return
+	}
+
+	job := models.PushJob{
+		Title: notification.Title,
+		Body:  notification.Body,
+		Data: map[string]string{
+			"notificationId": strconv.FormatUint(uint64(notification.ID), 10),
+			"type":           notification.Type,
+			"subjectType":    notification.SubjectType,
+			"subjectId":      strconv.FormatUint(uint64(notification.SubjectID), 10),
+		},
+	}
+
+	for _, device := range devices {
+		job.Devices = append(job.Devices, models.PushDevice{Platform: device.Platform, Token: device.Token})
+	}
+
+	if err := s.deliver(job); err != nil {
+		log.Println("[PushService] Could not schedule retry:", err)
+	}
+}
+
+// Sends a push again to the devices it failed to reach
+func (s pushService) Retry(job models.PushJob) error {
+	return s.deliver(job)
+}
+
+// Sends the push to its devices, all in one batch. Tokens the providers
+// reject are forgotten, devices that failed otherwise are retried later
+// with backoff until the attempts run out.
+func (s pushService) deliver(job models.PushJob) error {
+	messages := []push.Message{}
+	for _, device := range job.Devices {
+		messages = append(messages, push.Message{
+			Platform: device.Platform,
+			Token:    device.Token,
+			Title:    job.Title,
+			Body:     job.Body,
+			Data:     job.Data,
+		})
+	}
+
+	invalid := []string{}
+	failed := []models.PushDevice{}
+
+	for i, err := range s.dispatcher.Send(messages) {
+		switch {
+		case err == nil:
+		case errors.Is(err, push.ErrInvalidToken):
+			invalid = append(invalid, job.Devices[i].Token)
+		default:
+			log.Println("[PushService] Push failed:", err)
+			failed = append(failed, job.Devices[i])
+		}
+	}
+
+	if len(invalid) > 0 {
+		if pruned, err := s.deviceRepository.DeleteTokens(invalid); err != nil {
+			log.Println("[PushService] Could not forget invalid tokens:", err)
+		} else {
+			log.Println("[PushService] Forgot", pruned, "invalid tokens")
+		}
+	}
+
+	job.Attempt++
+	if len(failed) == 0 || job.Attempt >= pushAttempts {
+		return nil
+	}
+
+	job.Devices = failed
+
+	return s.scheduleRetry(job)
+}
+
+func (s pushService) scheduleRetry(job models.PushJob) error {
+	payload, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+
+	// Keys only need to be unique, the same push is never retried twice
+	key, err := newToken()
+	if err != nil {
+		return err
+	}
+
+	backoff := pushBackoff
+	for i := uint(1); i < job.Attempt; i++ {
+		backoff *= 4
+	}
+
+	_, err = s.jobRepository.ScheduleJob(models.ScheduledJobs{
+		Key:     "push:" + key,
+		Type:    models.JobPush,
+		Payload: string(payload),
+		RunAt:   time.Now().Add(backoff),
+	})
+
+	return err
+}
@@ -0,0 +1,167 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/push"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PushServiceUnitTestSuite struct {
+	suite.Suite
+	mockDeviceRepo *mocks.DeviceRepository
+	mockLoginRepo  *mocks.LoginRepository
+	mockJobRepo    *mocks.JobRepository
+	recorder       *push.Recorder
+	service        PushService
+	notification   models.Notifications
+	devices        []models.DeviceTokens
+	err            error
+}
+
+func (suite *PushServiceUnitTestSuite) SetupTest() {
+	suite.mockDeviceRepo = new(mocks.DeviceRepository)
+	suite.mockLoginRepo = new(mocks.LoginRepository)
+	suite.mockJobRepo = new(mocks.JobRepository)
+	suite.recorder = push.NewRecorder()
+	suite.service = NewPushService(suite.mockDeviceRepo, suite.mockLoginRepo, suite.mockJobRepo, suite.recorder)
+
+	suite.notification = models.Notifications{
+		UsersID:     4,
+		Type:        models.NotifyEventChanged,
+		Title:       "Park cleanup has changed",
+		SubjectType: models.SubjectEvent,
+		SubjectID:   1,
+	}
+	suite.notification.ID = 8
+
+	suite.devices = []models.DeviceTokens{
+		{UsersID: 4, Platform: push.Expo, Token: "phone"},
+		{UsersID: 4, Platform: push.APNs, Token: "tablet"},
+		{UsersID: 4, Platform: push.FCM, Token: "old-phone"},
+	}
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *PushServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockDeviceRepo.AssertExpectations(suite.T())
+	suite.mockLoginRepo.AssertExpectations(suite.T())
+	suite.mockJobRepo.AssertExpectations(suite.T())
+}
+
+func TestPushServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(PushServiceUnitTestSuite))
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_RegisterDevice() {
+	session := models.Delegations{UsersID: 4}
+	session.ID = 2
+	device := models.DeviceTokens{UsersID: 4, DelegationsID: 2, Platform: push.Expo, Token: "ExponentPushToken[abc]"}
+
+	suite.mockLoginRepo.On("FindRefreshToken", float64(4), models.Delegations{}).Return(session, nil)
+	suite.mockDeviceRepo.On("SaveDevice", device).Return(device, nil)
+
+	res, err := suite.service.RegisterDevice(4, " Expo", "ExponentPushToken[abc] ")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), res.DelegationsID)
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_RegisterDevice_SignedOut() {
+	suite.mockLoginRepo.On("FindRefreshToken", float64(4), models.Delegations{}).Return(models.Delegations{}, suite.err)
+
+	_, err := suite.service.RegisterDevice(4, push.Expo, "ExponentPushToken[abc]")
+
+	assert.EqualError(suite.T(), err, "sign in again to register this device")
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_RegisterDevice_UnknownPlatform() {
+	_, err := suite.service.RegisterDevice(4, "pager", "abc")
+
+	assert.EqualError(suite.T(), err, "platform must be one of expo, fcm, apns")
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_Push() {
+	suite.mockDeviceRepo.On("GetDevices", uint(4)).Return(suite.devices, nil)
+
+	suite.service.Push(suite.notification)
+
+	// Every device in one batch, with what the app needs to open it
+	sent := suite.recorder.Sent()
+	assert.Equal(suite.T(), 1, suite.recorder.Batches())
+	assert.Len(suite.T(), sent, 3)
+	assert.Equal(suite.T(), "Park cleanup has changed", sent[0].Title)
+	assert.Equal(suite.T(), map[string]string{
+		"notificationId": "8",
+		"type":           models.NotifyEventChanged,
+		"subjectType":    models.SubjectEvent,
+		"subjectId":      "1",
+	}, sent[1].Data)
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_Push_NoDevices() {
+	suite.mockDeviceRepo.On("GetDevices", uint(4)).Return([]models.DeviceTokens{}, nil)
+
+	suite.service.Push(suite.notification)
+
+	assert.Equal(suite.T(), 0, suite.recorder.Batches())
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_Push_PrunesAndRetries() {
+	suite.recorder.Invalid["old-phone"] = true
+	suite.recorder.Failing["tablet"] = 1
+
+	suite.mockDeviceRepo.On("GetDevices", uint(4)).Return(suite.devices, nil)
+	suite.mockDeviceRepo.On("DeleteTokens", []string{"old-phone"}).Return(int64(1), nil)
+
+	// Only the tablet is retried, 30 seconds later
+	var retry models.PushJob
+	suite.mockJobRepo.On("ScheduleJob", mock.MatchedBy(func(job models.ScheduledJobs) bool {
+		_ = json.Unmarshal([]byte(job.Payload), &retry)
+
+		return job.Type == models.JobPush &&
+			job.RunAt.After(time.Now().Add(25*time.Second)) && job.RunAt.Before(time.Now().Add(35*time.Second))
+	})).Return(true, nil).Once()
+
+	suite.service.Push(suite.notification)
+
+	assert.Len(suite.T(), suite.recorder.Sent(), 1)
+	assert.Equal(suite.T(), []models.PushDevice{{Platform: push.APNs, Token: "tablet"}}, retry.Devices)
+	assert.Equal(suite.T(), uint(1), retry.Attempt)
+
+	// The retry gets through
+	err := suite.service.Retry(retry)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), suite.recorder.Sent(), 2)
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_Retry_Backoff() {
+	suite.recorder.Failing["tablet"] = 1
+
+	suite.mockJobRepo.On("ScheduleJob", mock.MatchedBy(func(job models.ScheduledJobs) bool {
+		// The third attempt waits 30s × 4²
+		return job.RunAt.After(time.Now().Add(7*time.Minute)) && job.RunAt.Before(time.Now().Add(9*time.Minute))
+	})).Return(true, nil).Once()
+
+	err := suite.service.Retry(models.PushJob{Devices: []models.PushDevice{{Platform: push.APNs, Token: "tablet"}}, Attempt: 2})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *PushServiceUnitTestSuite) TestPushService_Retry_GivesUp() {
+	suite.recorder.Failing["tablet"] = 1
+
+	err := suite.service.Retry(models.PushJob{Devices: []models.PushDevice{{Platform: push.APNs, Token: "tablet"}}, Attempt: pushAttempts - 1})
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), suite.recorder.Sent())
+}
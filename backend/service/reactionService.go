@@ -0,0 +1,206 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+type ReactionService interface {
+	React(uint, uint, string) (models.ReactionSummary, error)
+	Unreact(uint, uint) (models.ReactionSummary, error)
+	Reactions(uint, uint) (models.ReactionSummary, error)
+	Reactors(uint, string, string, int, uint) (models.ReactionPage, error)
+}
+
+type reactionService struct {
+	reactionRepository repository.ReactionRepository
+	postsRepository    repository.PostsRepository
+	usersRepository    repository.UsersRepository
+	notifications      NotificationService
+	hub                realtime.Hub
+}
+
+// Instantiated in router.go
+func NewReactionService(r repository.ReactionRepository, p repository.PostsRepository, u repository.UsersRepository, n NotificationService, h realtime.Hub) ReactionService {
+	return reactionService{
+		reactionRepository: r,
+		postsRepository:    p,
+		usersRepository:    u,
+		notifications:      n,
+		hub:                h,
+	}
+}
+
+// How notifications word each reaction
+var reactionVerbs = map[string]string{
+	models.ReactionLike:      "liked",
+	models.ReactionLove:      "loved",
+	models.ReactionCelebrate: "celebrated",
+	models.ReactionSupport:   "supported",
+}
+
+// Where pages of reactions continue from
+type reactionPosition struct {
+	ID uint `json:"id"`
+}
+
+// Sets the user's reaction to a post they can see, replacing the one they
+// had. The author of the post is only notified the first time.
+func (s reactionService) React(userId uint, postId uint, reactionType string) (models.ReactionSummary, error) {
+	log.Println("[ReactionService] React...")
+
+	reactionType = strings.ToLower(strings.TrimSpace(reactionType))
+	if !models.IsReaction(reactionType) {
+		return models.ReactionSummary{}, errors.New("reaction must be one of " + strings.Join(models.ReactionTypes, ", "))
+	}
+
+	post, err := visiblePost(s.postsRepository, postId, userId)
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	previous, err := s.reactionRepository.SetReaction(models.Reactions{
+		PostsID: postId,
+		UsersID: userId,
+		Handle:  user.Handle,
+		Type:    reactionType,
+	})
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	summary, err := s.changed(postId, previous != reactionType)
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	if previous == "" {
+		s.notifyAuthor(post, user, reactionType)
+	}
+
+	summary.Mine = reactionType
+
+	return summary, nil
+}
+
+// Removes the user's reaction to the post, if they had one
+func (s reactionService) Unreact(userId uint, postId uint) (models.ReactionSummary, error) {
+	log.Println("[ReactionService] Unreact...")
+
+	removed, err := s.reactionRepository.DeleteReaction(postId, userId)
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	return s.changed(postId, removed != "")
+}
+
+// Counts the reactions to the post by type, if the user can see it
+func (s reactionService) Reactions(postId uint, viewerId uint) (models.ReactionSummary, error) {
+	if _, err := visiblePost(s.postsRepository, postId, viewerId); err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	return s.counts(postId)
+}
+
+func (s reactionService) counts(postId uint) (models.ReactionSummary, error) {
+	counts, err := s.reactionRepository.GetReactionCounts(postId)
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	summary := models.ReactionSummary{PostsID: postId, Counts: map[string]uint{}}
+	for _, reactionType := range models.ReactionTypes {
+		summary.Counts[reactionType] = 0
+	}
+
+	for _, count := range counts {
+		summary.Counts[count.Type] += count.Count
+		summary.Total += count.Count
+	}
+
+	return summary, nil
+}
+
+// Lists a page of who reacted to the post, if the user can see it, latest
+// first, only those who reacted reactionType unless it is empty
+func (s reactionService) Reactors(postId uint, reactionType string, cursor string, limit int, viewerId uint) (models.ReactionPage, error) {
+	reactionType = strings.ToLower(strings.TrimSpace(reactionType))
+	if reactionType != "" && !models.IsReaction(reactionType) {
+		return models.ReactionPage{}, errors.New("reaction must be one of " + strings.Join(models.ReactionTypes, ", "))
+	}
+
+	var position reactionPosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.ReactionPage{}, err
+	}
+
+	if _, err := visiblePost(s.postsRepository, postId, viewerId); err != nil {
+		return models.ReactionPage{}, err
+	}
+
+	// One extra tells whether there is another page
+	reactions, err := s.reactionRepository.GetReactions(postId, reactionType, position.ID, limit+1)
+	if err != nil {
+		return models.ReactionPage{}, err
+	}
+
+	page := models.ReactionPage{Reactions: reactions}
+
+	if len(reactions) > limit {
+		page.Reactions = reactions[:limit]
+		page.NextCursor = encodeCursor(reactionPosition{ID: page.Reactions[limit-1].ID})
+	}
+
+	return page, nil
+}
+
+// Counts the post's reactions after a change, telling its viewers when they
+// changed
+func (s reactionService) changed(postId uint, changed bool) (models.ReactionSummary, error) {
+	summary, err := s.counts(postId)
+	if err != nil {
+		return models.ReactionSummary{}, err
+	}
+
+	if changed {
+		s.hub.Publish(realtime.PostTopic(postId), "reactions", summary)
+	}
+
+	return summary, nil
+}
+
+// Tells the author of the post how the user reacted to it. Failures are only
+// logged.
+func (s reactionService) notifyAuthor(post models.Posts, user models.Users, reactionType string) {
+	author, err := s.usersRepository.FindUserByHandle(post.Handle)
+	if err != nil {
+		log.Println("[ReactionService] Could not find author to notify:", err)
+		return
+	}
+
+	if author.ID == user.ID {
+		return
+	}
+
+	s.notifications.Notify(models.Notifications{
+		UsersID:     author.ID,
+		Type:        models.NotifyReaction,
+		Title:       "@" + user.Handle + " " + reactionVerbs[reactionType] + " your post",
+		SubjectType: models.SubjectPost,
+		SubjectID:   post.ID,
+		ActorID:     user.ID,
+	}, nil)
+}
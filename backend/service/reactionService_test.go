@@ -0,0 +1,201 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ReactionServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.ReactionRepository
+	mockPostsRepo *mocks.PostsRepository
+	mockUsersRepo *mocks.UsersRepository
+	notifications *mocks.NotificationService
+	mockHub       *mocks.Hub
+	service       ReactionService
+	post          models.Posts
+	reactor       models.Users
+	err           error
+}
+
+func (suite *ReactionServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ReactionRepository)
+	suite.mockPostsRepo = new(mocks.PostsRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.notifications = new(mocks.NotificationService)
+	suite.mockHub = new(mocks.Hub)
+	suite.service = NewReactionService(suite.mockRepo, suite.mockPostsRepo, suite.mockUsersRepo, suite.notifications, suite.mockHub)
+
+	suite.post = models.Posts{Handle: "grace"}
+	suite.post.ID = 3
+	suite.reactor = models.Users{Handle: "ada"}
+	suite.reactor.ID = 4
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *ReactionServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPostsRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.mockHub.AssertExpectations(suite.T())
+}
+
+func TestReactionServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(ReactionServiceUnitTestSuite))
+}
+
+// Expects the post's counts to be read after a like and a love
+func (suite *ReactionServiceUnitTestSuite) expectCounts() {
+	suite.mockRepo.On("GetReactionCounts", uint(3)).Return([]models.ReactionCounts{
+		{PostsID: 3, Type: models.ReactionLike, Count: 1},
+		{PostsID: 3, Type: models.ReactionLove, Count: 1},
+	}, nil).Once()
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_React() {
+	author := models.Users{Handle: "grace"}
+	author.ID = 6
+
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.reactor, nil)
+	suite.mockRepo.On("SetReaction", models.Reactions{PostsID: 3, UsersID: 4, Handle: "ada", Type: models.ReactionLove}).Return("", nil)
+	suite.expectCounts()
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "reactions", mock.Anything).Once()
+	suite.mockUsersRepo.On("FindUserByHandle", "grace").Return(author, nil)
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 6 && n.Type == models.NotifyReaction && n.ActorID == 4 &&
+			n.Title == "@ada loved your post"
+	}), mock.Anything).Return(models.Notifications{}, nil).Once()
+
+	summary, err := suite.service.React(4, 3, " Love")
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), summary.Total)
+	assert.Equal(suite.T(), map[string]uint{"like": 1, "love": 1, "celebrate": 0, "support": 0}, summary.Counts)
+	assert.Equal(suite.T(), models.ReactionLove, summary.Mine)
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_React_Changed() {
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.reactor, nil)
+	suite.mockRepo.On("SetReaction", mock.Anything).Return(models.ReactionLike, nil)
+	suite.expectCounts()
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "reactions", mock.Anything).Once()
+
+	// The author was notified the first time
+	_, err := suite.service.React(4, 3, models.ReactionLove)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_React_Again() {
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(suite.reactor, nil)
+	suite.mockRepo.On("SetReaction", mock.Anything).Return(models.ReactionLove, nil)
+	suite.expectCounts()
+
+	// Nothing changed, so nothing is published
+	summary, err := suite.service.React(4, 3, models.ReactionLove)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), summary.Total)
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_React_UnknownType() {
+	_, err := suite.service.React(4, 3, "dislike")
+
+	assert.EqualError(suite.T(), err, "reaction must be one of like, love, celebrate, support")
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_React_NoPost() {
+	suite.mockPostsRepo.On("FindPost", "3").Return(models.Posts{}, nil)
+
+	_, err := suite.service.React(4, 3, models.ReactionLike)
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_Unreact() {
+	suite.mockRepo.On("DeleteReaction", uint(3), uint(4)).Return(models.ReactionLike, nil)
+	suite.expectCounts()
+	suite.mockHub.On("Publish", realtime.PostTopic(3), "reactions", mock.Anything).Once()
+
+	summary, err := suite.service.Unreact(4, 3)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "", summary.Mine)
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_Unreact_Error() {
+	suite.mockRepo.On("DeleteReaction", uint(3), uint(4)).Return("", suite.err)
+
+	_, err := suite.service.Unreact(4, 3)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_Reactors() {
+	reactions := []models.Reactions{{Handle: "ada"}, {Handle: "linus"}, {Handle: "grace"}}
+	for i := range reactions {
+		reactions[i].ID = uint(30 - i)
+	}
+
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockRepo.On("GetReactions", uint(3), models.ReactionLike, uint(0), 3).Return(reactions, nil).Once()
+
+	page, err := suite.service.Reactors(3, "like", "", 2, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Reactions, 2)
+
+	// The next page carries on before the last one listed
+	suite.mockRepo.On("GetReactions", uint(3), models.ReactionLike, uint(29), 3).Return(reactions[2:], nil).Once()
+
+	next, err := suite.service.Reactors(3, "like", page.NextCursor, 2, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), next.Reactions, 1)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_Reactions_FriendsOnly() {
+	suite.post.Audience = models.AudienceFriends
+
+	// Ada isn't one of grace's friends, so she can't tell the post exists
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "ada"}, nil)
+
+	_, err := suite.service.Reactions(3, 4)
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_Reactors_SignedOut() {
+	suite.post.Audience = models.AudienceFriends
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+
+	_, err := suite.service.Reactors(3, "like", "", 2, 0)
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *ReactionServiceUnitTestSuite) TestReactionService_React_MembersOnly() {
+	orgId := uint(2)
+	suite.post.Audience = models.AudienceMembers
+	suite.post.AudienceOrganizationID = &orgId
+
+	suite.mockPostsRepo.On("FindPost", "3").Return(suite.post, nil)
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "ada", OrganizationIDs: []uint{5}}, nil)
+
+	_, err := suite.service.React(4, 3, models.ReactionLike)
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
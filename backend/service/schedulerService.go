@@ -0,0 +1,423 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+const (
+	// How often the scheduler looks for due jobs
+	schedulerPoll = 30 * time.Second
+	// How long a claimed job is held before another instance may retry it
+	jobLease = 5 * time.Minute
+	// Jobs claimed at a time
+	jobBatch = 20
+	// Failing jobs are retried this many times in all, waiting attempts²
+	// minutes in between
+	jobAttempts = 5
+
+	// How often reminders for upcoming sign-ups are scheduled
+	reminderPlanInterval = 15 * time.Minute
+	// Reminders due longer ago than this when planned are not sent
+	reminderGrace = reminderPlanInterval
+	// Sign-ups more than this far from their occurrence date are looked
+	// at, as edits and shifts can move when they start
+	reminderSlack = 2 * 24 * time.Hour
+
+	// Digests go out on Mondays at this hour, UTC
+	digestWeekday = time.Monday
+	digestHour    = 9
+)
+
+// How long before the start of a sign-up its volunteer is reminded
+var reminderLeads = []time.Duration{24 * time.Hour, 2 * time.Hour}
+
+type SchedulerService interface {
+	Start(context.Context)
+	Seed(time.Time) error
+	RunDue(time.Time) (int, error)
+}
+
+type schedulerService struct {
+	jobRepository       repository.JobRepository
+	signupRepository    repository.SignupRepository
+	eventRepository     repository.EventRepository
+	shiftRepository     repository.ShiftRepository
+	feedRepository      repository.FeedRepository
+	notificationService NotificationService
+	pushService         PushService
+	worker              string
+}
+
+// Instantiated in router.go
+func NewSchedulerService(j repository.JobRepository, sr repository.SignupRepository, e repository.EventRepository, sh repository.ShiftRepository, f repository.FeedRepository, n NotificationService, p PushService) SchedulerService {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "scheduler"
+	}
+
+	return schedulerService{
+		jobRepository:       j,
+		signupRepository:    sr,
+		eventRepository:     e,
+		shiftRepository:     sh,
+		feedRepository:      f,
+		notificationService: n,
+		pushService:         p,
+		worker:              hostname + ":" + strconv.Itoa(os.Getpid()),
+	}
+}
+
+// Runs due jobs until ctx is done. Every instance of the app runs one, the
+// jobs are shared through the database.
+func (s schedulerService) Start(ctx context.Context) {
+	log.Println("[SchedulerService] Starting as", s.worker)
+
+	if err := s.Seed(time.Now()); err != nil {
+		log.Println("[SchedulerService] Could not seed jobs:", err)
+	}
+
+	ticker := time.NewTicker(schedulerPoll)
+	defer ticker.Stop()
+
+	for {
+		// Keep going while there is a backlog
+		for {
+			ran, err := s.RunDue(time.Now())
+			if err != nil {
+				log.Println("[SchedulerService] Could not run jobs:", err)
+			}
+			if err != nil || ran < jobBatch {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Println("[SchedulerService] Stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Schedules the planning jobs, which schedule themselves again after each
+// run. Instances seeding at the same time schedule the same jobs.
+func (s schedulerService) Seed(now time.Time) error {
+	if _, err := s.schedule(models.JobPlanReminders, planReminderKey(now), now.Truncate(reminderPlanInterval), nil); err != nil {
+		return err
+	}
+
+	next := nextDigest(now)
+	_, err := s.schedule(models.JobPlanDigests, planDigestKey(next), next, nil)
+
+	return err
+}
+
+// Claims and runs the jobs due at now. Returns how many were run.
+func (s schedulerService) RunDue(now time.Time) (int, error) {
+	jobs, err := s.jobRepository.ClaimJobs(s.worker, now, jobLease, jobBatch)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, job := range jobs {
+		s.finish(job, s.run(job, now), now)
+	}
+
+	return len(jobs), nil
+}
+
+func (s schedulerService) run(job models.ScheduledJobs, now time.Time) error {
+	switch job.Type {
+	case models.JobPlanReminders:
+		return s.planReminders(now)
+	case models.JobReminder:
+		var payload models.ReminderJob
+		if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+			return err
+		}
+		return s.remind(payload, now)
+	case models.JobPlanDigests:
+		return s.planDigests(job.RunAt, now)
+	case models.JobDigest:
+		var payload models.DigestJob
+		if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+			return err
+		}
+		return s.digest(payload)
+	case models.JobPush:
+		var payload models.PushJob
+		if err := json.Unmarshal([]byte(job.Payload), &payload); err != nil {
+			return err
+		}
+		return s.pushService.Retry(payload)
+	}
+
+	return errors.New("unknown job type " + job.Type)
+}
+
+// Completes the job, or puts it back to retry after attempts² minutes until
+// it runs out of attempts
+func (s schedulerService) finish(job models.ScheduledJobs, err error, now time.Time) {
+	if err == nil {
+		err = s.jobRepository.CompleteJob(job, now)
+	} else if job.Attempts >= jobAttempts {
+		log.Println("[SchedulerService] Job", job.Key, "failed:", err)
+		err = s.jobRepository.FailJob(job, err.Error())
+	} else {
+		log.Println("[SchedulerService] Job", job.Key, "will be retried:", err)
+		backoff := time.Duration(job.Attempts*job.Attempts) * time.Minute
+		err = s.jobRepository.RetryJob(job, err.Error(), now.Add(backoff))
+	}
+
+	if err != nil {
+		log.Println("[SchedulerService] Could not release job", job.Key+":", err)
+	}
+}
+
+func (s schedulerService) schedule(jobType string, key string, runAt time.Time, payload any) (bool, error) {
+	data := []byte{}
+	if payload != nil {
+		var err error
+		if data, err = json.Marshal(payload); err != nil {
+			return false, err
+		}
+	}
+
+	return s.jobRepository.ScheduleJob(models.ScheduledJobs{
+		Key:     key,
+		Type:    jobType,
+		Payload: string(data),
+		RunAt:   runAt,
+	})
+}
+
+// Schedules the reminders of the sign-ups starting in the next day or so,
+// then the next planning run. Reminders already scheduled are kept.
+func (s schedulerService) planReminders(now time.Time) error {
+	next := now.Truncate(reminderPlanInterval).Add(reminderPlanInterval)
+	if _, err := s.schedule(models.JobPlanReminders, planReminderKey(next), next, nil); err != nil {
+		return err
+	}
+
+	horizon := now.Add(reminderLeads[0] + 2*reminderPlanInterval)
+
+	signups, err := s.signupRepository.GetSignupsBetween(now.Add(-reminderSlack), horizon.Add(reminderSlack))
+	if err != nil {
+		return err
+	}
+
+	occurrences, err := resolveSignups(s.eventRepository, s.shiftRepository, signups)
+	if err != nil {
+		return err
+	}
+
+	for _, occurrence := range occurrences {
+		if occurrence.Cancelled || occurrence.Series.Status != models.EventPublished {
+			continue
+		}
+
+		start := occurrence.Entry().Start
+		if !start.After(now) || start.After(horizon) {
+			continue
+		}
+
+		for _, lead := range reminderLeads {
+			runAt := start.Add(-lead)
+			if runAt.Before(now.Add(-reminderGrace)) {
+				continue
+			}
+
+			payload := models.ReminderJob{
+				EventID:        occurrence.Signup.EventID,
+				UsersID:        occurrence.Signup.UsersID,
+				OccurrenceDate: occurrence.Signup.OccurrenceDate,
+				ShiftID:        occurrence.Signup.ShiftID,
+				Start:          start,
+				Lead:           lead,
+			}
+
+			if _, err := s.schedule(models.JobReminder, reminderKey(payload), runAt, payload); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Reminds the volunteer of the sign-up, unless they withdrew or it was
+// cancelled or moved since the reminder was scheduled
+func (s schedulerService) remind(payload models.ReminderJob, now time.Time) error {
+	if !payload.Start.After(now) {
+		return nil
+	}
+
+	signup, err := s.signupRepository.FindSignup(payload.EventID, payload.UsersID, payload.OccurrenceDate, payload.ShiftID)
+	if err != nil {
+		// Withdrawn
+		return nil
+	}
+
+	if signup.Event, err = s.eventRepository.GetEventById(strconv.FormatUint(uint64(payload.EventID), 10)); err != nil {
+		return nil
+	}
+
+	occurrences, err := resolveSignups(s.eventRepository, s.shiftRepository, []models.EventSignups{signup})
+	if err != nil {
+		return err
+	}
+
+	if len(occurrences) == 0 {
+		return nil
+	}
+
+	occurrence := occurrences[0]
+	entry := occurrence.Entry()
+
+	if occurrence.Cancelled || occurrence.Series.Status != models.EventPublished || !entry.Start.Equal(payload.Start) {
+		return nil
+	}
+
+	body := "It starts " + formatStart(occurrence.Event, entry.Start)
+	if occurrence.Event.Address != "" {
+		body += ", at " + occurrence.Event.Address
+	}
+
+	s.notificationService.Notify(models.Notifications{
+		UsersID:     payload.UsersID,
+		Type:        models.NotifyReminder,
+		Title:       fmt.Sprintf("Reminder: %s starts in %s", entry.Summary, leadText(payload.Lead)),
+		Body:        body + ".",
+		SubjectType: models.SubjectEvent,
+		SubjectID:   payload.EventID,
+	}, nil)
+
+	return nil
+}
+
+// Schedules the digest of each user following an organization for the week
+// up to the run, then the next week's run
+func (s schedulerService) planDigests(runAt time.Time, now time.Time) error {
+	next := nextDigest(now)
+	if _, err := s.schedule(models.JobPlanDigests, planDigestKey(next), next, nil); err != nil {
+		return err
+	}
+
+	userIds, err := s.feedRepository.FollowerIds()
+	if err != nil {
+		return err
+	}
+
+	for _, userId := range userIds {
+		payload := models.DigestJob{UsersID: userId, Since: runAt.AddDate(0, 0, -7), Until: runAt}
+		key := "digest:" + strconv.FormatUint(uint64(userId), 10) + ":" + runAt.UTC().Format("2006-01-02")
+
+		if _, err := s.schedule(models.JobDigest, key, now, payload); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Sends the user the upcoming events their organizations added during the
+// week that match their interests. Nothing is sent for a quiet week.
+func (s schedulerService) digest(payload models.DigestJob) error {
+	orgIds, err := s.feedRepository.FollowedOrganizationIds(payload.UsersID)
+	if err != nil {
+		return err
+	}
+
+	if len(orgIds) == 0 {
+		return nil
+	}
+
+	tagIds, err := s.feedRepository.InterestTagIds(payload.UsersID)
+	if err != nil {
+		return err
+	}
+
+	events, err := s.feedRepository.NewEvents(orgIds, tagIds, payload.Since, payload.Until)
+	if err != nil {
+		return err
+	}
+
+	if len(events) == 0 {
+		return nil
+	}
+
+	lines := []string{}
+	for _, event := range events {
+		lines = append(lines, "- "+event.Name+" by "+event.Organization.Name+", "+formatStart(event, event.Start))
+	}
+
+	title := "1 new event this week"
+	if len(events) > 1 {
+		title = strconv.Itoa(len(events)) + " new events this week"
+	}
+
+	s.notificationService.Notify(models.Notifications{
+		UsersID: payload.UsersID,
+		Type:    models.NotifyDigest,
+		Title:   title,
+		Body:    "New from the organizations you follow:\n\n" + strings.Join(lines, "\n"),
+	}, nil)
+
+	return nil
+}
+
+// Key of the planning run of the slot now falls in
+func planReminderKey(now time.Time) string {
+	return "plan_reminders:" + now.Truncate(reminderPlanInterval).UTC().Format(time.RFC3339)
+}
+
+func planDigestKey(at time.Time) string {
+	return "plan_digests:" + at.UTC().Format("2006-01-02")
+}
+
+// One reminder per sign-up, lead and start, so a moved occurrence gets
+// reminders for its new start
+func reminderKey(payload models.ReminderJob) string {
+	return fmt.Sprintf("reminder:%d:%d:%d:%s:%d:%d",
+		int64(payload.Lead/time.Minute), payload.EventID, payload.UsersID,
+		payload.OccurrenceDate.UTC().Format(time.RFC3339), payload.ShiftID, payload.Start.Unix())
+}
+
+// The first digest time after now
+func nextDigest(now time.Time) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), digestHour, 0, 0, 0, time.UTC)
+	next = next.AddDate(0, 0, (int(digestWeekday)-int(next.Weekday())+7)%7)
+
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+
+	return next
+}
+
+func leadText(lead time.Duration) string {
+	hours := int(lead / time.Hour)
+	if hours == 1 {
+		return "1 hour"
+	}
+
+	return strconv.Itoa(hours) + " hours"
+}
+
+// The start in the event's time zone
+func formatStart(event models.Event, start time.Time) string {
+	return start.In(event.Location()).Format("Mon Jan 2 at 3:04 PM MST")
+}
@@ -0,0 +1,275 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SchedulerServiceUnitTestSuite struct {
+	suite.Suite
+	mockJobRepo          *mocks.JobRepository
+	mockSignupRepo       *mocks.SignupRepository
+	mockEventRepo        *mocks.EventRepository
+	mockShiftRepo        *mocks.ShiftRepository
+	mockFeedRepo         *mocks.FeedRepository
+	mockNotificationServ *mocks.NotificationService
+	mockPushService      *mocks.PushService
+	service              SchedulerService
+	now                  time.Time
+	start                time.Time
+	series               models.Event
+	signup               models.EventSignups
+	err                  error
+}
+
+func (suite *SchedulerServiceUnitTestSuite) SetupTest() {
+	suite.mockJobRepo = new(mocks.JobRepository)
+	suite.mockSignupRepo = new(mocks.SignupRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockShiftRepo = new(mocks.ShiftRepository)
+	suite.mockFeedRepo = new(mocks.FeedRepository)
+	suite.mockNotificationServ = new(mocks.NotificationService)
+	suite.mockPushService = new(mocks.PushService)
+	suite.service = NewSchedulerService(suite.mockJobRepo, suite.mockSignupRepo, suite.mockEventRepo, suite.mockShiftRepo, suite.mockFeedRepo, suite.mockNotificationServ, suite.mockPushService)
+
+	// Saturday morning, a day before the park cleanup
+	suite.now = time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	suite.start = time.Date(2034, 4, 2, 9, 0, 0, 0, time.UTC)
+
+	suite.series = models.Event{
+		Name:   "Park cleanup",
+		Status: models.EventPublished,
+		Start:  suite.start,
+		End:    suite.start.Add(3 * time.Hour),
+	}
+	suite.series.ID = 1
+
+	suite.signup = models.EventSignups{EventID: 1, UsersID: 4, OccurrenceDate: suite.start, Event: suite.series}
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *SchedulerServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockJobRepo.AssertExpectations(suite.T())
+	suite.mockSignupRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockShiftRepo.AssertExpectations(suite.T())
+	suite.mockFeedRepo.AssertExpectations(suite.T())
+	suite.mockNotificationServ.AssertExpectations(suite.T())
+	suite.mockPushService.AssertExpectations(suite.T())
+}
+
+func TestSchedulerServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(SchedulerServiceUnitTestSuite))
+}
+
+// Expects a job with the key to be scheduled at runAt
+func (suite *SchedulerServiceUnitTestSuite) expectJob(key string, runAt time.Time) {
+	suite.mockJobRepo.On("ScheduleJob", mock.MatchedBy(func(job models.ScheduledJobs) bool {
+		return job.Key == key && job.RunAt.Equal(runAt)
+	})).Return(true, nil).Once()
+}
+
+// Expects the job to be claimed and then completed
+func (suite *SchedulerServiceUnitTestSuite) expectRun(job models.ScheduledJobs) {
+	suite.mockJobRepo.On("ClaimJobs", mock.Anything, suite.now, jobLease, jobBatch).Return([]models.ScheduledJobs{job}, nil)
+	suite.mockJobRepo.On("CompleteJob", job, suite.now).Return(nil)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) reminderJob(lead time.Duration) models.ScheduledJobs {
+	payload, _ := json.Marshal(models.ReminderJob{EventID: 1, UsersID: 4, OccurrenceDate: suite.start, Start: suite.start, Lead: lead})
+
+	return models.ScheduledJobs{Key: "reminder", Type: models.JobReminder, Payload: string(payload), Attempts: 1}
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Seed() {
+	suite.expectJob("plan_reminders:2034-04-01T09:00:00Z", suite.now)
+	// Next Monday
+	suite.expectJob("plan_digests:2034-04-03", time.Date(2034, 4, 3, 9, 0, 0, 0, time.UTC))
+
+	err := suite.service.Seed(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_PlanReminders() {
+	suite.expectRun(models.ScheduledJobs{Key: "plan_reminders", Type: models.JobPlanReminders, Attempts: 1})
+	suite.expectJob("plan_reminders:2034-04-01T09:15:00Z", suite.now.Add(reminderPlanInterval))
+
+	suite.mockSignupRepo.On("GetSignupsBetween", suite.now.Add(-reminderSlack), suite.now.Add(24*time.Hour+2*reminderPlanInterval+reminderSlack)).
+		Return([]models.EventSignups{suite.signup}, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	// A day and two hours before it starts
+	suite.expectJob("reminder:1440:1:4:2034-04-02T09:00:00Z:0:2027581200", suite.now)
+	suite.expectJob("reminder:120:1:4:2034-04-02T09:00:00Z:0:2027581200", suite.start.Add(-2*time.Hour))
+
+	ran, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), 1, ran)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_PlanReminders_Cancelled() {
+	suite.expectRun(models.ScheduledJobs{Key: "plan_reminders", Type: models.JobPlanReminders, Attempts: 1})
+	suite.expectJob("plan_reminders:2034-04-01T09:15:00Z", suite.now.Add(reminderPlanInterval))
+
+	suite.mockSignupRepo.On("GetSignupsBetween", mock.Anything, mock.Anything).Return([]models.EventSignups{suite.signup}, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{{EventID: 1, OccurrenceDate: suite.start}}, nil)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Remind() {
+	job := suite.reminderJob(24 * time.Hour)
+	suite.expectRun(job)
+
+	suite.mockSignupRepo.On("FindSignup", uint(1), uint(4), suite.start, uint(0)).Return(models.EventSignups{EventID: 1, UsersID: 4, OccurrenceDate: suite.start}, nil)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockNotificationServ.On("Notify", mock.MatchedBy(func(notification models.Notifications) bool {
+		return notification.UsersID == 4 && notification.Type == models.NotifyReminder &&
+			notification.Title == "Reminder: Park cleanup starts in 24 hours" && notification.SubjectID == 1
+	}), (*mailer.Message)(nil)).Return()
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Remind_Moved() {
+	job := suite.reminderJob(2 * time.Hour)
+	suite.expectRun(job)
+
+	// The occurrence now starts an hour later, its own reminder is sent
+	override := suite.series
+	override.ID = 7
+	override.SeriesID = &suite.series.ID
+	override.OccurrenceDate = &suite.start
+	override.Start = suite.start.Add(time.Hour)
+
+	suite.mockSignupRepo.On("FindSignup", uint(1), uint(4), suite.start, uint(0)).Return(models.EventSignups{EventID: 1, UsersID: 4, OccurrenceDate: suite.start}, nil)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{override}, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Remind_Withdrawn() {
+	job := suite.reminderJob(24 * time.Hour)
+	suite.expectRun(job)
+
+	suite.mockSignupRepo.On("FindSignup", uint(1), uint(4), suite.start, uint(0)).Return(models.EventSignups{}, suite.err)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Retry() {
+	job := models.ScheduledJobs{Key: "digest:4:2034-04-03", Type: models.JobDigest, Payload: `{"UsersID":4}`, Attempts: 2}
+
+	suite.mockJobRepo.On("ClaimJobs", mock.Anything, suite.now, jobLease, jobBatch).Return([]models.ScheduledJobs{job}, nil)
+	suite.mockFeedRepo.On("FollowedOrganizationIds", uint(4)).Return([]uint{}, suite.err)
+	// Waits attempts² minutes
+	suite.mockJobRepo.On("RetryJob", job, "error", suite.now.Add(4*time.Minute)).Return(nil)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Retry_GivesUp() {
+	job := models.ScheduledJobs{Key: "mystery", Type: "mystery", Attempts: jobAttempts}
+
+	suite.mockJobRepo.On("ClaimJobs", mock.Anything, suite.now, jobLease, jobBatch).Return([]models.ScheduledJobs{job}, nil)
+	suite.mockJobRepo.On("FailJob", job, "unknown job type mystery").Return(nil)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_PlanDigests() {
+	runAt := time.Date(2034, 4, 3, 9, 0, 0, 0, time.UTC)
+	suite.now = runAt.Add(time.Minute)
+
+	suite.expectRun(models.ScheduledJobs{Key: "plan_digests:2034-04-03", Type: models.JobPlanDigests, RunAt: runAt, Attempts: 1})
+	suite.expectJob("plan_digests:2034-04-10", runAt.AddDate(0, 0, 7))
+
+	suite.mockFeedRepo.On("FollowerIds").Return([]uint{4, 6}, nil)
+	suite.mockJobRepo.On("ScheduleJob", mock.MatchedBy(func(job models.ScheduledJobs) bool {
+		var payload models.DigestJob
+		_ = json.Unmarshal([]byte(job.Payload), &payload)
+
+		return job.Key == "digest:4:2034-04-03" && payload.Since.Equal(runAt.AddDate(0, 0, -7)) && payload.Until.Equal(runAt)
+	})).Return(true, nil).Once()
+	suite.expectJob("digest:6:2034-04-03", suite.now)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Digest() {
+	since := time.Date(2034, 3, 27, 9, 0, 0, 0, time.UTC)
+	until := since.AddDate(0, 0, 7)
+	payload, _ := json.Marshal(models.DigestJob{UsersID: 4, Since: since, Until: until})
+	suite.expectRun(models.ScheduledJobs{Key: "digest", Type: models.JobDigest, Payload: string(payload), Attempts: 1})
+
+	suite.series.Organization = models.Organization{Name: "Friends of the Park"}
+
+	suite.mockFeedRepo.On("FollowedOrganizationIds", uint(4)).Return([]uint{3}, nil)
+	suite.mockFeedRepo.On("InterestTagIds", uint(4)).Return([]uint{}, nil)
+	suite.mockFeedRepo.On("NewEvents", []uint{3}, []uint{}, since, until).Return([]models.Event{suite.series}, nil)
+	suite.mockNotificationServ.On("Notify", mock.MatchedBy(func(notification models.Notifications) bool {
+		return notification.Type == models.NotifyDigest && notification.Title == "1 new event this week" &&
+			notification.Body == "New from the organizations you follow:\n\n- Park cleanup by Friends of the Park, Sun Apr 2 at 9:00 AM UTC"
+	}), (*mailer.Message)(nil)).Return()
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Digest_QuietWeek() {
+	payload, _ := json.Marshal(models.DigestJob{UsersID: 4})
+	suite.expectRun(models.ScheduledJobs{Key: "digest", Type: models.JobDigest, Payload: string(payload), Attempts: 1})
+
+	suite.mockFeedRepo.On("FollowedOrganizationIds", uint(4)).Return([]uint{3}, nil)
+	suite.mockFeedRepo.On("InterestTagIds", uint(4)).Return([]uint{9}, nil)
+	suite.mockFeedRepo.On("NewEvents", []uint{3}, []uint{9}, time.Time{}, time.Time{}).Return([]models.Event{}, nil)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SchedulerServiceUnitTestSuite) TestSchedulerService_Push() {
+	payload := models.PushJob{Title: "Park cleanup has changed", Devices: []models.PushDevice{{Platform: "expo", Token: "a"}}, Attempt: 1}
+	data, _ := json.Marshal(payload)
+	suite.expectRun(models.ScheduledJobs{Key: "push:abc", Type: models.JobPush, Payload: string(data), Attempts: 1})
+
+	suite.mockPushService.On("Retry", payload).Return(nil)
+
+	_, err := suite.service.RunDue(suite.now)
+
+	assert.Nil(suite.T(), err)
+}
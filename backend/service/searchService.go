@@ -0,0 +1,124 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+// The longest window hashtags trend over
+const maxTrendingWindow = 30 * 24 * time.Hour
+
+type SearchService interface {
+	TrendingHashtags(window time.Duration, limit int) ([]models.TrendingHashtag, error)
+	TaggedPosts(tag string, cursor string, limit int, viewerId uint) (models.PostPage, error)
+	SearchPosts(query string, cursor string, limit int, viewerId uint) (models.PostPage, error)
+}
+
+type searchService struct {
+	searchRepository repository.SearchRepository
+	postsRepository  repository.PostsRepository
+}
+
+// Instantiated in router.go
+func NewSearchService(s repository.SearchRepository, p repository.PostsRepository) SearchService {
+	return searchService{
+		searchRepository: s,
+		postsRepository:  p,
+	}
+}
+
+// Lists the hashtags public posts and their comments used most over the
+// window, most used first
+func (s searchService) TrendingHashtags(window time.Duration, limit int) ([]models.TrendingHashtag, error) {
+	if window < time.Hour || window > maxTrendingWindow {
+		return []models.TrendingHashtag{}, errors.New("window must be between 1 hour and 30 days")
+	}
+
+	return s.searchRepository.TrendingHashtags(time.Now().Add(-window), limit)
+}
+
+// Lists a page of the posts using the hashtag that the user can see, latest
+// first. The tag may start with #.
+func (s searchService) TaggedPosts(tag string, cursor string, limit int, viewerId uint) (models.PostPage, error) {
+	tags := hashtags("#" + strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+	if len(tags) != 1 {
+		return models.PostPage{}, errors.New("invalid hashtag")
+	}
+
+	return s.postPage(cursor, limit, viewerId, func(viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+		return s.searchRepository.TaggedPosts(tags[0], viewer, beforeId, limit)
+	})
+}
+
+// Lists a page of the posts matching the query that the user can see, latest
+// first. A query of just #tag finds the posts using the hashtag, and one of
+// just @handle those mentioning the user, themselves or in their comments.
+func (s searchService) SearchPosts(query string, cursor string, limit int, viewerId uint) (models.PostPage, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return models.PostPage{}, errors.New("search text is required")
+	}
+
+	if !strings.ContainsAny(query, " \t\n") {
+		if strings.HasPrefix(query, "#") {
+			return s.TaggedPosts(query, cursor, limit, viewerId)
+		}
+
+		if handles := indexedHandles(query); len(handles) == 1 && strings.EqualFold(query, "@"+handles[0]) {
+			return s.postPage(cursor, limit, viewerId, func(viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+				return s.searchRepository.MentioningPosts(handles[0], viewer, beforeId, limit)
+			})
+		}
+	}
+
+	return s.postPage(cursor, limit, viewerId, func(viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error) {
+		return s.searchRepository.SearchPosts(query, viewer, beforeId, limit)
+	})
+}
+
+// Lists the page of posts find gives the user, from the cursor on
+func (s searchService) postPage(cursor string, limit int, viewerId uint, find func(viewer models.PostViewer, beforeId uint, limit int) ([]models.Posts, error)) (models.PostPage, error) {
+	var position postPosition
+	if err := decodeCursor(cursor, &position); err != nil {
+		return models.PostPage{}, err
+	}
+
+	viewer, err := findViewer(s.postsRepository, viewerId)
+	if err != nil {
+		return models.PostPage{}, err
+	}
+
+	// One extra tells whether there is another page
+	posts, err := find(viewer, position.ID, limit+1)
+	if err != nil {
+		return models.PostPage{}, err
+	}
+
+	page := models.PostPage{Posts: posts}
+	if len(posts) > limit {
+		page.Posts = posts[:limit]
+		page.NextCursor = encodeCursor(postPosition{ID: page.Posts[limit-1].ID})
+	}
+
+	return page, nil
+}
+
+// Indexes the hashtags and mentions in the post. Failing to is only logged.
+func indexPost(r repository.SearchRepository, post models.Posts) {
+	if err := r.IndexPost(post, hashtags(post.PostDescription), indexedHandles(post.PostDescription)); err != nil {
+		log.Println("[SearchService] Could not index post:", err)
+	}
+}
+
+// Indexes the hashtags and mentions in the comment. Failing to is only
+// logged.
+func indexComment(r repository.SearchRepository, comment models.Comments) {
+	if err := r.IndexComment(comment, hashtags(comment.CommentDescription), indexedHandles(comment.CommentDescription)); err != nil {
+		log.Println("[SearchService] Could not index comment:", err)
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SearchServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo      *mocks.SearchRepository
+	mockPostsRepo *mocks.PostsRepository
+	service       SearchService
+	viewer        models.PostViewer
+	err           error
+}
+
+func (suite *SearchServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.SearchRepository)
+	suite.mockPostsRepo = new(mocks.PostsRepository)
+	suite.service = NewSearchService(suite.mockRepo, suite.mockPostsRepo)
+
+	suite.viewer = models.PostViewer{Handle: "ada", FriendHandles: []string{"grace"}, OrganizationIDs: []uint{2}}
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *SearchServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockPostsRepo.AssertExpectations(suite.T())
+}
+
+func TestSearchServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchServiceUnitTestSuite))
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_TrendingHashtags() {
+	suite.mockRepo.On("TrendingHashtags", mock.MatchedBy(func(since time.Time) bool {
+		return time.Since(since) > 23*time.Hour && time.Since(since) < 25*time.Hour
+	}), 10).Return([]models.TrendingHashtag{{Tag: "cleanup", Uses: 4}}, nil)
+
+	trending, err := suite.service.TrendingHashtags(24*time.Hour, 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), "cleanup", trending[0].Tag)
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_TrendingHashtags_Window() {
+	_, err := suite.service.TrendingHashtags(0, 10)
+	assert.EqualError(suite.T(), err, "window must be between 1 hour and 30 days")
+
+	_, err = suite.service.TrendingHashtags(31*24*time.Hour, 10)
+	assert.EqualError(suite.T(), err, "window must be between 1 hour and 30 days")
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_TaggedPosts() {
+	posts := []models.Posts{{}, {}, {}}
+	for i := range posts {
+		posts[i].ID = uint(30 - i)
+	}
+
+	// Only the posts the viewer can see are looked for
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(suite.viewer, nil).Twice()
+	suite.mockRepo.On("TaggedPosts", "cleanup", suite.viewer, uint(0), 3).Return(posts, nil).Once()
+
+	page, err := suite.service.TaggedPosts("#Cleanup", "", 2, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), page.Posts, 2)
+
+	suite.mockRepo.On("TaggedPosts", "cleanup", suite.viewer, uint(29), 3).Return(posts[2:], nil).Once()
+
+	next, err := suite.service.TaggedPosts("cleanup", page.NextCursor, 2, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), next.Posts, 1)
+	assert.Equal(suite.T(), "", next.NextCursor)
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_TaggedPosts_Invalid() {
+	_, err := suite.service.TaggedPosts("#1", "", 20, 4)
+
+	assert.EqualError(suite.T(), err, "invalid hashtag")
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_SearchPosts_SignedOut() {
+	// Only public posts
+	suite.mockRepo.On("SearchPosts", "park cleanup", models.PostViewer{}, uint(0), 21).Return([]models.Posts{}, nil)
+
+	page, err := suite.service.SearchPosts(" park cleanup ", "", 20, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), page.Posts)
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_SearchPosts_Hashtag() {
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(suite.viewer, nil)
+	suite.mockRepo.On("TaggedPosts", "park", suite.viewer, uint(0), 21).Return([]models.Posts{}, nil)
+
+	_, err := suite.service.SearchPosts("#park", "", 20, 4)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_SearchPosts_Mention() {
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(suite.viewer, nil)
+	suite.mockRepo.On("MentioningPosts", "grace", suite.viewer, uint(0), 21).Return([]models.Posts{}, nil)
+
+	_, err := suite.service.SearchPosts("@Grace", "", 20, 4)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_SearchPosts_Empty() {
+	_, err := suite.service.SearchPosts("  ", "", 20, 4)
+
+	assert.EqualError(suite.T(), err, "search text is required")
+}
+
+func (suite *SearchServiceUnitTestSuite) TestSearchService_SearchPosts_ViewerError() {
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(models.PostViewer{}, suite.err)
+
+	_, err := suite.service.SearchPosts("cleanup", "", 20, 4)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
@@ -0,0 +1,207 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+type ShiftService interface {
+	CreateShift(uint, models.EventShifts, []uint, uint) (models.EventShifts, error)
+	GetShifts(uint, time.Time, uint) ([]models.ShiftOccurrence, error)
+	GetShiftById(uint) (models.EventShifts, error)
+	UpdateShift(models.EventShifts, models.EventShifts, []uint, uint) (models.EventShifts, error)
+	DeleteShift(models.EventShifts, uint) error
+}
+
+type shiftService struct {
+	shiftRepository    repository.ShiftRepository
+	eventRepository    repository.EventRepository
+	tagRepository      repository.TagRepository
+	orgUsersRepository repository.OrgUsersRepository
+}
+
+// Instantiated in router.go
+func NewShiftService(r repository.ShiftRepository, e repository.EventRepository, t repository.TagRepository, o repository.OrgUsersRepository) ShiftService {
+	return shiftService{
+		shiftRepository:    r,
+		eventRepository:    e,
+		tagRepository:      t,
+		orgUsersRepository: o,
+	}
+}
+
+// Adds a shift to an event. Shifts of a recurring event are added to the
+// series and repeat with every occurrence. Only managers of the event's
+// organization can, while it can still be edited.
+func (s shiftService) CreateShift(eventId uint, shift models.EventShifts, skillIds []uint, userId uint) (models.EventShifts, error) {
+	log.Println("[ShiftService] Create shift...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.EventShifts{}, err
+	}
+
+	if event.SeriesID != nil {
+		return models.EventShifts{}, errors.New("shifts belong to the series, add them to event " +
+			strconv.FormatUint(uint64(*event.SeriesID), 10))
+	}
+
+	if err := s.checkManaged(event, userId); err != nil {
+		return models.EventShifts{}, err
+	}
+
+	shift.EventID = event.ID
+	if shift.Skills, err = s.validateShift(shift, skillIds); err != nil {
+		return models.EventShifts{}, err
+	}
+
+	return s.shiftRepository.CreateShift(shift)
+}
+
+// Lists the shifts of an event at one occurrence, with how many volunteers
+// signed up to each. A zero occurrence means the first one. Shifts of
+// drafts are only visible to their organization's staff.
+func (s shiftService) GetShifts(eventId uint, occurrence time.Time, viewerId uint) ([]models.ShiftOccurrence, error) {
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return []models.ShiftOccurrence{}, err
+	}
+
+	if err := checkVisible(s.orgUsersRepository, event, viewerId); err != nil {
+		return []models.ShiftOccurrence{}, err
+	}
+
+	if occurrence.IsZero() {
+		occurrence = event.Start
+	}
+
+	series, date, start, err := resolveOccurrence(s.eventRepository, event, occurrence)
+	if err != nil {
+		return []models.ShiftOccurrence{}, err
+	}
+
+	shifts, err := s.shiftRepository.GetShifts(series.ID)
+	if err != nil {
+		return []models.ShiftOccurrence{}, err
+	}
+
+	occurrences := []models.ShiftOccurrence{}
+	for _, shift := range shifts {
+		count, err := s.shiftRepository.CountShiftSignups(shift.ID, date)
+		if err != nil {
+			return []models.ShiftOccurrence{}, err
+		}
+
+		shiftStart, shiftEnd := shiftWindow(shift, series, start)
+		occurrences = append(occurrences, models.ShiftOccurrence{
+			Shift:    shift,
+			Start:    shiftStart,
+			End:      shiftEnd,
+			SignedUp: count,
+		})
+	}
+
+	return occurrences, nil
+}
+
+func (s shiftService) GetShiftById(id uint) (models.EventShifts, error) {
+	return s.shiftRepository.GetShiftById(id)
+}
+
+// Replaces the details and required skills of a shift, as a manager of
+// its event's organization
+func (s shiftService) UpdateShift(shift models.EventShifts, changes models.EventShifts, skillIds []uint, userId uint) (models.EventShifts, error) {
+	log.Println("[ShiftService] Update shift...")
+
+	if err := s.checkShiftManaged(shift, userId); err != nil {
+		return models.EventShifts{}, err
+	}
+
+	changes.Model = shift.Model
+	changes.EventID = shift.EventID
+
+	var err error
+	if changes.Skills, err = s.validateShift(changes, skillIds); err != nil {
+		return models.EventShifts{}, err
+	}
+
+	return s.shiftRepository.UpdateShift(changes)
+}
+
+// Removes a shift, as a manager of its event's organization. Shifts anyone
+// signed up to stay, their volunteers would be left without one.
+func (s shiftService) DeleteShift(shift models.EventShifts, userId uint) error {
+	log.Println("[ShiftService] Delete shift...")
+
+	if err := s.checkShiftManaged(shift, userId); err != nil {
+		return err
+	}
+
+	signedUp, err := s.shiftRepository.HasSignups(shift.ID)
+	if err != nil {
+		return err
+	}
+
+	if signedUp {
+		return errors.New("volunteers are signed up to this shift")
+	}
+
+	return s.shiftRepository.DeleteShift(shift)
+}
+
+// Fails unless the user manages the organization of the shift's event and
+// the event can still be edited
+func (s shiftService) checkShiftManaged(shift models.EventShifts, userId uint) error {
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(shift.EventID), 10))
+	if err != nil {
+		return err
+	}
+
+	return s.checkManaged(event, userId)
+}
+
+// Fails unless the user manages the event's organization and the event can
+// still be edited
+func (s shiftService) checkManaged(event models.Event, userId uint) error {
+	if err := requireManager(s.orgUsersRepository, userId, event.OrganizationID); err != nil {
+		return err
+	}
+
+	return checkEditable(event)
+}
+
+// Checks the times and capacity of a shift and returns its required
+// skills, which must all be skill tags
+func (s shiftService) validateShift(shift models.EventShifts, skillIds []uint) ([]models.Tags, error) {
+	if !shift.End.After(shift.Start) {
+		return []models.Tags{}, errors.New("end must be after start")
+	}
+
+	if shift.Capacity < 0 {
+		return []models.Tags{}, errors.New("capacity must not be negative")
+	}
+
+	skillIds = uniqueIds(skillIds)
+
+	skills, err := s.tagRepository.GetTagsByIds(skillIds)
+	if err != nil {
+		return []models.Tags{}, err
+	}
+
+	if len(skills) != len(skillIds) {
+		return []models.Tags{}, errors.New("unknown skill id")
+	}
+
+	for _, skill := range skills {
+		if skill.Category != models.TagSkill {
+			return []models.Tags{}, errors.New(skill.Name + " is not a skill")
+		}
+	}
+
+	return skills, nil
+}
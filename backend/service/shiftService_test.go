@@ -0,0 +1,270 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ShiftServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.ShiftRepository
+	mockEventRepo    *mocks.EventRepository
+	mockTagRepo      *mocks.TagRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	service          ShiftService
+	event            models.Event
+	err              error
+}
+
+func (suite *ShiftServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.ShiftRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockTagRepo = new(mocks.TagRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.service = NewShiftService(suite.mockRepo, suite.mockEventRepo, suite.mockTagRepo, suite.mockOrgUsersRepo)
+
+	suite.event = models.Event{OrganizationID: 2, Status: models.EventPublished}
+	suite.event.ID = 1
+	suite.event.Start = time.Date(2023, 4, 1, 8, 0, 0, 0, time.UTC)
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *ShiftServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockTagRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestShiftServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(ShiftServiceUnitTestSuite))
+}
+
+// Expects user 7 to manage the event's organization
+func (suite *ShiftServiceUnitTestSuite) expectManager() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil).Once()
+}
+
+func (suite *ShiftServiceUnitTestSuite) shift(from int, to int) models.EventShifts {
+	return models.EventShifts{
+		Role:  "Cook",
+		Start: suite.event.Start.Add(time.Duration(from) * time.Hour),
+		End:   suite.event.Start.Add(time.Duration(to) * time.Hour),
+	}
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_CreateShift_EndBeforeStart() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+
+	_, err := suite.service.CreateShift(1, suite.shift(2, 1), []uint{}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_CreateShift_NotASkill() {
+	food := models.Tags{Name: "Food", Category: models.TagCauseArea}
+	food.ID = 4
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+	suite.mockTagRepo.On("GetTagsByIds", []uint{4}).Return([]models.Tags{food}, nil)
+
+	_, err := suite.service.CreateShift(1, suite.shift(0, 2), []uint{4, 4}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_CreateShift_EditedOccurrence() {
+	seriesId := uint(3)
+	suite.event.SeriesID = &seriesId
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+
+	_, err := suite.service.CreateShift(1, suite.shift(0, 2), []uint{}, 7)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_CreateShift() {
+	cooking := models.Tags{Name: "Cooking", Category: models.TagSkill}
+	cooking.ID = 5
+	expected := suite.shift(0, 2)
+	expected.EventID = 1
+	expected.Skills = []models.Tags{cooking}
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+	suite.mockTagRepo.On("GetTagsByIds", []uint{5}).Return([]models.Tags{cooking}, nil)
+	suite.mockRepo.On("CreateShift", expected).Return(expected, nil)
+
+	res, err := suite.service.CreateShift(1, suite.shift(0, 2), []uint{5}, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), expected, res)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_GetShifts_Occurrence() {
+	suite.event.Recurrence = "FREQ=WEEKLY"
+	occurrence := suite.event.Start.AddDate(0, 0, 7)
+	shift := suite.shift(2, 4)
+	shift.ID = 6
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.mockRepo.On("GetShifts", uint(1)).Return([]models.EventShifts{shift}, nil)
+	suite.mockRepo.On("CountShiftSignups", uint(6), occurrence).Return(int64(2), nil)
+
+	res, err := suite.service.GetShifts(1, occurrence, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+	assert.Equal(suite.T(), occurrence.Add(2*time.Hour), res[0].Start)
+	assert.Equal(suite.T(), occurrence.Add(4*time.Hour), res[0].End)
+	assert.Equal(suite.T(), int64(2), res[0].SignedUp)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_CreateShift_NotManager() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	_, err := suite.service.CreateShift(1, suite.shift(0, 2), []uint{}, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateShift", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_CreateShift_Cancelled() {
+	suite.event.Status = models.EventCancelled
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+
+	_, err := suite.service.CreateShift(1, suite.shift(0, 2), []uint{}, 7)
+
+	assert.NotNil(suite.T(), err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "CreateShift", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_UpdateShift() {
+	shift := suite.shift(0, 2)
+	shift.ID = 6
+	shift.EventID = 1
+	changes := suite.shift(1, 3)
+	expected := changes
+	expected.Model = shift.Model
+	expected.EventID = 1
+	expected.Skills = []models.Tags{}
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+	suite.mockTagRepo.On("GetTagsByIds", []uint{}).Return([]models.Tags{}, nil)
+	suite.mockRepo.On("UpdateShift", expected).Return(expected, nil)
+
+	res, err := suite.service.UpdateShift(shift, changes, []uint{}, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), expected, res)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_UpdateShift_NotManager() {
+	shift := suite.shift(0, 2)
+	shift.EventID = 1
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.UpdateShift(shift, suite.shift(1, 3), []uint{}, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateShift", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_UpdateShift_Completed() {
+	shift := suite.shift(0, 2)
+	shift.EventID = 1
+	suite.event.Status = models.EventCompleted
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+
+	_, err := suite.service.UpdateShift(shift, suite.shift(1, 3), []uint{}, 7)
+
+	assert.NotNil(suite.T(), err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "UpdateShift", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_DeleteShift() {
+	shift := suite.shift(0, 2)
+	shift.ID = 6
+	shift.EventID = 1
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+	suite.mockRepo.On("HasSignups", uint(6)).Return(false, nil)
+	suite.mockRepo.On("DeleteShift", shift).Return(nil)
+
+	err := suite.service.DeleteShift(shift, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_DeleteShift_SignedUp() {
+	shift := suite.shift(0, 2)
+	shift.ID = 6
+	shift.EventID = 1
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.expectManager()
+	suite.mockRepo.On("HasSignups", uint(6)).Return(true, nil)
+
+	err := suite.service.DeleteShift(shift, 7)
+
+	assert.NotNil(suite.T(), err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "DeleteShift", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_DeleteShift_NotManager() {
+	shift := suite.shift(0, 2)
+	shift.ID = 6
+	shift.EventID = 1
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	err := suite.service.DeleteShift(shift, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "DeleteShift", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_GetShifts_Draft() {
+	suite.event.Status = models.EventDraft
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.GetShifts(1, time.Time{}, 4)
+
+	assert.NotNil(suite.T(), err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetShifts", mock.Anything)
+}
+
+func (suite *ShiftServiceUnitTestSuite) TestShiftService_GetShifts_DraftStaff() {
+	suite.event.Status = models.EventDraft
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+	suite.mockRepo.On("GetShifts", uint(1)).Return([]models.EventShifts{}, nil)
+
+	res, err := suite.service.GetShifts(1, time.Time{}, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), res)
+}
@@ -0,0 +1,377 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/ical"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+	"gorm.io/gorm"
+)
+
+type SignupService interface {
+	SignUp(uint, uint, time.Time, uint) (models.EventSignups, error)
+	Withdraw(uint, uint, time.Time, uint) error
+	GetSignups(uint, time.Time, uint) ([]models.EventSignups, error)
+}
+
+type signupService struct {
+	signupRepository   repository.SignupRepository
+	eventRepository    repository.EventRepository
+	shiftRepository    repository.ShiftRepository
+	tagRepository      repository.TagRepository
+	usersRepository    repository.UsersRepository
+	waiverRepository   repository.WaiverRepository
+	consentRepository  repository.GuardianConsentRepository
+	mailer             mailer.Mailer
+	notifications      NotificationService
+	hub                realtime.Hub
+	orgUsersRepository repository.OrgUsersRepository
+}
+
+// Instantiated in router.go
+func NewSignupService(r repository.SignupRepository, e repository.EventRepository, sh repository.ShiftRepository, t repository.TagRepository, u repository.UsersRepository, w repository.WaiverRepository, c repository.GuardianConsentRepository, m mailer.Mailer, n NotificationService, h realtime.Hub, o repository.OrgUsersRepository) SignupService {
+	return signupService{
+		signupRepository:   r,
+		eventRepository:    e,
+		shiftRepository:    sh,
+		tagRepository:      t,
+		usersRepository:    u,
+		waiverRepository:   w,
+		consentRepository:  c,
+		mailer:             m,
+		notifications:      n,
+		hub:                h,
+		orgUsersRepository: o,
+	}
+}
+
+// Signs the user up to an event. For a recurring event occurrence is the
+// start of the occurrence to sign up to; it is ignored otherwise. Events
+// with shifts are signed up to one shift at a time, which must have room
+// left, match the user's skills and not overlap another of their shifts.
+// The user must have accepted the event's waivers and meet its minimum
+// age and background check; minors need their guardian's consent. They
+// are emailed a calendar invite for it and notified in the app.
+func (s signupService) SignUp(eventId uint, userId uint, occurrence time.Time, shiftId uint) (models.EventSignups, error) {
+	log.Println("[SignupService] Sign up...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.EventSignups{}, err
+	}
+
+	series, date, start, err := resolveOccurrence(s.eventRepository, event, occurrence)
+	if err != nil {
+		return models.EventSignups{}, err
+	}
+
+	if err := checkSignupsOpen(series); err != nil {
+		return models.EventSignups{}, err
+	}
+
+	if start.Before(time.Now()) {
+		return models.EventSignups{}, errors.New("event has already started")
+	}
+
+	req, err := eventRequirements(s.waiverRepository, s.consentRepository, s.usersRepository, series, userId, start)
+	if err != nil {
+		return models.EventSignups{}, err
+	}
+
+	if !req.Met {
+		return models.EventSignups{}, unmetRequirement(req)
+	}
+
+	shifts, err := s.shiftRepository.GetShifts(series.ID)
+	if err != nil {
+		return models.EventSignups{}, err
+	}
+
+	if len(shifts) == 0 && shiftId != 0 {
+		return models.EventSignups{}, errors.New("event has no shifts")
+	}
+
+	var shift *models.EventShifts
+	if len(shifts) > 0 {
+		for i := range shifts {
+			if shifts[i].ID == shiftId {
+				shift = &shifts[i]
+			}
+		}
+
+		if shift == nil {
+			return models.EventSignups{}, errors.New("a shift of this event is required")
+		}
+
+		if err := s.checkShift(userId, *shift, series, date, start); err != nil {
+			return models.EventSignups{}, err
+		}
+	}
+
+	if _, err := s.signupRepository.FindSignup(series.ID, userId, date, shiftId); err == nil {
+		return models.EventSignups{}, errors.New("already signed up")
+	}
+
+	signup, err := s.signupRepository.CreateSignup(models.EventSignups{
+		EventID:        series.ID,
+		UsersID:        userId,
+		OccurrenceDate: date,
+		ShiftID:        shiftId,
+	})
+	if err != nil {
+		return models.EventSignups{}, err
+	}
+
+	s.sendInvite(ical.Request, signup, series, occurrenceEvent(event, series), start, shift)
+
+	s.hub.Publish(realtime.EventTopic(series.ID), "signup", signup)
+
+	s.notifications.Notify(models.Notifications{
+		UsersID:     userId,
+		Type:        models.NotifySignup,
+		Title:       "You're signed up for " + occurrenceEvent(event, series).Name,
+		Body:        "See you on " + start.In(series.Location()).Format("Monday, January 2 2006 at 3:04 PM MST") + ".",
+		SubjectType: models.SubjectEvent,
+		SubjectID:   series.ID,
+	}, nil)
+
+	return signup, nil
+}
+
+// Sign-ups only change while the event is published. Completed events
+// keep theirs for hour verification, cancelled ones for history.
+func checkSignupsOpen(event models.Event) error {
+	switch event.Status {
+	case models.EventDraft:
+		return errors.New("event is not published yet")
+	case models.EventCancelled:
+		return errors.New("event is cancelled")
+	case models.EventCompleted:
+		return errors.New("event is completed, sign-ups are closed")
+	}
+
+	return nil
+}
+
+// The event signed up to through event: its edited occurrence, or the
+// series itself
+func occurrenceEvent(event models.Event, series models.Event) models.Event {
+	if event.SeriesID != nil {
+		return event
+	}
+	return series
+}
+
+// Emails the user a calendar invite for their sign-up, or its cancellation.
+// Failing to send is only logged, the sign-up stands either way.
+func (s signupService) sendInvite(method string, signup models.EventSignups, series models.Event, event models.Event, start time.Time, shift *models.EventShifts) {
+	var user models.Users
+	user, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(signup.UsersID), 10), user)
+	if err != nil {
+		log.Println("[SignupService] Could not find user to email:", err)
+		return
+	}
+
+	entry := signupEvent(signup, series, event, start, shift)
+	entry.Organizer = s.mailer.From()
+	entry.Attendees = []string{user.Email}
+
+	when := entry.Start.In(event.Location()).Format("Monday, January 2 2006 at 3:04 PM MST")
+	subject := "You're signed up: " + entry.Summary
+	body := "You are signed up for " + entry.Summary + " on " + when + ". The attached invite adds it to your calendar."
+
+	if method == ical.Cancel {
+		entry.Status = ical.Cancelled
+		entry.Sequence = 1
+		subject = "Sign-up withdrawn: " + entry.Summary
+		body = "You are no longer signed up for " + entry.Summary + " on " + when + "."
+	}
+
+	err = s.mailer.Send(mailer.Message{
+		To:          user.Email,
+		Subject:     subject,
+		Body:        body,
+		Attachments: []mailer.Attachment{inviteAttachment("invite.ics", method, entry)},
+	})
+	if err != nil {
+		log.Println("[SignupService] Could not email invite:", err)
+	}
+}
+
+// Checks that the user can take the shift at the occurrence of series with
+// the original start date, which starts at start. Whether it is full is
+// checked as the sign-up is made.
+func (s signupService) checkShift(userId uint, shift models.EventShifts, series models.Event, date time.Time, start time.Time) error {
+	if len(shift.Skills) > 0 {
+		tags, err := s.tagRepository.GetOwnerTags(&models.Users{Model: gorm.Model{ID: userId}})
+		if err != nil {
+			return err
+		}
+
+		has := map[uint]bool{}
+		for _, tag := range tags {
+			has[tag.ID] = true
+		}
+
+		missing := []string{}
+		for _, skill := range shift.Skills {
+			if !has[skill.ID] {
+				missing = append(missing, skill.Name)
+			}
+		}
+
+		if len(missing) > 0 {
+			return errors.New("missing required skills: " + strings.Join(missing, ", "))
+		}
+	}
+
+	shiftStart, shiftEnd := shiftWindow(shift, series, start)
+
+	return s.checkConflicts(userId, shift.ID, date, shiftStart, shiftEnd)
+}
+
+// Fails when the user already has a shift overlapping [start, end)
+func (s signupService) checkConflicts(userId uint, shiftId uint, date time.Time, start time.Time, end time.Time) error {
+	// Shifts may start a while before or after their occurrence
+	signups, err := s.signupRepository.GetUserSignups(userId, start.AddDate(0, 0, -7))
+	if err != nil {
+		return err
+	}
+
+	shiftIds := []uint{}
+	seriesIds := []uint{}
+	for _, signup := range signups {
+		if signup.ShiftID != 0 {
+			shiftIds = append(shiftIds, signup.ShiftID)
+			seriesIds = append(seriesIds, signup.EventID)
+		}
+	}
+
+	if len(shiftIds) == 0 {
+		return nil
+	}
+
+	shifts, err := s.shiftRepository.GetShiftsByIds(uniqueIds(shiftIds))
+	if err != nil {
+		return err
+	}
+
+	overrides, err := s.eventRepository.GetOverrides(uniqueIds(seriesIds))
+	if err != nil {
+		return err
+	}
+
+	byId := map[uint]models.EventShifts{}
+	for _, shift := range shifts {
+		byId[shift.ID] = shift
+	}
+
+	moved := map[occurrenceKey]time.Time{}
+	for _, override := range overrides {
+		if override.SeriesID != nil && override.OccurrenceDate != nil {
+			moved[keyOf(*override.SeriesID, *override.OccurrenceDate)] = override.Start
+		}
+	}
+
+	for _, signup := range signups {
+		shift, ok := byId[signup.ShiftID]
+		if !ok || (signup.ShiftID == shiftId && signup.OccurrenceDate.Equal(date)) {
+			continue
+		}
+
+		occurrenceStart := signup.OccurrenceDate
+		if movedTo, ok := moved[keyOf(signup.EventID, signup.OccurrenceDate)]; ok {
+			occurrenceStart = movedTo
+		}
+
+		otherStart, otherEnd := shiftWindow(shift, signup.Event, occurrenceStart)
+		if otherStart.Before(end) && start.Before(otherEnd) {
+			return errors.New("overlaps your " + shift.Role + " shift at " + signup.Event.Name)
+		}
+	}
+
+	return nil
+}
+
+// Withdraws the user's sign-up to an event, or to an occurrence or shift
+// of it, and emails the user a cancellation of its invite
+func (s signupService) Withdraw(eventId uint, userId uint, occurrence time.Time, shiftId uint) error {
+	log.Println("[SignupService] Withdraw...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return err
+	}
+
+	if err := checkSignupsOpen(event); err != nil {
+		return err
+	}
+
+	seriesId, date := seriesOccurrence(event, occurrence)
+
+	if err := s.signupRepository.DeleteSignup(seriesId, userId, date, shiftId); err != nil {
+		return err
+	}
+
+	s.hub.Publish(realtime.EventTopic(seriesId), "withdrawal", models.EventSignups{
+		EventID:        seriesId,
+		UsersID:        userId,
+		OccurrenceDate: date,
+		ShiftID:        shiftId,
+	})
+
+	// Nothing to cancel in calendars when the occurrence itself is gone
+	series, date, start, err := resolveOccurrence(s.eventRepository, event, occurrence)
+	if err != nil {
+		return nil
+	}
+
+	var shift *models.EventShifts
+	if shiftId != 0 {
+		found, err := s.shiftRepository.GetShiftById(shiftId)
+		if err != nil {
+			return nil
+		}
+		shift = &found
+	}
+
+	signup := models.EventSignups{EventID: series.ID, UsersID: userId, OccurrenceDate: date, ShiftID: shiftId}
+	s.sendInvite(ical.Cancel, signup, series, occurrenceEvent(event, series), start, shift)
+
+	return nil
+}
+
+// Lists the sign-ups to an event. For a recurring event a zero occurrence
+// lists those of every occurrence. The organization's staff see everyone's,
+// other users only their own.
+func (s signupService) GetSignups(eventId uint, occurrence time.Time, userId uint) ([]models.EventSignups, error) {
+	log.Println("[SignupService] Get sign-ups...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return []models.EventSignups{}, err
+	}
+
+	seriesId, date := seriesOccurrence(event, occurrence)
+
+	signups, err := s.signupRepository.GetSignups(seriesId, date)
+	if err != nil || isStaff(s.orgUsersRepository, userId, event.OrganizationID) {
+		return signups, err
+	}
+
+	own := []models.EventSignups{}
+	for _, signup := range signups {
+		if signup.UsersID == userId {
+			own = append(own, signup)
+		}
+	}
+
+	return own, nil
+}
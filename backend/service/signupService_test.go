@@ -0,0 +1,555 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mailer"
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SignupServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo        *mocks.SignupRepository
+	mockEventRepo   *mocks.EventRepository
+	mockShiftRepo   *mocks.ShiftRepository
+	mockTagRepo     *mocks.TagRepository
+	mockUsersRepo   *mocks.UsersRepository
+	mockWaiverRepo  *mocks.WaiverRepository
+	mockConsentRepo *mocks.GuardianConsentRepository
+	mockMailer      *mocks.Mailer
+	notifications   *mocks.NotificationService
+	mockHub         *mocks.Hub
+	mockOrgUsers    *mocks.OrgUsersRepository
+	service         SignupService
+	series          models.Event
+	err             error
+}
+
+func (suite *SignupServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.SignupRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockShiftRepo = new(mocks.ShiftRepository)
+	suite.mockTagRepo = new(mocks.TagRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.mockWaiverRepo = new(mocks.WaiverRepository)
+	suite.mockConsentRepo = new(mocks.GuardianConsentRepository)
+	suite.mockMailer = new(mocks.Mailer)
+	suite.notifications = new(mocks.NotificationService)
+	suite.mockHub = new(mocks.Hub)
+	suite.mockOrgUsers = new(mocks.OrgUsersRepository)
+	suite.service = NewSignupService(suite.mockRepo, suite.mockEventRepo, suite.mockShiftRepo, suite.mockTagRepo,
+		suite.mockUsersRepo, suite.mockWaiverRepo, suite.mockConsentRepo, suite.mockMailer, suite.notifications, suite.mockHub, suite.mockOrgUsers)
+
+	// Weekly, starting next week
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 7)
+	suite.series.ID = 1
+	suite.series.Start = start
+	suite.series.Recurrence = "FREQ=WEEKLY"
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *SignupServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockShiftRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsers.AssertExpectations(suite.T())
+	suite.mockTagRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+	suite.mockWaiverRepo.AssertExpectations(suite.T())
+	suite.mockConsentRepo.AssertExpectations(suite.T())
+	suite.mockMailer.AssertExpectations(suite.T())
+	suite.notifications.AssertExpectations(suite.T())
+	suite.mockHub.AssertExpectations(suite.T())
+}
+
+// Expects the event to require no waivers
+func (suite *SignupServiceUnitTestSuite) expectNoWaivers(event models.Event) {
+	suite.mockWaiverRepo.On("GetEventWaivers", event.OrganizationID, event.ID).Return([]models.Waivers{}, nil)
+}
+
+// Expects user 4, an adult, to be looked up once for the requirements
+func (suite *SignupServiceUnitTestSuite) expectAdult() {
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).
+		Return(models.Users{Email: "ada@example.com", Birthdate: "1990-05-01"}, nil).Once()
+}
+
+// Expects user 4 to be notified of their sign-up to the event with id,
+// and its roster to be updated
+func (suite *SignupServiceUnitTestSuite) expectNotice(eventId uint) {
+	suite.mockHub.On("Publish", realtime.EventTopic(eventId), "signup", mock.Anything).Once()
+	suite.notifications.On("Notify", mock.MatchedBy(func(n models.Notifications) bool {
+		return n.UsersID == 4 && n.Type == models.NotifySignup &&
+			n.SubjectType == models.SubjectEvent && n.SubjectID == eventId
+	}), (*mailer.Message)(nil)).Once()
+}
+
+// Expects user 4 to be emailed an invite with the calendar method
+func (suite *SignupServiceUnitTestSuite) expectInvite(method string, check func(string) bool) {
+	var user models.Users
+	user.ID = 4
+	user.Email = "ada@example.com"
+
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(user, nil)
+	suite.mockMailer.On("From").Return("events@volunteerone.org")
+	suite.mockMailer.On("Send", mock.MatchedBy(func(message mailer.Message) bool {
+		if message.To != user.Email || len(message.Attachments) != 1 {
+			return false
+		}
+
+		attachment := message.Attachments[0]
+		cal := string(attachment.Data)
+
+		return attachment.ContentType == "text/calendar; charset=utf-8; method="+method &&
+			strings.Contains(cal, "METHOD:"+method+"\r\n") &&
+			strings.Contains(cal, "ATTENDEE;ROLE=REQ-PARTICIPANT;RSVP=FALSE:mailto:ada@example.com\r\n") &&
+			check(cal)
+	})).Return(nil)
+}
+
+func TestSignupServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(SignupServiceUnitTestSuite))
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_Occurrence() {
+	occurrence := suite.series.Start.AddDate(0, 0, 14)
+	expected := models.EventSignups{EventID: 1, UsersID: 4, OccurrenceDate: occurrence}
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.expectNoWaivers(suite.series)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(1)).Return([]models.EventShifts{}, nil)
+	suite.mockRepo.On("FindSignup", uint(1), uint(4), occurrence, uint(0)).Return(models.EventSignups{}, suite.err)
+	suite.mockRepo.On("CreateSignup", expected).Return(expected, nil)
+	suite.expectNotice(expected.EventID)
+	suite.expectInvite("REQUEST", func(cal string) bool {
+		return strings.Contains(cal, "DTSTART:"+occurrence.Format("20060102T150405Z")+"\r\n") &&
+			!strings.Contains(cal, "RRULE")
+	})
+
+	res, err := suite.service.SignUp(1, 4, occurrence, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), expected, res)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_NotAnOccurrence() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+
+	_, err := suite.service.SignUp(1, 4, suite.series.Start.AddDate(0, 0, 1), 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_EditedOccurrence() {
+	// Signing up to the edited copy signs up to the occurrence of the series
+	occurrence := suite.series.Start.AddDate(0, 0, 7)
+	var edited models.Event
+	edited.ID = 5
+	edited.SeriesID = &suite.series.ID
+	edited.OccurrenceDate = &occurrence
+	edited.Start = occurrence.Add(2 * time.Hour)
+	expected := models.EventSignups{EventID: 1, UsersID: 4, OccurrenceDate: occurrence}
+
+	suite.mockEventRepo.On("GetEventById", "5").Return(edited, nil)
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.expectNoWaivers(suite.series)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(1)).Return([]models.EventShifts{}, nil)
+	suite.mockRepo.On("FindSignup", uint(1), uint(4), occurrence, uint(0)).Return(models.EventSignups{}, suite.err)
+	suite.mockRepo.On("CreateSignup", expected).Return(expected, nil)
+	suite.expectNotice(expected.EventID)
+	suite.expectInvite("REQUEST", func(cal string) bool {
+		return strings.Contains(cal, "DTSTART:"+edited.Start.Format("20060102T150405Z")+"\r\n")
+	})
+
+	_, err := suite.service.SignUp(5, 4, time.Time{}, 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_AlreadySignedUp() {
+	var event models.Event
+	event.ID = 2
+	event.Start = suite.series.Start
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{}, nil)
+	suite.mockRepo.On("FindSignup", uint(2), uint(4), event.Start, uint(0)).Return(models.EventSignups{}, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_Past() {
+	var event models.Event
+	event.ID = 2
+	event.Start = time.Now().Add(-time.Hour)
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_GetSignups_WholeSeries() {
+	suite.series.OrganizationID = 2
+	signups := []models.EventSignups{{UsersID: 4}, {UsersID: 6}}
+
+	// Staff see the whole roster
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockRepo.On("GetSignups", uint(1), time.Time{}).Return(signups, nil)
+	suite.mockOrgUsers.On("FindOrgUser", uint(8), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	roster, err := suite.service.GetSignups(1, time.Time{}, 8)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), roster, 2)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_GetSignups_Volunteer() {
+	suite.series.OrganizationID = 2
+	signups := []models.EventSignups{{UsersID: 4}, {UsersID: 6}}
+
+	// Everyone else only sees their own sign-ups
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockRepo.On("GetSignups", uint(1), time.Time{}).Return(signups, nil)
+	suite.mockOrgUsers.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	own, err := suite.service.GetSignups(1, time.Time{}, 4)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.EventSignups{{UsersID: 4}}, own)
+}
+
+// A one-off event starting tomorrow at 8 with a shift from 8 to 10
+func (suite *SignupServiceUnitTestSuite) shiftEvent() (models.Event, models.EventShifts) {
+	var event models.Event
+	event.ID = 2
+	event.Name = "Food drive"
+	event.Start = time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 1)
+
+	var shift models.EventShifts
+	shift.ID = 7
+	shift.EventID = 2
+	shift.Role = "Setup"
+	shift.Start = event.Start
+	shift.End = event.Start.Add(2 * time.Hour)
+
+	return event, shift
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_ShiftRequired() {
+	event, shift := suite.shiftEvent()
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{shift}, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_ShiftFull() {
+	event, shift := suite.shiftEvent()
+	shift.Capacity = 3
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{shift}, nil)
+	suite.mockRepo.On("GetUserSignups", uint(4), event.Start.AddDate(0, 0, -7)).Return([]models.EventSignups{}, nil)
+	suite.mockRepo.On("FindSignup", uint(2), uint(4), event.Start, uint(7)).Return(models.EventSignups{}, suite.err)
+	suite.mockRepo.On("CreateSignup", models.EventSignups{EventID: 2, UsersID: 4, OccurrenceDate: event.Start, ShiftID: 7}).
+		Return(models.EventSignups{}, repository.ErrShiftFull)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 7)
+
+	assert.EqualError(suite.T(), err, "shift is full")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_MissingSkill() {
+	event, shift := suite.shiftEvent()
+	driving := models.Tags{Name: "Driving", Category: models.TagSkill}
+	driving.ID = 9
+	shift.Skills = []models.Tags{driving}
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{shift}, nil)
+	suite.mockTagRepo.On("GetOwnerTags", mock.Anything).Return([]models.Tags{}, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 7)
+
+	assert.EqualError(suite.T(), err, "missing required skills: Driving")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_ShiftConflict() {
+	event, shift := suite.shiftEvent()
+
+	// Already serving 9 to 11 at another event that day
+	var other models.Event
+	other.ID = 3
+	other.Name = "Soup kitchen"
+	other.Start = event.Start.Add(time.Hour)
+
+	var serving models.EventShifts
+	serving.ID = 8
+	serving.Role = "Serving"
+	serving.Start = other.Start
+	serving.End = other.Start.Add(2 * time.Hour)
+
+	existing := models.EventSignups{EventID: 3, UsersID: 4, OccurrenceDate: other.Start, ShiftID: 8, Event: other}
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{shift}, nil)
+	suite.mockRepo.On("GetUserSignups", uint(4), event.Start.AddDate(0, 0, -7)).
+		Return([]models.EventSignups{existing}, nil)
+	suite.mockShiftRepo.On("GetShiftsByIds", []uint{8}).Return([]models.EventShifts{serving}, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{3}).Return([]models.Event{}, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 7)
+
+	assert.EqualError(suite.T(), err, "overlaps your Serving shift at Soup kitchen")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_Shift() {
+	event, shift := suite.shiftEvent()
+	expected := models.EventSignups{EventID: 2, UsersID: 4, OccurrenceDate: event.Start, ShiftID: 7}
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{shift}, nil)
+	suite.mockRepo.On("GetUserSignups", uint(4), event.Start.AddDate(0, 0, -7)).Return([]models.EventSignups{}, nil)
+	suite.mockRepo.On("FindSignup", uint(2), uint(4), event.Start, uint(7)).Return(models.EventSignups{}, suite.err)
+	suite.mockRepo.On("CreateSignup", expected).Return(expected, nil)
+	suite.expectNotice(expected.EventID)
+	suite.expectInvite("REQUEST", func(cal string) bool {
+		return strings.Contains(cal, "SUMMARY:"+event.Name+": "+shift.Role+"\r\n") &&
+			strings.Contains(cal, "DTEND:"+shift.End.UTC().Format("20060102T150405Z")+"\r\n")
+	})
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 7)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_EmailFails() {
+	var event models.Event
+	event.ID = 2
+	event.Start = suite.series.Start
+	expected := models.EventSignups{EventID: 2, UsersID: 4, OccurrenceDate: event.Start}
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{}, nil)
+	suite.mockRepo.On("FindSignup", uint(2), uint(4), event.Start, uint(0)).Return(models.EventSignups{}, suite.err)
+	suite.mockRepo.On("CreateSignup", expected).Return(expected, nil)
+	suite.expectNotice(expected.EventID)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(models.Users{Email: "ada@example.com"}, nil)
+	suite.mockMailer.On("From").Return("events@volunteerone.org")
+	suite.mockMailer.On("Send", mock.Anything).Return(suite.err)
+
+	res, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), expected, res)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_Withdraw_CancelsInvite() {
+	occurrence := suite.series.Start.AddDate(0, 0, 7)
+	uid := fmt.Sprintf("UID:signup-1-4-%d-0@volunteerone\r\n", occurrence.Unix())
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockRepo.On("DeleteSignup", uint(1), uint(4), occurrence, uint(0)).Return(nil)
+	suite.mockHub.On("Publish", "event:1", "withdrawal", models.EventSignups{EventID: 1, UsersID: 4, OccurrenceDate: occurrence}).Once()
+	suite.mockEventRepo.On("GetExceptions", []uint{1}).Return([]models.EventExceptions{}, nil)
+	suite.mockEventRepo.On("GetOverrides", []uint{1}).Return([]models.Event{}, nil)
+	suite.expectInvite("CANCEL", func(cal string) bool {
+		return strings.Contains(cal, uid) && strings.Contains(cal, "STATUS:CANCELLED\r\n")
+	})
+
+	err := suite.service.Withdraw(1, 4, occurrence, 0)
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_Withdraw_NotSignedUp() {
+	occurrence := suite.series.Start.AddDate(0, 0, 7)
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.series, nil)
+	suite.mockRepo.On("DeleteSignup", uint(1), uint(4), occurrence, uint(0)).Return(suite.err)
+
+	err := suite.service.Withdraw(1, 4, occurrence, 0)
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_Draft() {
+	event := suite.series
+	event.Recurrence = ""
+	event.Status = models.EventDraft
+	suite.mockEventRepo.On("GetEventById", "1").Return(event, nil)
+
+	_, err := suite.service.SignUp(1, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "event is not published yet")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_Cancelled() {
+	event := suite.series
+	event.Recurrence = ""
+	event.Status = models.EventCancelled
+	suite.mockEventRepo.On("GetEventById", "1").Return(event, nil)
+
+	_, err := suite.service.SignUp(1, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "event is cancelled")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_Withdraw_Completed() {
+	event := suite.series
+	event.Status = models.EventCompleted
+	suite.mockEventRepo.On("GetEventById", "1").Return(event, nil)
+
+	err := suite.service.Withdraw(1, 4, suite.series.Start, 0)
+
+	assert.EqualError(suite.T(), err, "event is completed, sign-ups are closed")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_WaiverNotAccepted() {
+	var event models.Event
+	event.ID = 2
+	event.OrganizationID = 3
+	event.Start = suite.series.Start
+
+	// Accepted the first version, the waiver is now at its second
+	waiver := models.Waivers{OrganizationID: 3, Title: "Liability", Version: 2}
+	waiver.ID = 6
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.mockWaiverRepo.On("GetEventWaivers", uint(3), uint(2)).Return([]models.Waivers{waiver}, nil)
+	suite.mockWaiverRepo.On("GetAcceptances", uint(4), []uint{6}).
+		Return([]models.WaiverAcceptances{{WaiverID: 6, UsersID: 4, Version: 1}}, nil)
+	suite.expectAdult()
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, `accept the waiver "Liability" before signing up`)
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_TooYoung() {
+	var event models.Event
+	event.ID = 2
+	event.Start = suite.series.Start
+	event.MinimumAge = 18
+
+	// Turns 18 the day after the event
+	birthday := event.Start.AddDate(-18, 0, 1)
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).
+		Return(models.Users{Birthdate: birthday.Format("01/02/2006")}, nil)
+	suite.mockConsentRepo.On("FindApprovedConsent", uint(4), uint(2)).Return(models.GuardianConsents{}, suite.err)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "volunteers must be at least 18 years old")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_BackgroundCheckExpired() {
+	var event models.Event
+	event.ID = 2
+	event.OrganizationID = 3
+	event.Start = suite.series.Start
+	event.BackgroundCheck = true
+
+	expires := event.Start.AddDate(0, 0, -1)
+	check := models.BackgroundChecks{UsersID: 4, OrganizationID: 3, ExpiresAt: &expires}
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.expectAdult()
+	suite.mockWaiverRepo.On("FindBackgroundCheck", uint(4), uint(3)).Return(check, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "a background check is required to sign up")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_NoBirthdate() {
+	var event models.Event
+	event.ID = 2
+	event.Start = suite.series.Start
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(models.Users{}, nil)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "add your birthdate to your profile before signing up")
+}
+
+// A 15 year old on the day of a one-off event of organization 3
+func (suite *SignupServiceUnitTestSuite) minorEvent() models.Event {
+	var event models.Event
+	event.ID = 2
+	event.OrganizationID = 3
+	event.Start = suite.series.Start
+
+	suite.mockEventRepo.On("GetEventById", "2").Return(event, nil)
+	suite.expectNoWaivers(event)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).
+		Return(models.Users{Birthdate: event.Start.AddDate(-15, 0, 0).Format("2006-01-02")}, nil).Once()
+
+	return event
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_MinorWithoutConsent() {
+	suite.minorEvent()
+	suite.mockConsentRepo.On("FindApprovedConsent", uint(4), uint(2)).Return(models.GuardianConsents{}, suite.err)
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.EqualError(suite.T(), err, "volunteers under 18 need a guardian's consent to sign up")
+}
+
+func (suite *SignupServiceUnitTestSuite) TestSignupService_SignUp_MinorWithConsent() {
+	event := suite.minorEvent()
+	expected := models.EventSignups{EventID: 2, UsersID: 4, OccurrenceDate: event.Start}
+
+	suite.mockConsentRepo.On("FindApprovedConsent", uint(4), uint(2)).
+		Return(models.GuardianConsents{UsersID: 4, Status: models.ConsentApproved}, nil)
+	suite.mockShiftRepo.On("GetShifts", uint(2)).Return([]models.EventShifts{}, nil)
+	suite.mockRepo.On("FindSignup", uint(2), uint(4), event.Start, uint(0)).Return(models.EventSignups{}, suite.err)
+	suite.mockRepo.On("CreateSignup", expected).Return(expected, nil)
+	suite.expectNotice(expected.EventID)
+	suite.expectInvite("REQUEST", func(cal string) bool { return true })
+
+	_, err := suite.service.SignUp(2, 4, time.Time{}, 0)
+
+	assert.Nil(suite.T(), err)
+}
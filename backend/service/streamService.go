@@ -0,0 +1,90 @@
+package service
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+type StreamService interface {
+	Subscribe(uint, []uint, []uint) (*realtime.Subscription, error)
+	Unsubscribe(*realtime.Subscription)
+	IssueTicket(uint) (string, error)
+	RedeemTicket(string) (uint, bool)
+}
+
+type streamService struct {
+	hub                realtime.Hub
+	postsRepository    repository.PostsRepository
+	eventRepository    repository.EventRepository
+	orgUsersRepository repository.OrgUsersRepository
+	tickets            *streamTickets
+}
+
+// Instantiated in router.go
+func NewStreamService(h realtime.Hub, p repository.PostsRepository, e repository.EventRepository, o repository.OrgUsersRepository) StreamService {
+	return streamService{
+		hub:                h,
+		postsRepository:    p,
+		eventRepository:    e,
+		orgUsersRepository: o,
+		tickets:            newStreamTickets(),
+	}
+}
+
+// Subscribes the user to their own notifications, to what happens on the
+// posts with postIds they can see and to the rosters of the events with
+// eventIds. Rosters are for the managers of the event's organization only.
+func (s streamService) Subscribe(userId uint, postIds []uint, eventIds []uint) (*realtime.Subscription, error) {
+	log.Println("[StreamService] Subscribe...")
+
+	topics := []string{realtime.UserTopic(userId)}
+
+	for _, postId := range uniqueIds(postIds) {
+		if _, err := visiblePost(s.postsRepository, postId, userId); err != nil {
+			return nil, err
+		}
+		topics = append(topics, realtime.PostTopic(postId))
+	}
+
+	for _, eventId := range uniqueIds(eventIds) {
+		event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+		if err != nil {
+			return nil, err
+		}
+
+		if err := requireManager(s.orgUsersRepository, userId, event.OrganizationID); err != nil {
+			return nil, err
+		}
+
+		// Rosters of edited occurrences are kept with their series
+		seriesId, _ := seriesOccurrence(event, time.Time{})
+		topics = append(topics, realtime.EventTopic(seriesId))
+	}
+
+	return s.hub.Subscribe(topics...), nil
+}
+
+func (s streamService) Unsubscribe(subscription *realtime.Subscription) {
+	log.Println("[StreamService] Unsubscribe...")
+
+	s.hub.Unsubscribe(subscription)
+}
+
+// Issues a single-use ticket that opens a stream for the user within
+// streamTicketTTL
+func (s streamService) IssueTicket(userId uint) (string, error) {
+	return s.tickets.issue(userId, time.Now())
+}
+
+// Returns the user a valid ticket was issued to. Each ticket works once.
+func (s streamService) RedeemTicket(ticket string) (uint, bool) {
+	if ticket == "" {
+		return 0, false
+	}
+
+	return s.tickets.redeem(ticket, time.Now())
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/realtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+)
+
+type StreamServiceUnitTestSuite struct {
+	suite.Suite
+	mockPostsRepo    *mocks.PostsRepository
+	mockEventRepo    *mocks.EventRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	hub              realtime.Hub
+	service          StreamService
+	event            models.Event
+	err              error
+}
+
+func (suite *StreamServiceUnitTestSuite) SetupTest() {
+	suite.mockPostsRepo = new(mocks.PostsRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.hub = realtime.NewHub(realtime.NewLocalBroker())
+	suite.service = NewStreamService(suite.hub, suite.mockPostsRepo, suite.mockEventRepo, suite.mockOrgUsersRepo)
+
+	// An edited occurrence of series 1, of organization 3
+	seriesId := uint(1)
+	occurrence := time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+	suite.event = models.Event{OrganizationID: 3, SeriesID: &seriesId, OccurrenceDate: &occurrence}
+	suite.event.ID = 5
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *StreamServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockPostsRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestStreamServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(StreamServiceUnitTestSuite))
+}
+
+func (suite *StreamServiceUnitTestSuite) TestStreamService_Subscribe() {
+	post := models.Posts{Handle: "grace"}
+	post.ID = 9
+
+	suite.mockPostsRepo.On("FindPost", "9").Return(post, nil)
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(3)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+
+	subscription, err := suite.service.Subscribe(4, []uint{9, 9}, []uint{5})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []string{"user:4", "post:9", "event:1"}, subscription.Topics)
+
+	// Rosters of occurrences are published to their series
+	suite.hub.Publish(realtime.EventTopic(1), "signup", models.EventSignups{EventID: 1})
+	suite.hub.Publish(realtime.UserTopic(5), "notification", models.Notifications{})
+
+	event := <-subscription.Events
+	assert.Equal(suite.T(), "signup", event.Type)
+	assert.Len(suite.T(), subscription.Events, 0)
+
+	suite.service.Unsubscribe(subscription)
+}
+
+func (suite *StreamServiceUnitTestSuite) TestStreamService_Subscribe_NotManager() {
+	suite.mockEventRepo.On("GetEventById", "5").Return(suite.event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(3)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	_, err := suite.service.Subscribe(4, []uint{}, []uint{5})
+
+	assert.Equal(suite.T(), ErrNotManager, err)
+}
+
+func (suite *StreamServiceUnitTestSuite) TestStreamService_Subscribe_UnknownPost() {
+	suite.mockPostsRepo.On("FindPost", "9").Return(models.Posts{}, suite.err)
+
+	_, err := suite.service.Subscribe(4, []uint{9}, []uint{})
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *StreamServiceUnitTestSuite) TestStreamService_Subscribe_FriendsOnlyPost() {
+	post := models.Posts{Handle: "grace", Audience: models.AudienceFriends}
+	post.ID = 9
+
+	// Only grace's friends hear about the post
+	suite.mockPostsRepo.On("FindPost", "9").Return(post, nil)
+	suite.mockPostsRepo.On("FindViewer", uint(4)).Return(models.PostViewer{Handle: "ada"}, nil)
+
+	_, err := suite.service.Subscribe(4, []uint{9}, []uint{})
+
+	assert.EqualError(suite.T(), err, "post not found")
+}
+
+func (suite *StreamServiceUnitTestSuite) TestStreamService_Ticket() {
+	ticket, err := suite.service.IssueTicket(4)
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), ticket, 64)
+
+	userId, ok := suite.service.RedeemTicket(ticket)
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), uint(4), userId)
+
+	// Tickets work once
+	_, ok = suite.service.RedeemTicket(ticket)
+	assert.False(suite.T(), ok)
+
+	_, ok = suite.service.RedeemTicket("")
+	assert.False(suite.T(), ok)
+}
+
+func (suite *StreamServiceUnitTestSuite) TestStreamService_Ticket_Expired() {
+	tickets := newStreamTickets()
+	issuedAt := time.Date(2034, 4, 1, 9, 0, 0, 0, time.UTC)
+
+	expired, _ := tickets.issue(4, issuedAt)
+	valid, _ := tickets.issue(5, issuedAt)
+
+	_, ok := tickets.redeem(expired, issuedAt.Add(streamTicketTTL))
+	assert.False(suite.T(), ok)
+
+	userId, ok := tickets.redeem(valid, issuedAt.Add(streamTicketTTL-time.Second))
+	assert.True(suite.T(), ok)
+	assert.Equal(suite.T(), uint(5), userId)
+
+	// Issuing drops the tickets nobody redeemed in time
+	unused, _ := tickets.issue(4, issuedAt)
+	tickets.issue(5, issuedAt.Add(streamTicketTTL))
+	_, ok = tickets.tickets[unused]
+	assert.False(suite.T(), ok)
+}
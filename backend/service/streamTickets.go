@@ -0,0 +1,64 @@
+package service
+
+import (
+	"sync"
+	"time"
+)
+
+// How long a stream ticket can be redeemed for after it is issued
+const streamTicketTTL = 30 * time.Second
+
+// Single-use tickets that open a stream for clients which cannot set
+// headers, such as the browser's EventSource, so the access token never
+// ends up in URLs and logs
+type streamTickets struct {
+	mu      sync.Mutex
+	tickets map[string]streamTicket
+}
+
+type streamTicket struct {
+	userId    uint
+	expiresAt time.Time
+}
+
+func newStreamTickets() *streamTickets {
+	return &streamTickets{
+		tickets: map[string]streamTicket{},
+	}
+}
+
+// Issues a ticket for the user, dropping the expired ones nobody redeemed
+func (t *streamTickets) issue(userId uint, now time.Time) (string, error) {
+	ticket, err := newToken()
+	if err != nil {
+		return "", err
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for key, issued := range t.tickets {
+		if !now.Before(issued.expiresAt) {
+			delete(t.tickets, key)
+		}
+	}
+
+	t.tickets[ticket] = streamTicket{userId: userId, expiresAt: now.Add(streamTicketTTL)}
+
+	return ticket, nil
+}
+
+// Returns the user the ticket was issued to, once, while it is valid
+func (t *streamTickets) redeem(ticket string, now time.Time) (uint, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	issued, ok := t.tickets[ticket]
+	if !ok {
+		return 0, false
+	}
+
+	delete(t.tickets, ticket)
+
+	return issued.userId, now.Before(issued.expiresAt)
+}
@@ -0,0 +1,288 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+	"gorm.io/gorm"
+)
+
+// Name of the one-time migration that turns the legacy free-form strings
+// into tags
+const legacyTagMigration = "legacy-tags"
+
+type TagService interface {
+	CreateTag(string, string) (models.Tags, error)
+	GetTags(string) ([]models.Tags, error)
+	GetTagById(string) (models.Tags, error)
+	UpdateTag(models.Tags, string, string) (models.Tags, error)
+	DeleteTag(models.Tags) error
+	Autocomplete(string, string, int) ([]models.Tags, error)
+	GetUserTags(uint) ([]models.Tags, error)
+	SetUserTags(uint, []uint) ([]models.Tags, error)
+	GetOrganizationTags(uint) ([]models.Tags, error)
+	SetOrganizationTags(uint, []uint, uint) ([]models.Tags, error)
+	GetEventTags(uint, uint) ([]models.Tags, error)
+	SetEventTags(uint, []uint, uint) ([]models.Tags, error)
+	MigrateLegacyTags() error
+}
+
+type tagService struct {
+	tagRepository      repository.TagRepository
+	eventRepository    repository.EventRepository
+	orgUsersRepository repository.OrgUsersRepository
+}
+
+// Instantiated in router.go
+func NewTagService(r repository.TagRepository, e repository.EventRepository, o repository.OrgUsersRepository) TagService {
+	return tagService{
+		tagRepository:      r,
+		eventRepository:    e,
+		orgUsersRepository: o,
+	}
+}
+
+func (t tagService) CreateTag(name string, category string) (models.Tags, error) {
+	log.Println("[TagService] Create tag...")
+
+	name = normalizeTagName(name)
+	if name == "" {
+		return models.Tags{}, errors.New("tag name is required")
+	}
+	if !models.ValidTagCategory(category) {
+		return models.Tags{}, errors.New("invalid tag category")
+	}
+
+	return t.tagRepository.CreateTag(models.Tags{Name: name, Category: category})
+}
+
+func (t tagService) GetTags(category string) ([]models.Tags, error) {
+	if category != "" && !models.ValidTagCategory(category) {
+		return []models.Tags{}, errors.New("invalid tag category")
+	}
+
+	return t.tagRepository.GetTags(category)
+}
+
+func (t tagService) GetTagById(id string) (models.Tags, error) {
+	return t.tagRepository.GetTagById(id)
+}
+
+func (t tagService) UpdateTag(tag models.Tags, name string, category string) (models.Tags, error) {
+	log.Println("[TagService] Update tag...")
+
+	name = normalizeTagName(name)
+	if name == "" {
+		return models.Tags{}, errors.New("tag name is required")
+	}
+	if !models.ValidTagCategory(category) {
+		return models.Tags{}, errors.New("invalid tag category")
+	}
+
+	tag.Name = name
+	tag.Category = category
+
+	return t.tagRepository.UpdateTag(tag)
+}
+
+func (t tagService) DeleteTag(tag models.Tags) error {
+	log.Println("[TagService] Delete tag...")
+	return t.tagRepository.DeleteTag(tag)
+}
+
+// Suggests tags whose name starts with the query
+func (t tagService) Autocomplete(query string, category string, limit int) ([]models.Tags, error) {
+	query = normalizeTagName(query)
+	if query == "" {
+		return []models.Tags{}, nil
+	}
+	if category != "" && !models.ValidTagCategory(category) {
+		return []models.Tags{}, errors.New("invalid tag category")
+	}
+
+	return t.tagRepository.SearchTags(query, category, limit)
+}
+
+func (t tagService) GetUserTags(userId uint) ([]models.Tags, error) {
+	return t.tagRepository.GetOwnerTags(&models.Users{Model: gorm.Model{ID: userId}})
+}
+
+func (t tagService) SetUserTags(userId uint, tagIds []uint) ([]models.Tags, error) {
+	return t.setOwnerTags(&models.Users{Model: gorm.Model{ID: userId}}, tagIds)
+}
+
+func (t tagService) GetOrganizationTags(orgId uint) ([]models.Tags, error) {
+	return t.tagRepository.GetOwnerTags(&models.Organization{Model: gorm.Model{ID: orgId}})
+}
+
+// Only managers of the organization can set its tags
+func (t tagService) SetOrganizationTags(orgId uint, tagIds []uint, userId uint) ([]models.Tags, error) {
+	if err := requireManager(t.orgUsersRepository, userId, orgId); err != nil {
+		return []models.Tags{}, err
+	}
+
+	return t.setOwnerTags(&models.Organization{Model: gorm.Model{ID: orgId}}, tagIds)
+}
+
+// The tags of an event, which for drafts only their organization's staff
+// can see
+func (t tagService) GetEventTags(eventId uint, viewerId uint) ([]models.Tags, error) {
+	event, err := t.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return []models.Tags{}, err
+	}
+
+	if err := checkVisible(t.orgUsersRepository, event, viewerId); err != nil {
+		return []models.Tags{}, err
+	}
+
+	return t.tagRepository.GetOwnerTags(&models.Event{Model: gorm.Model{ID: eventId}})
+}
+
+// Only managers of the event's organization can set its tags
+func (t tagService) SetEventTags(eventId uint, tagIds []uint, userId uint) ([]models.Tags, error) {
+	event, err := t.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return []models.Tags{}, err
+	}
+
+	if err := requireManager(t.orgUsersRepository, userId, event.OrganizationID); err != nil {
+		return []models.Tags{}, err
+	}
+
+	return t.setOwnerTags(&models.Event{Model: gorm.Model{ID: eventId}}, tagIds)
+}
+
+// Only curated tags can be linked, so every id has to exist
+func (t tagService) setOwnerTags(owner models.Model, tagIds []uint) ([]models.Tags, error) {
+	tags, err := t.tagRepository.GetTagsByIds(tagIds)
+	if err != nil {
+		return []models.Tags{}, err
+	}
+
+	if len(tags) != len(uniqueIds(tagIds)) {
+		return []models.Tags{}, errors.New("unknown tag id")
+	}
+
+	err = t.tagRepository.ReplaceOwnerTags(owner, tags)
+	if err != nil {
+		return []models.Tags{}, err
+	}
+
+	return tags, nil
+}
+
+// Parses the legacy comma separated Interests/Skills/GoodFor/CauseAreas
+// strings into tags and links them. Runs once; later calls do nothing.
+func (t tagService) MigrateLegacyTags() error {
+	applied, err := t.tagRepository.MigrationApplied(legacyTagMigration)
+	if err != nil || applied {
+		return err
+	}
+
+	log.Println("[TagService] Migrating legacy tags...")
+
+	users, err := t.tagRepository.AllUsers()
+	if err != nil {
+		return err
+	}
+	for i := range users {
+		if err := t.linkLegacyTags(&users[i], users[i].Interests, models.TagInterest); err != nil {
+			return err
+		}
+	}
+
+	orgs, err := t.tagRepository.AllOrganizations()
+	if err != nil {
+		return err
+	}
+	for i := range orgs {
+		if err := t.linkLegacyTags(&orgs[i], orgs[i].Interests, models.TagInterest); err != nil {
+			return err
+		}
+	}
+
+	events, err := t.tagRepository.AllEvents()
+	if err != nil {
+		return err
+	}
+	for i := range events {
+		fields := map[string]string{
+			models.TagInterest:  events[i].Interests,
+			models.TagSkill:     events[i].Skills,
+			models.TagGoodFor:   events[i].GoodFor,
+			models.TagCauseArea: events[i].CauseAreas,
+		}
+		for category, value := range fields {
+			if err := t.linkLegacyTags(&events[i], value, category); err != nil {
+				return err
+			}
+		}
+	}
+
+	return t.tagRepository.RecordMigration(legacyTagMigration)
+}
+
+func (t tagService) linkLegacyTags(owner models.Model, value string, category string) error {
+	names := parseTagList(value)
+	if len(names) == 0 {
+		return nil
+	}
+
+	tags := []models.Tags{}
+	for _, name := range names {
+		tag, err := t.tagRepository.FindOrCreateTag(name, category)
+		if err != nil {
+			return err
+		}
+		tags = append(tags, tag)
+	}
+
+	return t.tagRepository.AppendOwnerTags(owner, tags)
+}
+
+// Splits a free-form list such as "Community, Food; Health" into tag names,
+// dropping blanks and case-insensitive duplicates
+func parseTagList(value string) []string {
+	names := []string{}
+	seen := map[string]bool{}
+
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return r == ',' || r == ';' || r == '\n'
+	})
+
+	for _, part := range parts {
+		name := normalizeTagName(part)
+		key := strings.ToLower(name)
+		if name == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// Trims and collapses whitespace in a tag name
+func normalizeTagName(name string) string {
+	return strings.Join(strings.Fields(name), " ")
+}
+
+func uniqueIds(ids []uint) []uint {
+	unique := []uint{}
+	seen := map[uint]bool{}
+
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+
+	return unique
+}
@@ -0,0 +1,215 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+	"gorm.io/gorm"
+)
+
+type TagServiceUnitTestSuite struct {
+	suite.Suite
+	mockRepo         *mocks.TagRepository
+	mockEventRepo    *mocks.EventRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	service          TagService
+	tag              models.Tags
+	err              error
+}
+
+func (suite *TagServiceUnitTestSuite) SetupTest() {
+	suite.mockRepo = new(mocks.TagRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.service = NewTagService(suite.mockRepo, suite.mockEventRepo, suite.mockOrgUsersRepo)
+
+	suite.tag = models.Tags{Name: "Food", Category: models.TagCauseArea}
+	suite.tag.ID = 4
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *TagServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestTagServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(TagServiceUnitTestSuite))
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_CreateTag_Normalizes() {
+	expected := models.Tags{Name: "Children & Youth", Category: models.TagCauseArea}
+	suite.mockRepo.On("CreateTag", expected).Return(expected, nil)
+
+	res, err := suite.service.CreateTag("  Children   & Youth ", models.TagCauseArea)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), expected, res)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_CreateTag_InvalidCategory() {
+	_, err := suite.service.CreateTag("Food", "colour")
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_CreateTag_EmptyName() {
+	_, err := suite.service.CreateTag("   ", models.TagSkill)
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_Autocomplete_EmptyQuery() {
+	res, err := suite.service.Autocomplete(" ", "", 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Empty(suite.T(), res)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_Autocomplete() {
+	suite.mockRepo.On("SearchTags", "Fo", models.TagCauseArea, 10).Return([]models.Tags{suite.tag}, nil)
+
+	res, err := suite.service.Autocomplete("Fo", models.TagCauseArea, 10)
+
+	assert.Nil(suite.T(), err)
+	assert.Len(suite.T(), res, 1)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_SetUserTags_UnknownTag() {
+	suite.mockRepo.On("GetTagsByIds", []uint{4, 9}).Return([]models.Tags{suite.tag}, nil)
+
+	_, err := suite.service.SetUserTags(1, []uint{4, 9})
+
+	assert.NotNil(suite.T(), err)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_SetUserTags_Success() {
+	owner := &models.Users{Model: gorm.Model{ID: 1}}
+	suite.mockRepo.On("GetTagsByIds", []uint{4, 4}).Return([]models.Tags{suite.tag}, nil)
+	suite.mockRepo.On("ReplaceOwnerTags", owner, []models.Tags{suite.tag}).Return(nil)
+
+	res, err := suite.service.SetUserTags(1, []uint{4, 4})
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.Tags{suite.tag}, res)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_SetOrganizationTags() {
+	owner := &models.Organization{Model: gorm.Model{ID: 2}}
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleManager}, nil)
+	suite.mockRepo.On("GetTagsByIds", []uint{4}).Return([]models.Tags{suite.tag}, nil)
+	suite.mockRepo.On("ReplaceOwnerTags", owner, []models.Tags{suite.tag}).Return(nil)
+
+	res, err := suite.service.SetOrganizationTags(2, []uint{4}, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.Tags{suite.tag}, res)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_SetOrganizationTags_NotManager() {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleMember}, nil)
+
+	_, err := suite.service.SetOrganizationTags(2, []uint{4}, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "ReplaceOwnerTags", mock.Anything, mock.Anything)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_SetEventTags() {
+	event := models.Event{OrganizationID: 2}
+	event.ID = 5
+	owner := &models.Event{Model: gorm.Model{ID: 5}}
+	suite.mockEventRepo.On("GetEventById", "5").Return(event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{Role: models.RoleOwner}, nil)
+	suite.mockRepo.On("GetTagsByIds", []uint{4}).Return([]models.Tags{suite.tag}, nil)
+	suite.mockRepo.On("ReplaceOwnerTags", owner, []models.Tags{suite.tag}).Return(nil)
+
+	res, err := suite.service.SetEventTags(5, []uint{4}, 7)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.Tags{suite.tag}, res)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_SetEventTags_NotManager() {
+	event := models.Event{OrganizationID: 2}
+	event.ID = 5
+	suite.mockEventRepo.On("GetEventById", "5").Return(event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(7), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.SetEventTags(5, []uint{4}, 7)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+	suite.mockRepo.AssertNotCalled(suite.T(), "ReplaceOwnerTags", mock.Anything, mock.Anything)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_GetEventTags() {
+	event := models.Event{OrganizationID: 2, Status: models.EventPublished}
+	event.ID = 5
+	suite.mockEventRepo.On("GetEventById", "5").Return(event, nil)
+	suite.mockRepo.On("GetOwnerTags", &models.Event{Model: gorm.Model{ID: 5}}).Return([]models.Tags{suite.tag}, nil)
+
+	res, err := suite.service.GetEventTags(5, 0)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), []models.Tags{suite.tag}, res)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_GetEventTags_Draft() {
+	event := models.Event{OrganizationID: 2, Status: models.EventDraft}
+	event.ID = 5
+	suite.mockEventRepo.On("GetEventById", "5").Return(event, nil)
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(4), uint(2)).Return(models.OrgUsers{}, suite.err)
+
+	_, err := suite.service.GetEventTags(5, 4)
+
+	assert.NotNil(suite.T(), err)
+	suite.mockRepo.AssertNotCalled(suite.T(), "GetOwnerTags", mock.Anything)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_MigrateLegacyTags_AlreadyApplied() {
+	suite.mockRepo.On("MigrationApplied", legacyTagMigration).Return(true, nil)
+
+	err := suite.service.MigrateLegacyTags()
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_MigrateLegacyTags() {
+	var user models.Users
+	user.ID = 1
+	user.Interests = "Food, Health"
+
+	suite.mockRepo.On("MigrationApplied", legacyTagMigration).Return(false, nil)
+	suite.mockRepo.On("AllUsers").Return([]models.Users{user}, nil)
+	suite.mockRepo.On("AllOrganizations").Return([]models.Organization{}, nil)
+	suite.mockRepo.On("AllEvents").Return([]models.Event{}, nil)
+	suite.mockRepo.On("FindOrCreateTag", "Food", models.TagInterest).Return(models.Tags{Name: "Food"}, nil)
+	suite.mockRepo.On("FindOrCreateTag", "Health", models.TagInterest).Return(models.Tags{Name: "Health"}, nil)
+	suite.mockRepo.On("AppendOwnerTags", mock.Anything, mock.Anything).Return(nil)
+	suite.mockRepo.On("RecordMigration", legacyTagMigration).Return(nil)
+
+	err := suite.service.MigrateLegacyTags()
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *TagServiceUnitTestSuite) TestTagService_MigrateLegacyTags_Fail() {
+	suite.mockRepo.On("MigrationApplied", legacyTagMigration).Return(false, nil)
+	suite.mockRepo.On("AllUsers").Return([]models.Users{}, suite.err)
+
+	err := suite.service.MigrateLegacyTags()
+
+	assert.Equal(suite.T(), suite.err, err)
+}
+
+func TestParseTagList(t *testing.T) {
+	names := parseTagList("Children & Youth, Community;community,, Health ")
+
+	assert.Equal(t, []string{"Children & Youth", "Community", "Health"}, names)
+}
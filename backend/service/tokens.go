@@ -0,0 +1,17 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// Returns a random secret for URLs and codes that grant access on their
+// own, 64 hex characters
+func newToken() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(secret), nil
+}
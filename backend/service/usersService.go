@@ -1,7 +1,9 @@
 package service
 
 import (
+	"errors"
 	"log"
+	"strconv"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
@@ -12,10 +14,13 @@ type UsersService interface {
 	CreateUser(user models.Users) (models.Users, error)
 	OneUser(id string, user models.Users) (models.Users, error)
 	UpdateUser(user models.Users) (models.Users, error)
+	SetBirthdate(id string, birthdate string) (models.Users, error)
 	DeleteUser(user models.Users) (models.Users, error)
 	HashPassword(password []byte) ([]byte, error)
 }
 
+var ErrBirthdateLocked = errors.New("birthdate can only be changed by an administrator once set")
+
 type usersService struct {
 	usersRepository repository.UsersRepository
 }
@@ -29,6 +34,10 @@ func NewUsersService(r repository.UsersRepository) UsersService {
 func (u usersService) CreateUser(user models.Users) (models.Users, error) {
 	log.Println("[UsersService] Create user...")
 
+	if _, err := models.ParseBirthdate(user.Birthdate); err != nil {
+		return models.Users{}, err
+	}
+
 	return u.usersRepository.CreateUser(user)
 }
 
@@ -38,9 +47,42 @@ func (u usersService) OneUser(id string, user models.Users) (models.Users, error
 	return u.usersRepository.OneUser(id, user)
 }
 
+// Saves the user. Their birthdate decides which events they can sign up to
+// and whether a guardian must consent, so once set it can't change.
 func (u usersService) UpdateUser(user models.Users) (models.Users, error) {
 	log.Println("[UsersService] Update User...")
 
+	if _, err := models.ParseBirthdate(user.Birthdate); err != nil {
+		return models.Users{}, err
+	}
+
+	existing, err := u.usersRepository.OneUser(strconv.FormatUint(uint64(user.ID), 10), models.Users{})
+	if err != nil {
+		return models.Users{}, err
+	}
+
+	if existing.Birthdate != "" && user.Birthdate != existing.Birthdate {
+		return models.Users{}, ErrBirthdateLocked
+	}
+
+	return u.usersRepository.UpdateUser(user)
+}
+
+// Corrects the birthdate of the user with id, for administrators
+func (u usersService) SetBirthdate(id string, birthdate string) (models.Users, error) {
+	log.Println("[UsersService] Set birthdate...")
+
+	if _, err := models.ParseBirthdate(birthdate); err != nil {
+		return models.Users{}, err
+	}
+
+	user, err := u.usersRepository.OneUser(id, models.Users{})
+	if err != nil {
+		return models.Users{}, err
+	}
+
+	user.Birthdate = birthdate
+
 	return u.usersRepository.UpdateUser(user)
 }
 
@@ -2,10 +2,12 @@ package service
 
 import (
 	"testing"
+	"time"
 
 	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
 	"github.com/VolunteerOne/volunteer-one-app/backend/models"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 func TestUsersService_CreateUser(t *testing.T) {
@@ -67,3 +69,110 @@ func TestUsersService_DeleteUser(t *testing.T) {
 	assert.Equal(t, res, user)
 	assert.Nil(t, err)
 }
+
+func TestUsersService_CreateUser_InvalidBirthdate(t *testing.T) {
+	for _, birthdate := range []string{"", "31/12/2000", "2000-13-01", time.Now().AddDate(1, 0, 0).Format("2006-01-02")} {
+		var user models.Users
+		user.Email = "test@email.com"
+		user.Birthdate = birthdate
+
+		// The repository is never reached
+		mockRepo := new(mocks.UsersRepository)
+
+		fromRepo := NewUsersService(mockRepo)
+		_, err := fromRepo.CreateUser(user)
+
+		mockRepo.AssertExpectations(t)
+		assert.NotNil(t, err, birthdate)
+	}
+}
+
+func TestUsersService_UpdateUser(t *testing.T) {
+	var user models.Users
+	user.ID = 4
+	user.Birthdate = "2010-05-01"
+	user.FirstName = "Ada"
+
+	var existing models.Users
+	existing.ID = 4
+	existing.Birthdate = "2010-05-01"
+
+	mockRepo := new(mocks.UsersRepository)
+	mockRepo.On("OneUser", "4", models.Users{}).Return(existing, nil)
+	mockRepo.On("UpdateUser", user).Return(user, nil)
+
+	res, err := NewUsersService(mockRepo).UpdateUser(user)
+
+	mockRepo.AssertExpectations(t)
+	assert.Equal(t, user, res)
+	assert.Nil(t, err)
+}
+
+func TestUsersService_UpdateUser_FirstBirthdate(t *testing.T) {
+	var user models.Users
+	user.ID = 4
+	user.Birthdate = "2010-05-01"
+
+	var existing models.Users
+	existing.ID = 4
+
+	// Users from before birthdates were required can set theirs
+	mockRepo := new(mocks.UsersRepository)
+	mockRepo.On("OneUser", "4", models.Users{}).Return(existing, nil)
+	mockRepo.On("UpdateUser", user).Return(user, nil)
+
+	res, err := NewUsersService(mockRepo).UpdateUser(user)
+
+	mockRepo.AssertExpectations(t)
+	assert.Equal(t, user, res)
+	assert.Nil(t, err)
+}
+
+func TestUsersService_UpdateUser_BirthdateLocked(t *testing.T) {
+	var user models.Users
+	user.ID = 4
+	user.Birthdate = "2000-05-01"
+
+	var existing models.Users
+	existing.ID = 4
+	existing.Birthdate = "2010-05-01"
+
+	// Becoming an adult would get around age limits and guardian consent,
+	// with or without a consent asked for
+	mockRepo := new(mocks.UsersRepository)
+	mockRepo.On("OneUser", "4", models.Users{}).Return(existing, nil)
+
+	_, err := NewUsersService(mockRepo).UpdateUser(user)
+
+	mockRepo.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "UpdateUser", mock.Anything)
+	assert.Equal(t, ErrBirthdateLocked, err)
+}
+
+func TestUsersService_SetBirthdate(t *testing.T) {
+	var existing models.Users
+	existing.ID = 4
+	existing.Birthdate = "2010-05-01"
+
+	updated := existing
+	updated.Birthdate = "2000-05-01"
+
+	mockRepo := new(mocks.UsersRepository)
+	mockRepo.On("OneUser", "4", models.Users{}).Return(existing, nil)
+	mockRepo.On("UpdateUser", updated).Return(updated, nil)
+
+	res, err := NewUsersService(mockRepo).SetBirthdate("4", "2000-05-01")
+
+	mockRepo.AssertExpectations(t)
+	assert.Equal(t, updated, res)
+	assert.Nil(t, err)
+}
+
+func TestUsersService_SetBirthdate_Invalid(t *testing.T) {
+	mockRepo := new(mocks.UsersRepository)
+
+	_, err := NewUsersService(mockRepo).SetBirthdate("4", "someday")
+
+	mockRepo.AssertNotCalled(t, "OneUser", mock.Anything, mock.Anything)
+	assert.NotNil(t, err)
+}
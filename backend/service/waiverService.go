@@ -0,0 +1,325 @@
+package service
+
+import (
+	"errors"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/VolunteerOne/volunteer-one-app/backend/repository"
+)
+
+type WaiverService interface {
+	CreateWaiver(uint, uint, models.Waivers) (models.Waivers, error)
+	GetWaiver(uint) (models.Waivers, error)
+	OrganizationWaivers(uint) ([]models.Waivers, error)
+	UpdateWaiver(uint, uint, string, string) (models.Waivers, error)
+	DeleteWaiver(uint, uint) error
+	WaiverVersions(uint, uint) ([]models.WaiverVersions, error)
+	Accept(uint, uint, uint) (models.WaiverAcceptances, error)
+	Requirements(uint, uint, time.Time) (models.EventRequirements, error)
+	RecordBackgroundCheck(uint, uint, uint, time.Time, *time.Time) (models.BackgroundChecks, error)
+}
+
+type waiverService struct {
+	waiverRepository   repository.WaiverRepository
+	consentRepository  repository.GuardianConsentRepository
+	eventRepository    repository.EventRepository
+	orgUsersRepository repository.OrgUsersRepository
+	usersRepository    repository.UsersRepository
+}
+
+// Instantiated in router.go
+func NewWaiverService(w repository.WaiverRepository, c repository.GuardianConsentRepository, e repository.EventRepository, o repository.OrgUsersRepository, u repository.UsersRepository) WaiverService {
+	return waiverService{
+		waiverRepository:   w,
+		consentRepository:  c,
+		eventRepository:    e,
+		orgUsersRepository: o,
+		usersRepository:    u,
+	}
+}
+
+// Creates a waiver of the organization. With an event set it only applies
+// to that event, or to the whole series of an edited occurrence.
+func (s waiverService) CreateWaiver(orgId uint, managerId uint, waiver models.Waivers) (models.Waivers, error) {
+	log.Println("[WaiverService] Create waiver...")
+
+	if err := requireManager(s.orgUsersRepository, managerId, orgId); err != nil {
+		return models.Waivers{}, err
+	}
+
+	if waiver.Title == "" || waiver.Body == "" {
+		return models.Waivers{}, errors.New("title and body are required")
+	}
+
+	if waiver.EventID != nil {
+		event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(*waiver.EventID), 10))
+		if err != nil || event.OrganizationID != orgId {
+			return models.Waivers{}, errors.New("event not found")
+		}
+
+		seriesId, _ := seriesOccurrence(event, time.Time{})
+		waiver.EventID = &seriesId
+	}
+
+	waiver.OrganizationID = orgId
+
+	return s.waiverRepository.CreateWaiver(waiver)
+}
+
+func (s waiverService) GetWaiver(id uint) (models.Waivers, error) {
+	log.Println("[WaiverService] Get waiver...")
+
+	return s.waiverRepository.GetWaiverById(id)
+}
+
+func (s waiverService) OrganizationWaivers(orgId uint) ([]models.Waivers, error) {
+	log.Println("[WaiverService] Organization waivers...")
+
+	return s.waiverRepository.GetOrganizationWaivers(orgId)
+}
+
+// Publishes a new version of the waiver. Volunteers who accepted an
+// earlier one have to accept it again before signing up.
+func (s waiverService) UpdateWaiver(id uint, managerId uint, title string, body string) (models.Waivers, error) {
+	log.Println("[WaiverService] Update waiver...")
+
+	waiver, err := s.waiverRepository.GetWaiverById(id)
+	if err != nil {
+		return models.Waivers{}, err
+	}
+
+	if err := requireManager(s.orgUsersRepository, managerId, waiver.OrganizationID); err != nil {
+		return models.Waivers{}, err
+	}
+
+	if title == "" || body == "" {
+		return models.Waivers{}, errors.New("title and body are required")
+	}
+
+	if title == waiver.Title && body == waiver.Body {
+		return waiver, nil
+	}
+
+	waiver.Title = title
+	waiver.Body = body
+
+	return s.waiverRepository.UpdateWaiver(waiver)
+}
+
+// Stops requiring the waiver. Its versions and acceptances are kept.
+func (s waiverService) DeleteWaiver(id uint, managerId uint) error {
+	log.Println("[WaiverService] Delete waiver...")
+
+	waiver, err := s.waiverRepository.GetWaiverById(id)
+	if err != nil {
+		return err
+	}
+
+	if err := requireManager(s.orgUsersRepository, managerId, waiver.OrganizationID); err != nil {
+		return err
+	}
+
+	return s.waiverRepository.DeleteWaiver(waiver)
+}
+
+// Lists every version of the waiver to its organization's staff
+func (s waiverService) WaiverVersions(id uint, userId uint) ([]models.WaiverVersions, error) {
+	log.Println("[WaiverService] Waiver versions...")
+
+	waiver, err := s.waiverRepository.GetWaiverById(id)
+	if err != nil {
+		return []models.WaiverVersions{}, err
+	}
+
+	if !isStaff(s.orgUsersRepository, userId, waiver.OrganizationID) {
+		return []models.WaiverVersions{}, ErrNotStaff
+	}
+
+	return s.waiverRepository.GetWaiverVersions(id)
+}
+
+// Records the user accepting the waiver. version is the one they were
+// shown, which must still be the current one.
+func (s waiverService) Accept(id uint, userId uint, version uint) (models.WaiverAcceptances, error) {
+	log.Println("[WaiverService] Accept waiver...")
+
+	waiver, err := s.waiverRepository.GetWaiverById(id)
+	if err != nil {
+		return models.WaiverAcceptances{}, err
+	}
+
+	if version != waiver.Version {
+		return models.WaiverAcceptances{}, errors.New("waiver has changed, review version " +
+			strconv.FormatUint(uint64(waiver.Version), 10) + " before accepting")
+	}
+
+	acceptances, err := s.waiverRepository.GetAcceptances(userId, []uint{id})
+	if err != nil {
+		return models.WaiverAcceptances{}, err
+	}
+
+	for _, acceptance := range acceptances {
+		if acceptance.Version == version {
+			return models.WaiverAcceptances{}, errors.New("already accepted")
+		}
+	}
+
+	return s.waiverRepository.CreateAcceptance(models.WaiverAcceptances{
+		WaiverID:   id,
+		UsersID:    userId,
+		Version:    version,
+		AcceptedAt: time.Now(),
+	})
+}
+
+// What the user needs to sign up to the event and which of it they meet.
+// Age is checked on the day of the occurrence, or today for a recurring
+// event without one.
+func (s waiverService) Requirements(eventId uint, userId uint, occurrence time.Time) (models.EventRequirements, error) {
+	log.Println("[WaiverService] Requirements...")
+
+	event, err := s.eventRepository.GetEventById(strconv.FormatUint(uint64(eventId), 10))
+	if err != nil {
+		return models.EventRequirements{}, err
+	}
+
+	series, start := event, time.Now()
+	if event.SeriesID != nil || event.Recurrence == "" || !occurrence.IsZero() {
+		if series, _, start, err = resolveOccurrence(s.eventRepository, event, occurrence); err != nil {
+			return models.EventRequirements{}, err
+		}
+	}
+
+	return eventRequirements(s.waiverRepository, s.consentRepository, s.usersRepository, series, userId, start)
+}
+
+// Records that the organization cleared the user's background check
+func (s waiverService) RecordBackgroundCheck(orgId uint, managerId uint, userId uint, clearedAt time.Time, expiresAt *time.Time) (models.BackgroundChecks, error) {
+	log.Println("[WaiverService] Record background check...")
+
+	if err := requireManager(s.orgUsersRepository, managerId, orgId); err != nil {
+		return models.BackgroundChecks{}, err
+	}
+
+	if clearedAt.IsZero() {
+		clearedAt = time.Now()
+	}
+
+	if expiresAt != nil && !expiresAt.After(clearedAt) {
+		return models.BackgroundChecks{}, errors.New("expiry must be after clearance")
+	}
+
+	if _, err := s.usersRepository.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{}); err != nil {
+		return models.BackgroundChecks{}, errors.New("user not found")
+	}
+
+	return s.waiverRepository.CreateBackgroundCheck(models.BackgroundChecks{
+		UsersID:        userId,
+		OrganizationID: orgId,
+		ClearedAt:      clearedAt,
+		ExpiresAt:      expiresAt,
+		RecordedBy:     managerId,
+	})
+}
+
+// Checks the user against the waivers, minimum age and background check of
+// the series, for an occurrence starting at start. Minors also need their
+// guardian's consent.
+func eventRequirements(w repository.WaiverRepository, c repository.GuardianConsentRepository, u repository.UsersRepository, series models.Event, userId uint, start time.Time) (models.EventRequirements, error) {
+	req := models.EventRequirements{
+		Waivers:         []models.WaiverRequirement{},
+		MinimumAge:      series.MinimumAge,
+		BackgroundCheck: series.BackgroundCheck,
+	}
+
+	waivers, err := w.GetEventWaivers(series.OrganizationID, series.ID)
+	if err != nil {
+		return models.EventRequirements{}, err
+	}
+
+	waiverIds := []uint{}
+	for _, waiver := range waivers {
+		waiverIds = append(waiverIds, waiver.ID)
+	}
+
+	accepted := map[uint]uint{}
+	if len(waiverIds) > 0 {
+		acceptances, err := w.GetAcceptances(userId, waiverIds)
+		if err != nil {
+			return models.EventRequirements{}, err
+		}
+
+		for _, acceptance := range acceptances {
+			if acceptance.Version > accepted[acceptance.WaiverID] {
+				accepted[acceptance.WaiverID] = acceptance.Version
+			}
+		}
+	}
+
+	req.Met = true
+	for _, waiver := range waivers {
+		r := models.WaiverRequirement{
+			Waiver:          waiver,
+			AcceptedVersion: accepted[waiver.ID],
+			Accepted:        accepted[waiver.ID] == waiver.Version,
+		}
+		req.Met = req.Met && r.Accepted
+		req.Waivers = append(req.Waivers, r)
+	}
+
+	user, err := u.OneUser(strconv.FormatUint(uint64(userId), 10), models.Users{})
+	if err != nil {
+		return models.EventRequirements{}, err
+	}
+
+	// Without a valid birthdate the user cannot show they are old enough,
+	// or an adult
+	age, err := user.Age(start.In(series.Location()))
+	req.Birthdate = err == nil
+	req.OldEnough = req.Birthdate && age >= int(series.MinimumAge)
+	req.Minor = req.Birthdate && age < models.AdultAge
+	req.Met = req.Met && req.Birthdate && req.OldEnough
+
+	if req.Minor {
+		req.GuardianConsent = hasGuardianConsent(c, userId, series.ID)
+		req.Met = req.Met && req.GuardianConsent
+	}
+
+	if series.BackgroundCheck {
+		check, err := w.FindBackgroundCheck(userId, series.OrganizationID)
+		req.HasBackgroundCheck = err == nil && check.ValidAt(start)
+		req.Met = req.Met && req.HasBackgroundCheck
+	}
+
+	return req, nil
+}
+
+// The first requirement the user does not meet, as an error
+func unmetRequirement(req models.EventRequirements) error {
+	for _, waiver := range req.Waivers {
+		if !waiver.Accepted {
+			return errors.New("accept the waiver \"" + waiver.Waiver.Title + "\" before signing up")
+		}
+	}
+
+	if !req.Birthdate {
+		return errors.New("add your birthdate to your profile before signing up")
+	}
+
+	if !req.OldEnough {
+		return errors.New("volunteers must be at least " + strconv.FormatUint(uint64(req.MinimumAge), 10) + " years old")
+	}
+
+	if req.BackgroundCheck && !req.HasBackgroundCheck {
+		return errors.New("a background check is required to sign up")
+	}
+
+	if req.Minor && !req.GuardianConsent {
+		return errors.New("volunteers under 18 need a guardian's consent to sign up")
+	}
+
+	return nil
+}
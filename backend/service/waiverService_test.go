@@ -0,0 +1,219 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/VolunteerOne/volunteer-one-app/backend/mocks"
+	"github.com/VolunteerOne/volunteer-one-app/backend/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type WaiverServiceUnitTestSuite struct {
+	suite.Suite
+	mockWaiverRepo   *mocks.WaiverRepository
+	mockConsentRepo  *mocks.GuardianConsentRepository
+	mockEventRepo    *mocks.EventRepository
+	mockOrgUsersRepo *mocks.OrgUsersRepository
+	mockUsersRepo    *mocks.UsersRepository
+	service          WaiverService
+	waiver           models.Waivers
+	event            models.Event
+	err              error
+}
+
+func (suite *WaiverServiceUnitTestSuite) SetupTest() {
+	suite.mockWaiverRepo = new(mocks.WaiverRepository)
+	suite.mockConsentRepo = new(mocks.GuardianConsentRepository)
+	suite.mockEventRepo = new(mocks.EventRepository)
+	suite.mockOrgUsersRepo = new(mocks.OrgUsersRepository)
+	suite.mockUsersRepo = new(mocks.UsersRepository)
+	suite.service = NewWaiverService(suite.mockWaiverRepo, suite.mockConsentRepo, suite.mockEventRepo, suite.mockOrgUsersRepo, suite.mockUsersRepo)
+
+	// The second version of a waiver of organization 3
+	suite.waiver = models.Waivers{OrganizationID: 3, Title: "Liability", Body: "I take part at my own risk.", Version: 2}
+	suite.waiver.ID = 6
+
+	// A published event of organization 3 next week, for adults only
+	suite.event = models.Event{}
+	suite.event.ID = 1
+	suite.event.OrganizationID = 3
+	suite.event.Status = models.EventPublished
+	suite.event.Start = time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 7)
+	suite.event.MinimumAge = 18
+
+	suite.err = fmt.Errorf("error")
+}
+
+func (suite *WaiverServiceUnitTestSuite) AfterTest(_, _ string) {
+	suite.mockWaiverRepo.AssertExpectations(suite.T())
+	suite.mockConsentRepo.AssertExpectations(suite.T())
+	suite.mockEventRepo.AssertExpectations(suite.T())
+	suite.mockOrgUsersRepo.AssertExpectations(suite.T())
+	suite.mockUsersRepo.AssertExpectations(suite.T())
+}
+
+func TestWaiverServiceUnitTestSuite(t *testing.T) {
+	suite.Run(t, new(WaiverServiceUnitTestSuite))
+}
+
+func (suite *WaiverServiceUnitTestSuite) manager(role uint) {
+	suite.mockOrgUsersRepo.On("FindOrgUser", uint(2), uint(3)).Return(models.OrgUsers{Role: role}, nil)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_CreateWaiver_ForEditedOccurrence() {
+	// Waivers of an edited occurrence apply to its whole series
+	occurrence := suite.event.Start
+	var edited models.Event
+	edited.ID = 5
+	edited.OrganizationID = 3
+	edited.SeriesID = &suite.event.ID
+	edited.OccurrenceDate = &occurrence
+
+	suite.manager(models.RoleManager)
+	suite.mockEventRepo.On("GetEventById", "5").Return(edited, nil)
+	suite.mockWaiverRepo.On("CreateWaiver", mock.MatchedBy(func(w models.Waivers) bool {
+		return w.OrganizationID == 3 && w.EventID != nil && *w.EventID == 1
+	})).Return(suite.waiver, nil)
+
+	eventId := uint(5)
+	_, err := suite.service.CreateWaiver(3, 2, models.Waivers{EventID: &eventId, Title: "Liability", Body: "Risk"})
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_CreateWaiver_OtherOrganizationsEvent() {
+	event := suite.event
+	event.OrganizationID = 4
+
+	suite.manager(models.RoleOwner)
+	suite.mockEventRepo.On("GetEventById", "1").Return(event, nil)
+
+	eventId := uint(1)
+	_, err := suite.service.CreateWaiver(3, 2, models.Waivers{EventID: &eventId, Title: "Liability", Body: "Risk"})
+
+	assert.EqualError(suite.T(), err, "event not found")
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_CreateWaiver_NotManager() {
+	suite.manager(models.RoleMember)
+
+	_, err := suite.service.CreateWaiver(3, 2, models.Waivers{Title: "Liability", Body: "Risk"})
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_UpdateWaiver() {
+	suite.manager(models.RoleManager)
+	suite.mockWaiverRepo.On("GetWaiverById", uint(6)).Return(suite.waiver, nil)
+	suite.mockWaiverRepo.On("UpdateWaiver", mock.MatchedBy(func(w models.Waivers) bool {
+		return w.Body == "Updated" && w.Version == 2
+	})).Return(suite.waiver, nil)
+
+	_, err := suite.service.UpdateWaiver(6, 2, "Liability", "Updated")
+
+	assert.Nil(suite.T(), err)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_UpdateWaiver_Unchanged() {
+	// Saving the same text does not ask everyone to accept again
+	suite.manager(models.RoleManager)
+	suite.mockWaiverRepo.On("GetWaiverById", uint(6)).Return(suite.waiver, nil)
+
+	res, err := suite.service.UpdateWaiver(6, 2, suite.waiver.Title, suite.waiver.Body)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), res.Version)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_Accept() {
+	suite.mockWaiverRepo.On("GetWaiverById", uint(6)).Return(suite.waiver, nil)
+	suite.mockWaiverRepo.On("GetAcceptances", uint(4), []uint{6}).
+		Return([]models.WaiverAcceptances{{WaiverID: 6, UsersID: 4, Version: 1}}, nil)
+	suite.mockWaiverRepo.On("CreateAcceptance", mock.MatchedBy(func(a models.WaiverAcceptances) bool {
+		return a.WaiverID == 6 && a.UsersID == 4 && a.Version == 2 && !a.AcceptedAt.IsZero()
+	})).Return(func(a models.WaiverAcceptances) models.WaiverAcceptances {
+		return a
+	}, nil)
+
+	res, err := suite.service.Accept(6, 4, 2)
+
+	assert.Nil(suite.T(), err)
+	assert.Equal(suite.T(), uint(2), res.Version)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_Accept_OutdatedVersion() {
+	suite.mockWaiverRepo.On("GetWaiverById", uint(6)).Return(suite.waiver, nil)
+
+	_, err := suite.service.Accept(6, 4, 1)
+
+	assert.EqualError(suite.T(), err, "waiver has changed, review version 2 before accepting")
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_Accept_Twice() {
+	suite.mockWaiverRepo.On("GetWaiverById", uint(6)).Return(suite.waiver, nil)
+	suite.mockWaiverRepo.On("GetAcceptances", uint(4), []uint{6}).
+		Return([]models.WaiverAcceptances{{WaiverID: 6, UsersID: 4, Version: 2}}, nil)
+
+	_, err := suite.service.Accept(6, 4, 2)
+
+	assert.EqualError(suite.T(), err, "already accepted")
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_Requirements() {
+	event := suite.event
+	event.BackgroundCheck = true
+
+	// 18 on the day of the event, with a background check that never expires
+	birthday := event.Start.AddDate(-18, 0, 0)
+
+	suite.mockEventRepo.On("GetEventById", "1").Return(event, nil)
+	suite.mockWaiverRepo.On("GetEventWaivers", uint(3), uint(1)).Return([]models.Waivers{suite.waiver}, nil)
+	suite.mockWaiverRepo.On("GetAcceptances", uint(4), []uint{6}).
+		Return([]models.WaiverAcceptances{{WaiverID: 6, UsersID: 4, Version: 2}}, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).
+		Return(models.Users{Birthdate: birthday.Format("2006-01-02")}, nil)
+	suite.mockWaiverRepo.On("FindBackgroundCheck", uint(4), uint(3)).
+		Return(models.BackgroundChecks{UsersID: 4, OrganizationID: 3}, nil)
+
+	req, err := suite.service.Requirements(1, 4, time.Time{})
+
+	assert.Nil(suite.T(), err)
+	assert.True(suite.T(), req.Met)
+	assert.True(suite.T(), req.Waivers[0].Accepted)
+	assert.True(suite.T(), req.OldEnough)
+	assert.True(suite.T(), req.HasBackgroundCheck)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_Requirements_NoBirthdate() {
+	suite.mockEventRepo.On("GetEventById", "1").Return(suite.event, nil)
+	suite.mockWaiverRepo.On("GetEventWaivers", uint(3), uint(1)).Return([]models.Waivers{}, nil)
+	suite.mockUsersRepo.On("OneUser", "4", models.Users{}).Return(models.Users{}, nil)
+
+	req, err := suite.service.Requirements(1, 4, time.Time{})
+
+	assert.Nil(suite.T(), err)
+	assert.False(suite.T(), req.OldEnough)
+	assert.False(suite.T(), req.Met)
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_RecordBackgroundCheck_ExpiresBeforeCleared() {
+	cleared := time.Now()
+	expires := cleared.Add(-time.Hour)
+	suite.manager(models.RoleManager)
+
+	_, err := suite.service.RecordBackgroundCheck(3, 2, 4, cleared, &expires)
+
+	assert.EqualError(suite.T(), err, "expiry must be after clearance")
+}
+
+func (suite *WaiverServiceUnitTestSuite) TestWaiverService_RecordBackgroundCheck_NotManager() {
+	suite.manager(models.RoleMember)
+
+	_, err := suite.service.RecordBackgroundCheck(3, 2, 4, time.Now(), nil)
+
+	assert.ErrorIs(suite.T(), err, ErrNotManager)
+}